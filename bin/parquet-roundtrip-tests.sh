@@ -0,0 +1,15 @@
+#!/bin/bash
+
+set -e
+
+# reads files written by ticks.ParquetWriter with a real Parquet library
+# the tests are a separate module as the library requires a newer Go version than the main module
+go_minor=$(go version | sed -E 's/.* go1\.([0-9]+).*/\1/')
+if [[ "$go_minor" =~ ^[0-9]+$ ]] && [ "$go_minor" -lt 24 ]; then
+	echo "skipping Parquet round-trip tests, Go 1.24+ required"
+	exit 0
+fi
+
+pushd ticks/roundtrip
+go test -v ./...
+popd
@@ -0,0 +1,117 @@
+package demoinfocs
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// clutch is an ongoing clutch situation.
+type clutch struct {
+	player *common.Player
+	team   common.Team
+}
+
+// updateClutch dispatches events.ClutchStarted if the kill left a player alone against one or more opponents.
+// Only the first clutch situation of a round is tracked.
+func (p *Parser) updateClutch(kill events.Kill) {
+	if kill.Victim != nil {
+		// The victim's health may not have been updated yet
+		p.killedThisRound[kill.Victim] = true
+	}
+
+	if p.clutch != nil {
+		return
+	}
+
+	// The victim's team is checked first, for a 1v1 the clutch belongs to the team that just lost a player
+	teams := [2]common.Team{common.TeamTerrorists, common.TeamCounterTerrorists}
+	if kill.Victim != nil && kill.Victim.Team == common.TeamCounterTerrorists {
+		teams[0], teams[1] = teams[1], teams[0]
+	}
+
+	controllers := p.botControllers()
+
+	for _, team := range teams {
+		alive := p.alivePlayers(team, controllers)
+		opponents := p.alivePlayers(otherTeam(team), controllers)
+
+		if len(alive) == 1 && len(opponents) > 0 {
+			p.startClutch(alive[0], team, opponents)
+			return
+		}
+	}
+}
+
+func otherTeam(team common.Team) common.Team {
+	if team == common.TeamTerrorists {
+		return common.TeamCounterTerrorists
+	}
+
+	return common.TeamTerrorists
+}
+
+func (p *Parser) startClutch(pl *common.Player, team common.Team, opponents []*common.Player) {
+	p.clutch = &clutch{
+		player: pl,
+		team:   team,
+	}
+
+	p.eventDispatcher.Dispatch(events.ClutchStarted{
+		Player:    pl,
+		Opponents: opponents,
+	})
+}
+
+// endClutch dispatches events.ClutchEnded if there is an ongoing clutch situation.
+func (p *Parser) endClutch(winner common.Team) {
+	if p.clutch != nil {
+		p.eventDispatcher.Dispatch(events.ClutchEnded{
+			Player: p.clutch.player,
+			Won:    p.clutch.team == winner,
+		})
+	}
+
+	p.resetClutch()
+}
+
+func (p *Parser) resetClutch() {
+	p.clutch = nil
+	p.killedThisRound = make(map[*common.Player]bool)
+}
+
+// botControllers maps bots to the players that are controlling them.
+func (p *Parser) botControllers() map[*common.Player]*common.Player {
+	controllers := make(map[*common.Player]*common.Player)
+
+	for _, pl := range p.gameState.Participants().Playing() {
+		if !pl.IsControllingBot() {
+			continue
+		}
+
+		if bot := pl.ControlledBot(); bot != nil {
+			controllers[bot] = pl
+		}
+	}
+
+	return controllers
+}
+
+// alivePlayers returns the connected players of a team that are still alive.
+// Bots that are controlled by a player are replaced by the controlling player.
+func (p *Parser) alivePlayers(team common.Team, controllers map[*common.Player]*common.Player) []*common.Player {
+	var alive []*common.Player
+
+	for _, pl := range p.gameState.Participants().TeamMembers(team) {
+		if !pl.IsAlive() || p.killedThisRound[pl] || pl.IsControllingBot() {
+			continue
+		}
+
+		if controller := controllers[pl]; controller != nil {
+			pl = controller
+		}
+
+		alive = append(alive, pl)
+	}
+
+	return alive
+}
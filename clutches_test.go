@@ -0,0 +1,156 @@
+package demoinfocs
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+	st "github.com/markus-wa/demoinfocs-golang/sendtables"
+	fakest "github.com/markus-wa/demoinfocs-golang/sendtables/fake"
+)
+
+type clutchTest struct {
+	parser   *Parser
+	started  []events.ClutchStarted
+	ended    []events.ClutchEnded
+	nextUser int
+}
+
+func newClutchTest() *clutchTest {
+	ct := &clutchTest{parser: NewParser(rand.Reader)}
+
+	ct.parser.RegisterEventHandler(func(e events.ClutchStarted) {
+		ct.started = append(ct.started, e)
+	})
+	ct.parser.RegisterEventHandler(func(e events.ClutchEnded) {
+		ct.ended = append(ct.ended, e)
+	})
+
+	return ct
+}
+
+// addPlayer adds a connected & alive player, controlledBot is the entity-ID of the bot the player controls (0 for none).
+func (ct *clutchTest) addPlayer(team common.Team, controlledBot int) *common.Player {
+	ct.nextUser++
+
+	controlling := st.PropertyValue{}
+	if controlledBot != 0 {
+		controlling.IntVal = 1
+	}
+
+	isControllingBot := new(fakest.Property)
+	isControllingBot.On("Value").Return(controlling)
+	controlledBotIndex := new(fakest.Property)
+	controlledBotIndex.On("Value").Return(st.PropertyValue{IntVal: controlledBot})
+
+	entity := new(fakest.Entity)
+	entity.On("FindPropertyI", "m_bIsControllingBot").Return(isControllingBot)
+	entity.On("FindPropertyI", "m_iControlledBotEntIndex").Return(controlledBotIndex)
+
+	pl := common.NewPlayer(ct.parser.demoInfoProvider)
+	pl.UserID = ct.nextUser
+	pl.EntityID = ct.nextUser
+	pl.Entity = entity
+	pl.IsConnected = true
+	pl.Team = team
+	pl.Hp = 100
+
+	ct.parser.gameState.playersByUserID[pl.UserID] = pl
+	ct.parser.gameState.playersByEntityID[pl.EntityID] = pl
+
+	return pl
+}
+
+func (ct *clutchTest) kill(killer, victim *common.Player) {
+	ct.parser.updateClutch(events.Kill{Killer: killer, Victim: victim})
+}
+
+func TestClutch_Won(t *testing.T) {
+	ct := newClutchTest()
+
+	t1 := ct.addPlayer(common.TeamTerrorists, 0)
+	t2 := ct.addPlayer(common.TeamTerrorists, 0)
+	ct1 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+	ct2 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+	ct3 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+
+	ct.kill(ct1, t1)
+	assert.Len(t, ct.started, 1)
+	assert.Equal(t, t2, ct.started[0].Player)
+	assert.ElementsMatch(t, []*common.Player{ct1, ct2, ct3}, ct.started[0].Opponents)
+
+	ct.kill(t2, ct1)
+	ct.kill(t2, ct2)
+	assert.Len(t, ct.started, 1, "only the first clutch of a round should be reported")
+
+	ct.parser.endClutch(common.TeamTerrorists)
+	assert.Equal(t, []events.ClutchEnded{{Player: t2, Won: true}}, ct.ended)
+
+	ct.parser.endClutch(common.TeamTerrorists)
+	assert.Len(t, ct.ended, 1)
+}
+
+func TestClutch_Lost(t *testing.T) {
+	ct := newClutchTest()
+
+	t1 := ct.addPlayer(common.TeamTerrorists, 0)
+	ct1 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+	ct2 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+
+	ct.kill(t1, ct1)
+	assert.Equal(t, []events.ClutchStarted{{Player: ct2, Opponents: []*common.Player{t1}}}, ct.started)
+
+	ct.parser.endClutch(common.TeamTerrorists)
+	assert.Equal(t, []events.ClutchEnded{{Player: ct2, Won: false}}, ct.ended)
+}
+
+func TestClutch_IgnoresDisconnected(t *testing.T) {
+	ct := newClutchTest()
+
+	t1 := ct.addPlayer(common.TeamTerrorists, 0)
+	t2 := ct.addPlayer(common.TeamTerrorists, 0)
+	ct1 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+	ct2 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+	ct.addPlayer(common.TeamCounterTerrorists, 0).IsConnected = false
+
+	ct.kill(t1, ct1)
+	assert.Len(t, ct.started, 1)
+	assert.Equal(t, ct2, ct.started[0].Player)
+	assert.ElementsMatch(t, []*common.Player{t1, t2}, ct.started[0].Opponents)
+}
+
+func TestClutch_BotTakenOver(t *testing.T) {
+	ct := newClutchTest()
+
+	t1 := ct.addPlayer(common.TeamTerrorists, 0)
+	bot := ct.addPlayer(common.TeamTerrorists, 0)
+	bot.IsBot = true
+	taker := ct.addPlayer(common.TeamTerrorists, bot.EntityID)
+	taker.Hp = 0
+	ct1 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+	ct.addPlayer(common.TeamCounterTerrorists, 0)
+
+	ct.kill(ct1, t1)
+
+	assert.Len(t, ct.started, 1)
+	assert.Equal(t, taker, ct.started[0].Player)
+}
+
+func TestClutch_ResetOnRoundStart(t *testing.T) {
+	ct := newClutchTest()
+
+	t1 := ct.addPlayer(common.TeamTerrorists, 0)
+	ct1 := ct.addPlayer(common.TeamCounterTerrorists, 0)
+	ct.addPlayer(common.TeamCounterTerrorists, 0)
+
+	ct.kill(t1, ct1)
+	ct.parser.resetClutch()
+	ct.parser.endClutch(common.TeamTerrorists)
+
+	assert.Len(t, ct.started, 1)
+	assert.Empty(t, ct.ended)
+	assert.Empty(t, ct.parser.killedThisRound)
+}
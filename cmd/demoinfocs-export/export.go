@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"time"
+	"unicode"
+
+	"github.com/golang/geo/r3"
+
+	dem "github.com/markus-wa/demoinfocs-golang"
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+	"github.com/markus-wa/demoinfocs-golang/msg"
+)
+
+// line is a single line of the output.
+type line struct {
+	Type  string      `json:"type"`  // Name of the event type, e.g. 'Kill'
+	Frame int         `json:"frame"` // Demo frame at which the event was dispatched
+	Tick  int         `json:"tick"`  // Ingame tick at which the event was dispatched
+	Time  float64     `json:"time"`  // Time since the start of the demo in seconds
+	Data  interface{} `json:"data"`  // The event, see eventData()
+}
+
+// playerRef is the flattened representation of a *common.Player.
+type playerRef struct {
+	SteamID int64  `json:"steamId"`
+	UserID  int    `json:"userId"`
+	Name    string `json:"name"`
+}
+
+// ballot is a single vote of common.Vote.Votes.
+type ballot struct {
+	Player interface{}       `json:"player"` // See playerRef
+	Option common.VoteOption `json:"option"`
+}
+
+// exporter writes all events dispatched by a parser as JSON lines.
+type exporter struct {
+	parser dem.IParser
+	w      *bufio.Writer
+	enc    *json.Encoder
+	filter map[string]bool // Event types to export, nil means all
+	err    error
+}
+
+func newExporter(parser dem.IParser, w io.Writer, filter []string) *exporter {
+	bw := bufio.NewWriter(w)
+
+	ex := &exporter{
+		parser: parser,
+		w:      bw,
+		enc:    json.NewEncoder(bw),
+	}
+
+	if len(filter) > 0 {
+		ex.filter = make(map[string]bool, len(filter))
+		for _, name := range filter {
+			ex.filter[name] = true
+		}
+	}
+
+	parser.RegisterEventHandler(ex.handleEvent)
+
+	return ex
+}
+
+func (ex *exporter) handleEvent(e interface{}) {
+	if ex.err != nil {
+		return
+	}
+
+	t := reflect.TypeOf(e)
+	if t.PkgPath() != reflect.TypeOf(events.Kill{}).PkgPath() {
+		return
+	}
+
+	if ex.filter != nil && !ex.filter[t.Name()] {
+		return
+	}
+
+	ex.err = ex.enc.Encode(line{
+		Type:  t.Name(),
+		Frame: ex.parser.CurrentFrame(),
+		Tick:  ex.parser.GameState().IngameTick(),
+		Time:  ex.parser.CurrentTime().Seconds(),
+		Data:  eventData(e),
+	})
+}
+
+// flush writes any buffered lines and returns the first error that occurred while exporting.
+func (ex *exporter) flush() error {
+	if ex.err != nil {
+		return ex.err
+	}
+
+	return ex.w.Flush()
+}
+
+/*
+eventData converts an event into a JSON friendly representation with stable field names.
+
+Field names are the Go field names in lowerCamelCase, fields of embedded structs are flattened.
+Players are replaced by their SteamID, UserID and name, equipment by its type and entity-ID.
+The votes of a common.Vote are written as a list of players & options, ordered by UserID.
+Durations are converted to seconds and other enums are written as numbers.
+*/
+func eventData(e interface{}) interface{} {
+	return jsonValue(reflect.ValueOf(e))
+}
+
+type converter func(v reflect.Value) interface{}
+
+// converters contains the types that aren't converted field by field.
+// Pointer types are only converted if they are not nil.
+var converters map[reflect.Type]converter
+
+func init() {
+	converters = map[reflect.Type]converter{
+		reflect.TypeOf((*common.Player)(nil)): func(v reflect.Value) interface{} {
+			pl := v.Interface().(*common.Player)
+			return playerRef{SteamID: pl.SteamID, UserID: pl.UserID, Name: pl.Name}
+		},
+		reflect.TypeOf(common.Equipment{}): func(v reflect.Value) interface{} {
+			eq := v.Interface().(common.Equipment)
+			return map[string]interface{}{
+				"entityId": eq.EntityID,
+				"type":     eq.Weapon.String(),
+				"owner":    jsonValue(reflect.ValueOf(eq.Owner)),
+			}
+		},
+		reflect.TypeOf((*common.TeamState)(nil)): func(v reflect.Value) interface{} {
+			ts := v.Interface().(*common.TeamState)
+			return map[string]interface{}{
+				"team":     ts.Team(),
+				"id":       ts.ID,
+				"score":    ts.Score,
+				"clanName": ts.ClanName,
+			}
+		},
+		reflect.TypeOf((*common.GrenadeProjectile)(nil)): func(v reflect.Value) interface{} {
+			proj := v.Interface().(*common.GrenadeProjectile)
+			return map[string]interface{}{
+				"entityId": proj.EntityID,
+				"weapon":   jsonValue(reflect.ValueOf(proj.WeaponInstance)),
+				"thrower":  jsonValue(reflect.ValueOf(proj.Thrower)),
+				"position": jsonValue(reflect.ValueOf(proj.Position)),
+			}
+		},
+		reflect.TypeOf((*common.Inferno)(nil)): func(v reflect.Value) interface{} {
+			return map[string]interface{}{
+				"entityId": v.Interface().(*common.Inferno).EntityID,
+			}
+		},
+		reflect.TypeOf(map[*common.Player]common.VoteOption(nil)): func(v reflect.Value) interface{} {
+			votes := v.Interface().(map[*common.Player]common.VoteOption)
+
+			voters := make([]*common.Player, 0, len(votes))
+			for pl := range votes {
+				voters = append(voters, pl)
+			}
+
+			sort.Slice(voters, func(i, j int) bool {
+				return voters[i].UserID < voters[j].UserID
+			})
+
+			ballots := make([]ballot, 0, len(voters))
+			for _, pl := range voters {
+				ballots = append(ballots, ballot{Player: jsonValue(reflect.ValueOf(pl)), Option: votes[pl]})
+			}
+
+			return ballots
+		},
+		reflect.TypeOf(common.EqUnknown): func(v reflect.Value) interface{} {
+			return v.Interface().(common.EquipmentElement).String()
+		},
+		reflect.TypeOf(r3.Vector{}): func(v reflect.Value) interface{} {
+			vec := v.Interface().(r3.Vector)
+			return map[string]float64{"x": vec.X, "y": vec.Y, "z": vec.Z}
+		},
+		reflect.TypeOf(time.Duration(0)): func(v reflect.Value) interface{} {
+			return v.Interface().(time.Duration).Seconds()
+		},
+		reflect.TypeOf(events.BombsiteA): func(v reflect.Value) interface{} {
+			if v.Int() == 0 {
+				return ""
+			}
+			return string(rune(v.Int()))
+		},
+		reflect.TypeOf((*msg.CSVCMsg_GameEventKeyT)(nil)): func(v reflect.Value) interface{} {
+			return gameEventKeyValue(v.Interface().(*msg.CSVCMsg_GameEventKeyT))
+		},
+	}
+}
+
+func jsonValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if conv, ok := converters[v.Type()]; ok {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil
+		}
+		return conv(v)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return jsonValue(v.Elem())
+
+	case reflect.Struct:
+		m := make(map[string]interface{})
+		structFields(v, m)
+		return m
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		s := make([]interface{}, v.Len())
+		for i := range s {
+			s[i] = jsonValue(v.Index(i))
+		}
+		return s
+
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			m[fmt.Sprint(k.Interface())] = jsonValue(v.MapIndex(k))
+		}
+		return m
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	}
+
+	return v.Interface()
+}
+
+func structFields(v reflect.Value, m map[string]interface{}) {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// Unexported
+			continue
+		}
+
+		if _, hasConverter := converters[f.Type]; f.Anonymous && f.Type.Kind() == reflect.Struct && !hasConverter {
+			structFields(v.Field(i), m)
+			continue
+		}
+
+		m[lowerCamelCase(f.Name)] = jsonValue(v.Field(i))
+	}
+}
+
+// Values of CSVCMsg_GameEventKeyT.Type
+const (
+	gameEventKeyTypeString = iota + 1
+	gameEventKeyTypeFloat
+	gameEventKeyTypeLong
+	gameEventKeyTypeShort
+	gameEventKeyTypeByte
+	gameEventKeyTypeBool
+	gameEventKeyTypeUint64
+	gameEventKeyTypeWString
+)
+
+func gameEventKeyValue(key *msg.CSVCMsg_GameEventKeyT) interface{} {
+	switch key.GetType() {
+	case gameEventKeyTypeString:
+		return key.GetValString()
+	case gameEventKeyTypeFloat:
+		return key.GetValFloat()
+	case gameEventKeyTypeLong:
+		return key.GetValLong()
+	case gameEventKeyTypeShort:
+		return key.GetValShort()
+	case gameEventKeyTypeByte:
+		return key.GetValByte()
+	case gameEventKeyTypeBool:
+		return key.GetValBool()
+	case gameEventKeyTypeUint64:
+		return key.GetValUint64()
+	case gameEventKeyTypeWString:
+		return string(key.GetValWstring())
+	}
+
+	return nil
+}
+
+// lowerCamelCase converts a Go identifier to lowerCamelCase where acronyms are treated like normal words,
+// e.g. 'Kill' -> 'kill', 'SteamID' -> 'steamId' and 'HLTVPosition' -> 'hltvPosition'.
+func lowerCamelCase(s string) string {
+	in := []rune(s)
+	out := make([]rune, len(in))
+
+	for i, r := range in {
+		isWordStart := i == 0 || !unicode.IsUpper(in[i-1]) ||
+			(i+1 < len(in) && unicode.IsLower(in[i+1]))
+
+		if i > 0 && isWordStart {
+			out[i] = r
+		} else {
+			out[i] = unicode.ToLower(r)
+		}
+	}
+
+	return string(out)
+}
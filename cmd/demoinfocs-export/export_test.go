@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+	"github.com/markus-wa/demoinfocs-golang/fake"
+	"github.com/markus-wa/demoinfocs-golang/msg"
+)
+
+func newFakeParser() *fake.Parser {
+	p := fake.NewParser()
+	gs := new(fake.GameState)
+
+	p.On("GameState").Return(gs)
+	p.On("CurrentFrame").Return(64)
+	p.On("CurrentTime").Return(time.Second)
+	p.On("ParseToEnd").Return(nil)
+	gs.On("IngameTick").Return(128)
+
+	return p
+}
+
+func decodeLines(t *testing.T, b []byte) []map[string]interface{} {
+	var res []map[string]interface{}
+
+	for _, l := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		var m map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(l), &m))
+		res = append(res, m)
+	}
+
+	return res
+}
+
+func TestExporter(t *testing.T) {
+	p := newFakeParser()
+
+	killer := &common.Player{Name: "killer", SteamID: 76561198000000001, UserID: 2}
+	p.MockEvents(events.Kill{
+		Killer:     killer,
+		Weapon:     &common.Equipment{Weapon: common.EqAK47, EntityID: 5, Owner: killer},
+		IsHeadshot: true,
+	})
+	p.MockEvents(events.RoundStart{TimeLimit: 115})
+
+	var buf bytes.Buffer
+	ex := newExporter(p, &buf, nil)
+
+	assert.NoError(t, p.ParseToEnd())
+	assert.NoError(t, ex.flush())
+
+	lines := decodeLines(t, buf.Bytes())
+	assert.Len(t, lines, 2)
+
+	kill := lines[0]
+	assert.Equal(t, "Kill", kill["type"])
+	assert.Equal(t, float64(64), kill["frame"])
+	assert.Equal(t, float64(128), kill["tick"])
+	assert.Equal(t, float64(1), kill["time"])
+
+	killerRef := map[string]interface{}{"steamId": float64(76561198000000001), "userId": float64(2), "name": "killer"}
+	assert.Equal(t, map[string]interface{}{
+		"victim":            nil,
+		"killer":            killerRef,
+		"assister":          nil,
+		"isHeadshot":        true,
+		"penetratedObjects": float64(0),
+		"weapon": map[string]interface{}{
+			"entityId": float64(5),
+			"type":     "AK-47",
+			"owner":    killerRef,
+		},
+	}, kill["data"])
+
+	assert.Equal(t, "RoundStart", lines[1]["type"])
+}
+
+func TestExporter_Filter(t *testing.T) {
+	p := newFakeParser()
+
+	p.MockEvents(events.Kill{}, events.RoundStart{}, events.RoundEnd{})
+
+	var buf bytes.Buffer
+	ex := newExporter(p, &buf, []string{"RoundEnd"})
+
+	assert.NoError(t, p.ParseToEnd())
+	assert.NoError(t, ex.flush())
+
+	lines := decodeLines(t, buf.Bytes())
+	assert.Len(t, lines, 1)
+	assert.Equal(t, "RoundEnd", lines[0]["type"])
+}
+
+func TestEventData_Embedded(t *testing.T) {
+	data := eventData(events.BombPlanted{BombEvent: events.BombEvent{Site: events.BombsiteB}})
+
+	assert.Equal(t, map[string]interface{}{"player": nil, "site": "B"}, data)
+}
+
+func TestEventData_GenericGameEvent(t *testing.T) {
+	data := eventData(events.GenericGameEvent{
+		Name: "player_footstep",
+		Data: map[string]*msg.CSVCMsg_GameEventKeyT{
+			"userid": {Type: gameEventKeyTypeShort, ValShort: 3},
+			"text":   {Type: gameEventKeyTypeString, ValString: "hi"},
+		},
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"name": "player_footstep",
+		"data": map[string]interface{}{
+			"userid": int32(3),
+			"text":   "hi",
+		},
+	}, data)
+}
+
+func TestEventData_Votes(t *testing.T) {
+	yes := &common.Player{Name: "yes", SteamID: 76561198000000002, UserID: 3}
+	no := &common.Player{Name: "no", SteamID: 76561198000000001, UserID: 2}
+	vote := &common.Vote{
+		Votes: map[*common.Player]common.VoteOption{
+			yes: common.VoteOptionYes,
+			no:  common.VoteOptionNo,
+		},
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	assert.NoError(t, enc.Encode(eventData(events.VoteCast{Player: yes, Vote: vote})))
+
+	var data map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &data))
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"player": map[string]interface{}{"steamId": float64(76561198000000001), "userId": float64(2), "name": "no"},
+			"option": float64(common.VoteOptionNo),
+		},
+		map[string]interface{}{
+			"player": map[string]interface{}{"steamId": float64(76561198000000002), "userId": float64(3), "name": "yes"},
+			"option": float64(common.VoteOptionYes),
+		},
+	}
+	assert.Equal(t, expected, data["vote"].(map[string]interface{})["votes"])
+}
+
+func TestLowerCamelCase(t *testing.T) {
+	assert.Equal(t, "kill", lowerCamelCase("Kill"))
+	assert.Equal(t, "steamId", lowerCamelCase("SteamID"))
+	assert.Equal(t, "id", lowerCamelCase("ID"))
+	assert.Equal(t, "hltvPosition", lowerCamelCase("HLTVPosition"))
+	assert.Equal(t, "isHeadshot", lowerCamelCase("IsHeadshot"))
+}
+
+func TestRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test")
+	}
+
+	out := filepath.Join(os.TempDir(), "demoinfocs-export-test.jsonl")
+	defer os.Remove(out)
+
+	err := run([]string{"-demo", "../../test/cs-demos/default.dem", "-events", "Kill, RoundEnd", "-out", out}, nil)
+	assert.NoError(t, err)
+
+	b, err := ioutil.ReadFile(out)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, decodeLines(t, b))
+}
+
+func TestRun_MissingDemo(t *testing.T) {
+	assert.Error(t, run(nil, nil))
+}
@@ -0,0 +1,90 @@
+/*
+Command demoinfocs-export parses a demo and writes all events as JSON Lines (one JSON object per line).
+
+Usage:
+
+	demoinfocs-export -demo /path/to/demo.dem [-events Kill,RoundEnd] [-out events.jsonl]
+
+Each line looks like this:
+
+	{"type":"Kill","frame":1234,"tick":2468,"time":19.28,"data":{"victim":{"steamId":76561198000000000,"userId":2,"name":"..."},...}}
+
+'type' is the name of the event type in the events package and 'time' is the time since the start of the demo in seconds.
+The field names of 'data' are the field names of the event in lowerCamelCase.
+Players are written as objects containing their SteamID, UserID and name.
+The votes of a vote are written as a list of objects containing the player and the option.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	dem "github.com/markus-wa/demoinfocs-golang"
+)
+
+func main() {
+	err := run(os.Args[1:], os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	fl := flag.NewFlagSet("demoinfocs-export", flag.ContinueOnError)
+
+	demoPath := fl.String("demo", "", "Demo file `path`")
+	eventTypes := fl.String("events", "", "Comma separated `list` of event types to export (e.g. 'Kill,RoundEnd'), all if empty")
+	outPath := fl.String("out", "", "Output file `path`, stdout if empty")
+
+	err := fl.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if *demoPath == "" {
+		return fmt.Errorf("missing -demo")
+	}
+
+	f, err := os.Open(*demoPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	out := stdout
+	if *outPath != "" {
+		outFile, err := os.Create(*outPath)
+		if err != nil {
+			return err
+		}
+		defer outFile.Close()
+
+		out = outFile
+	}
+
+	var filter []string
+	if *eventTypes != "" {
+		for _, t := range strings.Split(*eventTypes, ",") {
+			filter = append(filter, strings.TrimSpace(t))
+		}
+	}
+
+	p := dem.NewParser(f)
+
+	ex := newExporter(p, out, filter)
+
+	err = p.ParseToEnd()
+
+	// Write what we have, even if parsing failed
+	flushErr := ex.flush()
+	if err != nil {
+		return err
+	}
+
+	return flushErr
+}
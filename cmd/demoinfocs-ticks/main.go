@@ -0,0 +1,75 @@
+/*
+Command demoinfocs-ticks writes the state of all players every N ticks to a Parquet file.
+
+Usage:
+
+	demoinfocs-ticks -demo /path/to/demo.dem -out ticks.parquet [-interval 16]
+
+See the ticks package for the columns of the file.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	dem "github.com/markus-wa/demoinfocs-golang"
+	"github.com/markus-wa/demoinfocs-golang/ticks"
+)
+
+func main() {
+	err := run(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fl := flag.NewFlagSet("demoinfocs-ticks", flag.ContinueOnError)
+
+	demoPath := fl.String("demo", "", "Demo file `path`")
+	outPath := fl.String("out", "", "Output file `path`")
+	interval := fl.Int("interval", 16, "Sample every `n` ticks")
+	rowGroupSize := fl.Int("row-group-size", ticks.DefaultRowGroupSize, "Maximum number of `rows` per Parquet row-group")
+
+	err := fl.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if *demoPath == "" || *outPath == "" {
+		return fmt.Errorf("-demo and -out are required")
+	}
+
+	f, err := os.Open(*demoPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	out, err := os.Create(*outPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	p := dem.NewParser(f)
+	pw := ticks.NewParquetWriter(out, *rowGroupSize)
+	s := ticks.NewSampler(p, pw, *interval)
+
+	err = p.ParseToEnd()
+
+	// Write the footer even if parsing failed, otherwise the rows we have can't be read
+	closeErr := pw.Close()
+	if err != nil {
+		return err
+	}
+
+	if s.Err() != nil {
+		return s.Err()
+	}
+
+	return closeErr
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dem "github.com/markus-wa/demoinfocs-golang"
+)
+
+func TestRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test")
+	}
+
+	out := filepath.Join(os.TempDir(), "demoinfocs-ticks-test.parquet")
+	defer os.Remove(out)
+
+	err := run([]string{"-demo", "../../test/cs-demos/default.dem", "-out", out})
+	assert.NoError(t, err)
+
+	info, err := os.Stat(out)
+	assert.NoError(t, err)
+	assert.NotZero(t, info.Size())
+}
+
+func TestRun_MissingFlags(t *testing.T) {
+	assert.Error(t, run([]string{"-demo", "demo.dem"}))
+}
+
+func TestRun_UnexpectedEndOfDemo(t *testing.T) {
+	// Valid header followed by a truncated packet
+	data := make([]byte, 1072)
+	copy(data, "HL2DEMO")
+	data = append(data, 2, 1, 0, 0, 0, 0, 1, 2, 3)
+
+	demo := filepath.Join(os.TempDir(), "demoinfocs-ticks-test-truncated.dem")
+	assert.NoError(t, ioutil.WriteFile(demo, data, 0644))
+	defer os.Remove(demo)
+
+	out := filepath.Join(os.TempDir(), "demoinfocs-ticks-test-truncated.parquet")
+	defer os.Remove(out)
+
+	err := run([]string{"-demo", demo, "-out", out})
+	assert.Equal(t, dem.ErrUnexpectedEndOfDemo, err)
+
+	b, err := ioutil.ReadFile(out)
+	assert.NoError(t, err)
+	assert.True(t, len(b) > 8)
+	assert.Equal(t, "PAR1", string(b[len(b)-4:]), "the footer should be written even if parsing failed")
+}
@@ -0,0 +1,56 @@
+package demoinfocs
+
+import (
+	"github.com/golang/geo/r3"
+
+	"github.com/markus-wa/demoinfocs-golang/bitread"
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// parseCommandInfo reads the 152 byte CommandInfo at the start of a packet.
+// It's only dispatched if it's set, which is not the case for GOTV demos.
+func (p *Parser) parseCommandInfo() {
+	info := readCommandInfo(p.bitReader)
+	if info == (common.CommandInfo{}) {
+		return
+	}
+
+	p.msgQueue <- events.FrameCommandInfo{Info: info}
+}
+
+func (p *Parser) handleFrameCommandInfo(info events.FrameCommandInfo) {
+	p.eventDispatcher.Dispatch(info)
+}
+
+// readCommandInfo reads a CommandInfo (see democmdinfo_t in the Source SDK).
+// It consists of two 76 byte splits of the following format:
+//
+//	flags [4 bytes]
+//	viewOrigin, viewAngles, localViewAngles [3x 12 bytes]
+//	viewOrigin2, viewAngles2, localViewAngles2 [3x 12 bytes]
+func readCommandInfo(r *bitread.BitReader) common.CommandInfo {
+	var info common.CommandInfo
+
+	for i := range info.Splits {
+		split := &info.Splits[i]
+
+		split.Flags = common.CommandInfoFlags(r.ReadInt(32))
+		split.RawViewOrigin = readVector(r)
+		split.RawViewAngles = readVector(r)
+		split.RawLocalViewAngles = readVector(r)
+		split.RawViewOrigin2 = readVector(r)
+		split.RawViewAngles2 = readVector(r)
+		split.RawLocalViewAngles2 = readVector(r)
+	}
+
+	return info
+}
+
+func readVector(r *bitread.BitReader) r3.Vector {
+	return r3.Vector{
+		X: float64(r.ReadFloat()),
+		Y: float64(r.ReadFloat()),
+		Z: float64(r.ReadFloat()),
+	}
+}
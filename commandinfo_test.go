@@ -0,0 +1,66 @@
+package demoinfocs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// commandInfoData returns a CommandInfo where only the first split has a view origin & angles.
+func commandInfoData() []byte {
+	var b bytes.Buffer
+
+	write := func(data interface{}) {
+		binary.Write(&b, binary.LittleEndian, data)
+	}
+
+	write(uint32(common.CommandInfoUseAngles2))
+	write([3]float32{100.5, -200, 64}) // View origin
+	write([3]float32{1, 2, 3})         // View angles
+	write([3]float32{4, 5, 6})         // Local view angles
+	write([3]float32{0, 0, 0})         // View origin 2
+	write([3]float32{-10.5, 90, 0})    // View angles 2
+	write([3]float32{-10.5, 90, 0})    // Local view angles 2
+	write(make([]byte, 76))            // Second split
+	write([2]int32{1, 2})              // SeqNrIn & SeqNrOut
+	write(int32(0))                    // No net-messages
+
+	return b.Bytes()
+}
+
+func TestParseCommandInfo(t *testing.T) {
+	// GOTV packets don't contain view information
+	gotvPacket := make([]byte, 152+4+4+4)
+
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 1)
+	d.frame(dcPacket, 1, commandInfoData())
+	d.frame(dcPacket, 2, gotvPacket)
+	d.syncTicks(3, 3).stop(3)
+
+	p := NewParserWithConfig(d.reader(), ParserConfig{MsgQueueBufferSize: -1})
+
+	var actual []events.FrameCommandInfo
+	p.RegisterEventHandler(func(e events.FrameCommandInfo) {
+		actual = append(actual, e)
+	})
+
+	err := p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, p.GameState().IngameTick(), "parsing should continue after the packets")
+	assert.Len(t, actual, 1, "packets without view information shouldn't be dispatched")
+
+	split := actual[0].Info.Splits[0]
+	assert.Equal(t, common.CommandInfoUseAngles2, split.Flags)
+	assert.Equal(t, r3.Vector{X: 100.5, Y: -200, Z: 64}, split.ViewOrigin())
+	assert.Equal(t, r3.Vector{X: 1, Y: 2, Z: 3}, split.RawViewAngles)
+	assert.Equal(t, r3.Vector{X: -10.5, Y: 90}, split.ViewAngles())
+	assert.Equal(t, r3.Vector{X: -10.5, Y: 90}, split.LocalViewAngles())
+	assert.Equal(t, common.CommandInfoSplit{}, actual[0].Info.Splits[1])
+}
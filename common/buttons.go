@@ -0,0 +1,39 @@
+package common
+
+// Buttons is a bitmask of the buttons (actions) that a player is pressing, see events.UserCommand.
+type Buttons uint32
+
+// Buttons constants, see in_buttons.h of the Source SDK.
+const (
+	ButtonAttack    Buttons = 1 << 0
+	ButtonJump      Buttons = 1 << 1
+	ButtonDuck      Buttons = 1 << 2
+	ButtonForward   Buttons = 1 << 3
+	ButtonBack      Buttons = 1 << 4
+	ButtonUse       Buttons = 1 << 5
+	ButtonCancel    Buttons = 1 << 6
+	ButtonLeft      Buttons = 1 << 7
+	ButtonRight     Buttons = 1 << 8
+	ButtonMoveLeft  Buttons = 1 << 9
+	ButtonMoveRight Buttons = 1 << 10
+	ButtonAttack2   Buttons = 1 << 11
+	ButtonRun       Buttons = 1 << 12
+	ButtonReload    Buttons = 1 << 13
+	ButtonAlt1      Buttons = 1 << 14
+	ButtonAlt2      Buttons = 1 << 15
+	ButtonScore     Buttons = 1 << 16 // Scoreboard
+	ButtonSpeed     Buttons = 1 << 17 // Walk (+speed)
+	ButtonWalk      Buttons = 1 << 18
+	ButtonZoom      Buttons = 1 << 19
+	ButtonWeapon1   Buttons = 1 << 20
+	ButtonWeapon2   Buttons = 1 << 21
+	ButtonBullRush  Buttons = 1 << 22
+	ButtonGrenade1  Buttons = 1 << 23
+	ButtonGrenade2  Buttons = 1 << 24
+	ButtonLookSpin  Buttons = 1 << 25 // Inspect weapon
+)
+
+// Has returns true if all of the given buttons are pressed.
+func (b Buttons) Has(buttons Buttons) bool {
+	return b&buttons == buttons
+}
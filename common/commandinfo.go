@@ -0,0 +1,62 @@
+package common
+
+import "github.com/golang/geo/r3"
+
+// CommandInfoFlags is a bitmask that defines which values of a CommandInfoSplit should be used.
+type CommandInfoFlags uint32
+
+// CommandInfoFlags constants, see democmdinfo_t in the Source SDK.
+const (
+	CommandInfoUseOrigin2 CommandInfoFlags = 1 << 0
+	CommandInfoUseAngles2 CommandInfoFlags = 1 << 1
+	CommandInfoNoInterp   CommandInfoFlags = 1 << 2 // Don't interpolate between this and the last view
+)
+
+// CommandInfo contains the view of the recording client at the time of a demo packet, see events.FrameCommandInfo.
+// The values are only set in POV demos.
+type CommandInfo struct {
+	// Splits contains one entry per split-screen slot, only the first one is used in CS:GO.
+	Splits [2]CommandInfoSplit
+}
+
+// CommandInfoSplit contains the view of a single split-screen slot.
+// Use ViewOrigin(), ViewAngles() and LocalViewAngles() to get the values selected by Flags.
+type CommandInfoSplit struct {
+	Flags CommandInfoFlags
+
+	RawViewOrigin      r3.Vector
+	RawViewAngles      r3.Vector // Pitch (X), yaw (Y) & roll (Z) in degrees
+	RawLocalViewAngles r3.Vector
+
+	// Alternative (resampled) values, used instead of the above if CommandInfoUseOrigin2 or CommandInfoUseAngles2 is set.
+	RawViewOrigin2      r3.Vector
+	RawViewAngles2      r3.Vector
+	RawLocalViewAngles2 r3.Vector
+}
+
+// ViewOrigin returns the position of the view (eyes).
+func (s CommandInfoSplit) ViewOrigin() r3.Vector {
+	if s.Flags&CommandInfoUseOrigin2 != 0 {
+		return s.RawViewOrigin2
+	}
+
+	return s.RawViewOrigin
+}
+
+// ViewAngles returns the view angles, pitch (X), yaw (Y) & roll (Z) in degrees.
+func (s CommandInfoSplit) ViewAngles() r3.Vector {
+	if s.Flags&CommandInfoUseAngles2 != 0 {
+		return s.RawViewAngles2
+	}
+
+	return s.RawViewAngles
+}
+
+// LocalViewAngles returns the local (client-side) view angles, pitch (X), yaw (Y) & roll (Z) in degrees.
+func (s CommandInfoSplit) LocalViewAngles() r3.Vector {
+	if s.Flags&CommandInfoUseAngles2 != 0 {
+		return s.RawLocalViewAngles2
+	}
+
+	return s.RawLocalViewAngles
+}
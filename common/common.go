@@ -99,6 +99,24 @@ type Bomb struct {
 	// Contains the last location of the dropped or planted bomb.
 	LastOnGroundPosition r3.Vector
 	Carrier              *Player
+
+	IsTicking      bool // True while the bomb is planted and hasn't exploded or been defused yet
+	IsBeingDefused bool
+
+	timerProvider bombTimerProvider
+}
+
+// bombTimerProvider provides the timers of the planted bomb, which are calculated from the bomb entity and the current in-game tick.
+type bombTimerProvider interface {
+	BombTimeToExplode() time.Duration
+	BombDefuseTimeRemaining() time.Duration
+}
+
+// NewBomb creates a bomb that gets its timers from the given provider.
+//
+// Intended for internal use only.
+func NewBomb(timerProvider bombTimerProvider) Bomb {
+	return Bomb{timerProvider: timerProvider}
 }
 
 // Position returns the current position of the bomb.
@@ -112,6 +130,27 @@ func (b Bomb) Position() r3.Vector {
 	return b.LastOnGroundPosition
 }
 
+// TimeToExplode returns the time until the planted bomb explodes.
+// Returns 0 if the bomb isn't ticking (not planted, defused or exploded).
+func (b Bomb) TimeToExplode() time.Duration {
+	if !b.IsTicking || b.timerProvider == nil {
+		return 0
+	}
+
+	return b.timerProvider.BombTimeToExplode()
+}
+
+// DefuseTimeRemaining returns the time until the current defuse is finished.
+// Returns 0 if the bomb isn't being defused.
+// The defuse is possible if this is less than TimeToExplode().
+func (b Bomb) DefuseTimeRemaining() time.Duration {
+	if !b.IsTicking || !b.IsBeingDefused || b.timerProvider == nil {
+		return 0
+	}
+
+	return b.timerProvider.BombDefuseTimeRemaining()
+}
+
 // TeamState contains a team's ID, score, clan name & country flag.
 type TeamState struct {
 	team            Team
@@ -112,3 +112,75 @@ func entityWithProperty(propName string, value st.PropertyValue) st.IEntity {
 	entity.On("FindPropertyI", propName).Return(prop)
 	return entity
 }
+
+func TestGameRules_ActiveTimeout(t *testing.T) {
+	assert.Equal(t, TeamUnassigned, GameRules{}.ActiveTimeout())
+	assert.Equal(t, TeamTerrorists, GameRules{TerroristTimeOutActive: true}.ActiveTimeout())
+	assert.Equal(t, TeamCounterTerrorists, GameRules{CTTimeOutActive: true}.ActiveTimeout())
+}
+
+func TestRoundWinStatus_Winner(t *testing.T) {
+	assert.Equal(t, TeamUnassigned, RoundWinStatusUndecided.Winner())
+	assert.Equal(t, TeamSpectators, RoundWinStatusDraw.Winner())
+	assert.Equal(t, TeamTerrorists, RoundWinStatusTerrorists.Winner())
+	assert.Equal(t, TeamCounterTerrorists, RoundWinStatusCounterTerrorists.Winner())
+}
+
+func TestGameRules_IsOvertime(t *testing.T) {
+	assert.False(t, GameRules{}.IsOvertime())
+	assert.True(t, GameRules{OvertimePlaying: 2}.IsOvertime())
+}
+
+type bombTimerProviderMock struct {
+	timeToExplode       time.Duration
+	defuseTimeRemaining time.Duration
+}
+
+func (p bombTimerProviderMock) BombTimeToExplode() time.Duration {
+	return p.timeToExplode
+}
+
+func (p bombTimerProviderMock) BombDefuseTimeRemaining() time.Duration {
+	return p.defuseTimeRemaining
+}
+
+func TestBomb_Timers(t *testing.T) {
+	bomb := NewBomb(bombTimerProviderMock{timeToExplode: 30 * time.Second, defuseTimeRemaining: 5 * time.Second})
+
+	assert.Zero(t, bomb.TimeToExplode(), "bomb isn't planted")
+	assert.Zero(t, bomb.DefuseTimeRemaining(), "bomb isn't planted")
+
+	bomb.IsTicking = true
+	assert.Equal(t, 30*time.Second, bomb.TimeToExplode())
+	assert.Zero(t, bomb.DefuseTimeRemaining(), "bomb isn't being defused")
+
+	bomb.IsBeingDefused = true
+	assert.Equal(t, 5*time.Second, bomb.DefuseTimeRemaining())
+}
+
+func TestBomb_Timers_NoProvider(t *testing.T) {
+	bomb := Bomb{IsTicking: true, IsBeingDefused: true}
+
+	assert.Zero(t, bomb.TimeToExplode())
+	assert.Zero(t, bomb.DefuseTimeRemaining())
+}
+
+func TestCommandInfoSplit(t *testing.T) {
+	split := CommandInfoSplit{
+		RawViewOrigin:       r3.Vector{X: 1},
+		RawViewAngles:       r3.Vector{Y: 2},
+		RawLocalViewAngles:  r3.Vector{Z: 3},
+		RawViewOrigin2:      r3.Vector{X: 4},
+		RawViewAngles2:      r3.Vector{Y: 5},
+		RawLocalViewAngles2: r3.Vector{Z: 6},
+	}
+
+	assert.Equal(t, r3.Vector{X: 1}, split.ViewOrigin())
+	assert.Equal(t, r3.Vector{Y: 2}, split.ViewAngles())
+	assert.Equal(t, r3.Vector{Z: 3}, split.LocalViewAngles())
+
+	split.Flags = CommandInfoUseOrigin2 | CommandInfoUseAngles2
+	assert.Equal(t, r3.Vector{X: 4}, split.ViewOrigin())
+	assert.Equal(t, r3.Vector{Y: 5}, split.ViewAngles())
+	assert.Equal(t, r3.Vector{Z: 6}, split.LocalViewAngles())
+}
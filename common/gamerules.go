@@ -63,3 +63,70 @@ func GamePhaseNames() map[GamePhase]string {
 func (r GamePhase) String() string {
 	return gamePhaseToString[r]
 }
+
+// RoundWinStatus represents the winner of the current round.
+// The values are the same as the ones of Team, TeamSpectators is used for a draw.
+type RoundWinStatus int
+
+// RoundWinStatus constants
+const (
+	RoundWinStatusUndecided         RoundWinStatus = 0 // The round is still in progress
+	RoundWinStatusDraw              RoundWinStatus = 1
+	RoundWinStatusTerrorists        RoundWinStatus = 2
+	RoundWinStatusCounterTerrorists RoundWinStatus = 3
+)
+
+// Winner returns the team that won the round.
+// Returns TeamUnassigned if the round is still in progress and TeamSpectators for a draw.
+func (s RoundWinStatus) Winner() Team {
+	return Team(s)
+}
+
+// RoundWinReason represents the reason why a round ended.
+// The values are the same as the ones of events.RoundEndReason, convert with events.RoundEndReason(reason).
+type RoundWinReason int
+
+// GameRules contains the state of the game rules according to CCSGameRulesProxy.
+type GameRules struct {
+	// RoundWinStatus is the winner of the current round.
+	// RoundWinStatusUndecided while the round is in progress.
+	RoundWinStatus RoundWinStatus
+	// RoundWinReason is the reason why the current round ended.
+	// Only valid if RoundWinStatus != RoundWinStatusUndecided.
+	RoundWinReason RoundWinReason
+
+	OvertimePlaying          int     // Number of the overtime currently being played, 0 during regulation time
+	IsGameRestart            bool    // True while the game is restarting (e.g. after mp_restartgame)
+	NumBestOfMaps            int     // Number of maps of the series (e.g. 3 for a BO3), 0 if unknown
+	MatchDevice              int     // Type of the device the match is played on (e.g. PC or console)
+	WarmupPeriodEnd          float32 // Server time (seconds) at which the warmup period ends
+	RoundStartTime           float32 // Server time (seconds) at which the current round starts (after the freeze time)
+	RoundTime                int     // Length of the round (seconds) without the freeze time
+	IsFreezePeriod           bool    // True during the freeze time at the start of a round
+	TimeUntilNextPhaseStarts float32 // Seconds until the next game phase starts (e.g. after halftime)
+
+	TerroristTimeOutActive    bool    // True while a timeout called by the terrorists is running
+	CTTimeOutActive           bool    // True while a timeout called by the CTs is running
+	TerroristTimeOutRemaining float32 // Remaining timeout time (seconds) of the terrorists, for the whole match
+	CTTimeOutRemaining        float32 // Remaining timeout time (seconds) of the CTs, for the whole match
+	TerroristTimeOuts         int     // Number of timeouts the terrorists have left
+	CTTimeOuts                int     // Number of timeouts the CTs have left
+}
+
+// ActiveTimeout returns the team whose timeout is currently running.
+// Returns TeamUnassigned if there is no timeout.
+func (gr GameRules) ActiveTimeout() Team {
+	switch {
+	case gr.TerroristTimeOutActive:
+		return TeamTerrorists
+	case gr.CTTimeOutActive:
+		return TeamCounterTerrorists
+	default:
+		return TeamUnassigned
+	}
+}
+
+// IsOvertime returns true if an overtime is currently being played.
+func (gr GameRules) IsOvertime() bool {
+	return gr.OvertimePlaying > 0
+}
@@ -26,6 +26,7 @@ type Player struct {
 	EntityID                    int       // The ID of the player-entity, see Entity field
 	UserID                      int       // Mostly used in game-events to address this player
 	Name                        string    // Steam / in-game user name
+	LastPlaceName               string    // Name of the navigation mesh area the player was last in (e.g. 'BombsiteA'), see also metadata.Map.PlaceNameOr()
 	Hp                          int
 	Armor                       int
 	Money                       int
@@ -190,6 +191,45 @@ func (p *Player) IsScoped() bool {
 	return p.Entity.FindPropertyI("m_bIsScoped").Value().IntVal == 1
 }
 
+// IsInHostageRescueZone returns whether the player is currently in a hostage rescue zone or not.
+func (p *Player) IsInHostageRescueZone() bool {
+	return p.propertyValue("m_bInHostageRescueZone").IntVal == 1
+}
+
+// ShotsFired returns the number of shots fired in the current spray.
+// Resets to 0 once the player stops shooting, useful for analysing spray control together with AimPunchAngle().
+// Returns 0 if the value isn't available (e.g. for other players in POV demos).
+func (p *Player) ShotsFired() int {
+	return p.propertyValue("cslocaldata.m_iShotsFired").IntVal
+}
+
+// AimPunchAngle returns the current recoil offset of the player's aim (X = pitch, Y = yaw, Z = roll) in degrees.
+// The actual direction of a shot is the view direction plus two times the aim punch angle (see weapon_recoil_scale).
+// Returns an empty vector if the value isn't available (e.g. for other players in POV demos).
+func (p *Player) AimPunchAngle() r3.Vector {
+	return p.propertyValue("localdata.m_Local.m_aimPunchAngle").VectorVal
+}
+
+// LowerBodyYawTarget returns the yaw (in degrees) that the lower body of the player's model is turning towards.
+func (p *Player) LowerBodyYawTarget() float32 {
+	return p.propertyValue("m_flLowerBodyYawTarget").FloatVal
+}
+
+// propertyValue returns the value of a property of the player's entity.
+// Returns an empty value if the player has no entity or the entity doesn't have the property.
+func (p *Player) propertyValue(name string) st.PropertyValue {
+	if p.Entity == nil {
+		return st.PropertyValue{}
+	}
+
+	prop := p.Entity.FindPropertyI(name)
+	if prop == nil {
+		return st.PropertyValue{}
+	}
+
+	return prop.Value()
+}
+
 // CashSpentThisRound returns the amount of cash the player spent in the current round.
 //
 // Deprecated, use Player.AdditionalPlayerInformation.CashSpentThisRound instead.
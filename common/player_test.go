@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/geo/r3"
 	"github.com/stretchr/testify/assert"
 
 	st "github.com/markus-wa/demoinfocs-golang/sendtables"
@@ -190,6 +191,39 @@ func TestPlayer_IsScoped(t *testing.T) {
 	assert.True(t, pl.IsScoped())
 }
 
+func TestPlayer_IsInHostageRescueZone(t *testing.T) {
+	pl := playerWithProperty("m_bInHostageRescueZone", st.PropertyValue{IntVal: 1})
+
+	assert.True(t, pl.IsInHostageRescueZone())
+}
+
+func TestPlayer_ShotsFired(t *testing.T) {
+	pl := playerWithProperty("cslocaldata.m_iShotsFired", st.PropertyValue{IntVal: 7})
+
+	assert.Equal(t, 7, pl.ShotsFired())
+}
+
+func TestPlayer_AimPunchAngle(t *testing.T) {
+	pl := playerWithProperty("localdata.m_Local.m_aimPunchAngle", st.PropertyValue{VectorVal: r3.Vector{X: -1.5, Y: 0.5}})
+
+	assert.Equal(t, r3.Vector{X: -1.5, Y: 0.5}, pl.AimPunchAngle())
+}
+
+func TestPlayer_LowerBodyYawTarget(t *testing.T) {
+	pl := playerWithProperty("m_flLowerBodyYawTarget", st.PropertyValue{FloatVal: 90})
+
+	assert.Equal(t, float32(90), pl.LowerBodyYawTarget())
+}
+
+func TestPlayer_PropertyAccessors_NilEntity(t *testing.T) {
+	pl := new(Player)
+
+	assert.False(t, pl.IsInHostageRescueZone())
+	assert.Zero(t, pl.ShotsFired())
+	assert.Zero(t, pl.AimPunchAngle())
+	assert.Zero(t, pl.LowerBodyYawTarget())
+}
+
 func TestPlayer_IsAirborne_NilEntity(t *testing.T) {
 	pl := new(Player)
 
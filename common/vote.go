@@ -0,0 +1,67 @@
+package common
+
+// VoteType is the type of the issue of a vote.
+type VoteType int
+
+// VoteType constants give information about what a vote is about.
+const (
+	VoteTypeKick             VoteType = 0
+	VoteTypeChangeLevel      VoteType = 1
+	VoteTypeNextLevel        VoteType = 2
+	VoteTypeSwapTeams        VoteType = 3
+	VoteTypeScrambleTeams    VoteType = 4
+	VoteTypeRestartGame      VoteType = 5
+	VoteTypeSurrender        VoteType = 6
+	VoteTypeRematch          VoteType = 7
+	VoteTypeContinue         VoteType = 8
+	VoteTypePauseMatch       VoteType = 9
+	VoteTypeUnpauseMatch     VoteType = 10
+	VoteTypeLoadBackup       VoteType = 11
+	VoteTypeEndWarmup        VoteType = 12
+	VoteTypeStartTimeout     VoteType = 13
+	VoteTypeEndTimeout       VoteType = 14
+	VoteTypeReadyForMatch    VoteType = 15
+	VoteTypeNotReadyForMatch VoteType = 16
+)
+
+// VoteOption is an option that a player voted for.
+type VoteOption int
+
+// VoteOption constants for yes / no votes (F1 / F2).
+const (
+	VoteOptionYes VoteOption = 0
+	VoteOptionNo  VoteOption = 1
+)
+
+// Vote is a vote of the players, e.g. to kick a player or for a tactical timeout.
+type Vote struct {
+	Issuer    *Player // Player who called the vote, may be nil (e.g. for votes started by the server)
+	Issue     VoteType
+	Target    *Player // Target of the vote (e.g. the player to kick), may be nil
+	Team      Team    // Team that is voting, TeamUnassigned if all players are voting
+	Details   string  // Details of the issue, e.g. the name of the player to kick
+	StartTick int     // In-game tick at which the vote was called
+
+	// Options that the players voted for so far.
+	Votes map[*Player]VoteOption
+}
+
+// Yes returns the number of players that voted yes.
+func (v *Vote) Yes() int {
+	return v.count(VoteOptionYes)
+}
+
+// No returns the number of players that voted no.
+func (v *Vote) No() int {
+	return v.count(VoteOptionNo)
+}
+
+func (v *Vote) count(option VoteOption) (n int) {
+	for _, o := range v.Votes {
+		if o == option {
+			n++
+		}
+	}
+
+	return
+}
@@ -103,6 +103,16 @@ func (p *Parser) bindBomb() {
 		p.gameState.bomb.Carrier = nil
 
 		bomb.LastOnGroundPosition = bombEntity.Position()
+
+		bombEntity.BindProperty("m_flC4Blow", &p.gameState.bombExplosionTime, st.ValTypeFloat32)
+		bombEntity.BindProperty("m_flDefuseCountDown", &p.gameState.bombDefuseTime, st.ValTypeFloat32)
+		bombEntity.BindProperty("m_bBombTicking", &bomb.IsTicking, st.ValTypeBoolInt)
+		bombEntity.BindProperty("m_bBeingDefused", &bomb.IsBeingDefused, st.ValTypeBoolInt)
+
+		bombEntity.OnDestroy(func() {
+			bomb.IsTicking = false
+			bomb.IsBeingDefused = false
+		})
 	})
 }
 
@@ -251,6 +261,11 @@ func (p *Parser) bindNewPlayer(playerEntity st.IEntity) {
 	playerEntity.BindProperty("localdata.m_Local.m_bDucking", &pl.IsDucking, st.ValTypeBoolInt)
 	playerEntity.BindProperty("m_iAccount", &pl.Money, st.ValTypeInt)
 
+	// Missing in some older demos
+	if placeNameProp := playerEntity.FindPropertyI("m_szLastPlaceName"); placeNameProp != nil {
+		placeNameProp.Bind(&pl.LastPlaceName, st.ValTypeString)
+	}
+
 	playerEntity.BindProperty("m_angEyeAngles[1]", &pl.ViewDirectionX, st.ValTypeFloat32)
 	playerEntity.BindProperty("m_angEyeAngles[0]", &pl.ViewDirectionY, st.ValTypeFloat32)
 	playerEntity.FindPropertyI("m_flFlashDuration").OnUpdate(func(val st.PropertyValue) {
@@ -602,25 +617,82 @@ func (p *Parser) bindGameRules() {
 			})
 		})
 
-		// TODO: seems like this is more reliable than RoundEnd events
-		// "m_eRoundWinReason"
-
-		// TODO: future fields to use
-		// "m_iRoundWinStatus"
-		// "m_nOvertimePlaying"
-		// "m_bGameRestart"
-		// "m_MatchDevice"
-		// "m_bHasMatchStarted"
-		// "m_numBestOfMaps"
-		// "m_fWarmupPeriodEnd"
-		// "m_timeUntilNextPhaseStarts"
-
-		// TODO: timeout data
-		// "m_bTerroristTimeOutActive"
-		// "m_bCTTimeOutActive"
-		// "m_flTerroristTimeOutRemaining"
-		// "m_flCTTimeOutRemaining"
-		// "m_nTerroristTimeOuts"
-		// "m_nCTTimeOuts"
+		rules := &p.gameState.rules
+
+		// Some of the properties are missing in older demos
+		bindIfExists := func(name string, variable interface{}, valueType st.PropertyValueType) {
+			if prop := entity.FindPropertyI(grPrefix(name)); prop != nil {
+				prop.Bind(variable, valueType)
+			}
+		}
+
+		if prop := entity.FindPropertyI(grPrefix("m_iRoundWinStatus")); prop != nil {
+			prop.OnUpdate(func(val st.PropertyValue) {
+				rules.RoundWinStatus = common.RoundWinStatus(val.IntVal)
+			})
+		}
+
+		if prop := entity.FindPropertyI(grPrefix("m_eRoundWinReason")); prop != nil {
+			prop.OnUpdate(func(val st.PropertyValue) {
+				rules.RoundWinReason = common.RoundWinReason(val.IntVal)
+			})
+		}
+
+		bindIfExists("m_bGameRestart", &rules.IsGameRestart, st.ValTypeBoolInt)
+		bindIfExists("m_numBestOfMaps", &rules.NumBestOfMaps, st.ValTypeInt)
+		bindIfExists("m_MatchDevice", &rules.MatchDevice, st.ValTypeInt)
+		bindIfExists("m_fWarmupPeriodEnd", &rules.WarmupPeriodEnd, st.ValTypeFloat32)
+		bindIfExists("m_fRoundStartTime", &rules.RoundStartTime, st.ValTypeFloat32)
+		bindIfExists("m_iRoundTime", &rules.RoundTime, st.ValTypeInt)
+		bindIfExists("m_bFreezePeriod", &rules.IsFreezePeriod, st.ValTypeBoolInt)
+		bindIfExists("m_timeUntilNextPhaseStarts", &rules.TimeUntilNextPhaseStarts, st.ValTypeFloat32)
+		bindIfExists("m_flTerroristTimeOutRemaining", &rules.TerroristTimeOutRemaining, st.ValTypeFloat32)
+		bindIfExists("m_flCTTimeOutRemaining", &rules.CTTimeOutRemaining, st.ValTypeFloat32)
+		bindIfExists("m_nTerroristTimeOuts", &rules.TerroristTimeOuts, st.ValTypeInt)
+		bindIfExists("m_nCTTimeOuts", &rules.CTTimeOuts, st.ValTypeInt)
+
+		// The initial values aren't changes, e.g. if the recording started during an overtime or timeout
+		created := false
+		entity.OnCreateFinished(func() {
+			created = true
+		})
+
+		if prop := entity.FindPropertyI(grPrefix("m_nOvertimePlaying")); prop != nil {
+			prop.OnUpdate(func(val st.PropertyValue) {
+				old := rules.OvertimePlaying
+				rules.OvertimePlaying = val.IntVal
+
+				if created && rules.OvertimePlaying > old {
+					p.eventDispatcher.Dispatch(events.OvertimeStart{Number: rules.OvertimePlaying})
+				}
+			})
+		}
+
+		p.bindTimeoutActive(entity.FindPropertyI(grPrefix("m_bTerroristTimeOutActive")), &rules.TerroristTimeOutActive, common.TeamTerrorists, &created)
+		p.bindTimeoutActive(entity.FindPropertyI(grPrefix("m_bCTTimeOutActive")), &rules.CTTimeOutActive, common.TeamCounterTerrorists, &created)
+	})
+}
+
+// bindTimeoutActive binds the timeout state of a team and dispatches TimeoutStart & TimeoutEnd events.
+// No events are dispatched before the entity is created (see Entity.OnCreateFinished()).
+func (p *Parser) bindTimeoutActive(prop st.IProperty, active *bool, team common.Team, created *bool) {
+	if prop == nil {
+		return
+	}
+
+	prop.OnUpdate(func(val st.PropertyValue) {
+		old := *active
+		*active = val.IntVal == 1
+
+		if !*created {
+			return
+		}
+
+		switch {
+		case *active && !old:
+			p.eventDispatcher.Dispatch(events.TimeoutStart{Team: team})
+		case !*active && old:
+			p.eventDispatcher.Dispatch(events.TimeoutEnd{Team: team})
+		}
 	})
 }
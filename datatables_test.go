@@ -138,3 +138,45 @@ func configurePlayerEntityMock(id int, entity *fakest.Entity) {
 		destroyCallback()
 	})
 }
+
+func TestParser_BindTimeoutActive(t *testing.T) {
+	p := newParser()
+
+	prop := new(fakest.Property)
+	var updateHandler st.PropertyUpdateHandler
+	prop.On("OnUpdate", mock.Anything).Run(func(args mock.Arguments) {
+		updateHandler = args.Get(0).(st.PropertyUpdateHandler)
+	})
+
+	created := false
+	p.bindTimeoutActive(prop, &p.gameState.rules.CTTimeOutActive, common.TeamCounterTerrorists, &created)
+
+	var actual []interface{}
+	p.RegisterEventHandler(func(e events.TimeoutStart) {
+		actual = append(actual, e)
+	})
+	p.RegisterEventHandler(func(e events.TimeoutEnd) {
+		actual = append(actual, e)
+	})
+
+	// Recording started during a timeout
+	updateHandler(st.PropertyValue{IntVal: 1})
+	assert.Empty(t, actual, "no events should be dispatched before the entity is created")
+	assert.Equal(t, common.TeamCounterTerrorists, p.GameState().Rules().ActiveTimeout())
+
+	created = true
+
+	updateHandler(st.PropertyValue{IntVal: 0})
+	updateHandler(st.PropertyValue{IntVal: 1})
+	assert.Equal(t, common.TeamCounterTerrorists, p.GameState().Rules().ActiveTimeout())
+	updateHandler(st.PropertyValue{IntVal: 1})
+	updateHandler(st.PropertyValue{IntVal: 0})
+
+	expected := []interface{}{
+		events.TimeoutEnd{Team: common.TeamCounterTerrorists},
+		events.TimeoutStart{Team: common.TeamCounterTerrorists},
+		events.TimeoutEnd{Team: common.TeamCounterTerrorists},
+	}
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, common.TeamUnassigned, p.GameState().Rules().ActiveTimeout())
+}
@@ -233,6 +233,49 @@ func TestCancelParseToEnd(t *testing.T) {
 	assert.True(t, tix == maxTicks, "FrameDone handler was not triggered the correct amount of times")
 }
 
+func TestSnapshotRestore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test due to -short flag")
+	}
+
+	f := openFile(t, defaultDemPath)
+	defer mustClose(t, f)
+
+	p := dem.NewParser(f)
+
+	err := p.SeekToRound(10)
+	assert.NoError(t, err, "error seeking to round 10")
+
+	snapshot, err := p.Snapshot()
+	assert.NoError(t, err, "error creating snapshot")
+
+	f2 := openFile(t, defaultDemPath)
+	defer mustClose(t, f2)
+
+	restored := dem.NewParser(f2)
+	err = restored.RestoreSnapshot(snapshot)
+	assert.NoError(t, err, "error restoring snapshot")
+
+	assert.Equal(t, p.CurrentFrame(), restored.CurrentFrame())
+	assert.Equal(t, p.GameState().TotalRoundsPlayed(), restored.GameState().TotalRoundsPlayed())
+	assert.Equal(t, p.GameState().TeamTerrorists().Score, restored.GameState().TeamTerrorists().Score)
+	assert.Equal(t, p.GameState().TeamCounterTerrorists().Score, restored.GameState().TeamCounterTerrorists().Score)
+	assert.Equal(t, len(p.GameState().Participants().Playing()), len(restored.GameState().Participants().Playing()))
+
+	countKills := func(parser dem.IParser) (kills int) {
+		parser.RegisterEventHandler(func(events.Kill) {
+			kills++
+		})
+
+		err := parser.ParseToEnd()
+		assert.NoError(t, err, "ParseToEnd() returned an error")
+
+		return
+	}
+
+	assert.Equal(t, countKills(p), countKills(restored), "restored parser should see the same kills as the original")
+}
+
 func TestInvalidFileType(t *testing.T) {
 	invalidDemoData := make([]byte, 2048)
 	_, err := rand.Read(invalidDemoData)
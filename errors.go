@@ -0,0 +1,135 @@
+package demoinfocs
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// ErrCorruptDemo is matched by all errors that describe corrupt demo data
+// (CorruptPacketError, UnknownDemoCommandError, CustomDataError & StringTableError) when using errors.Is().
+// Parsing the same demo again will fail the same way, lenient parsing may still yield usable data.
+//
+// See also: ParserConfig.Lenient
+var ErrCorruptDemo = errors.New("demo data is corrupt (ErrCorruptDemo)")
+
+// DemoPosition describes where in the demo a problem occurred.
+type DemoPosition struct {
+	Frame  int // Demo-frame (not ingame-tick)
+	Offset int // Byte offset in the demo of the offending data (or of the frame containing it)
+}
+
+func (pos *DemoPosition) position() *DemoPosition {
+	return pos
+}
+
+func (pos DemoPosition) String() string {
+	return fmt.Sprintf("frame %d, byte offset %d", pos.Frame, pos.Offset)
+}
+
+// positionedError is implemented by all errors that describe corrupt demo data.
+type positionedError interface {
+	error
+	position() *DemoPosition
+}
+
+// CorruptPacketError signals that a net-message of a packet couldn't be unmarshalled.
+type CorruptPacketError struct {
+	DemoPosition
+	Cmd int   // Net-message ID
+	Err error // Error returned by proto.Unmarshal()
+}
+
+func (e *CorruptPacketError) Error() string {
+	return fmt.Sprintf("failed to unmarshal net-message %d (%s): %s", e.Cmd, e.DemoPosition, e.Err.Error())
+}
+
+// Unwrap returns the error returned by proto.Unmarshal().
+func (e *CorruptPacketError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true for ErrCorruptDemo.
+func (e *CorruptPacketError) Is(target error) bool {
+	return target == ErrCorruptDemo
+}
+
+// UnknownDemoCommandError signals that a frame had an unknown demo command.
+// Parsing can't continue after this since the size of the frame is unknown.
+type UnknownDemoCommandError struct {
+	DemoPosition
+	Cmd int // Demo command of the frame
+}
+
+func (e *UnknownDemoCommandError) Error() string {
+	return fmt.Sprintf("unknown demo command %d (%s)", e.Cmd, e.DemoPosition)
+}
+
+// Is returns true for ErrCorruptDemo.
+func (e *UnknownDemoCommandError) Is(target error) bool {
+	return target == ErrCorruptDemo
+}
+
+// CustomDataError signals that a frame contained custom data, which isn't supported.
+type CustomDataError struct {
+	DemoPosition
+	Size int // Size of the custom data in bytes
+}
+
+func (e *CustomDataError) Error() string {
+	return fmt.Sprintf("found CustomData but not handled (%d bytes, %s)", e.Size, e.DemoPosition)
+}
+
+// Is returns true for ErrCorruptDemo.
+func (e *CustomDataError) Is(target error) bool {
+	return target == ErrCorruptDemo
+}
+
+// StringTableError signals that a string table couldn't be parsed.
+type StringTableError struct {
+	DemoPosition
+	Table string // Name of the string table
+	Err   error  // Describes what went wrong
+}
+
+func (e *StringTableError) Error() string {
+	return fmt.Sprintf("failed to parse string table %q (%s): %s", e.Table, e.DemoPosition, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *StringTableError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true for ErrCorruptDemo.
+func (e *StringTableError) Is(target error) bool {
+	return target == ErrCorruptDemo
+}
+
+// PanicError signals that parsing a demo panicked, e.g. in an event handler.
+// It's only returned if ParserConfig.RecoverPanics is set and by ParseAll().
+//
+// If the value passed to panic() is an error, it can be retrieved via errors.Is() & errors.As().
+type PanicError struct {
+	DemoPosition
+	Value interface{} // Value passed to panic()
+	Stack []byte      // Stack trace of the go-routine that panicked
+}
+
+func newPanicError(value interface{}, pos DemoPosition) *PanicError {
+	return &PanicError{
+		DemoPosition: pos,
+		Value:        value,
+		Stack:        debug.Stack(),
+	}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic while parsing demo (%s): %v", e.DemoPosition, e.Value)
+}
+
+// Unwrap returns the value passed to panic() if it's an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
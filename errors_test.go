@@ -0,0 +1,53 @@
+package demoinfocs
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorruptPacketError(t *testing.T) {
+	err := &CorruptPacketError{
+		DemoPosition: DemoPosition{Frame: 10, Offset: 1234},
+		Cmd:          25,
+		Err:          io.ErrUnexpectedEOF,
+	}
+
+	assert.EqualError(t, err, "failed to unmarshal net-message 25 (frame 10, byte offset 1234): unexpected EOF")
+	assert.True(t, errors.Is(err, ErrCorruptDemo))
+	assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+	assert.False(t, errors.Is(err, ErrUnexpectedEndOfDemo))
+}
+
+func TestUnknownDemoCommandError(t *testing.T) {
+	var err error = &UnknownDemoCommandError{
+		DemoPosition: DemoPosition{Frame: 1, Offset: 2},
+		Cmd:          42,
+	}
+
+	assert.EqualError(t, err, "unknown demo command 42 (frame 1, byte offset 2)")
+	assert.True(t, errors.Is(err, ErrCorruptDemo))
+
+	var udcErr *UnknownDemoCommandError
+	assert.True(t, errors.As(err, &udcErr))
+	assert.Equal(t, 1, udcErr.Frame)
+	assert.Equal(t, 2, udcErr.Offset)
+}
+
+func TestCustomDataError(t *testing.T) {
+	err := &CustomDataError{DemoPosition: DemoPosition{Frame: 1, Offset: 2}, Size: 3}
+
+	assert.EqualError(t, err, "found CustomData but not handled (3 bytes, frame 1, byte offset 2)")
+	assert.True(t, errors.Is(err, ErrCorruptDemo))
+}
+
+func TestStringTableError(t *testing.T) {
+	cause := errors.New("test")
+	err := &StringTableError{DemoPosition: DemoPosition{Frame: 1, Offset: 2}, Table: "userinfo", Err: cause}
+
+	assert.EqualError(t, err, `failed to parse string table "userinfo" (frame 1, byte offset 2): test`)
+	assert.True(t, errors.Is(err, ErrCorruptDemo))
+	assert.True(t, errors.Is(err, cause))
+}
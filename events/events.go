@@ -146,6 +146,30 @@ type Kill struct {
 	IsHeadshot        bool
 }
 
+// KillTraded signals that the killer of a player was killed by a teammate of the victim
+// within the trade window (see ParserConfig.TradeWindow).
+// It is dispatched right after the Kill event of the trade kill.
+type KillTraded struct {
+	Kill      Kill          // The kill that was traded
+	TradeKill Kill          // The kill of the original killer
+	TimeDelta time.Duration // Time between the two kills
+}
+
+// ClutchStarted signals that a player is the last one alive of their team while at least one opponent is still alive.
+// Bots that are controlled by a player are represented by the controlling player.
+// Only the first clutch situation of a round is reported.
+type ClutchStarted struct {
+	Player    *common.Player
+	Opponents []*common.Player // Opponents that were alive when the clutch started
+}
+
+// ClutchEnded signals the end of a clutch situation, it's dispatched right after the RoundEnd event.
+// No ClutchEnded event is dispatched if RoundEnd is missing.
+type ClutchEnded struct {
+	Player *common.Player
+	Won    bool // True if the player's team won the round
+}
+
 // BotTakenOver signals that a player took over a bot.
 type BotTakenOver struct {
 	Taker *common.Player
@@ -422,6 +446,195 @@ type RankUpdate struct {
 	WinCount   int
 }
 
+// RadioText signals a radio command (e.g. 'Enemy spotted' or 'Need backup') of a player.
+// MsgName and Params contain the localization keys, e.g. MsgName '#Game_radio_location'
+// with the player's name, place name and radio message as parameters.
+type RadioText struct {
+	Player  *common.Player // Player who sent the radio command, may be nil
+	MsgName string
+	Params  []string
+}
+
+// VoteStart signals that a player called a vote (e.g. a tactical timeout or a kick).
+// It contains the raw network message, VoteStarted may be more interesting.
+type VoteStart struct {
+	Team          common.Team    // Team that is voting, TeamUnassigned if all players are voting
+	Caller        *common.Player // Player who called the vote, may be nil (e.g. for votes started by the server)
+	Target        *common.Player // Target of the vote (e.g. the player to kick), may be nil
+	Type          common.VoteType
+	DisplayString string // Localization key of the issue, e.g. '#SFUI_vote_start_timeout'
+	Details       string // Details of the issue, e.g. the name of the player to kick
+	OtherTeamStr  string // Localization key of the message shown to the other team
+	IsYesNoVote   bool
+}
+
+// VotePass signals that a vote passed.
+// It contains the raw network message, VoteEnded may be more interesting.
+type VotePass struct {
+	Team          common.Team // Team that voted, TeamUnassigned if all players voted
+	Type          common.VoteType
+	DisplayString string // Localization key of the result, e.g. '#SFUI_vote_passed_timeout'
+	Details       string
+}
+
+// VoteFailed signals that a vote failed.
+// It contains the raw network message, VoteEnded may be more interesting.
+type VoteFailed struct {
+	Team   common.Team // Team that voted, TeamUnassigned if all players voted
+	Reason int         // Reason why the vote failed (vote_create_failed_t in the Source SDK), e.g. 3 if there weren't enough yes votes
+}
+
+// VoteStarted signals that a vote was called.
+// See also: GameState.ActiveVote()
+type VoteStarted struct {
+	Issuer *common.Player // May be nil (e.g. for votes started by the server)
+	Issue  common.VoteType
+	Target *common.Player // May be nil
+	Vote   *common.Vote
+}
+
+// VoteCast signals that a player voted during the active vote.
+type VoteCast struct {
+	Player *common.Player // May be nil
+	Option common.VoteOption
+	Vote   *common.Vote
+}
+
+// VoteEnded signals that the active vote passed or failed.
+type VoteEnded struct {
+	Passed bool
+	Yes    int // Number of yes votes
+	No     int // Number of no votes
+	Vote   *common.Vote
+}
+
+// RoundBackupFilenames signals the file names of the round backups (see mp_backup_round_file) of the server.
+// One event is dispatched per backup file.
+type RoundBackupFilenames struct {
+	Count    int // Total number of backup files
+	Index    int
+	Filename string
+	NiceName string // Human readable name of the backup, e.g. including the score
+}
+
+// XPReward is a part of an XPUpdate.
+type XPReward struct {
+	Points   int
+	Category int
+}
+
+// XPUpdate signals that a player was rewarded with XP at the end of the match.
+type XPUpdate struct {
+	SteamID32              uint32
+	CurrentXP              int
+	CurrentLevel           int
+	OperationPointsAwarded int
+	Rewards                []XPReward
+}
+
+// MatchEndConditions signals the conditions under which the match ends.
+// This is usually sent at the start of the match.
+type MatchEndConditions struct {
+	FragLimit int
+	MaxRounds int // mp_maxrounds
+	WinLimit  int // mp_winlimit
+	TimeLimit int // mp_timelimit
+}
+
+// PlayerStat is a part of a PlayerStatsUpdate.
+type PlayerStat struct {
+	Index int // Index of the stat in the game's list of player stats
+	Delta int // Change of the stat
+}
+
+// PlayerStatsUpdate signals an update of a player's internal stats.
+// This is usually only available in POV demos.
+type PlayerStatsUpdate struct {
+	Player *common.Player // May be nil
+	Stats  []PlayerStat
+}
+
+// ReportHit signals the position where the recording player hit another player.
+// This is only available in POV demos.
+type ReportHit struct {
+	Position  r3.Vector
+	Timestamp float32 // Server time (seconds) of the hit
+}
+
+// DamagePrintout signals the damage summary that is shown to the recording player after being killed,
+// i.e. the hits & damage dealt to and taken from the killer.
+// It is decoded from the SendLastKillerDamageToClient user message and only available in POV demos.
+type DamagePrintout struct {
+	HitsGiven   int // Number of hits on the killer
+	DamageGiven int // Damage dealt to the killer
+	HitsTaken   int // Number of hits taken from the killer
+	DamageTaken int // Damage taken from the killer
+}
+
+// EndOfMatchAccolade is an accolade (e.g. most kills or most MVPs) that is shown at the end of the match.
+type EndOfMatchAccolade struct {
+	Type     int     // Accolade type (eaccolade)
+	Value    float32 // Value the accolade was awarded for, e.g. the number of kills
+	Position int     // Rank of the player for this accolade
+}
+
+// EndOfMatchPlayerData is a part of EndOfMatchAllPlayersData.
+type EndOfMatchPlayerData struct {
+	Player     *common.Player // May be nil
+	EntityID   int
+	SteamID    int64
+	Name       string
+	Team       common.Team
+	Accolade   *EndOfMatchAccolade // nil if the player didn't receive an accolade
+	ItemDefIDs []int               // Definition indices of the items shown on the player's model
+	Color      int                 // Color of the player on the radar & scoreboard
+	IsBot      bool
+}
+
+// EndOfMatchAllPlayersData signals the data of all players that is shown on the end of match screen.
+// This is usually sent when the match is over, after the last round has ended.
+type EndOfMatchAllPlayersData struct {
+	Players []EndOfMatchPlayerData
+	Scene   int
+}
+
+// ConsoleCommand signals a console command that was executed by the recording client (or GOTV),
+// e.g. '+attack' or 'say glhf' from binds.
+type ConsoleCommand struct {
+	Command string
+}
+
+// FrameCommandInfo signals the view origin & angles of the recording player at the start of a demo packet.
+// The values are more precise than the player's entity properties but are only set in POV demos,
+// the event isn't dispatched for packets without view information (e.g. in GOTV demos).
+type FrameCommandInfo struct {
+	Info common.CommandInfo
+}
+
+// UserCommand signals the input (CUserCmd) of the recording player.
+// This is only available in POV demos.
+type UserCommand struct {
+	Sequence      int       // Outgoing sequence number of the demo frame
+	CommandNumber int       // Number of the command, increases by one for each command
+	TickCount     int       // Client tick of the command
+	ViewAngles    r3.Vector // Pitch (X), yaw (Y) & roll (Z) in degrees
+	AimDirection  r3.Vector
+	ForwardMove   float32 // Forward (> 0) / backward (< 0) speed
+	SideMove      float32 // Right (> 0) / left (< 0) speed
+	UpMove        float32
+	Buttons       common.Buttons
+	Impulse       int
+	WeaponSelect  int // Entity ID of the weapon that is being switched to, 0 if none
+	WeaponSubType int
+	MouseDX       int // Mouse movement (X) since the last command
+	MouseDY       int // Mouse movement (Y) since the last command
+}
+
+// WarmupHasEnded signals that the warmup has ended.
+// See also IsWarmupPeriodChanged.
+type WarmupHasEnded struct {
+}
+
 // ItemEquip signals an item was equipped.
 // This event is not available in all demos.
 type ItemEquip struct {
@@ -542,6 +755,23 @@ type IsWarmupPeriodChanged struct {
 	NewIsWarmupPeriod bool
 }
 
+// TimeoutStart signals that a team's timeout has started.
+// See also: GameState.Rules() for the remaining timeouts.
+type TimeoutStart struct {
+	Team common.Team
+}
+
+// TimeoutEnd signals that a team's timeout has ended.
+type TimeoutEnd struct {
+	Team common.Team
+}
+
+// OvertimeStart signals that an overtime has started.
+// Dispatched when DT_GameRulesProxy.m_nOvertimePlaying increases.
+type OvertimeStart struct {
+	Number int // Number of the overtime, starting at 1
+}
+
 // PlayerSpottersChanged signals that a player's spotters (other players that can se him) changed.
 type PlayerSpottersChanged struct {
 	Spotted *common.Player
@@ -50,7 +50,7 @@ func main() {
 	header, err := p.ParseHeader()
 	checkError(err)
 
-	curMap = metadata.MapNameToMap[header.MapName]
+	curMap, _ = metadata.MapByName(header.MapName)
 
 	nadeTrajectories := make(map[int64]*nadePath) // Trajectories of all destroyed nades
 
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/mock"
 
 	dem "github.com/markus-wa/demoinfocs-golang"
@@ -80,6 +82,26 @@ func (gs *GameState) IsMatchStarted() bool {
 	return gs.Called().Bool(0)
 }
 
+// Rules is a mock-implementation of IGameState.Rules().
+func (gs *GameState) Rules() common.GameRules {
+	return gs.Called().Get(0).(common.GameRules)
+}
+
+// ActiveVote is a mock-implementation of IGameState.ActiveVote().
+func (gs *GameState) ActiveVote() *common.Vote {
+	return gs.Called().Get(0).(*common.Vote)
+}
+
+// RoundTimeRemaining is a mock-implementation of IGameState.RoundTimeRemaining().
+func (gs *GameState) RoundTimeRemaining() time.Duration {
+	return gs.Called().Get(0).(time.Duration)
+}
+
+// FreezeTimeRemaining is a mock-implementation of IGameState.FreezeTimeRemaining().
+func (gs *GameState) FreezeTimeRemaining() time.Duration {
+	return gs.Called().Get(0).(time.Duration)
+}
+
 // ConVars is a mock-implementation of IGameState.ConVars().
 func (gs *GameState) ConVars() map[string]string {
 	return gs.Called().Get(0).(map[string]string)
@@ -3,6 +3,7 @@
 package fake
 
 import (
+	"context"
 	"time"
 
 	dp "github.com/markus-wa/godispatch"
@@ -115,6 +116,11 @@ func (p *Parser) Progress() float32 {
 	return p.Called().Get(0).(float32)
 }
 
+// RecoveredProblems is a mock-implementation of IParser.RecoveredProblems().
+func (p *Parser) RecoveredProblems() []dem.RecoveredProblem {
+	return p.Called().Get(0).([]dem.RecoveredProblem)
+}
+
 // RegisterEventHandler is a mock-implementation of IParser.RegisterEventHandler().
 // Return HandlerIdentifier cannot be mocked (for now).
 func (p *Parser) RegisterEventHandler(handler interface{}) dp.HandlerIdentifier {
@@ -168,6 +174,32 @@ func (p *Parser) ParseToEnd() (err error) {
 	return args.Error(0)
 }
 
+// ParseToEndContext is a mock-implementation of IParser.ParseToEndContext().
+//
+// Dispatches Parser.Events and Parser.NetMessages in the specified order
+// until the context is done.
+//
+// Returns the mocked error value or ctx.Err() if the context is done before the end.
+func (p *Parser) ParseToEndContext(ctx context.Context) (err error) {
+	args := p.Called(ctx)
+
+	maxFrame := max(p.Events)
+	maxNetMessageFrame := max(p.NetMessages)
+	if maxFrame < maxNetMessageFrame {
+		maxFrame = maxNetMessageFrame
+	}
+
+	for p.currentFrame <= maxFrame {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		p.parseNextFrame()
+	}
+
+	return args.Error(0)
+}
+
 func (p *Parser) parseNextFrame() {
 	events, ok := p.Events[p.currentFrame]
 	if ok {
@@ -199,6 +231,23 @@ func (p *Parser) ParseNextFrame() (b bool, err error) {
 	return args.Bool(0), args.Error(1)
 }
 
+// ParseNextFrameContext is a mock-implementation of IParser.ParseNextFrameContext().
+//
+// Dispatches Parser.Events and Parser.NetMessages in the specified order unless the context is done.
+//
+// Returns the mocked bool and error values or false and ctx.Err() if the context is done.
+func (p *Parser) ParseNextFrameContext(ctx context.Context) (b bool, err error) {
+	args := p.Called(ctx)
+
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	p.parseNextFrame()
+
+	return args.Bool(0), args.Error(1)
+}
+
 func max(numbers map[int][]interface{}) (maxNumber int) {
 	for maxNumber = range numbers {
 		break
@@ -217,3 +266,37 @@ func max(numbers map[int][]interface{}) (maxNumber int) {
 func (p *Parser) Cancel() {
 	p.Called()
 }
+
+// SeekToTick is a mock-implementation of IParser.SeekToTick().
+// Does not dispatch any events or net-messages.
+//
+// Returns the mocked error value.
+func (p *Parser) SeekToTick(tick int) error {
+	return p.Called(tick).Error(0)
+}
+
+// SeekToRound is a mock-implementation of IParser.SeekToRound().
+// Does not dispatch any events or net-messages.
+//
+// Returns the mocked error value.
+func (p *Parser) SeekToRound(round int) error {
+	return p.Called(round).Error(0)
+}
+
+// Snapshot is a mock-implementation of IParser.Snapshot().
+//
+// Returns the mocked snapshot data and error.
+func (p *Parser) Snapshot() ([]byte, error) {
+	args := p.Called()
+
+	data, _ := args.Get(0).([]byte)
+	return data, args.Error(1)
+}
+
+// RestoreSnapshot is a mock-implementation of IParser.RestoreSnapshot().
+// Does not change the state of the mock.
+//
+// Returns the mocked error value.
+func (p *Parser) RestoreSnapshot(data []byte) error {
+	return p.Called(data).Error(0)
+}
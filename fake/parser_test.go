@@ -1,10 +1,12 @@
 package fake_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	assert "github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
 
 	common "github.com/markus-wa/demoinfocs-golang/common"
 	events "github.com/markus-wa/demoinfocs-golang/events"
@@ -128,3 +130,23 @@ func cmdKey(b ...byte) msg.CSVCMsg_CmdKeyValues {
 		Keyvalues: b,
 	}
 }
+
+func TestParseToEndContext_Cancelled(t *testing.T) {
+	p := fake.NewParser()
+	p.On("ParseToEndContext", mock.Anything).Return(nil)
+	p.MockEvents(kill(common.EqAK47))
+	p.MockEvents(kill(common.EqScout))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var actual []interface{}
+	p.RegisterEventHandler(func(e events.Kill) {
+		actual = append(actual, e)
+		cancel()
+	})
+
+	err := p.ParseToEndContext(ctx)
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Len(t, actual, 1)
+}
@@ -175,6 +175,9 @@ func newGameEventHandler(parser *Parser) gameEventHandler {
 		"smokegrenade_expired":           geh.smokeGrenadeExpired,          // Smoke expired
 		"switch_team":                    nil,                              // Dunno, only present in POV demos
 		"tournament_reward":              nil,                              // Dunno
+		"vote_cast":                      geh.voteCast,                     // Player voted during a vote, see also VoteStart user message
+		"vote_changed":                   nil,                              // Vote counts, we count the votes ourselves
+		"vote_options":                   nil,                              // Options of the vote
 		"weapon_fire":                    delayIfNoPlayers(geh.weaponFire), // Weapon was fired
 		"weapon_fire_on_empty":           nil,                              // Sounds boring
 		"weapon_reload":                  geh.weaponReload,                 // Weapon reloaded
@@ -186,6 +189,10 @@ func newGameEventHandler(parser *Parser) gameEventHandler {
 }
 
 func (geh gameEventHandler) roundStart(data map[string]*msg.CSVCMsg_GameEventKeyT) {
+	geh.parser.roundStarted = true
+	geh.parser.recentKills = nil
+	geh.parser.resetClutch()
+
 	geh.dispatch(events.RoundStart{
 		TimeLimit: int(data["timelimit"].GetValLong()),
 		FragLimit: int(data["fraglimit"].GetValLong()),
@@ -220,6 +227,8 @@ func (geh gameEventHandler) roundEnd(data map[string]*msg.CSVCMsg_GameEventKeyT)
 		WinnerState: winnerState,
 		LoserState:  loserState,
 	})
+
+	geh.parser.endClutch(winner)
 }
 
 func (geh gameEventHandler) roundOfficiallyEnded(data map[string]*msg.CSVCMsg_GameEventKeyT) {
@@ -310,14 +319,19 @@ func (geh gameEventHandler) playerDeath(data map[string]*msg.CSVCMsg_GameEventKe
 	killer := geh.playerByUserID32(data["attacker"].GetValShort())
 	wepType := common.MapEquipment(data["weapon"].GetValString())
 
-	geh.dispatch(events.Kill{
+	kill := events.Kill{
 		Victim:            geh.playerByUserID32(data["userid"].GetValShort()),
 		Killer:            killer,
 		Assister:          geh.playerByUserID32(data["assister"].GetValShort()),
 		IsHeadshot:        data["headshot"].GetValBool(),
 		PenetratedObjects: int(data["penetrated"].GetValShort()),
 		Weapon:            geh.getEquipmentInstance(killer, wepType),
-	})
+	}
+
+	geh.dispatch(kill)
+
+	geh.parser.handleTrades(kill)
+	geh.parser.updateClutch(kill)
 }
 
 func (geh gameEventHandler) playerHurt(data map[string]*msg.CSVCMsg_GameEventKeyT) {
@@ -719,3 +733,9 @@ func getCommunityID(guid string) int64 {
 	// WTF are we doing here?
 	return valveMagicNumber + authID*2 + authSrv
 }
+
+func (geh gameEventHandler) voteCast(data map[string]*msg.CSVCMsg_GameEventKeyT) {
+	pl := geh.gameState().playersByEntityID[int(data["entityid"].GetValLong())]
+
+	geh.parser.castVote(pl, common.VoteOption(data["vote_option"].GetValByte()))
+}
@@ -1,6 +1,8 @@
 package demoinfocs
 
 import (
+	"time"
+
 	"github.com/markus-wa/demoinfocs-golang/common"
 	st "github.com/markus-wa/demoinfocs-golang/sendtables"
 )
@@ -20,10 +22,15 @@ type GameState struct {
 	entities           map[int]*st.Entity                // Maps entity IDs to entities
 	conVars            map[string]string
 	bomb               common.Bomb
+	bombExplosionTime  float32 // Server time (seconds) at which the planted bomb explodes
+	bombDefuseTime     float32 // Server time (seconds) at which the current defuse is finished
 	totalRoundsPlayed  int
 	gamePhase          common.GamePhase
 	isWarmupPeriod     bool
 	isMatchStarted     bool
+	rules              common.GameRules
+	activeVote         *common.Vote
+	demoInfoProvider   demoInfoProvider                       // Provides the tick rate for the timers
 	lastFlash          lastFlash                              // Information about the last flash that exploded, used to find the attacker and projectile for player_blind events
 	currentDefuser     *common.Player                         // Player currently defusing the bomb, if any
 	currentPlanter     *common.Player                         // Player currently planting the bomb, if any
@@ -129,6 +136,62 @@ func (gs GameState) IsMatchStarted() bool {
 	return gs.isMatchStarted
 }
 
+// Rules returns the current state of the game rules (timeouts, overtime, round win status etc.) according to CCSGameRulesProxy.
+// The returned struct is a copy and is not updated on changes.
+func (gs GameState) Rules() common.GameRules {
+	return gs.rules
+}
+
+// ActiveVote returns the vote that is currently running (e.g. for a tactical timeout or a kick).
+// Returns nil if there is no active vote.
+func (gs GameState) ActiveVote() *common.Vote {
+	return gs.activeVote
+}
+
+// RoundTimeRemaining returns the time left on the round timer.
+// Returns the full round time during the freeze time (see FreezeTimeRemaining()).
+// The round timer is irrelevant once the bomb has been planted, see Bomb().TimeToExplode() instead.
+func (gs GameState) RoundTimeRemaining() time.Duration {
+	roundTime := time.Duration(gs.rules.RoundTime) * time.Second
+	remaining := gs.serverTimeRemaining(gs.rules.RoundStartTime + float32(gs.rules.RoundTime))
+
+	if remaining > roundTime {
+		return roundTime
+	}
+
+	return remaining
+}
+
+// FreezeTimeRemaining returns the time left until the freeze time at the start of a round ends.
+// Returns 0 outside of the freeze time.
+func (gs GameState) FreezeTimeRemaining() time.Duration {
+	if !gs.rules.IsFreezePeriod {
+		return 0
+	}
+
+	return gs.serverTimeRemaining(gs.rules.RoundStartTime)
+}
+
+// serverTimeRemaining returns the time from the current in-game tick until the given server time (seconds).
+// Returns 0 if the server time has already passed or if the tick rate is unknown.
+func (gs GameState) serverTimeRemaining(serverTime float32) time.Duration {
+	if gs.demoInfoProvider.parser == nil {
+		return 0
+	}
+
+	tickRate := gs.demoInfoProvider.TickRate()
+	if tickRate == 0 {
+		return 0
+	}
+
+	remaining := float64(serverTime) - float64(gs.ingameTick)/tickRate
+	if remaining <= 0 {
+		return 0
+	}
+
+	return time.Duration(remaining * float64(time.Second))
+}
+
 // ConVars returns a map of CVar keys and values.
 // Not all values might be set.
 // See also: https://developer.valvesoftware.com/wiki/List_of_CS:GO_Cvars.
@@ -137,7 +200,18 @@ func (gs *GameState) ConVars() map[string]string {
 }
 
 func newGameState() *GameState {
-	gs := &GameState{
+	gs := new(GameState)
+	gs.reset()
+
+	return gs
+}
+
+// reset (re-)initializes the GameState in place.
+// References to the GameState and its TeamStates stay valid.
+func (gs *GameState) reset() {
+	*gs = GameState{
+		demoInfoProvider:   gs.demoInfoProvider,
+		bomb:               common.NewBomb(gs.demoInfoProvider),
 		playersByEntityID:  make(map[int]*common.Player),
 		playersByUserID:    make(map[int]*common.Player),
 		grenadeProjectiles: make(map[int]*common.GrenadeProjectile),
@@ -154,8 +228,6 @@ func newGameState() *GameState {
 	gs.ctState = common.NewTeamState(common.TeamCounterTerrorists, gs.Participants().TeamMembers)
 	gs.tState.Opponent = &gs.ctState
 	gs.ctState.Opponent = &gs.tState
-
-	return gs
 }
 
 // Participants provides helper functions on top of the currently connected players.
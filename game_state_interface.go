@@ -3,6 +3,8 @@
 package demoinfocs
 
 import (
+	"time"
+
 	"github.com/markus-wa/demoinfocs-golang/common"
 	st "github.com/markus-wa/demoinfocs-golang/sendtables"
 )
@@ -50,6 +52,19 @@ type IGameState interface {
 	IsWarmupPeriod() bool
 	// IsMatchStarted returns whether the match has started according to CCSGameRulesProxy.
 	IsMatchStarted() bool
+	// Rules returns the current state of the game rules (timeouts, overtime, round win status etc.) according to CCSGameRulesProxy.
+	// The returned struct is a copy and is not updated on changes.
+	Rules() common.GameRules
+	// ActiveVote returns the vote that is currently running (e.g. for a tactical timeout or a kick).
+	// Returns nil if there is no active vote.
+	ActiveVote() *common.Vote
+	// RoundTimeRemaining returns the time left on the round timer.
+	// Returns the full round time during the freeze time (see FreezeTimeRemaining()).
+	// The round timer is irrelevant once the bomb has been planted, see Bomb().TimeToExplode() instead.
+	RoundTimeRemaining() time.Duration
+	// FreezeTimeRemaining returns the time left until the freeze time at the start of a round ends.
+	// Returns 0 outside of the freeze time.
+	FreezeTimeRemaining() time.Duration
 	// ConVars returns a map of CVar keys and values.
 	// Not all values might be set.
 	// See also: https://developer.valvesoftware.com/wiki/List_of_CS:GO_Cvars.
@@ -2,6 +2,7 @@ package demoinfocs
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -266,3 +267,64 @@ func newPlayer() *common.Player {
 	pl.IsConnected = true
 	return pl
 }
+
+func TestGameState_RoundTimers(t *testing.T) {
+	p := newParser()
+	p.header.PlaybackTicks = 128
+	p.header.PlaybackTime = time.Second
+
+	gs := p.gameState
+	gs.rules.RoundStartTime = 100
+	gs.rules.RoundTime = 115
+	gs.rules.IsFreezePeriod = true
+
+	// 5 seconds before the freeze time ends
+	gs.ingameTick = 95 * 128
+	assert.Equal(t, 5*time.Second, gs.FreezeTimeRemaining())
+	assert.Equal(t, 115*time.Second, gs.RoundTimeRemaining())
+
+	// 15 seconds into the round
+	gs.rules.IsFreezePeriod = false
+	gs.ingameTick = 115 * 128
+	assert.Zero(t, gs.FreezeTimeRemaining())
+	assert.Equal(t, 100*time.Second, gs.RoundTimeRemaining())
+
+	// After the round time ran out
+	gs.ingameTick = 300 * 128
+	assert.Zero(t, gs.RoundTimeRemaining())
+}
+
+func TestGameState_RoundTimers_NoParser(t *testing.T) {
+	gs := newGameState()
+	gs.rules.RoundTime = 115
+	gs.rules.IsFreezePeriod = true
+
+	assert.Zero(t, gs.FreezeTimeRemaining())
+	assert.Zero(t, gs.RoundTimeRemaining())
+}
+
+func TestGameState_BombTimers(t *testing.T) {
+	p := newParser()
+	p.header.PlaybackTicks = 128
+	p.header.PlaybackTime = time.Second
+
+	gs := p.gameState
+	gs.bombExplosionTime = 140
+	gs.bombDefuseTime = 115
+	gs.bomb.IsTicking = true
+	gs.bomb.IsBeingDefused = true
+	gs.ingameTick = 110 * 128
+
+	assert.Equal(t, 30*time.Second, gs.Bomb().TimeToExplode())
+	assert.Equal(t, 5*time.Second, gs.Bomb().DefuseTimeRemaining())
+}
+
+func TestGameState_BombTimers_NoParser(t *testing.T) {
+	gs := newGameState()
+	gs.bombExplosionTime = 140
+	gs.bomb.IsTicking = true
+	gs.bomb.IsBeingDefused = true
+
+	assert.Zero(t, gs.Bomb().TimeToExplode())
+	assert.Zero(t, gs.Bomb().DefuseTimeRemaining())
+}
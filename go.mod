@@ -18,4 +18,4 @@ require (
 
 replace github.com/dustin/go-heatmap => github.com/markus-wa/go-heatmap v1.0.0
 
-go 1.11
+go 1.13
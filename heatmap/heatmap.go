@@ -0,0 +1,263 @@
+// Package heatmap generates heatmaps of positions (e.g. of players or events) as overlays for the radar images of the metadata package.
+//
+// Points can come from any source, PlayerPoint() creates them from the current state of a parser.
+// See examples/heatmap (https://github.com/markus-wa/demoinfocs-golang/tree/master/examples/heatmap).
+package heatmap
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/dustin/go-heatmap/schemes"
+	"github.com/golang/geo/r3"
+
+	dem "github.com/markus-wa/demoinfocs-golang"
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/metadata"
+)
+
+// Default configuration values.
+const (
+	DefaultKernelSize = 15
+	DefaultOpacity    = 128
+)
+
+// Point is a position that is added to a heatmap.
+type Point struct {
+	Position r3.Vector
+	Side     common.Team // Side the player was playing on
+	TeamID   int         // ID of the player's team, stays the same after switching sides (see common.TeamState.ID)
+	Round    int         // Round number, starting at 1 (see GameState.TotalRoundsPlayed())
+}
+
+// PlayerPoint returns a point with the current position, side, team and round of a player.
+func PlayerPoint(p dem.IParser, pl *common.Player) Point {
+	point := Point{
+		Position: pl.Position,
+		Side:     pl.Team,
+		Round:    p.GameState().TotalRoundsPlayed() + 1,
+	}
+
+	if ts := p.GameState().Team(pl.Team); ts != nil {
+		point.TeamID = ts.ID
+	}
+
+	return point
+}
+
+// Filter decides whether a point is added to a heatmap.
+type Filter func(Point) bool
+
+// Side returns a filter that only accepts points of players on the given side.
+func Side(side common.Team) Filter {
+	return func(p Point) bool {
+		return p.Side == side
+	}
+}
+
+// Team returns a filter that only accepts points of players in the team with the given ID.
+func Team(id int) Filter {
+	return func(p Point) bool {
+		return p.TeamID == id
+	}
+}
+
+// Rounds returns a filter that only accepts points from the rounds 'from' to 'to' (inclusive).
+func Rounds(from, to int) Filter {
+	return func(p Point) bool {
+		return p.Round >= from && p.Round <= to
+	}
+}
+
+// ZRange returns a filter that only accepts points with a Z coordinate between min (inclusive) and max (exclusive).
+// See Config.Level for separating the levels of multi-level maps.
+func ZRange(min, max float64) Filter {
+	return func(p Point) bool {
+		return p.Position.Z >= min && p.Position.Z < max
+	}
+}
+
+// Config contains the configuration for a heatmap.
+type Config struct {
+	// KernelSize is the radius in pixels of the area around a point that is heated up.
+	// DefaultKernelSize is used if it's <= 0.
+	KernelSize int
+
+	// ColorScheme is the color ramp from the hottest to the coldest color,
+	// see github.com/dustin/go-heatmap/schemes.
+	// schemes.AlphaFire is used if it's empty.
+	ColorScheme []color.Color
+
+	// Opacity of the overlay. DefaultOpacity is used if it's 0.
+	Opacity uint8
+
+	// Filters that all need to accept a point for it to be added.
+	Filters []Filter
+
+	// Level is the name of the level of a multi-level map (see metadata.Map.Levels) that the heatmap is drawn for.
+	// Points on other levels aren't added. Empty for the default level.
+	Level string
+}
+
+// Heatmap accumulates points on a map.
+type Heatmap struct {
+	mapMetadata metadata.Map
+	config      Config
+	points      []Point
+}
+
+// New returns a new heatmap for the given map.
+func New(m metadata.Map, config Config) *Heatmap {
+	if config.KernelSize <= 0 {
+		config.KernelSize = DefaultKernelSize
+	}
+
+	if len(config.ColorScheme) == 0 {
+		config.ColorScheme = schemes.AlphaFire
+	}
+
+	if config.Opacity == 0 {
+		config.Opacity = DefaultOpacity
+	}
+
+	return &Heatmap{
+		mapMetadata: m,
+		config:      config,
+	}
+}
+
+// Add adds points that are accepted by all filters to the heatmap.
+func (h *Heatmap) Add(points ...Point) {
+	for _, p := range points {
+		if h.accepts(p) {
+			h.points = append(h.points, p)
+		}
+	}
+}
+
+func (h *Heatmap) accepts(p Point) bool {
+	if h.mapMetadata.LevelAt(p.Position.Z).Name != h.config.Level {
+		return false
+	}
+
+	for _, f := range h.config.Filters {
+		if !f(p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Points returns all points that were added to the heatmap.
+func (h *Heatmap) Points() []Point {
+	return h.points
+}
+
+// Overlay returns the heatmap as transparent image of the given size.
+// Pixel coordinates correspond to the radar images of the metadata package.
+func (h *Heatmap) Overlay(bounds image.Rectangle) *image.NRGBA {
+	density, max := h.density(bounds)
+
+	img := image.NewNRGBA(bounds)
+	if max == 0 {
+		return img
+	}
+
+	scheme := h.config.ColorScheme
+	w := bounds.Dx()
+
+	for i, d := range density {
+		if d == 0 {
+			continue
+		}
+
+		c := color.NRGBAModel.Convert(scheme[int(float64(len(scheme)-1)*(1-d/max))]).(color.NRGBA)
+		c.A = uint8(int(c.A) * int(h.config.Opacity) / 255)
+
+		img.SetNRGBA(bounds.Min.X+i%w, bounds.Min.Y+i/w, c)
+	}
+
+	return img
+}
+
+// density returns the accumulated kernel density of all points for each pixel (row by row) and the maximum density.
+func (h *Heatmap) density(bounds image.Rectangle) ([]float64, float64) {
+	w, ht := bounds.Dx(), bounds.Dy()
+	density := make([]float64, w*ht)
+	r := h.config.KernelSize
+
+	var max float64
+
+	for _, p := range h.points {
+		fx, fy, _ := h.mapMetadata.TranslateScale3D(p.Position)
+		cx, cy := int(math.Round(fx))-bounds.Min.X, int(math.Round(fy))-bounds.Min.Y
+
+		for y := cy - r; y <= cy+r; y++ {
+			if y < 0 || y >= ht {
+				continue
+			}
+
+			for x := cx - r; x <= cx+r; x++ {
+				if x < 0 || x >= w {
+					continue
+				}
+
+				d := math.Hypot(float64(x-cx), float64(y-cy)) / float64(r)
+				if d >= 1 {
+					continue
+				}
+
+				// Epanechnikov kernel
+				i := y*w + x
+				density[i] += 1 - d*d
+
+				if density[i] > max {
+					max = density[i]
+				}
+			}
+		}
+	}
+
+	return density, max
+}
+
+// Draw returns a copy of the radar image with the heatmap drawn on top of it.
+func (h *Heatmap) Draw(radar image.Image) *image.RGBA {
+	img := image.NewRGBA(radar.Bounds())
+	draw.Draw(img, img.Bounds(), radar, radar.Bounds().Min, draw.Src)
+	draw.Draw(img, img.Bounds(), h.Overlay(img.Bounds()), img.Bounds().Min, draw.Over)
+
+	return img
+}
+
+// WritePNG writes the heatmap drawn on top of the radar image as PNG.
+func (h *Heatmap) WritePNG(w io.Writer, radar image.Image) error {
+	return png.Encode(w, h.Draw(radar))
+}
+
+// WriteJPEG writes the heatmap drawn on top of the radar image as JPEG with the given quality (1 - 100).
+func (h *Heatmap) WriteJPEG(w io.Writer, radar image.Image, quality int) error {
+	return jpeg.Encode(w, h.Draw(radar), &jpeg.Options{Quality: quality})
+}
+
+// LoadRadar loads the radar image of a level of the map from a directory containing the images of metadata/maps.
+// The level is the name of a level of a multi-level map, empty for the default level.
+func LoadRadar(dir string, m metadata.Map, level string) (image.Image, error) {
+	f, err := os.Open(filepath.Join(dir, m.RadarImageName(metadata.Level{Name: level})+".jpg"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+
+	return img, err
+}
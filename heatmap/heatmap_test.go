@@ -0,0 +1,162 @@
+package heatmap
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/golang/geo/r2"
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/fake"
+	"github.com/markus-wa/demoinfocs-golang/metadata"
+)
+
+// testMap translates world coordinates 1:1 to pixels with (0, 0) at the top left
+var testMap = metadata.Map{Name: "de_test", Scale: 1}
+
+func point(x, y, z float64) Point {
+	return Point{Position: r3.Vector{X: x, Y: -y, Z: z}}
+}
+
+func TestPlayerPoint(t *testing.T) {
+	p := fake.NewParser()
+	gs := new(fake.GameState)
+	p.On("GameState").Return(gs)
+	gs.On("TotalRoundsPlayed").Return(3)
+	gs.On("Team").Return(&common.TeamState{ID: 2})
+
+	pl := &common.Player{Position: r3.Vector{X: 1, Y: 2, Z: 3}, Team: common.TeamTerrorists}
+
+	assert.Equal(t, Point{
+		Position: r3.Vector{X: 1, Y: 2, Z: 3},
+		Side:     common.TeamTerrorists,
+		TeamID:   2,
+		Round:    4,
+	}, PlayerPoint(p, pl))
+}
+
+func TestFilters(t *testing.T) {
+	hm := New(testMap, Config{
+		Filters: []Filter{Side(common.TeamTerrorists), Team(2), Rounds(2, 3), ZRange(0, 100)},
+	})
+
+	valid := Point{Side: common.TeamTerrorists, TeamID: 2, Round: 2}
+	hm.Add(valid)
+
+	wrongSide := valid
+	wrongSide.Side = common.TeamCounterTerrorists
+	wrongTeam := valid
+	wrongTeam.TeamID = 3
+	wrongRound := valid
+	wrongRound.Round = 4
+	wrongLevel := valid
+	wrongLevel.Position.Z = 100
+
+	hm.Add(wrongSide, wrongTeam, wrongRound, wrongLevel)
+
+	assert.Equal(t, []Point{valid}, hm.Points())
+}
+
+func TestOverlay(t *testing.T) {
+	scheme := []color.Color{
+		color.NRGBA{R: 255, A: 255},
+		color.NRGBA{G: 255, A: 255},
+		color.NRGBA{B: 255, A: 255},
+	}
+	hm := New(testMap, Config{KernelSize: 3, ColorScheme: scheme, Opacity: 255})
+
+	hm.Add(point(10, 10, 0), point(10, 10, 0), point(20, 10, 0))
+
+	img := hm.Overlay(image.Rect(0, 0, 32, 32))
+
+	// Hottest
+	assert.Equal(t, color.NRGBA{R: 255, A: 255}, img.NRGBAAt(10, 10))
+	// Half as hot
+	assert.Equal(t, color.NRGBA{G: 255, A: 255}, img.NRGBAAt(20, 10))
+	assert.Equal(t, color.NRGBA{G: 255, A: 255}, img.NRGBAAt(22, 10))
+	// Outside of the kernel
+	assert.Equal(t, color.NRGBA{}, img.NRGBAAt(23, 10))
+	assert.Equal(t, color.NRGBA{}, img.NRGBAAt(15, 10))
+}
+
+func TestOverlay_Opacity(t *testing.T) {
+	hm := New(testMap, Config{ColorScheme: []color.Color{color.White}})
+
+	hm.Add(point(10, 10, 0))
+
+	assert.Equal(t, color.NRGBA{R: 255, G: 255, B: 255, A: DefaultOpacity}, hm.Overlay(image.Rect(0, 0, 32, 32)).NRGBAAt(10, 10))
+}
+
+func TestOverlay_OutOfBounds(t *testing.T) {
+	hm := New(testMap, Config{})
+
+	hm.Add(point(-100, -100, 0), point(100, 100, 0))
+
+	img := hm.Overlay(image.Rect(0, 0, 32, 32))
+
+	assert.Equal(t, make([]uint8, 32*32*4), img.Pix)
+}
+
+func TestWritePNG(t *testing.T) {
+	radar := image.NewRGBA(image.Rect(0, 0, 32, 32))
+	for i := range radar.Pix {
+		radar.Pix[i] = 255
+	}
+
+	hm := New(testMap, Config{ColorScheme: []color.Color{color.NRGBA{R: 255, A: 255}}, Opacity: 255})
+	hm.Add(point(10, 10, 0))
+
+	var buf bytes.Buffer
+	err := hm.WritePNG(&buf, radar)
+	assert.NoError(t, err)
+
+	img, err := png.Decode(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, color.RGBAModel.Convert(img.At(10, 10)))
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBAModel.Convert(img.At(30, 30)))
+}
+
+func TestLevels(t *testing.T) {
+	m := testMap
+	m.Levels = []metadata.Level{
+		{AltitudeMin: 0, AltitudeMax: 100},
+		{Name: "lower", AltitudeMin: -100, AltitudeMax: 0, Offset: r2.Point{X: 10}},
+	}
+
+	upper := New(m, Config{})
+	lower := New(m, Config{Level: "lower", KernelSize: 3, ColorScheme: []color.Color{color.White}, Opacity: 255})
+
+	upperPoint := point(10, 10, 50)
+	lowerPoint := point(10, 10, -50)
+
+	upper.Add(upperPoint, lowerPoint)
+	lower.Add(upperPoint, lowerPoint)
+
+	assert.Equal(t, []Point{upperPoint}, upper.Points())
+	assert.Equal(t, []Point{lowerPoint}, lower.Points())
+
+	// Offset of the lower level's image
+	img := lower.Overlay(image.Rect(0, 0, 32, 32))
+	assert.Equal(t, color.NRGBA{}, img.NRGBAAt(10, 10))
+	assert.Equal(t, color.NRGBA{R: 255, G: 255, B: 255, A: 255}, img.NRGBAAt(20, 10))
+}
+
+func TestLoadRadar(t *testing.T) {
+	img, err := LoadRadar("../metadata/maps", metadata.MapDeCache, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 1024, 1024), img.Bounds())
+
+	_, err = LoadRadar("../metadata/maps", metadata.MapDeNuke, "lower")
+
+	assert.NoError(t, err)
+
+	_, err = LoadRadar("../metadata/maps", testMap, "")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,453 @@
+// Package match builds a round based summary of a match from the events of a demoinfocs.IParser.
+//
+// It takes care of some known quirks of demos such as missing RoundEnd or MatchStart events
+// and rounds that are played during the warmup or before a match restart.
+package match
+
+import (
+	"time"
+
+	dem "github.com/markus-wa/demoinfocs-golang"
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// Match contains all rounds of a match that were finished.
+type Match struct {
+	Map    string
+	Rounds []Round
+}
+
+// Round contains a summary of a single round.
+//
+// Players are the same references as in the events, so their state reflects the latest parsed frame, not the time of the round.
+type Round struct {
+	Number            int // Starts at 1, rounds during the warmup and before a match restart aren't counted
+	Half              int // Starts at 1, overtime halves are counted as well
+	StartTick         int
+	FreezetimeEndTick int // 0 if the freeze-time end wasn't recorded (e.g. if the demo started mid-round)
+	EndTick           int // Tick of the RoundEnd event or of the score update if RoundEnd was missing
+	Duration          time.Duration
+	Winner            common.Team           // TeamSpectators for draws
+	EndReason         events.RoundEndReason // 0 if RoundEnd was missing and the winner was determined by the score update
+	EndMessage        string
+	ScoreT            int // Score of the terrorists after the round
+	ScoreCT           int // Score of the counter-terrorists after the round
+	Kills             []Kill
+	Trades            []Trade // Kills that were traded, see events.KillTraded
+	Damages           []Damage
+	Bomb              []BombEvent
+	EconomyT          TeamEconomy
+	EconomyCT         TeamEconomy
+	PlayersT          []*common.Player // Terrorists at the end of the freeze-time (or at the start of the round if that's missing)
+	PlayersCT         []*common.Player // Counter-terrorists at the end of the freeze-time (or at the start of the round if that's missing)
+	Clutch            *Clutch          // Clutch situation of the round, nil if there was none
+
+	startTime time.Duration
+}
+
+// Kill is a events.Kill that happened during a round.
+type Kill struct {
+	events.Kill
+
+	Tick int
+	Time time.Duration // Time since the start of the demo, see IParser.CurrentTime()
+}
+
+// Trade is a events.KillTraded that happened during a round.
+type Trade struct {
+	events.KillTraded
+
+	Tick int
+}
+
+// Clutch is the clutch situation of a round, see events.ClutchStarted & events.ClutchEnded.
+type Clutch struct {
+	Player    *common.Player
+	Opponents []*common.Player // Opponents that were alive when the clutch started
+	StartTick int
+	Won       bool // False if the round was lost or if ClutchEnded is missing (e.g. if RoundEnd was missing)
+}
+
+// Damage is a events.PlayerHurt that happened during a round.
+type Damage struct {
+	events.PlayerHurt
+
+	Tick int
+}
+
+// BombEventType is the type for the various BombEventXYZ constants.
+type BombEventType byte
+
+// BombEventType constants give information about what happened with the bomb.
+const (
+	BombEventPlantBegin BombEventType = iota + 1
+	BombEventPlantAborted
+	BombEventPlanted
+	BombEventDefuseStart
+	BombEventDefuseAborted
+	BombEventDefused
+	BombEventExplode
+	BombEventDropped
+	BombEventPickup
+)
+
+// BombEvent is an entry of a round's bomb timeline.
+type BombEvent struct {
+	Type   BombEventType
+	Tick   int
+	Player *common.Player // May be nil for BombEventExplode
+	Site   rune           // 'A' or 'B', only set for plants, defuses and explosions
+}
+
+// TeamEconomy contains the economy of a team during a round.
+// The values are summed up over all members at the end of the round.
+type TeamEconomy struct {
+	Team                        common.Team
+	ClanName                    string
+	Money                       int // Money left at the end of the round (before the next round's income)
+	RoundStartEquipmentValue    int
+	FreezeTimeEndEquipmentValue int
+	CashSpent                   int
+}
+
+// Collector collects rounds from the events of a parser.
+// Use NewCollector() to create one and Match() to get the summary.
+type Collector struct {
+	parser dem.IParser
+
+	rounds       []*Round // Finished rounds
+	current      *Round   // Round currently being played, may already be finished (see currentEnded)
+	currentEnded bool
+	endedByScore bool // Whether the current round was ended by a score update because RoundEnd was missing
+
+	mapName string
+	half    int
+
+	isWarmupPeriod bool
+	isMatchStarted bool
+	matchStartSeen bool // If neither MatchStart nor MatchStartedChanged was seen all rounds outside of the warmup are recorded
+}
+
+// NewCollector creates a Collector and registers the necessary event handlers on the parser.
+// The parser should not have started parsing yet.
+func NewCollector(parser dem.IParser) *Collector {
+	c := &Collector{parser: parser, half: 1}
+
+	parser.RegisterEventHandler(c.onMatchStart)
+	parser.RegisterEventHandler(c.onMatchStartedChanged)
+	parser.RegisterEventHandler(c.onIsWarmupPeriodChanged)
+	parser.RegisterEventHandler(c.onRoundStart)
+	parser.RegisterEventHandler(c.onRoundFreezetimeEnd)
+	parser.RegisterEventHandler(c.onRoundEnd)
+	parser.RegisterEventHandler(c.onScoreUpdated)
+	parser.RegisterEventHandler(c.onGameHalfEnded)
+	parser.RegisterEventHandler(c.onKill)
+	parser.RegisterEventHandler(c.onKillTraded)
+	parser.RegisterEventHandler(c.onClutchStarted)
+	parser.RegisterEventHandler(c.onClutchEnded)
+	parser.RegisterEventHandler(c.onPlayerHurt)
+	parser.RegisterEventHandler(c.onBombEvent)
+	parser.RegisterEventHandler(c.onBombDropped)
+	parser.RegisterEventHandler(c.onBombPickup)
+
+	return c
+}
+
+// FromParser parses the demo to the end and returns the summary of the match.
+// The returned Match contains all rounds up to the point where an error occurred, if any.
+func FromParser(parser dem.IParser) (Match, error) {
+	c := NewCollector(parser)
+
+	err := parser.ParseToEnd()
+
+	return c.Match(), err
+}
+
+// Match returns the summary of all rounds that were finished so far.
+func (c *Collector) Match() Match {
+	m := Match{
+		Map:    c.mapName,
+		Rounds: make([]Round, 0, len(c.rounds)),
+	}
+
+	for _, r := range c.rounds {
+		m.Rounds = append(m.Rounds, *r)
+	}
+
+	return m
+}
+
+func (c *Collector) isRecording() bool {
+	return !c.isWarmupPeriod && (c.isMatchStarted || !c.matchStartSeen)
+}
+
+func (c *Collector) tick() int {
+	return c.parser.GameState().IngameTick()
+}
+
+// restart discards all rounds, used when the match is (re-)started.
+func (c *Collector) restart() {
+	c.rounds = nil
+	c.current = nil
+	c.half = 1
+}
+
+func (c *Collector) onMatchStart(events.MatchStart) {
+	c.matchStartSeen = true
+	c.isMatchStarted = true
+	c.restart()
+}
+
+func (c *Collector) onMatchStartedChanged(e events.MatchStartedChanged) {
+	c.matchStartSeen = true
+	c.isMatchStarted = e.NewIsStarted
+
+	if !e.OldIsStarted && e.NewIsStarted {
+		c.restart()
+	}
+}
+
+func (c *Collector) onIsWarmupPeriodChanged(e events.IsWarmupPeriodChanged) {
+	c.isWarmupPeriod = e.NewIsWarmupPeriod
+
+	if e.NewIsWarmupPeriod {
+		// Rounds before the warmup restart don't count
+		c.restart()
+	}
+}
+
+func (c *Collector) onRoundStart(events.RoundStart) {
+	// Unfinished rounds are dropped, they are usually caused by restarts
+	c.current = nil
+
+	if !c.isRecording() {
+		return
+	}
+
+	c.startRound()
+}
+
+func (c *Collector) startRound() *Round {
+	if c.mapName == "" {
+		c.mapName = c.parser.Header().MapName
+	}
+
+	c.current = &Round{
+		Half:      c.half,
+		StartTick: c.tick(),
+		startTime: c.parser.CurrentTime(),
+	}
+	c.currentEnded = false
+	c.endedByScore = false
+
+	c.updatePlayers()
+
+	return c.current
+}
+
+// round returns the current round or starts one if the demo started mid-round.
+// Returns nil if nothing is being recorded.
+func (c *Collector) round() *Round {
+	if !c.isRecording() {
+		return nil
+	}
+
+	if c.current == nil {
+		return c.startRound()
+	}
+
+	return c.current
+}
+
+func (c *Collector) onRoundFreezetimeEnd(events.RoundFreezetimeEnd) {
+	if r := c.round(); r != nil {
+		r.FreezetimeEndTick = c.tick()
+
+		// Players may still be (re-)connecting during the freeze-time
+		c.updatePlayers()
+	}
+}
+
+func (c *Collector) updatePlayers() {
+	c.current.PlayersT = c.parser.GameState().TeamTerrorists().Members()
+	c.current.PlayersCT = c.parser.GameState().TeamCounterTerrorists().Members()
+}
+
+func (c *Collector) onGameHalfEnded(events.GameHalfEnded) {
+	if c.isRecording() {
+		c.half++
+	}
+}
+
+func (c *Collector) onRoundEnd(e events.RoundEnd) {
+	if e.Reason == events.RoundEndReasonGameStart {
+		// 'Game commencing', not an actual round
+		c.current = nil
+		return
+	}
+
+	r := c.round()
+	if r == nil {
+		return
+	}
+
+	if c.currentEnded {
+		// RoundEnd can come after the score update
+		if c.endedByScore && r.Winner == e.Winner {
+			r.EndReason = e.Reason
+			r.EndMessage = e.Message
+			c.endedByScore = false
+		}
+
+		return
+	}
+
+	r.EndReason = e.Reason
+	r.EndMessage = e.Message
+
+	// The score hasn't been updated yet when RoundEnd is dispatched
+	scoreT := c.parser.GameState().TeamTerrorists().Score
+	scoreCT := c.parser.GameState().TeamCounterTerrorists().Score
+
+	switch e.Winner {
+	case common.TeamTerrorists:
+		scoreT++
+	case common.TeamCounterTerrorists:
+		scoreCT++
+	}
+
+	c.endRound(e.Winner, scoreT, scoreCT)
+}
+
+func (c *Collector) onScoreUpdated(e events.ScoreUpdated) {
+	// Only score increases by one can end a round, anything else is a reset or a correction
+	if e.NewScore != e.OldScore+1 || c.currentEnded {
+		return
+	}
+
+	r := c.round()
+	if r == nil {
+		return
+	}
+
+	winner := e.TeamState.Team()
+	scoreT := c.parser.GameState().TeamTerrorists().Score
+	scoreCT := c.parser.GameState().TeamCounterTerrorists().Score
+
+	// Don't rely on the TeamState being updated already
+	if winner == common.TeamTerrorists {
+		scoreT = e.NewScore
+	} else {
+		scoreCT = e.NewScore
+	}
+
+	c.endRound(winner, scoreT, scoreCT)
+	c.endedByScore = true
+}
+
+func (c *Collector) endRound(winner common.Team, scoreT, scoreCT int) {
+	r := c.current
+
+	r.Number = len(c.rounds) + 1
+	r.EndTick = c.tick()
+	r.Duration = c.parser.CurrentTime() - r.startTime
+	r.Winner = winner
+	r.ScoreT = scoreT
+	r.ScoreCT = scoreCT
+	r.EconomyT = teamEconomy(c.parser.GameState().TeamTerrorists())
+	r.EconomyCT = teamEconomy(c.parser.GameState().TeamCounterTerrorists())
+
+	c.rounds = append(c.rounds, r)
+	c.currentEnded = true
+}
+
+func teamEconomy(team *common.TeamState) TeamEconomy {
+	eco := TeamEconomy{
+		Team:     team.Team(),
+		ClanName: team.ClanName,
+	}
+
+	for _, pl := range team.Members() {
+		eco.Money += pl.Money
+		eco.RoundStartEquipmentValue += pl.RoundStartEquipmentValue
+		eco.FreezeTimeEndEquipmentValue += pl.FreezetimeEndEquipmentValue
+
+		if pl.AdditionalPlayerInformation != nil {
+			eco.CashSpent += pl.AdditionalPlayerInformation.CashSpentThisRound
+		}
+	}
+
+	return eco
+}
+
+func (c *Collector) onKill(e events.Kill) {
+	if r := c.round(); r != nil {
+		r.Kills = append(r.Kills, Kill{Kill: e, Tick: c.tick(), Time: c.parser.CurrentTime()})
+	}
+}
+
+func (c *Collector) onKillTraded(e events.KillTraded) {
+	if r := c.round(); r != nil {
+		r.Trades = append(r.Trades, Trade{KillTraded: e, Tick: c.tick()})
+	}
+}
+
+func (c *Collector) onClutchStarted(e events.ClutchStarted) {
+	if r := c.round(); r != nil {
+		r.Clutch = &Clutch{Player: e.Player, Opponents: e.Opponents, StartTick: c.tick()}
+	}
+}
+
+func (c *Collector) onClutchEnded(e events.ClutchEnded) {
+	// ClutchEnded is dispatched after RoundEnd, the round has already ended
+	if r := c.current; r != nil && r.Clutch != nil && r.Clutch.Player == e.Player {
+		r.Clutch.Won = e.Won
+	}
+}
+
+func (c *Collector) onPlayerHurt(e events.PlayerHurt) {
+	if r := c.round(); r != nil {
+		r.Damages = append(r.Damages, Damage{PlayerHurt: e, Tick: c.tick()})
+	}
+}
+
+func (c *Collector) onBombEvent(e events.BombEventIf) {
+	var be BombEvent
+
+	switch e := e.(type) {
+	case events.BombPlantBegin:
+		be = BombEvent{Type: BombEventPlantBegin, Player: e.Player, Site: rune(e.Site)}
+	case events.BombPlantAborted:
+		be = BombEvent{Type: BombEventPlantAborted, Player: e.Player}
+	case events.BombPlanted:
+		be = BombEvent{Type: BombEventPlanted, Player: e.Player, Site: rune(e.Site)}
+	case events.BombDefuseStart:
+		be = BombEvent{Type: BombEventDefuseStart, Player: e.Player}
+	case events.BombDefuseAborted:
+		be = BombEvent{Type: BombEventDefuseAborted, Player: e.Player}
+	case events.BombDefused:
+		be = BombEvent{Type: BombEventDefused, Player: e.Player, Site: rune(e.Site)}
+	case events.BombExplode:
+		be = BombEvent{Type: BombEventExplode, Player: e.Player, Site: rune(e.Site)}
+	default:
+		return
+	}
+
+	c.addBombEvent(be)
+}
+
+func (c *Collector) onBombDropped(e events.BombDropped) {
+	c.addBombEvent(BombEvent{Type: BombEventDropped, Player: e.Player})
+}
+
+func (c *Collector) onBombPickup(e events.BombPickup) {
+	c.addBombEvent(BombEvent{Type: BombEventPickup, Player: e.Player})
+}
+
+func (c *Collector) addBombEvent(e BombEvent) {
+	if r := c.round(); r != nil {
+		e.Tick = c.tick()
+		r.Bomb = append(r.Bomb, e)
+	}
+}
@@ -0,0 +1,219 @@
+package match_test
+
+import (
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+
+	common "github.com/markus-wa/demoinfocs-golang/common"
+	events "github.com/markus-wa/demoinfocs-golang/events"
+	fake "github.com/markus-wa/demoinfocs-golang/fake"
+	match "github.com/markus-wa/demoinfocs-golang/match"
+)
+
+type teams struct {
+	t         common.TeamState
+	ct        common.TeamState
+	tPlayers  []*common.Player
+	ctPlayers []*common.Player
+}
+
+func newParser() (*fake.Parser, *teams) {
+	p := fake.NewParser()
+	gs := new(fake.GameState)
+
+	tms := new(teams)
+	members := func(team common.Team) []*common.Player {
+		if team == common.TeamTerrorists {
+			return tms.tPlayers
+		}
+		return tms.ctPlayers
+	}
+	tms.t = common.NewTeamState(common.TeamTerrorists, members)
+	tms.ct = common.NewTeamState(common.TeamCounterTerrorists, members)
+
+	p.On("GameState").Return(gs)
+	p.On("CurrentTime").Return(time.Duration(0))
+	p.On("Header").Return(common.DemoHeader{MapName: "de_cache"})
+	p.On("ParseToEnd").Return(nil)
+	gs.On("IngameTick").Return(100)
+	gs.On("TeamTerrorists").Return(&tms.t)
+	gs.On("TeamCounterTerrorists").Return(&tms.ct)
+
+	// Update the scores like the real parser would
+	p.RegisterEventHandler(func(e events.ScoreUpdated) {
+		e.TeamState.Score = e.NewScore
+	})
+
+	return p, tms
+}
+
+func roundEnd(winner common.Team, reason events.RoundEndReason) events.RoundEnd {
+	return events.RoundEnd{Winner: winner, Reason: reason}
+}
+
+func TestFromParser(t *testing.T) {
+	p, tms := newParser()
+
+	killer := &common.Player{Name: "killer"}
+	victim := &common.Player{Name: "victim"}
+	tms.tPlayers = []*common.Player{killer}
+	tms.ctPlayers = []*common.Player{victim}
+	kill := events.Kill{Killer: killer, Victim: victim}
+	hurt := events.PlayerHurt{Attacker: killer, Player: victim, HealthDamage: 100}
+	planted := events.BombPlanted{BombEvent: events.BombEvent{Player: killer, Site: events.BombsiteA}}
+
+	p.MockEvents(events.MatchStart{})
+	p.MockEvents(events.RoundStart{}, events.RoundFreezetimeEnd{})
+	p.MockEvents(hurt, kill)
+	p.MockEvents(planted, events.BombExplode{})
+	p.MockEvents(roundEnd(common.TeamTerrorists, events.RoundEndReasonTargetBombed))
+	p.MockEvents(events.ScoreUpdated{OldScore: 0, NewScore: 1, TeamState: &tms.t})
+	p.MockEvents(events.GameHalfEnded{})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(roundEnd(common.TeamCounterTerrorists, events.RoundEndReasonCTWin))
+	p.MockEvents(events.ScoreUpdated{OldScore: 0, NewScore: 1, TeamState: &tms.ct})
+
+	m, err := match.FromParser(p)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "de_cache", m.Map)
+	assert.Len(t, m.Rounds, 2)
+
+	r1 := m.Rounds[0]
+	assert.Equal(t, 1, r1.Number)
+	assert.Equal(t, 1, r1.Half)
+	assert.Equal(t, []*common.Player{killer}, r1.PlayersT)
+	assert.Equal(t, []*common.Player{victim}, r1.PlayersCT)
+	assert.Equal(t, common.TeamTerrorists, r1.Winner)
+	assert.Equal(t, events.RoundEndReasonTargetBombed, r1.EndReason)
+	assert.Equal(t, 1, r1.ScoreT)
+	assert.Equal(t, 0, r1.ScoreCT)
+	assert.Equal(t, 100, r1.FreezetimeEndTick)
+	assert.Equal(t, []match.Kill{{Kill: kill, Tick: 100}}, r1.Kills)
+	assert.Equal(t, []match.Damage{{PlayerHurt: hurt, Tick: 100}}, r1.Damages)
+	assert.Equal(t, []match.BombEvent{
+		{Type: match.BombEventPlanted, Tick: 100, Player: killer, Site: 'A'},
+		{Type: match.BombEventExplode, Tick: 100},
+	}, r1.Bomb)
+	assert.Equal(t, common.TeamTerrorists, r1.EconomyT.Team)
+
+	r2 := m.Rounds[1]
+	assert.Equal(t, 2, r2.Number)
+	assert.Equal(t, 2, r2.Half)
+	assert.Equal(t, common.TeamCounterTerrorists, r2.Winner)
+	assert.Equal(t, 1, r2.ScoreT)
+	assert.Equal(t, 1, r2.ScoreCT)
+	assert.Empty(t, r2.Kills)
+}
+
+func TestFromParser_MissingRoundEnd(t *testing.T) {
+	p, tms := newParser()
+
+	p.MockEvents(events.MatchStart{})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(events.ScoreUpdated{OldScore: 0, NewScore: 1, TeamState: &tms.ct})
+	p.MockEvents(events.RoundStart{})
+
+	m, err := match.FromParser(p)
+
+	assert.NoError(t, err)
+	assert.Len(t, m.Rounds, 1)
+	assert.Equal(t, common.TeamCounterTerrorists, m.Rounds[0].Winner)
+	assert.Equal(t, events.RoundEndReason(0), m.Rounds[0].EndReason)
+	assert.Equal(t, 1, m.Rounds[0].ScoreCT)
+}
+
+func TestFromParser_RoundEndAfterScoreUpdate(t *testing.T) {
+	p, tms := newParser()
+
+	p.MockEvents(events.MatchStart{})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(events.ScoreUpdated{OldScore: 0, NewScore: 1, TeamState: &tms.ct})
+	p.MockEvents(roundEnd(common.TeamCounterTerrorists, events.RoundEndReasonBombDefused))
+
+	m, err := match.FromParser(p)
+
+	assert.NoError(t, err)
+	assert.Len(t, m.Rounds, 1)
+	assert.Equal(t, events.RoundEndReasonBombDefused, m.Rounds[0].EndReason)
+	assert.Equal(t, 1, m.Rounds[0].ScoreCT)
+}
+
+func TestFromParser_Warmup(t *testing.T) {
+	p, _ := newParser()
+
+	p.MockEvents(events.IsWarmupPeriodChanged{NewIsWarmupPeriod: true})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(roundEnd(common.TeamTerrorists, events.RoundEndReasonTerroristsWin))
+	p.MockEvents(events.IsWarmupPeriodChanged{OldIsWarmupPeriod: true, NewIsWarmupPeriod: false})
+	p.MockEvents(events.RoundEnd{Reason: events.RoundEndReasonGameStart})
+	p.MockEvents(events.MatchStart{})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(roundEnd(common.TeamCounterTerrorists, events.RoundEndReasonCTWin))
+
+	m, err := match.FromParser(p)
+
+	assert.NoError(t, err)
+	assert.Len(t, m.Rounds, 1)
+	assert.Equal(t, 1, m.Rounds[0].Number)
+	assert.Equal(t, common.TeamCounterTerrorists, m.Rounds[0].Winner)
+}
+
+func TestFromParser_MissingMatchStart(t *testing.T) {
+	p, _ := newParser()
+
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(roundEnd(common.TeamTerrorists, events.RoundEndReasonTerroristsWin))
+
+	m, err := match.FromParser(p)
+
+	assert.NoError(t, err)
+	assert.Len(t, m.Rounds, 1)
+}
+
+func TestFromParser_MatchRestart(t *testing.T) {
+	p, _ := newParser()
+
+	p.MockEvents(events.MatchStartedChanged{NewIsStarted: true})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(roundEnd(common.TeamTerrorists, events.RoundEndReasonTerroristsWin))
+	p.MockEvents(events.MatchStartedChanged{OldIsStarted: true, NewIsStarted: false})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(roundEnd(common.TeamTerrorists, events.RoundEndReasonTerroristsWin))
+	p.MockEvents(events.MatchStartedChanged{OldIsStarted: false, NewIsStarted: true})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(roundEnd(common.TeamCounterTerrorists, events.RoundEndReasonCTWin))
+
+	m, err := match.FromParser(p)
+
+	assert.NoError(t, err)
+	assert.Len(t, m.Rounds, 1)
+	assert.Equal(t, common.TeamCounterTerrorists, m.Rounds[0].Winner)
+}
+
+func TestFromParser_TradesAndClutch(t *testing.T) {
+	p, _ := newParser()
+
+	t1, t2 := &common.Player{Name: "t1"}, &common.Player{Name: "t2"}
+	ct1 := &common.Player{Name: "ct1"}
+	traded := events.KillTraded{
+		Kill:      events.Kill{Killer: ct1, Victim: t1},
+		TradeKill: events.Kill{Killer: t2, Victim: ct1},
+	}
+
+	p.MockEvents(events.MatchStart{})
+	p.MockEvents(events.RoundStart{})
+	p.MockEvents(events.ClutchStarted{Player: t2, Opponents: []*common.Player{ct1}})
+	p.MockEvents(traded.TradeKill, traded)
+	p.MockEvents(roundEnd(common.TeamTerrorists, events.RoundEndReasonTerroristsWin))
+	p.MockEvents(events.ClutchEnded{Player: t2, Won: true})
+
+	m, err := match.FromParser(p)
+
+	assert.NoError(t, err)
+	assert.Len(t, m.Rounds, 1)
+	assert.Equal(t, []match.Trade{{KillTraded: traded, Tick: 100}}, m.Rounds[0].Trades)
+	assert.Equal(t, &match.Clutch{Player: t2, Opponents: []*common.Player{ct1}, StartTick: 100, Won: true}, m.Rounds[0].Clutch)
+}
@@ -0,0 +1,212 @@
+package demoinfocs
+
+import (
+	"io"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/msg"
+)
+
+// DemoMetadata contains information about a demo that can be read without parsing the whole demo.
+//
+// See also: ReadMetadata()
+type DemoMetadata struct {
+	Header     common.DemoHeader
+	MapName    string            // Map name from the server info, same as Header.MapName if the server info is missing
+	ServerName string            // Host name from the server info, same as Header.ServerName if the server info is missing
+	TickRate   float64           // Server tick rate from the server info, same as Header.TickRate() if the server info is missing
+	Players    []PlayerInfo      // Players (incl. bots & GOTV) that were connected when the recording started, sorted by entity ID
+	ConVars    map[string]string // ConVars that were set during sign-on (e.g. mp_maxrounds)
+}
+
+// PlayerInfo contains information about a player from the 'userinfo' string table.
+type PlayerInfo struct {
+	EntityID int
+	UserID   int
+	SteamID  int64
+	Name     string
+	GUID     string
+	IsBot    bool
+	IsHLTV   bool
+}
+
+/*
+ReadMetadata reads the header and the sign-on data at the start of a demo
+without decoding any data-tables or entities.
+Reading stops as soon as the sign-on is finished, so this is a lot faster than parsing the demo.
+
+Returns ErrInvalidFileType if the filestamp (first 8 bytes) doesn't match HL2DEMO
+and ErrUnexpectedEndOfDemo if the demo ends before the sign-on is finished.
+May return a CorruptPacketError, UnknownDemoCommandError or StringTableError for corrupt demos.
+*/
+func ReadMetadata(r io.Reader) (md DemoMetadata, err error) {
+	// No header is parsed by the parser so the message queue is never created
+	p := NewParser(r)
+
+	defer func() {
+		if err == nil {
+			err = recoverFromUnexpectedEOF(recover())
+		}
+	}()
+
+	md.Header, err = readHeader(p.bitReader)
+	if err != nil {
+		return
+	}
+
+	md.MapName = md.Header.MapName
+	md.ServerName = md.Header.ServerName
+	md.TickRate = md.Header.TickRate()
+	md.ConVars = make(map[string]string)
+
+	for {
+		var done bool
+		done, err = p.readMetadataFrame(&md)
+		if done || err != nil {
+			return
+		}
+	}
+}
+
+// readMetadataFrame reads a frame of the sign-on data.
+// Returns true once the sign-on is finished.
+func (p *Parser) readMetadataFrame(md *DemoMetadata) (done bool, err error) {
+	frameStart := p.demoPosition()
+	cmd := demoCommand(p.bitReader.ReadSingleByte())
+
+	// Skip ingame tick & player slot
+	p.bitReader.Skip(32 + 8)
+
+	switch cmd {
+	case dcSignon:
+		return false, p.readSignonPacket(md)
+
+	case dcSynctick:
+		// Ignore
+
+	case dcConsoleCommand, dcDataTables, dcStringTables:
+		p.bitReader.Skip(p.bitReader.ReadSignedInt(32) << 3)
+
+	case dcUserCommand, dcCustomData:
+		p.bitReader.Skip(32)
+		p.bitReader.Skip(p.bitReader.ReadSignedInt(32) << 3)
+
+	case dcPacket, dcStop:
+		return true, nil
+
+	default:
+		return false, &UnknownDemoCommandError{
+			DemoPosition: DemoPosition{Offset: frameStart},
+			Cmd:          int(cmd),
+		}
+	}
+
+	return false, nil
+}
+
+// readSignonPacket reads the server info, the first 'userinfo' string table and ConVars from a sign-on packet.
+// All other net-messages are skipped.
+func (p *Parser) readSignonPacket(md *DemoMetadata) error {
+	// 152 bytes CommandInfo, 4 bytes SeqNrIn, 4 bytes SeqNrOut
+	p.bitReader.Skip((152 + 4 + 4) << 3)
+
+	p.bitReader.BeginChunk(p.bitReader.ReadSignedInt(32) << 3)
+
+	for !p.bitReader.ChunkFinished() {
+		cmd := int(p.bitReader.ReadVarInt32())
+		size := int(p.bitReader.ReadVarInt32())
+
+		p.bitReader.BeginChunk(size << 3)
+
+		var m proto.Message
+
+		switch cmd {
+		case int(msg.SVC_Messages_svc_ServerInfo):
+			m = new(msg.CSVCMsg_ServerInfo)
+
+		case int(msg.SVC_Messages_svc_CreateStringTable):
+			m = new(msg.CSVCMsg_CreateStringTable)
+
+		case int(msg.NET_Messages_net_SetConVar):
+			m = new(msg.CNETMsg_SetConVar)
+		}
+
+		if m != nil {
+			msgStart := p.demoPosition()
+
+			err := proto.Unmarshal(p.bitReader.ReadBytes(size), m)
+			if err != nil {
+				return &CorruptPacketError{
+					DemoPosition: DemoPosition{Offset: msgStart},
+					Cmd:          cmd,
+					Err:          err,
+				}
+			}
+
+			err = p.handleSignonMessage(md, m)
+			if err != nil {
+				return err
+			}
+		}
+
+		p.bitReader.EndChunk()
+	}
+
+	p.bitReader.EndChunk()
+
+	return nil
+}
+
+func (p *Parser) handleSignonMessage(md *DemoMetadata, m proto.Message) error {
+	switch m := m.(type) {
+	case *msg.CSVCMsg_ServerInfo:
+		if m.MapName != "" {
+			md.MapName = m.MapName
+		}
+
+		if m.HostName != "" {
+			md.ServerName = m.HostName
+		}
+
+		if m.TickInterval > 0 {
+			md.TickRate = 1 / float64(m.TickInterval)
+		}
+
+	case *msg.CSVCMsg_CreateStringTable:
+		if m.Name != stNameUserInfo || md.Players != nil {
+			return nil
+		}
+
+		p.processStringTable(m)
+		if err := p.error(); err != nil {
+			return err
+		}
+
+		md.Players = make([]PlayerInfo, 0, len(p.rawPlayers))
+		for i, rp := range p.rawPlayers {
+			md.Players = append(md.Players, PlayerInfo{
+				EntityID: i + 1,
+				UserID:   rp.userID,
+				SteamID:  rp.xuid,
+				Name:     rp.name,
+				GUID:     rp.guid,
+				IsBot:    rp.isFakePlayer || rp.guid == "BOT",
+				IsHLTV:   rp.isHltv,
+			})
+		}
+
+		sort.Slice(md.Players, func(i, j int) bool {
+			return md.Players[i].EntityID < md.Players[j].EntityID
+		})
+
+	case *msg.CNETMsg_SetConVar:
+		for _, cvar := range m.GetConvars().GetCvars() {
+			md.ConVars[cvar.Name] = cvar.Value
+		}
+	}
+
+	return nil
+}
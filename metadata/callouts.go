@@ -0,0 +1,132 @@
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/golang/geo/r2"
+	"github.com/golang/geo/r3"
+)
+
+// Callout is a named area of a map (e.g. 'A Site' or 'Banana').
+type Callout struct {
+	Name string
+
+	// Polygon of the area in in-game world coordinates (X & Y).
+	// Consecutive points are connected, as are the last and the first point.
+	Polygon []r2.Point
+
+	// Level of a multi-level map that the callout is on (see Map.Levels), empty for the default level.
+	Level string
+}
+
+// Contains returns true if the given X & Y coordinates are inside the callout's polygon.
+func (c Callout) Contains(x, y float64) bool {
+	inside := false
+
+	// Ray casting, count the edges crossed by a ray from the point towards +X
+	for i, j := 0, len(c.Polygon)-1; i < len(c.Polygon); j, i = i, i+1 {
+		a, b := c.Polygon[i], c.Polygon[j]
+		if (a.Y > y) != (b.Y > y) && x < (b.X-a.X)*(y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// PlaceName returns the name of the first callout of the map that contains the given position.
+// Only callouts on the level of the position are considered for multi-level maps.
+// Returns an empty string if the map has no callouts or if no callout contains the position.
+// The pre-defined maps have no callouts, see Map.Callouts.
+//
+// See also: PlaceNameOr() which falls back to the place name of the map's navigation mesh.
+func (m Map) PlaceName(pos r3.Vector) string {
+	if len(m.Callouts) == 0 {
+		return ""
+	}
+
+	level := m.LevelAt(pos.Z).Name
+
+	for _, c := range m.Callouts {
+		if c.Level == level && c.Contains(pos.X, pos.Y) {
+			return c.Name
+		}
+	}
+
+	return ""
+}
+
+// PlaceNameOr returns the name of the first callout of the map that contains the given position, see PlaceName().
+// Returns fallback if no callout contains the position, e.g. because the map has no callouts.
+// The fallback is usually the place name of the map's navigation mesh:
+//
+//	place := m.PlaceNameOr(player.Position, player.LastPlaceName)
+func (m Map) PlaceNameOr(pos r3.Vector, fallback string) string {
+	if name := m.PlaceName(pos); name != "" {
+		return name
+	}
+
+	return fallback
+}
+
+// calloutJSON is the representation of a Callout in callout files.
+type calloutJSON struct {
+	Name    string       `json:"name"`
+	Polygon [][2]float64 `json:"polygon"`
+	Level   string       `json:"level"`
+}
+
+/*
+ParseCallouts parses callouts from JSON. The expected format is a list of named polygons in world coordinates:
+
+	[
+		{"name": "A Site", "polygon": [[1000, 2400], [1300, 2400], [1300, 2800], [1000, 2800]]},
+		{"name": "Ramp", "polygon": [[-500, -1200], [-300, -1200], [-300, -800]], "level": "lower"}
+	]
+
+Callouts that overlap should be ordered from most to least specific as the first match is used by Map.PlaceName().
+*/
+func ParseCallouts(r io.Reader) ([]Callout, error) {
+	var raw []calloutJSON
+
+	err := json.NewDecoder(r).Decode(&raw)
+	if err != nil {
+		return nil, err
+	}
+
+	callouts := make([]Callout, 0, len(raw))
+
+	for _, c := range raw {
+		if len(c.Polygon) < 3 {
+			return nil, fmt.Errorf("callout %q needs at least 3 points but has %d", c.Name, len(c.Polygon))
+		}
+
+		polygon := make([]r2.Point, len(c.Polygon))
+		for i, p := range c.Polygon {
+			polygon[i] = r2.Point{X: p[0], Y: p[1]}
+		}
+
+		callouts = append(callouts, Callout{
+			Name:    c.Name,
+			Polygon: polygon,
+			Level:   c.Level,
+		})
+	}
+
+	return callouts, nil
+}
+
+// LoadCallouts parses a callout file, see ParseCallouts() for the format.
+// The callouts can be assigned to Map.Callouts and registered with RegisterMap().
+func LoadCallouts(file string) ([]Callout, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseCallouts(f)
+}
@@ -0,0 +1,61 @@
+package metadata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/geo/r2"
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallout_Contains(t *testing.T) {
+	// Concave polygon (L-shape)
+	c := Callout{Polygon: []r2.Point{{X: 0, Y: 0}, {X: 200, Y: 0}, {X: 200, Y: 100}, {X: 100, Y: 100}, {X: 100, Y: 200}, {X: 0, Y: 200}}}
+
+	assert.True(t, c.Contains(50, 50))
+	assert.True(t, c.Contains(150, 50))
+	assert.True(t, c.Contains(50, 150))
+	assert.False(t, c.Contains(150, 150))
+	assert.False(t, c.Contains(-1, 50))
+	assert.False(t, c.Contains(50, 201))
+	assert.False(t, Callout{}.Contains(0, 0))
+}
+
+func TestMap_PlaceName(t *testing.T) {
+	callouts, err := LoadCallouts("testdata/callouts.json")
+	assert.NoError(t, err)
+
+	m := MapDeNuke
+	m.Callouts = callouts
+
+	assert.Equal(t, "A Site", m.PlaceName(r3.Vector{X: 50, Y: 50, Z: 0}))
+	assert.Equal(t, "Long A", m.PlaceName(r3.Vector{X: 200, Y: 50, Z: 0}))
+	assert.Equal(t, "Ramp", m.PlaceName(r3.Vector{X: 10, Y: 10, Z: -600}))
+	assert.Equal(t, "", m.PlaceName(r3.Vector{X: 200, Y: 50, Z: -600}))
+	assert.Equal(t, "", m.PlaceName(r3.Vector{X: 500, Y: 50, Z: 0}))
+	assert.Equal(t, "", MapDeNuke.PlaceName(r3.Vector{X: 50, Y: 50, Z: 0}))
+}
+
+func TestMap_PlaceNameOr(t *testing.T) {
+	callouts, err := LoadCallouts("testdata/callouts.json")
+	assert.NoError(t, err)
+
+	m := MapDeNuke
+	m.Callouts = callouts
+
+	assert.Equal(t, "A Site", m.PlaceNameOr(r3.Vector{X: 50, Y: 50, Z: 0}, "BombsiteA"))
+	assert.Equal(t, "BombsiteA", m.PlaceNameOr(r3.Vector{X: 500, Y: 50, Z: 0}, "BombsiteA"), "no callout contains the position")
+	assert.Equal(t, "BombsiteA", MapDeNuke.PlaceNameOr(r3.Vector{X: 50, Y: 50, Z: 0}, "BombsiteA"), "no callouts")
+}
+
+func TestParseCallouts_Errors(t *testing.T) {
+	_, err := ParseCallouts(strings.NewReader(`[{"name": "Mid", "polygon": [[0, 0], [1, 1]]}]`))
+	assert.EqualError(t, err, `callout "Mid" needs at least 3 points but has 2`)
+
+	_, err = ParseCallouts(strings.NewReader(`{`))
+	assert.Error(t, err)
+
+	_, err = LoadCallouts("testdata/missing.json")
+	assert.Error(t, err)
+}
@@ -3,7 +3,10 @@
 package metadata
 
 import (
+	"math"
+
 	"github.com/golang/geo/r2"
+	"github.com/golang/geo/r3"
 )
 
 // Map represents a CS:GO map. It contains information required to translate
@@ -12,6 +15,71 @@ type Map struct {
 	Name  string
 	PZero r2.Point
 	Scale float64
+
+	// Levels contains the vertical sections of multi-level maps (e.g. de_nuke & de_vertigo),
+	// each of which has its own radar image. The first level is the default level.
+	// Empty for maps with only one level.
+	Levels []Level
+
+	// Callouts contains named areas of the map, see PlaceName() & LoadCallouts().
+	// Empty for the pre-defined maps, no callout data is shipped with this package.
+	// Load callouts with LoadCallouts() and register the map with RegisterMap() to use them,
+	// otherwise use PlaceNameOr() to fall back to the place names of the map's navigation mesh (common.Player.LastPlaceName).
+	Callouts []Callout
+}
+
+// Level is a vertical section of a multi-level map.
+type Level struct {
+	// Name of the level, used as suffix for the radar image (e.g. 'lower' for de_nuke_lower.jpg).
+	// Empty for the default level.
+	Name string
+
+	// Positions with a Z coordinate in [AltitudeMin, AltitudeMax) are on this level.
+	AltitudeMin float64
+	AltitudeMax float64
+
+	// Offset of the level's radar image in pixels, added after translating & scaling.
+	Offset r2.Point
+}
+
+// Contains returns true if the given Z coordinate is on this level.
+func (l Level) Contains(z float64) bool {
+	return z >= l.AltitudeMin && z < l.AltitudeMax
+}
+
+// LevelAt returns the level that contains the given Z coordinate.
+// Returns the default level if no level contains it or if the map has only one level (an empty Level in that case).
+func (m Map) LevelAt(z float64) Level {
+	for _, l := range m.Levels {
+		if l.Contains(z) {
+			return l
+		}
+	}
+
+	if len(m.Levels) > 0 {
+		return m.Levels[0]
+	}
+
+	return Level{}
+}
+
+// TranslateScale3D translates and scales in-game world coordinates to pixel coordinates
+// on the radar image of the level of the position.
+// Returns the pixel coordinates and the level.
+func (m Map) TranslateScale3D(pos r3.Vector) (x, y float64, level Level) {
+	level = m.LevelAt(pos.Z)
+	x, y = m.TranslateScale(pos.X, pos.Y)
+
+	return x + level.Offset.X, y + level.Offset.Y, level
+}
+
+// RadarImageName returns the file name (without extension) of the radar image of a level in the maps folder.
+func (m Map) RadarImageName(level Level) string {
+	if level.Name == "" {
+		return m.Name
+	}
+
+	return m.Name + "_" + level.Name
 }
 
 // Translate translates in-game world-relative coordinates to (0, 0) relative coordinates.
@@ -31,6 +99,17 @@ func makeMap(name string, x, y, scale float64) Map {
 	return Map{Name: name, PZero: r2.Point{X: x, Y: y}, Scale: scale}
 }
 
+// makeMultiLevelMap creates a map stuct with a default (upper) and a lower level that are split at the given altitude.
+func makeMultiLevelMap(name string, x, y, scale, split float64) Map {
+	m := makeMap(name, x, y, scale)
+	m.Levels = []Level{
+		{AltitudeMin: split, AltitudeMax: math.Inf(1)},
+		{Name: "lower", AltitudeMin: math.Inf(-1), AltitudeMax: split},
+	}
+
+	return m
+}
+
 // Pre-defined map translations.
 var (
 	MapDeCache    = makeMap("de_cache", -2000, 3250, 5.5)
@@ -39,13 +118,16 @@ var (
 	MapDeDust2    = makeMap("de_dust2", -2476, 3239, 4.4)
 	MapDeInferno  = makeMap("de_inferno", -2087, 3870, 4.9)
 	MapDeMirage   = makeMap("de_mirage", -3230, 1713, 5)
-	MapDeNuke     = makeMap("de_nuke", -3453, 2887, 7)
+	MapDeNuke     = makeMultiLevelMap("de_nuke", -3453, 2887, 7, -495)
 	MapDeOverpass = makeMap("de_overpass", -4831, 1781, 5.2)
 	MapDeTrain    = makeMap("de_train", -2477, 2392, 4.7)
-	MapDeVertigo  = makeMap("de_vertigo", -3168, 1762, 4)
+	MapDeVertigo  = makeMultiLevelMap("de_vertigo", -3168, 1762, 4, 11700)
 )
 
 // MapNameToMap translates a map name to a Map.
+// It only contains the pre-defined maps and must not be modified after initialization.
+//
+// Deprecated: Use MapByName(), which also returns maps added with RegisterMap() or LoadOverviews().
 var MapNameToMap = map[string]Map{
 	"de_cache":    MapDeCache,
 	"de_canals":   MapDeCanals,
@@ -0,0 +1,39 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/golang/geo/r2"
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslateScale3D(t *testing.T) {
+	x, y, level := MapDeNuke.TranslateScale3D(r3.Vector{X: -3453 + 70, Y: 2887 - 140, Z: -600})
+
+	assert.Equal(t, float64(10), x)
+	assert.Equal(t, float64(20), y)
+	assert.Equal(t, "lower", level.Name)
+	assert.Equal(t, "de_nuke_lower", MapDeNuke.RadarImageName(level))
+
+	_, _, level = MapDeNuke.TranslateScale3D(r3.Vector{Z: -495})
+
+	assert.Equal(t, "", level.Name)
+	assert.Equal(t, "de_nuke", MapDeNuke.RadarImageName(level))
+}
+
+func TestTranslateScale3D_Offset(t *testing.T) {
+	m := makeMap("test", 0, 0, 1)
+	m.Levels = []Level{{AltitudeMin: 0, AltitudeMax: 1, Offset: r2.Point{X: 5, Y: 10}}}
+
+	x, y, _ := m.TranslateScale3D(r3.Vector{X: 1, Y: -1, Z: 0.5})
+
+	assert.Equal(t, float64(6), x)
+	assert.Equal(t, float64(11), y)
+}
+
+func TestLevelAt(t *testing.T) {
+	assert.Equal(t, Level{}, MapDeCache.LevelAt(0))
+	assert.Equal(t, MapDeVertigo.Levels[0], MapDeVertigo.LevelAt(11700))
+	assert.Equal(t, MapDeVertigo.Levels[1], MapDeVertigo.LevelAt(11699))
+}
@@ -0,0 +1,384 @@
+package metadata
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidOverview signals that an overview file doesn't contain the required values (pos_x, pos_y & scale).
+var ErrInvalidOverview = errors.New("overview is missing pos_x, pos_y or scale (ErrInvalidOverview)")
+
+// Maps registered via RegisterMap() and LoadOverviews(), these take precedence over MapNameToMap.
+var (
+	registeredMaps = make(map[string]Map)
+	mapsLock       sync.RWMutex
+)
+
+// RegisterMap adds a map or replaces the existing one with the same name, see MapByName().
+// It's safe to call RegisterMap() concurrently with MapByName().
+func RegisterMap(m Map) {
+	mapsLock.Lock()
+	registeredMaps[m.Name] = m
+	mapsLock.Unlock()
+}
+
+// MapByName returns the map for a map name as returned by Parser.ParseHeader(),
+// either one that was registered via RegisterMap() or one of MapNameToMap.
+// Workshop paths (e.g. 'workshop/123456789/de_example') are reduced to the name of the map.
+func MapByName(name string) (Map, bool) {
+	m, ok := lookupMap(name)
+	if !ok {
+		m, ok = lookupMap(path.Base(name))
+	}
+
+	return m, ok
+}
+
+func lookupMap(name string) (Map, bool) {
+	mapsLock.RLock()
+	m, ok := registeredMaps[name]
+	mapsLock.RUnlock()
+
+	if !ok {
+		m, ok = MapNameToMap[name]
+	}
+
+	return m, ok
+}
+
+// LoadOverviews parses all overview files (*.txt) in a directory (e.g. 'csgo/resource/overviews') and registers the maps.
+// Files that aren't valid overviews are skipped.
+// Returns the registered maps.
+func LoadOverviews(dir string) ([]Map, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	var maps []Map
+
+	for _, file := range files {
+		m, err := loadOverview(file)
+		if err == ErrInvalidOverview {
+			// Probably not an overview file
+			continue
+		}
+
+		if err != nil {
+			return maps, fmt.Errorf("failed to load overview %q: %s", file, err.Error())
+		}
+
+		RegisterMap(m)
+
+		maps = append(maps, m)
+	}
+
+	return maps, nil
+}
+
+func loadOverview(file string) (Map, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return Map{}, err
+	}
+	defer f.Close()
+
+	return ParseOverview(f)
+}
+
+/*
+ParseOverview parses an overview file in Valve's KeyValues format (resource/overviews/<map>.txt).
+
+The name of the map is taken from the root key of the file.
+Vertical sections are parsed into Map.Levels, the 'default' section becomes the default level.
+
+Returns ErrInvalidOverview if pos_x, pos_y or scale are missing.
+*/
+func ParseOverview(r io.Reader) (Map, error) {
+	root, err := parseKeyValues(r)
+	if err != nil {
+		return Map{}, err
+	}
+
+	var m Map
+
+	m.Name = root.key
+
+	var hasX, hasY, hasScale bool
+
+	for _, kv := range root.children {
+		var err error
+
+		switch strings.ToLower(kv.key) {
+		case "pos_x":
+			m.PZero.X, err = parseFloat(kv)
+			hasX = true
+
+		case "pos_y":
+			m.PZero.Y, err = parseFloat(kv)
+			hasY = true
+
+		case "scale":
+			m.Scale, err = parseFloat(kv)
+			hasScale = true
+
+		case "verticalsections":
+			m.Levels, err = parseVerticalSections(kv)
+		}
+
+		if err != nil {
+			return Map{}, err
+		}
+	}
+
+	if !hasX || !hasY || !hasScale {
+		return Map{}, ErrInvalidOverview
+	}
+
+	return m, nil
+}
+
+func parseVerticalSections(sections *keyValue) ([]Level, error) {
+	var levels []Level
+
+	for _, section := range sections.children {
+		var level Level
+
+		if !strings.EqualFold(section.key, "default") {
+			level.Name = section.key
+		}
+
+		for _, kv := range section.children {
+			var err error
+
+			switch strings.ToLower(kv.key) {
+			case "altitudemin":
+				level.AltitudeMin, err = parseFloat(kv)
+
+			case "altitudemax":
+				level.AltitudeMax, err = parseFloat(kv)
+
+			case "pos_x":
+				level.Offset.X, err = parseFloat(kv)
+
+			case "pos_y":
+				level.Offset.Y, err = parseFloat(kv)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		levels = append(levels, level)
+	}
+
+	// The default level comes first
+	sort.SliceStable(levels, func(i, j int) bool {
+		return levels[i].Name == "" && levels[j].Name != ""
+	})
+
+	return levels, nil
+}
+
+func parseFloat(kv *keyValue) (float64, error) {
+	f, err := strconv.ParseFloat(kv.value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q for %q", kv.value, kv.key)
+	}
+
+	return f, nil
+}
+
+// keyValue is a node of a KeyValues file, either with a value or with children.
+type keyValue struct {
+	key      string
+	value    string
+	children []*keyValue
+}
+
+// parseKeyValues parses the first root node of a KeyValues file.
+func parseKeyValues(r io.Reader) (*keyValue, error) {
+	t := &kvTokenizer{r: bufio.NewReader(r), line: 1}
+
+	key, err := t.next()
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := t.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if open != "{" {
+		return nil, fmt.Errorf("line %d: expected '{' after %q", t.line, key)
+	}
+
+	root := &keyValue{key: key}
+
+	return root, t.parseChildren(root)
+}
+
+func (t *kvTokenizer) parseChildren(parent *keyValue) error {
+	for {
+		key, err := t.next()
+		if err == io.EOF {
+			return fmt.Errorf("line %d: missing '}' for %q", t.line, parent.key)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if key == "}" {
+			return nil
+		}
+
+		value, err := t.next()
+		if err != nil {
+			return fmt.Errorf("line %d: missing value for %q", t.line, key)
+		}
+
+		kv := &keyValue{key: key}
+
+		if value == "{" {
+			err = t.parseChildren(kv)
+			if err != nil {
+				return err
+			}
+		} else {
+			kv.value = value
+		}
+
+		parent.children = append(parent.children, kv)
+	}
+}
+
+type kvTokenizer struct {
+	r    *bufio.Reader
+	line int
+}
+
+// next returns the next token; a (quoted or unquoted) string, '{' or '}'.
+// Comments and conditionals (e.g. [$WIN32]) are skipped.
+func (t *kvTokenizer) next() (string, error) {
+	for {
+		c, err := t.r.ReadByte()
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case c == '\n':
+			t.line++
+
+		case c == ' ' || c == '\t' || c == '\r':
+			// Skip whitespace
+
+		case c == '{' || c == '}':
+			return string(c), nil
+
+		case c == '/':
+			err = t.skipComment()
+			if err != nil {
+				return "", err
+			}
+
+		case c == '[':
+			_, err = t.r.ReadString(']')
+			if err != nil {
+				return "", err
+			}
+
+		case c == '"':
+			return t.quoted()
+
+		default:
+			err = t.r.UnreadByte()
+			if err != nil {
+				return "", err
+			}
+
+			return t.unquoted()
+		}
+	}
+}
+
+func (t *kvTokenizer) skipComment() error {
+	c, err := t.r.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	if c != '/' {
+		return fmt.Errorf("line %d: unexpected '/'", t.line)
+	}
+
+	_, err = t.r.ReadString('\n')
+	t.line++
+
+	return err
+}
+
+func (t *kvTokenizer) quoted() (string, error) {
+	var sb strings.Builder
+
+	for {
+		c, err := t.r.ReadByte()
+		if err == io.EOF {
+			return "", fmt.Errorf("line %d: unterminated string", t.line)
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		switch c {
+		case '"':
+			return sb.String(), nil
+
+		case '\\':
+			// Only escaped quotes & backslashes, other backslashes are often used in paths
+			next, err := t.r.Peek(1)
+			if err == nil && (next[0] == '"' || next[0] == '\\') {
+				c, _ = t.r.ReadByte()
+			}
+
+		case '\n':
+			t.line++
+		}
+
+		sb.WriteByte(c)
+	}
+}
+
+func (t *kvTokenizer) unquoted() (string, error) {
+	var sb strings.Builder
+
+	for {
+		c, err := t.r.ReadByte()
+		if err == io.EOF {
+			return sb.String(), nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '"' || c == '{' || c == '}' {
+			return sb.String(), t.r.UnreadByte()
+		}
+
+		sb.WriteByte(c)
+	}
+}
@@ -0,0 +1,122 @@
+package metadata
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/geo/r2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOverview(t *testing.T) {
+	f, err := os.Open("testdata/de_nuke.txt")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	m, err := ParseOverview(f)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		Name:  "de_nuke",
+		PZero: r2.Point{X: -3453, Y: 2887},
+		Scale: 7,
+		Levels: []Level{
+			{AltitudeMin: -495, AltitudeMax: 10000},
+			{Name: "lower", AltitudeMin: -10000, AltitudeMax: -495},
+		},
+	}, m)
+}
+
+func TestParseOverview_Unquoted(t *testing.T) {
+	f, err := os.Open("testdata/de_example.txt")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	m, err := ParseOverview(f)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Map{Name: "de_example", PZero: r2.Point{X: -100.5, Y: 200}, Scale: 2}, m)
+}
+
+func TestParseKeyValues_Escapes(t *testing.T) {
+	kv, err := parseKeyValues(strings.NewReader(`"root" { "name" "path\to\file \"quoted\"" }`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, `path\to\file "quoted"`, kv.children[0].value)
+}
+
+func TestParseOverview_Errors(t *testing.T) {
+	cases := map[string]string{
+		"missing brace":       `"de_test" { "pos_x" "1"`,
+		"missing open brace":  `"de_test" "pos_x"`,
+		"unterminated string": `"de_test" { "pos_x" "1 }`,
+		"invalid number":      `"de_test" { "pos_x" "a" "pos_y" "1" "scale" "1" }`,
+		"invalid comment":     `"de_test" { / }`,
+		"empty":               ``,
+	}
+
+	for name, overview := range cases {
+		_, err := ParseOverview(strings.NewReader(overview))
+		assert.Error(t, err, name)
+	}
+
+	_, err := ParseOverview(strings.NewReader(`"de_test" { "pos_x" "1" "pos_y" "1" }`))
+	assert.Equal(t, ErrInvalidOverview, err)
+}
+
+func TestLoadOverviews(t *testing.T) {
+	defer unregisterMaps("de_example", "de_nuke")
+
+	maps, err := LoadOverviews("testdata")
+
+	assert.NoError(t, err)
+	assert.Len(t, maps, 2)
+
+	m, ok := MapByName("workshop/123456789/de_example")
+	assert.True(t, ok)
+	assert.Equal(t, maps[0], m)
+
+	// Replaced by the loaded overview, which is the same
+	m, ok = MapByName("de_nuke")
+	assert.True(t, ok)
+	assert.Equal(t, MapDeNuke.PZero, m.PZero)
+
+	_, ok = MapByName("de_unknown")
+	assert.False(t, ok)
+
+	_, ok = MapNameToMap["de_example"]
+	assert.False(t, ok, "MapNameToMap shouldn't be modified")
+}
+
+func TestRegisterMap_Concurrent(t *testing.T) {
+	defer unregisterMaps("de_concurrent")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		RegisterMap(Map{Name: "de_concurrent"})
+	}()
+
+	go func() {
+		defer wg.Done()
+		MapByName("de_concurrent")
+	}()
+
+	wg.Wait()
+
+	_, ok := MapByName("de_concurrent")
+	assert.True(t, ok)
+}
+
+func unregisterMaps(names ...string) {
+	mapsLock.Lock()
+	defer mapsLock.Unlock()
+
+	for _, name := range names {
+		delete(registeredMaps, name)
+	}
+}
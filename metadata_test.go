@@ -0,0 +1,188 @@
+package demoinfocs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/msg"
+)
+
+// bitWriter writes bits in the same order as they are read by the bitread package.
+type bitWriter struct {
+	buf []byte
+	n   int
+}
+
+func (w *bitWriter) bits(v uint64, n int) {
+	for i := 0; i < n; i++ {
+		if w.n%8 == 0 {
+			w.buf = append(w.buf, 0)
+		}
+
+		if v&(1<<uint(i)) != 0 {
+			w.buf[w.n/8] |= 1 << uint(w.n%8)
+		}
+
+		w.n++
+	}
+}
+
+func (w *bitWriter) bit(b bool) {
+	if b {
+		w.bits(1, 1)
+	} else {
+		w.bits(0, 1)
+	}
+}
+
+func (w *bitWriter) bytes(b []byte) {
+	for _, x := range b {
+		w.bits(uint64(x), 8)
+	}
+}
+
+func fixedCString(s string, n int) []byte {
+	b := make([]byte, n)
+	copy(b, s)
+	return b
+}
+
+func rawPlayerInfo(xuid int64, name string, userID int, guid string, isFakePlayer bool) []byte {
+	var buf bytes.Buffer
+
+	b := make([]byte, 8)
+	buf.Write(b) // version
+	binary.BigEndian.PutUint64(b, uint64(xuid))
+	buf.Write(b)
+	buf.Write(fixedCString(name, 128))
+	b = make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(userID))
+	buf.Write(b)
+	buf.Write(fixedCString(guid, 33))
+	buf.Write(make([]byte, 4))   // friendsID
+	buf.Write(make([]byte, 128)) // friendsName
+	if isFakePlayer {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	buf.WriteByte(0)               // isHltv
+	buf.Write(make([]byte, 4*4+1)) // customFiles & filesDownloaded
+
+	return buf.Bytes()
+}
+
+// userInfoTable returns a 'userinfo' string table (max. 64 entries) with the given players by entry index.
+func userInfoTable(players map[int][]byte) *msg.CSVCMsg_CreateStringTable {
+	w := new(bitWriter)
+	w.bit(false) // No dictionary encoding
+
+	for i := 0; i < 64; i++ {
+		pl, ok := players[i]
+		if !ok {
+			continue
+		}
+
+		w.bit(false) // Explicit index
+		w.bits(uint64(i), 6)
+		w.bit(true)  // Has string
+		w.bit(false) // No history
+		w.bytes(append([]byte{byte('0' + i)}, 0))
+		w.bit(true) // Has user data
+		w.bits(uint64(len(pl)), 14)
+		w.bytes(pl)
+	}
+
+	return &msg.CSVCMsg_CreateStringTable{
+		Name:       stNameUserInfo,
+		MaxEntries: 64,
+		NumEntries: int32(len(players)),
+		StringData: append(w.buf, make([]byte, 16)...),
+	}
+}
+
+func marshalledNetMessage(t *testing.T, cmd int, m proto.Message) []byte {
+	b, err := proto.Marshal(m)
+	assert.NoError(t, err)
+
+	return netMessage(cmd, b)
+}
+
+func TestReadMetadata(t *testing.T) {
+	h := fakeDemoHeader()
+	h.ServerName = "header server name"
+
+	d := newFakeDemo(h)
+	d.frame(dcSignon, 0, packet(
+		marshalledNetMessage(t, int(msg.SVC_Messages_svc_ServerInfo), &msg.CSVCMsg_ServerInfo{
+			MapName:      "de_dust2",
+			HostName:     "server info host name",
+			TickInterval: 1 / 64.0,
+		}),
+		marshalledNetMessage(t, int(msg.NET_Messages_net_SetConVar), &msg.CNETMsg_SetConVar{
+			Convars: &msg.CMsg_CVars{Cvars: []*msg.CMsg_CVars_CVar{{Name: "mp_maxrounds", Value: "30"}}},
+		}),
+		// Should be skipped
+		netMessage(int(msg.SVC_Messages_svc_GameEvent), []byte{0x0a, 0x05, 'a'}),
+	))
+	d.frame(dcDataTables, 0, int32Bytes(3), []byte{1, 2, 3})
+	d.frame(dcSignon, 0, packet(
+		marshalledNetMessage(t, int(msg.SVC_Messages_svc_CreateStringTable), userInfoTable(map[int][]byte{
+			4: rawPlayerInfo(0, "Bot", 3, "BOT", true),
+			1: rawPlayerInfo(76561198000000000, "Player", 2, "STEAM_1:0:1", false),
+		})),
+		marshalledNetMessage(t, int(msg.SVC_Messages_svc_CreateStringTable), userInfoTable(map[int][]byte{
+			2: rawPlayerInfo(0, "Not read", 4, "BOT", true),
+		})),
+	))
+	// Corrupt packet after sign-on that shouldn't be read anymore
+	d.frame(dcPacket, 1, packet(netMessage(int(msg.SVC_Messages_svc_ServerInfo), []byte{0x0a, 0x05, 'a'})))
+	d.stop(1)
+
+	md, err := ReadMetadata(d.reader())
+
+	assert.NoError(t, err)
+	assert.Equal(t, DemoMetadata{
+		Header:     h,
+		MapName:    "de_dust2",
+		ServerName: "server info host name",
+		TickRate:   64,
+		Players: []PlayerInfo{
+			{EntityID: 2, UserID: 2, SteamID: 76561198000000000, Name: "Player", GUID: "STEAM_1:0:1"},
+			{EntityID: 5, UserID: 3, Name: "Bot", GUID: "BOT", IsBot: true},
+		},
+		ConVars: map[string]string{"mp_maxrounds": "30"},
+	}, md)
+}
+
+func TestReadMetadata_HeaderOnly(t *testing.T) {
+	h := fakeDemoHeader()
+	h.PlaybackTime = 2 * time.Second
+
+	md, err := ReadMetadata(newFakeDemo(h).stop(0).reader())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "de_cache", md.MapName)
+	assert.Equal(t, float64(64), md.TickRate)
+	assert.Nil(t, md.Players)
+}
+
+func TestReadMetadata_InvalidFileType(t *testing.T) {
+	_, err := ReadMetadata(bytes.NewReader(make([]byte, 2000)))
+
+	assert.Equal(t, ErrInvalidFileType, err)
+}
+
+func TestReadMetadata_UnexpectedEnd(t *testing.T) {
+	d := newFakeDemo(fakeDemoHeader())
+	d.buf.WriteByte(byte(dcSignon))
+
+	_, err := ReadMetadata(bytes.NewReader(d.buf.Bytes()))
+
+	assert.Equal(t, ErrUnexpectedEndOfDemo, err)
+}
@@ -21,7 +21,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ECsgoGCMsg int32
 
@@ -374,7 +374,7 @@ func (m *GameServerPing) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_GameServerPing.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -433,7 +433,7 @@ func (m *DataCenterPing) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_DataCenterPing.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -486,7 +486,7 @@ func (m *DetailedSearchStatistic) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_DetailedSearchStatistic.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -550,7 +550,7 @@ func (m *TournamentPlayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_TournamentPlayer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -640,7 +640,7 @@ func (m *TournamentTeam) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_TournamentTeam.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -720,7 +720,7 @@ func (m *TournamentEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_TournamentEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -834,7 +834,7 @@ func (m *GlobalStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_GlobalStatistics.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -977,7 +977,7 @@ func (m *OperationalStatisticDescription) XXX_Marshal(b []byte, deterministic bo
 		return xxx_messageInfo_OperationalStatisticDescription.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1029,7 +1029,7 @@ func (m *OperationalStatisticElement) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_OperationalStatisticElement.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1082,7 +1082,7 @@ func (m *OperationalStatisticsPacket) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_OperationalStatisticsPacket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1144,7 +1144,7 @@ func (m *PlayerRankingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_PlayerRankingInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1218,7 +1218,7 @@ func (m *PlayerCommendationInfo) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_PlayerCommendationInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1277,7 +1277,7 @@ func (m *PlayerMedalsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_PlayerMedalsInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1331,7 +1331,7 @@ func (m *AccountActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_AccountActivity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1399,7 +1399,7 @@ func (m *TournamentMatchSetup) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_TournamentMatchSetup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1483,7 +1483,7 @@ func (m *ServerHltvInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_ServerHltvInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1665,7 +1665,7 @@ func (m *IpAddressMask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_IpAddressMask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1745,7 +1745,7 @@ func (m *XpProgressData) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_XpProgressData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1798,7 +1798,7 @@ func (m *MatchEndItemUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_MatchEndItemUpdates.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1859,7 +1859,7 @@ func (m *ScoreLeaderboardData) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_ScoreLeaderboardData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1925,7 +1925,7 @@ func (m *ScoreLeaderboardData_Entry) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_ScoreLeaderboardData_Entry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1977,7 +1977,7 @@ func (m *ScoreLeaderboardData_AccountEntries) XXX_Marshal(b []byte, deterministi
 		return xxx_messageInfo_ScoreLeaderboardData_AccountEntries.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2034,7 +2034,7 @@ func (m *PlayerQuestData) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_PlayerQuestData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2122,7 +2122,7 @@ func (m *PlayerQuestData_QuestItemData) XXX_Marshal(b []byte, deterministic bool
 		return xxx_messageInfo_PlayerQuestData_QuestItemData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2183,7 +2183,7 @@ func (m *CMsgGC_ServerQuestUpdateData) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CMsgGC_ServerQuestUpdateData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2254,7 +2254,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGCOperationalStats) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGCOperationalStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2318,7 +2318,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerConfirm) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGC2ServerConfirm.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2381,7 +2381,7 @@ func (m *CMsgGCCStrike15V2_GC2ServerReservationUpdate) XXX_Marshal(b []byte, det
 		return xxx_messageInfo_CMsgGCCStrike15V2_GC2ServerReservationUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2437,7 +2437,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingStart) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingStart.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2516,7 +2516,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingStop) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingStop.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2569,7 +2569,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingClient2ServerPing) XXX_Marshal(b []byte, d
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingClient2ServerPing.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2666,7 +2666,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate) XXX_Marshal(b []byte, det
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2815,7 +2815,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate_Note) XXX_Marshal(b []byte
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate_Note.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2891,7 +2891,7 @@ func (m *CDataGCCStrike15V2_TournamentMatchDraft) XXX_Marshal(b []byte, determin
 		return xxx_messageInfo_CDataGCCStrike15V2_TournamentMatchDraft.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3003,7 +3003,7 @@ func (m *CDataGCCStrike15V2_TournamentMatchDraft_Entry) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CDataGCCStrike15V2_TournamentMatchDraft_Entry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3057,7 +3057,7 @@ func (m *CPreMatchInfoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CPreMatchInfoData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3124,7 +3124,7 @@ func (m *CPreMatchInfoData_TeamStats) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CPreMatchInfoData_TeamStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3203,7 +3203,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerReserve) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGC2ServerReserve.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3386,7 +3386,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerReservationResponse) XXX_Marshal(b [
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingServerReservationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3552,7 +3552,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientReserve) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGC2ClientReserve.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3667,7 +3667,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingServerRoundStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3890,7 +3890,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats_DropInfo) XXX_Marshal(b [
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingServerRoundStats_DropInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3945,7 +3945,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEnd) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingServerMatchEnd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4046,7 +4046,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEndPartial) XXX_Marshal(b []byt
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingServerMatchEndPartial.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4121,7 +4121,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingClient2GCHello) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingClient2GCHello.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4180,7 +4180,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientHello) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGC2ClientHello.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4352,7 +4352,7 @@ func (m *CMsgGCCStrike15V2_AccountPrivacySettings) XXX_Marshal(b []byte, determi
 		return xxx_messageInfo_CMsgGCCStrike15V2_AccountPrivacySettings.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4401,7 +4401,7 @@ func (m *CMsgGCCStrike15V2_AccountPrivacySettings_Setting) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_AccountPrivacySettings_Setting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4459,7 +4459,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientAbandon) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGC2ClientAbandon.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4530,7 +4530,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingServer2GCKick) XXX_Marshal(b []byte, deter
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingServer2GCKick.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4593,7 +4593,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerRankUpdate) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingGC2ServerRankUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4644,7 +4644,7 @@ func (m *CMsgGCCStrike15V2_ClientGCRankUpdate) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientGCRankUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4692,7 +4692,7 @@ func (m *CMsgGCCStrike15V2_MatchmakingOperator2GCBlogUpdate) XXX_Marshal(b []byt
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchmakingOperator2GCBlogUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4742,7 +4742,7 @@ func (m *CMsgGCCStrike15V2_ServerNotificationForUserPenalty) XXX_Marshal(b []byt
 		return xxx_messageInfo_CMsgGCCStrike15V2_ServerNotificationForUserPenalty.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4807,7 +4807,7 @@ func (m *CMsgGCCStrike15V2_ClientReportPlayer) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientReportPlayer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4903,7 +4903,7 @@ func (m *CMsgGCCStrike15V2_ClientCommendPlayer) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientCommendPlayer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4973,7 +4973,7 @@ func (m *CMsgGCCStrike15V2_ClientReportServer) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientReportServer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5059,7 +5059,7 @@ func (m *CMsgGCCStrike15V2_ClientReportResponse) XXX_Marshal(b []byte, determini
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientReportResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5147,7 +5147,7 @@ func (m *CMsgGCCStrike15V2_ClientRequestWatchInfoFriends) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientRequestWatchInfoFriends.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5238,7 +5238,7 @@ func (m *WatchableMatchInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_WatchableMatchInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5375,7 +5375,7 @@ func (m *CMsgGCCStrike15V2_ClientRequestJoinFriendData) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientRequestJoinFriendData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5464,7 +5464,7 @@ func (m *CMsgGCCStrike15V2_ClientRequestJoinServerData) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientRequestJoinServerData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5540,8 +5540,10 @@ type CMsgGCCstrike15V2_ClientRequestNewMission struct {
 func (m *CMsgGCCstrike15V2_ClientRequestNewMission) Reset() {
 	*m = CMsgGCCstrike15V2_ClientRequestNewMission{}
 }
-func (m *CMsgGCCstrike15V2_ClientRequestNewMission) String() string { return proto.CompactTextString(m) }
-func (*CMsgGCCstrike15V2_ClientRequestNewMission) ProtoMessage()    {}
+func (m *CMsgGCCstrike15V2_ClientRequestNewMission) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CMsgGCCstrike15V2_ClientRequestNewMission) ProtoMessage() {}
 func (*CMsgGCCstrike15V2_ClientRequestNewMission) Descriptor() ([]byte, []int) {
 	return fileDescriptor_27ac1cab292d7f96, []int{54}
 }
@@ -5553,7 +5555,7 @@ func (m *CMsgGCCstrike15V2_ClientRequestNewMission) XXX_Marshal(b []byte, determ
 		return xxx_messageInfo_CMsgGCCstrike15V2_ClientRequestNewMission.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5613,7 +5615,7 @@ func (m *CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5695,7 +5697,7 @@ func (m *CMsgGCCStrike15V2_WatchInfoUsers) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CMsgGCCStrike15V2_WatchInfoUsers.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5767,7 +5769,7 @@ func (m *CMsgGCCStrike15V2_ClientRequestPlayersProfile) XXX_Marshal(b []byte, de
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientRequestPlayersProfile.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5833,7 +5835,7 @@ func (m *CMsgGCCStrike15V2_PlayersProfile) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CMsgGCCStrike15V2_PlayersProfile.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5895,7 +5897,7 @@ func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseUpdate) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCCStrike15V2_PlayerOverwatchCaseUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6002,7 +6004,7 @@ func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseAssignment) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_PlayerOverwatchCaseAssignment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6121,7 +6123,7 @@ func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseStatus) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCCStrike15V2_PlayerOverwatchCaseStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6173,7 +6175,7 @@ func (m *CClientHeaderOverwatchEvidence) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CClientHeaderOverwatchEvidence.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6226,7 +6228,7 @@ func (m *CMsgGCCStrike15V2_GC2ClientTextMsg) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCCStrike15V2_GC2ClientTextMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6285,7 +6287,7 @@ func (m *CMsgGCCStrike15V2_Client2GCTextMsg) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCCStrike15V2_Client2GCTextMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6339,7 +6341,7 @@ func (m *CMsgGCCStrike15V2_MatchEndRunRewardDrops) XXX_Marshal(b []byte, determi
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchEndRunRewardDrops.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6407,7 +6409,7 @@ func (m *CEconItemPreviewDataBlock) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CEconItemPreviewDataBlock.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6575,7 +6577,7 @@ func (m *CEconItemPreviewDataBlock_Sticker) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CEconItemPreviewDataBlock_Sticker.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6658,7 +6660,7 @@ func (m *CMsgGCCStrike15V2_MatchEndRewardDropsNotification) XXX_Marshal(b []byte
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchEndRewardDropsNotification.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6702,7 +6704,7 @@ func (m *CMsgItemAcknowledged) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CMsgItemAcknowledged.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6753,7 +6755,7 @@ func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockRequest) XXX_Marshal(b [
 		return xxx_messageInfo_CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6822,7 +6824,7 @@ func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockResponse) XXX_Marshal(b
 		return xxx_messageInfo_CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6872,7 +6874,7 @@ func (m *CMsgGCCStrike15V2_TournamentMatchRewardDropsNotification) XXX_Marshal(b
 		return xxx_messageInfo_CMsgGCCStrike15V2_TournamentMatchRewardDropsNotification.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6933,7 +6935,7 @@ func (m *CMsgGCCStrike15V2_MatchListRequestCurrentLiveGames) XXX_Marshal(b []byt
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchListRequestCurrentLiveGames.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -6974,7 +6976,7 @@ func (m *CMsgGCCStrike15V2_MatchListRequestLiveGameForUser) XXX_Marshal(b []byte
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchListRequestLiveGameForUser.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7022,7 +7024,7 @@ func (m *CMsgGCCStrike15V2_MatchListRequestRecentUserGames) XXX_Marshal(b []byte
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchListRequestRecentUserGames.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7070,7 +7072,7 @@ func (m *CMsgGCCStrike15V2_MatchListRequestTournamentGames) XXX_Marshal(b []byte
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchListRequestTournamentGames.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7120,7 +7122,7 @@ func (m *CMsgGCCStrike15V2_MatchListRequestFullGameInfo) XXX_Marshal(b []byte, d
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchListRequestFullGameInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7182,7 +7184,7 @@ func (m *CDataGCCStrike15V2_MatchInfo) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CDataGCCStrike15V2_MatchInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7258,7 +7260,7 @@ func (m *CDataGCCStrike15V2_TournamentGroupTeam) XXX_Marshal(b []byte, determini
 		return xxx_messageInfo_CDataGCCStrike15V2_TournamentGroupTeam.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7325,7 +7327,7 @@ func (m *CDataGCCStrike15V2_TournamentGroup) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CDataGCCStrike15V2_TournamentGroup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7434,7 +7436,7 @@ func (m *CDataGCCStrike15V2_TournamentGroup_Picks) XXX_Marshal(b []byte, determi
 		return xxx_messageInfo_CDataGCCStrike15V2_TournamentGroup_Picks.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7481,7 +7483,7 @@ func (m *CDataGCCStrike15V2_TournamentSection) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CDataGCCStrike15V2_TournamentSection.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7548,7 +7550,7 @@ func (m *CDataGCCStrike15V2_TournamentInfo) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CDataGCCStrike15V2_TournamentInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7611,7 +7613,7 @@ func (m *CMsgGCCStrike15V2_MatchList) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CMsgGCCStrike15V2_MatchList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7691,7 +7693,7 @@ func (m *CMsgGCCStrike15V2_Predictions) XXX_Marshal(b []byte, deterministic bool
 		return xxx_messageInfo_CMsgGCCStrike15V2_Predictions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7750,7 +7752,7 @@ func (m *CMsgGCCStrike15V2_Predictions_GroupMatchTeamPick) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_Predictions_GroupMatchTeamPick.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7823,7 +7825,7 @@ func (m *CMsgGCCStrike15V2_Fantasy) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CMsgGCCStrike15V2_Fantasy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7876,7 +7878,7 @@ func (m *CMsgGCCStrike15V2_Fantasy_FantasySlot) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CMsgGCCStrike15V2_Fantasy_FantasySlot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7935,7 +7937,7 @@ func (m *CMsgGCCStrike15V2_Fantasy_FantasyTeam) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CMsgGCCStrike15V2_Fantasy_FantasyTeam.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -7986,7 +7988,7 @@ func (m *CAttribute_String) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CAttribute_String.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8029,7 +8031,7 @@ func (m *CMsgGCToGCReloadVersions) XXX_Marshal(b []byte, deterministic bool) ([]
 		return xxx_messageInfo_CMsgGCToGCReloadVersions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8072,7 +8074,7 @@ func (m *CMsgCStrike15Welcome) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CMsgCStrike15Welcome.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8166,7 +8168,7 @@ func (m *CMsgGCCStrike15V2_ClientVarValueNotificationInfo) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientVarValueNotificationInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8244,7 +8246,7 @@ func (m *CMsgGCCStrike15V2_ServerVarValueNotificationInfo) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_ServerVarValueNotificationInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8290,8 +8292,10 @@ type CMsgGCCStrike15V2_GiftsLeaderboardRequest struct {
 func (m *CMsgGCCStrike15V2_GiftsLeaderboardRequest) Reset() {
 	*m = CMsgGCCStrike15V2_GiftsLeaderboardRequest{}
 }
-func (m *CMsgGCCStrike15V2_GiftsLeaderboardRequest) String() string { return proto.CompactTextString(m) }
-func (*CMsgGCCStrike15V2_GiftsLeaderboardRequest) ProtoMessage()    {}
+func (m *CMsgGCCStrike15V2_GiftsLeaderboardRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CMsgGCCStrike15V2_GiftsLeaderboardRequest) ProtoMessage() {}
 func (*CMsgGCCStrike15V2_GiftsLeaderboardRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_27ac1cab292d7f96, []int{90}
 }
@@ -8303,7 +8307,7 @@ func (m *CMsgGCCStrike15V2_GiftsLeaderboardRequest) XXX_Marshal(b []byte, determ
 		return xxx_messageInfo_CMsgGCCStrike15V2_GiftsLeaderboardRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8348,7 +8352,7 @@ func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse) XXX_Marshal(b []byte, deter
 		return xxx_messageInfo_CMsgGCCStrike15V2_GiftsLeaderboardResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8425,7 +8429,7 @@ func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse_GiftLeaderboardEntry) XXX_Ma
 		return xxx_messageInfo_CMsgGCCStrike15V2_GiftsLeaderboardResponse_GiftLeaderboardEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8479,7 +8483,7 @@ func (m *CMsgGCCStrike15V2_ClientSubmitSurveyVote) XXX_Marshal(b []byte, determi
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientSubmitSurveyVote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8519,8 +8523,10 @@ type CMsgGCCStrike15V2_Server2GCClientValidate struct {
 func (m *CMsgGCCStrike15V2_Server2GCClientValidate) Reset() {
 	*m = CMsgGCCStrike15V2_Server2GCClientValidate{}
 }
-func (m *CMsgGCCStrike15V2_Server2GCClientValidate) String() string { return proto.CompactTextString(m) }
-func (*CMsgGCCStrike15V2_Server2GCClientValidate) ProtoMessage()    {}
+func (m *CMsgGCCStrike15V2_Server2GCClientValidate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CMsgGCCStrike15V2_Server2GCClientValidate) ProtoMessage() {}
 func (*CMsgGCCStrike15V2_Server2GCClientValidate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_27ac1cab292d7f96, []int{93}
 }
@@ -8532,7 +8538,7 @@ func (m *CMsgGCCStrike15V2_Server2GCClientValidate) XXX_Marshal(b []byte, determ
 		return xxx_messageInfo_CMsgGCCStrike15V2_Server2GCClientValidate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8587,7 +8593,7 @@ func (m *CMsgGCCStrike15V2_Server2GCPureServerValidationFailure) XXX_Marshal(b [
 		return xxx_messageInfo_CMsgGCCStrike15V2_Server2GCPureServerValidationFailure.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8672,8 +8678,10 @@ type CMsgGCCStrike15V2_GC2ClientTournamentInfo struct {
 func (m *CMsgGCCStrike15V2_GC2ClientTournamentInfo) Reset() {
 	*m = CMsgGCCStrike15V2_GC2ClientTournamentInfo{}
 }
-func (m *CMsgGCCStrike15V2_GC2ClientTournamentInfo) String() string { return proto.CompactTextString(m) }
-func (*CMsgGCCStrike15V2_GC2ClientTournamentInfo) ProtoMessage()    {}
+func (m *CMsgGCCStrike15V2_GC2ClientTournamentInfo) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CMsgGCCStrike15V2_GC2ClientTournamentInfo) ProtoMessage() {}
 func (*CMsgGCCStrike15V2_GC2ClientTournamentInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_27ac1cab292d7f96, []int{95}
 }
@@ -8685,7 +8693,7 @@ func (m *CMsgGCCStrike15V2_GC2ClientTournamentInfo) XXX_Marshal(b []byte, determ
 		return xxx_messageInfo_CMsgGCCStrike15V2_GC2ClientTournamentInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8752,7 +8760,7 @@ func (m *CSOEconCoupon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_CSOEconCoupon.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8812,7 +8820,7 @@ func (m *CSOQuestProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CSOQuestProgress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8872,7 +8880,7 @@ func (m *CSOPersonaDataPublic) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CSOPersonaDataPublic.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8930,7 +8938,7 @@ func (m *CMsgGC_GlobalGame_Subscribe) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CMsgGC_GlobalGame_Subscribe.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -8974,7 +8982,7 @@ func (m *CMsgGC_GlobalGame_Unsubscribe) XXX_Marshal(b []byte, deterministic bool
 		return xxx_messageInfo_CMsgGC_GlobalGame_Unsubscribe.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9020,7 +9028,7 @@ func (m *CMsgGC_GlobalGame_Play) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CMsgGC_GlobalGame_Play.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9078,7 +9086,7 @@ func (m *CMsgGCCStrike15V2_AcknowledgePenalty) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgGCCStrike15V2_AcknowledgePenalty.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9129,7 +9137,7 @@ func (m *CMsgGCCStrike15V2_Client2GCRequestPrestigeCoin) XXX_Marshal(b []byte, d
 		return xxx_messageInfo_CMsgGCCStrike15V2_Client2GCRequestPrestigeCoin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9197,7 +9205,7 @@ func (m *CMsgGCCStrike15V2_Client2GCStreamUnlock) XXX_Marshal(b []byte, determin
 		return xxx_messageInfo_CMsgGCCStrike15V2_Client2GCStreamUnlock.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9252,7 +9260,7 @@ func (m *CMsgGCCStrike15V2_ClientToGCRequestElevate) XXX_Marshal(b []byte, deter
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientToGCRequestElevate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9297,7 +9305,7 @@ func (m *CMsgGCCStrike15V2_ClientToGCChat) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientToGCChat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9349,7 +9357,7 @@ func (m *CMsgGCCStrike15V2_GCToClientChat) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CMsgGCCStrike15V2_GCToClientChat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9401,7 +9409,7 @@ func (m *CMsgGCCStrike15V2_ClientAuthKeyCode) XXX_Marshal(b []byte, deterministi
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientAuthKeyCode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9452,7 +9460,7 @@ func (m *CMsgGCCStrike15_GotvSyncPacket) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CMsgGCCStrike15_GotvSyncPacket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9509,7 +9517,7 @@ func (m *PlayerDecalDigitalSignature) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_PlayerDecalDigitalSignature.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9647,7 +9655,7 @@ func (m *CMsgGCCStrike15V2_ClientPlayerDecalSign) XXX_Marshal(b []byte, determin
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientPlayerDecalSign.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9702,7 +9710,7 @@ func (m *CMsgGCCStrike15V2_ClientLogonFatalError) XXX_Marshal(b []byte, determin
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientLogonFatalError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9762,7 +9770,7 @@ func (m *CMsgGCCStrike15V2_ClientPollState) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientPollState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9828,7 +9836,7 @@ func (m *CMsgGCCStrike15V2_Party_Register) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CMsgGCCStrike15V2_Party_Register.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -9933,7 +9941,7 @@ func (m *CMsgGCCStrike15V2_Party_Search) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CMsgGCCStrike15V2_Party_Search.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10012,7 +10020,7 @@ func (m *CMsgGCCStrike15V2_Party_SearchResults) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CMsgGCCStrike15V2_Party_SearchResults.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10065,7 +10073,7 @@ func (m *CMsgGCCStrike15V2_Party_SearchResults_Entry) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCCStrike15V2_Party_SearchResults_Entry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10145,7 +10153,7 @@ func (m *CMsgGCCStrike15V2_Party_Invite) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CMsgGCCStrike15V2_Party_Invite.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10199,7 +10207,7 @@ func (m *CMsgGCCStrike15V2_Account_RequestCoPlays) XXX_Marshal(b []byte, determi
 		return xxx_messageInfo_CMsgGCCStrike15V2_Account_RequestCoPlays.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10256,7 +10264,7 @@ func (m *CMsgGCCStrike15V2_Account_RequestCoPlays_Player) XXX_Marshal(b []byte,
 		return xxx_messageInfo_CMsgGCCStrike15V2_Account_RequestCoPlays_Player.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10306,8 +10314,10 @@ type CMsgGCCStrike15V2_ClientToGCRequestTicket struct {
 func (m *CMsgGCCStrike15V2_ClientToGCRequestTicket) Reset() {
 	*m = CMsgGCCStrike15V2_ClientToGCRequestTicket{}
 }
-func (m *CMsgGCCStrike15V2_ClientToGCRequestTicket) String() string { return proto.CompactTextString(m) }
-func (*CMsgGCCStrike15V2_ClientToGCRequestTicket) ProtoMessage()    {}
+func (m *CMsgGCCStrike15V2_ClientToGCRequestTicket) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CMsgGCCStrike15V2_ClientToGCRequestTicket) ProtoMessage() {}
 func (*CMsgGCCStrike15V2_ClientToGCRequestTicket) Descriptor() ([]byte, []int) {
 	return fileDescriptor_27ac1cab292d7f96, []int{119}
 }
@@ -10319,7 +10329,7 @@ func (m *CMsgGCCStrike15V2_ClientToGCRequestTicket) XXX_Marshal(b []byte, determ
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientToGCRequestTicket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10384,7 +10394,7 @@ func (m *CMsgGCToClientSteamDatagramTicket) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCToClientSteamDatagramTicket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10427,7 +10437,7 @@ func (m *CMsgGCCStrike15V2_ClientRequestOffers) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientRequestOffers.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10468,7 +10478,7 @@ func (m *CMsgGCCStrike15V2_ClientRequestSouvenir) XXX_Marshal(b []byte, determin
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientRequestSouvenir.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10529,7 +10539,7 @@ func (m *CMsgGCCStrike15V2_ClientAccountBalance) XXX_Marshal(b []byte, determini
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientAccountBalance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10583,7 +10593,7 @@ func (m *CMsgGCCStrike15V2_ClientPartyJoinRelay) XXX_Marshal(b []byte, determini
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientPartyJoinRelay.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10634,7 +10644,7 @@ func (m *CMsgGCCStrike15V2_ClientPartyWarning) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientPartyWarning.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10683,7 +10693,7 @@ func (m *CMsgGCCStrike15V2_ClientPartyWarning_Entry) XXX_Marshal(b []byte, deter
 		return xxx_messageInfo_CMsgGCCStrike15V2_ClientPartyWarning_Entry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10735,7 +10745,7 @@ func (m *CMsgGCCStrike15V2_SetEventFavorite) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCCStrike15V2_SetEventFavorite.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10790,7 +10800,7 @@ func (m *CMsgGCCStrike15V2_GetEventFavorites_Request) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCCStrike15V2_GetEventFavorites_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -10840,7 +10850,7 @@ func (m *CMsgGCCStrike15V2_GetEventFavorites_Response) XXX_Marshal(b []byte, det
 		return xxx_messageInfo_CMsgGCCStrike15V2_GetEventFavorites_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -11033,664 +11043,659 @@ func init() {
 func init() { proto.RegisterFile("cstrike15_gcmessages.proto", fileDescriptor_27ac1cab292d7f96) }
 
 var fileDescriptor_27ac1cab292d7f96 = []byte{
-	// 10415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x69, 0x94, 0x1c, 0xc7,
-	0x79, 0x98, 0x66, 0xf6, 0xfe, 0xf6, 0x6a, 0x34, 0x96, 0xc0, 0x72, 0x41, 0x2e, 0xc1, 0xe6, 0x05,
-	0x80, 0xc0, 0x10, 0x00, 0x41, 0x51, 0xa4, 0x44, 0x49, 0x8b, 0x05, 0x30, 0x5c, 0x13, 0x20, 0x57,
-	0xb3, 0x00, 0xc9, 0xe7, 0x38, 0x9e, 0xd4, 0x76, 0xd7, 0xce, 0xb6, 0xb7, 0xa7, 0xbb, 0xd9, 0x5d,
-	0x33, 0xd8, 0xf5, 0x2f, 0xbd, 0xbc, 0x1c, 0x7e, 0xb2, 0x6c, 0xcb, 0x57, 0x22, 0x25, 0x96, 0x23,
-	0x3b, 0x7e, 0x52, 0x0e, 0x27, 0xb1, 0x13, 0x3b, 0x2f, 0x4e, 0x22, 0xcb, 0xb1, 0x1c, 0x5b, 0x72,
-	0xe2, 0x58, 0x71, 0x7c, 0xc5, 0xb1, 0x1d, 0x4b, 0x4c, 0xe2, 0xc4, 0x49, 0xe4, 0x5c, 0xff, 0xf2,
-	0xf2, 0x5e, 0x5e, 0x7d, 0x55, 0xd5, 0x5d, 0xd5, 0xd3, 0x3d, 0x33, 0x4b, 0x33, 0xc7, 0xaf, 0xdd,
-	0xf9, 0xbe, 0xaf, 0xee, 0xaf, 0xbe, 0xfa, 0xae, 0xaa, 0x86, 0x35, 0x37, 0x65, 0x89, 0x7f, 0x40,
-	0xaf, 0x3c, 0xd7, 0xee, 0xb8, 0x5d, 0x9a, 0xa6, 0xa4, 0x43, 0xd3, 0x46, 0x9c, 0x44, 0x2c, 0x5a,
-	0x3b, 0x99, 0x32, 0x4a, 0xba, 0x05, 0xe0, 0x69, 0x1a, 0x76, 0xfc, 0x90, 0x0e, 0x50, 0x3b, 0x1e,
-	0x2c, 0x35, 0x49, 0x97, 0xee, 0xd0, 0xa4, 0x4f, 0x93, 0x6d, 0x3f, 0xec, 0xd8, 0xab, 0x30, 0x19,
-	0xfb, 0x61, 0x67, 0xb5, 0x7e, 0xb6, 0x76, 0x6e, 0xea, 0xfa, 0xe4, 0x97, 0x7e, 0xf7, 0x91, 0xf7,
-	0xb4, 0x10, 0x62, 0xaf, 0x40, 0xdd, 0x8f, 0x57, 0x27, 0xce, 0xd6, 0xce, 0x2d, 0x4a, 0x78, 0xdd,
-	0x8f, 0x6d, 0x07, 0xe6, 0xfc, 0x30, 0x65, 0x24, 0x74, 0x69, 0xba, 0x3a, 0xa5, 0x21, 0x73, 0xb0,
-	0xf3, 0x3a, 0x2c, 0xdd, 0x20, 0x8c, 0x6c, 0xd2, 0x90, 0xc9, 0x56, 0x2e, 0xc0, 0x92, 0x47, 0x18,
-	0x69, 0xbb, 0x08, 0x6a, 0xfb, 0xde, 0x6a, 0xed, 0x6c, 0xed, 0xdc, 0x8c, 0x2c, 0xba, 0xe0, 0x65,
-	0xd4, 0x5b, 0x9e, 0xd1, 0xa3, 0x13, 0x7a, 0x8f, 0x9c, 0x4f, 0xd7, 0xe0, 0xf4, 0x0d, 0xca, 0x88,
-	0x1f, 0x50, 0x6f, 0x87, 0x92, 0xc4, 0xdd, 0xdf, 0x61, 0x84, 0xf9, 0x29, 0xf3, 0x5d, 0xfb, 0x51,
-	0x98, 0xeb, 0x90, 0x2e, 0x6d, 0xb3, 0xa3, 0x98, 0x62, 0xe5, 0xaa, 0x5f, 0xb3, 0x1c, 0x7c, 0xf7,
-	0x28, 0xa6, 0xf6, 0x45, 0x58, 0x4e, 0xb1, 0x54, 0x9b, 0xf9, 0x5d, 0xda, 0x26, 0x7d, 0xd1, 0x86,
-	0x22, 0x5c, 0x14, 0xc8, 0xbb, 0x7e, 0x97, 0x6e, 0xf4, 0x3b, 0xf6, 0x15, 0x38, 0x11, 0x07, 0xe4,
-	0x88, 0x26, 0x69, 0x5b, 0x20, 0x78, 0x9f, 0x26, 0x35, 0x7a, 0x4b, 0xa2, 0x77, 0x14, 0xd6, 0xf9,
-	0x5c, 0x1d, 0xac, 0xbb, 0x51, 0x2f, 0x09, 0x49, 0x97, 0x86, 0x6c, 0x1b, 0xd1, 0xf6, 0x63, 0x00,
-	0xc4, 0x75, 0xa3, 0x5e, 0xc8, 0xd4, 0xb0, 0xb3, 0x19, 0x93, 0xf0, 0x2d, 0xcf, 0x7e, 0x02, 0xe6,
-	0x45, 0x6d, 0xed, 0xd0, 0x77, 0x0f, 0xb0, 0x5b, 0x73, 0x92, 0x0a, 0x04, 0xe2, 0x55, 0xdf, 0x3d,
-	0xd0, 0xc9, 0x48, 0x97, 0xe2, 0xda, 0x14, 0xc9, 0x48, 0x97, 0xf2, 0x26, 0x25, 0x99, 0x17, 0xed,
-	0x1a, 0x7d, 0x9e, 0x13, 0xf0, 0x1b, 0xd1, 0xae, 0x56, 0xd7, 0x5e, 0x40, 0x3a, 0xb8, 0x94, 0x85,
-	0xba, 0x6e, 0x05, 0xa4, 0x63, 0x5f, 0x82, 0x65, 0x49, 0x16, 0x44, 0x2e, 0x61, 0x7e, 0x14, 0xae,
-	0x4e, 0x6b, 0xa4, 0x4b, 0x02, 0x79, 0x5b, 0xe2, 0xb4, 0x5a, 0x3d, 0x9a, 0xba, 0xab, 0x33, 0x83,
-	0xb5, 0xde, 0xa0, 0xa9, 0xeb, 0x7c, 0xb1, 0x06, 0x4b, 0xf9, 0x4c, 0xdd, 0xa5, 0xa4, 0x6b, 0x3f,
-	0x0c, 0x33, 0x9c, 0x93, 0xd5, 0x24, 0x29, 0x5e, 0x9c, 0xe6, 0xc0, 0x2d, 0xcf, 0x7e, 0x04, 0x66,
-	0x11, 0xcd, 0x48, 0xc7, 0x98, 0x1e, 0x2c, 0x74, 0x97, 0x74, 0x38, 0x03, 0x20, 0x01, 0x8e, 0x46,
-	0x9f, 0x19, 0x2c, 0x87, 0x63, 0x51, 0x24, 0x38, 0x79, 0x93, 0x45, 0x12, 0x9c, 0xba, 0xa7, 0x61,
-	0x46, 0x2e, 0xeb, 0xea, 0xd4, 0xd9, 0x89, 0x73, 0xf3, 0x57, 0x4f, 0x34, 0x8a, 0x2b, 0xda, 0x52,
-	0x14, 0xce, 0x77, 0x4c, 0xc0, 0x72, 0x8e, 0xbd, 0xd9, 0xa7, 0x21, 0xe3, 0xfd, 0xa4, 0xfc, 0x9f,
-	0xe2, 0x38, 0x66, 0x10, 0xba, 0xe5, 0xf1, 0x4e, 0x08, 0x82, 0xe2, 0x48, 0x44, 0x39, 0x3e, 0x94,
-	0xc7, 0x00, 0x04, 0xc9, 0xc0, 0x2a, 0x8b, 0xa2, 0xd8, 0xd3, 0x06, 0x58, 0xb2, 0x1e, 0xce, 0xcc,
-	0x29, 0x23, 0x09, 0x33, 0x96, 0x7a, 0x49, 0x54, 0xe7, 0x77, 0xe9, 0x0e, 0xc7, 0xf1, 0x2d, 0xa8,
-	0xd1, 0xd3, 0xd0, 0x33, 0x76, 0xef, 0x42, 0x46, 0x7d, 0x33, 0xf4, 0xec, 0xa7, 0x40, 0xfc, 0x6e,
-	0xc7, 0xbd, 0xdd, 0xc0, 0x77, 0x71, 0xc5, 0xd5, 0x40, 0xe6, 0x11, 0xb3, 0x8d, 0x88, 0xbc, 0xd2,
-	0x94, 0x91, 0x0e, 0xe5, 0x63, 0x9e, 0xd1, 0x48, 0x45, 0x25, 0x3b, 0x1c, 0xb5, 0xe5, 0xe5, 0x1d,
-	0x16, 0xb4, 0x38, 0xb6, 0x59, 0x9d, 0x95, 0x72, 0x6a, 0x1c, 0xe0, 0x65, 0x38, 0x41, 0x5c, 0xe6,
-	0xf7, 0x69, 0x3b, 0xa5, 0x2e, 0x67, 0x2e, 0x5e, 0xfd, 0x9c, 0xd6, 0xe7, 0x65, 0x81, 0xde, 0x11,
-	0xd8, 0x2d, 0xcf, 0xf9, 0x81, 0x69, 0xb0, 0x9a, 0x41, 0xb4, 0x4b, 0x82, 0x4c, 0x2e, 0xa4, 0xf6,
-	0xd3, 0xb0, 0xa4, 0xf6, 0x71, 0x14, 0x06, 0x7e, 0x68, 0x4a, 0x87, 0x45, 0x89, 0x7b, 0x0d, 0x51,
-	0x9c, 0x38, 0x45, 0xd9, 0x98, 0x11, 0x17, 0x24, 0x04, 0xe2, 0x24, 0x71, 0xa9, 0x84, 0x98, 0x18,
-	0x26, 0x21, 0x78, 0x11, 0x55, 0x3f, 0xe9, 0x13, 0x3f, 0x20, 0xbb, 0x01, 0x35, 0x85, 0x8a, 0x44,
-	0x6f, 0x28, 0x2c, 0xdf, 0x80, 0x51, 0xd8, 0x89, 0xfc, 0xb0, 0xd3, 0xee, 0x12, 0xe6, 0xee, 0x17,
-	0xc4, 0xee, 0x92, 0x44, 0xde, 0x11, 0xb8, 0x32, 0x21, 0x37, 0x5d, 0x2d, 0xe4, 0x6e, 0xf2, 0xfe,
-	0x20, 0x75, 0x9a, 0xcd, 0xd8, 0xea, 0x0c, 0x32, 0xfe, 0x6a, 0xa3, 0x42, 0xd4, 0xf2, 0x3e, 0x1a,
-	0x80, 0xd4, 0x3e, 0x07, 0x8b, 0x5d, 0xe2, 0x87, 0xed, 0x38, 0x4a, 0x59, 0xbb, 0x97, 0x04, 0xc6,
-	0xba, 0xce, 0x73, 0xd4, 0x76, 0x94, 0xb2, 0x7b, 0x49, 0x60, 0xbf, 0x08, 0xa7, 0x12, 0xfa, 0x56,
-	0xcf, 0x4f, 0xa8, 0xd7, 0x26, 0x71, 0xec, 0x7b, 0x6d, 0x3e, 0x5a, 0x2e, 0x55, 0xf4, 0x95, 0x5d,
-	0x51, 0x34, 0x1b, 0x9c, 0xe4, 0x75, 0x41, 0x61, 0x3f, 0x0b, 0x76, 0x9c, 0xf8, 0x2e, 0x4d, 0xf7,
-	0x29, 0x65, 0x59, 0x39, 0xd0, 0xca, 0x9d, 0xc8, 0xf1, 0xaa, 0xd0, 0x8b, 0x70, 0x8a, 0xdd, 0xf7,
-	0x19, 0x8e, 0x30, 0xa1, 0xa4, 0x9b, 0x66, 0x05, 0xe7, 0xf5, 0x06, 0x05, 0xcd, 0x8e, 0x20, 0x51,
-	0x65, 0x3f, 0x0c, 0x0f, 0x4a, 0x0e, 0x64, 0xd9, 0x2e, 0x6f, 0x23, 0x97, 0xfa, 0xde, 0xea, 0x82,
-	0x56, 0xfc, 0xb4, 0x20, 0x2b, 0xc8, 0x02, 0x1f, 0x79, 0x5e, 0xf1, 0x70, 0x2f, 0xe9, 0xd3, 0x23,
-	0xce, 0xc2, 0x8b, 0xfa, 0xea, 0x49, 0x16, 0x46, 0xa4, 0x38, 0x07, 0x12, 0xbe, 0x6e, 0xcf, 0x5e,
-	0x6d, 0xbb, 0xbd, 0x64, 0x75, 0x49, 0x23, 0x05, 0x89, 0xd8, 0xec, 0x25, 0xf6, 0x35, 0x38, 0xa9,
-	0xc8, 0xb2, 0x2d, 0x95, 0xb0, 0xd5, 0x65, 0x7d, 0x2a, 0x24, 0xc1, 0x4d, 0xb9, 0xa9, 0x12, 0xe6,
-	0xbc, 0x01, 0x8f, 0xbc, 0x16, 0xd3, 0x04, 0x05, 0xb5, 0xb6, 0x45, 0xb8, 0x40, 0x4e, 0xfc, 0x18,
-	0xc5, 0xf7, 0x2a, 0x4c, 0xe2, 0xbe, 0xac, 0x69, 0xeb, 0x87, 0x10, 0x7b, 0x0d, 0xa6, 0x7c, 0xef,
-	0x80, 0x1e, 0x19, 0x1b, 0x42, 0x80, 0x9c, 0x8f, 0xc0, 0x99, 0xb2, 0x8a, 0x6f, 0x06, 0x94, 0xcf,
-	0x45, 0x5e, 0xb4, 0x36, 0x50, 0xd4, 0x3e, 0x05, 0xd3, 0x7d, 0x12, 0xf4, 0x68, 0xba, 0x5a, 0x3f,
-	0x3b, 0x71, 0x6e, 0xaa, 0x25, 0x7f, 0xf1, 0xa3, 0xbe, 0xb4, 0xce, 0x74, 0x9b, 0xb8, 0x07, 0x94,
-	0xd9, 0x67, 0x61, 0x36, 0xc6, 0xff, 0x0a, 0x62, 0x36, 0x83, 0xda, 0x4f, 0xc2, 0x7c, 0x37, 0xe5,
-	0x73, 0x90, 0x32, 0xd2, 0x8d, 0x0d, 0xfd, 0x46, 0x47, 0xd8, 0xd7, 0xb2, 0x1e, 0x4c, 0x20, 0xdf,
-	0x3f, 0xd4, 0x18, 0x32, 0x96, 0xac, 0x7f, 0x3f, 0x53, 0x83, 0x13, 0xf2, 0x38, 0x20, 0xe1, 0x81,
-	0x1f, 0x76, 0xb6, 0xc2, 0xbd, 0x68, 0xbc, 0xb3, 0xfe, 0x61, 0x98, 0x49, 0x48, 0x78, 0xc0, 0x29,
-	0xf4, 0xb9, 0x9c, 0xe6, 0x40, 0xa1, 0xfe, 0xdc, 0xf7, 0xc3, 0xd4, 0x10, 0x24, 0x08, 0x41, 0xe6,
-	0xe0, 0x05, 0xdd, 0x7d, 0x12, 0x76, 0x84, 0xd8, 0xa8, 0x67, 0xcc, 0x41, 0xc2, 0x83, 0x4d, 0x84,
-	0xdb, 0x4f, 0xc2, 0x02, 0x92, 0x71, 0x4d, 0x88, 0x37, 0x32, 0x6d, 0x30, 0x11, 0x09, 0x0f, 0xb8,
-	0x32, 0xb4, 0xe5, 0x39, 0xdf, 0x55, 0x83, 0x53, 0x62, 0x08, 0x9b, 0x51, 0xb7, 0x4b, 0x43, 0x0f,
-	0xc7, 0x8c, 0xe3, 0x78, 0x0a, 0x16, 0xdc, 0xae, 0xd7, 0xde, 0x4b, 0x7c, 0x1a, 0x7a, 0x81, 0xb9,
-	0x70, 0xf3, 0x6e, 0xd7, 0xbb, 0x25, 0x11, 0x8a, 0x90, 0x51, 0x22, 0xa4, 0x5f, 0xbd, 0x40, 0x78,
-	0x57, 0x22, 0xf8, 0xcc, 0x70, 0xc2, 0x80, 0x12, 0x8f, 0x26, 0xa6, 0x4a, 0xe2, 0x76, 0xbd, 0xdb,
-	0x08, 0x76, 0xbe, 0xbd, 0x06, 0x96, 0xe8, 0xd1, 0x1d, 0xea, 0x91, 0x20, 0xc5, 0xbe, 0x5c, 0x86,
-	0x15, 0xcf, 0x4f, 0xb9, 0x24, 0x6d, 0xfb, 0x8c, 0x76, 0xd3, 0xb6, 0x47, 0xf7, 0x7c, 0xef, 0x10,
-	0xa5, 0xd4, 0x62, 0xcb, 0x96, 0xb8, 0x2d, 0x8e, 0xba, 0x81, 0x18, 0xfb, 0x26, 0x3c, 0xb4, 0x47,
-	0x09, 0xeb, 0x71, 0x19, 0xa3, 0x17, 0x55, 0x25, 0x67, 0xb5, 0xd6, 0x1f, 0x54, 0x94, 0x37, 0xf2,
-	0x7a, 0x44, 0x35, 0xce, 0x9f, 0xae, 0xc1, 0xf2, 0x86, 0x58, 0xb5, 0x0d, 0xbe, 0x4b, 0x7d, 0x76,
-	0xc4, 0xd9, 0x8e, 0xc8, 0xff, 0x4d, 0x25, 0x53, 0x41, 0xf9, 0xf2, 0x75, 0x23, 0xcf, 0x3c, 0x37,
-	0x10, 0x62, 0x9f, 0x82, 0x89, 0x2e, 0x31, 0x15, 0x6a, 0x0e, 0xb0, 0xd7, 0x61, 0x06, 0x05, 0xbb,
-	0xef, 0xe1, 0xbc, 0x4c, 0x2a, 0x85, 0x41, 0x02, 0x9d, 0xbf, 0x5a, 0x83, 0x95, 0x5c, 0xb2, 0xa0,
-	0x9c, 0xdf, 0xa1, 0xac, 0x17, 0x8f, 0x56, 0x35, 0x1c, 0x00, 0xa9, 0x52, 0xb5, 0x5d, 0x66, 0xec,
-	0x80, 0x59, 0xa1, 0x55, 0x6d, 0xf2, 0x8d, 0x34, 0xa7, 0x68, 0x18, 0xf6, 0x6d, 0x4a, 0x57, 0xac,
-	0xb6, 0xbc, 0xbb, 0x25, 0x67, 0xfc, 0x64, 0xd5, 0x19, 0xef, 0xfc, 0xc2, 0x34, 0x2c, 0x09, 0xe3,
-	0xe2, 0xe5, 0x80, 0xf5, 0x71, 0xfd, 0x1e, 0x87, 0x79, 0xd6, 0x6f, 0xf7, 0xbc, 0xb8, 0x1d, 0x47,
-	0x09, 0x33, 0x37, 0x05, 0xeb, 0xdf, 0xf3, 0xe2, 0xed, 0x28, 0x61, 0x9c, 0x69, 0x59, 0xbf, 0x7d,
-	0x9f, 0x0f, 0xae, 0xad, 0xa4, 0x8c, 0x9a, 0x09, 0x60, 0xfd, 0x37, 0x38, 0xe2, 0x15, 0x7a, 0x84,
-	0x6a, 0x60, 0xbf, 0x9d, 0x06, 0x11, 0x33, 0x77, 0xc8, 0x0c, 0xeb, 0xef, 0x70, 0x20, 0x67, 0x34,
-	0xd6, 0x6f, 0xbb, 0x81, 0x4f, 0x43, 0x96, 0x9a, 0x8c, 0xc6, 0xfa, 0x9b, 0x02, 0x2c, 0x89, 0xe2,
-	0x24, 0x3a, 0xf4, 0x8b, 0x56, 0x0c, 0xeb, 0x6f, 0x0b, 0x30, 0x2a, 0xa4, 0x7d, 0x3c, 0x45, 0x8d,
-	0x2d, 0x34, 0xcd, 0xfa, 0xfc, 0xf4, 0x34, 0x0d, 0x8e, 0xd9, 0x52, 0x83, 0xe3, 0x3c, 0x2c, 0x22,
-	0x49, 0x97, 0xc4, 0x9d, 0x24, 0xea, 0xc5, 0x78, 0xc6, 0x29, 0xb1, 0xba, 0xc0, 0x51, 0x77, 0x24,
-	0x86, 0x8f, 0x4b, 0x91, 0xe2, 0x89, 0x96, 0xa9, 0xb7, 0x92, 0x8a, 0x6b, 0x43, 0xac, 0xdf, 0xee,
-	0x92, 0x94, 0xdb, 0x4f, 0x68, 0xf3, 0xf9, 0x1e, 0x1e, 0x61, 0x6a, 0x96, 0x96, 0x59, 0xff, 0x0e,
-	0x62, 0x77, 0x04, 0x92, 0xaf, 0x1b, 0xeb, 0xa3, 0xd6, 0x1e, 0xc8, 0x09, 0xd3, 0x8f, 0xac, 0x05,
-	0xd6, 0xe7, 0x4a, 0x7b, 0x20, 0x66, 0xad, 0x01, 0x56, 0x46, 0xab, 0xe6, 0xce, 0x38, 0xa7, 0x24,
-	0xb5, 0x9a, 0x40, 0x9d, 0x5e, 0x4d, 0xe3, 0x52, 0x09, 0xbd, 0x9a, 0x4b, 0xd1, 0x97, 0x84, 0xf2,
-	0xad, 0x28, 0xfa, 0xb2, 0x6c, 0xf6, 0xa5, 0xc5, 0x51, 0x7a, 0x5f, 0x04, 0xad, 0xea, 0x8b, 0x65,
-	0xd6, 0x8d, 0xd4, 0x66, 0x5f, 0x04, 0xbd, 0xea, 0xcb, 0x89, 0x12, 0x7a, 0xd5, 0x17, 0x9d, 0x9e,
-	0x78, 0x5e, 0x42, 0xd3, 0x74, 0xd5, 0x2e, 0xa1, 0xdf, 0x10, 0x38, 0xae, 0xdc, 0xe4, 0xf5, 0x73,
-	0x16, 0x3e, 0xa9, 0x0b, 0x39, 0x55, 0x39, 0x67, 0x62, 0xbd, 0x66, 0xb5, 0x44, 0x2b, 0xda, 0x12,
-	0xa9, 0x9a, 0xd5, 0x0a, 0xad, 0xc1, 0x14, 0xb7, 0x56, 0xd2, 0xd5, 0x07, 0xf4, 0x83, 0x11, 0x41,
-	0xce, 0xf7, 0xd7, 0x60, 0x71, 0x2b, 0x96, 0x7d, 0xb8, 0x43, 0xd2, 0x03, 0xdb, 0x86, 0x1a, 0x31,
-	0xb6, 0x4f, 0x8d, 0x70, 0xd8, 0xae, 0x21, 0x6a, 0x6a, 0xbb, 0x1c, 0xe6, 0x1a, 0x7b, 0xa3, 0xe6,
-	0x72, 0x98, 0x67, 0x6c, 0x86, 0x1a, 0x1e, 0x34, 0xbb, 0x3e, 0x33, 0xd9, 0x1f, 0x21, 0xbc, 0x5f,
-	0x2c, 0x3a, 0xa0, 0xa1, 0xc1, 0xf7, 0x02, 0xe4, 0x7c, 0x23, 0x2c, 0xbd, 0x19, 0x6f, 0x27, 0x51,
-	0x87, 0xf7, 0x8b, 0x5b, 0xf9, 0x7c, 0x23, 0x1c, 0xf2, 0xcd, 0xed, 0xf3, 0x85, 0x32, 0x84, 0xe2,
-	0x61, 0xbc, 0x8d, 0x50, 0x7e, 0x72, 0x1d, 0xc6, 0x6d, 0x97, 0x30, 0xda, 0x89, 0x92, 0x23, 0x43,
-	0x12, 0xc1, 0x61, 0xbc, 0x29, 0xe1, 0xfc, 0xd0, 0x3f, 0x89, 0xf2, 0xed, 0x66, 0xe8, 0x71, 0x41,
-	0x7c, 0x2f, 0xf6, 0x08, 0x13, 0x3b, 0x11, 0xe5, 0xb7, 0x94, 0x73, 0x6a, 0x3a, 0xa7, 0x39, 0x50,
-	0x18, 0x16, 0x88, 0x26, 0x8c, 0x25, 0x4a, 0xc6, 0xeb, 0x73, 0xb2, 0xc4, 0xb1, 0x1b, 0x8c, 0x25,
-	0xf2, 0x7c, 0x78, 0x1e, 0x1e, 0xd0, 0xe9, 0x03, 0x46, 0xda, 0x78, 0xaa, 0x1b, 0x93, 0x66, 0xe7,
-	0x85, 0x02, 0x46, 0x5e, 0xe7, 0x78, 0xe7, 0xdb, 0x26, 0x60, 0x65, 0xc7, 0x8d, 0x12, 0x2a, 0xce,
-	0xab, 0xdd, 0x88, 0x24, 0x1e, 0x4e, 0xc1, 0x23, 0x30, 0xfb, 0x56, 0x8f, 0xa6, 0xac, 0xd8, 0xc3,
-	0x19, 0x84, 0x6e, 0xe1, 0x4a, 0xa7, 0xbc, 0xa0, 0xa9, 0x3d, 0x21, 0xc8, 0xbe, 0x0d, 0x4b, 0x52,
-	0x39, 0xa0, 0x21, 0x4b, 0xfc, 0x4c, 0x11, 0x79, 0xbc, 0x51, 0xd6, 0x56, 0x43, 0x1e, 0x49, 0x37,
-	0x05, 0x6d, 0xab, 0x50, 0xd6, 0xfe, 0x10, 0x2c, 0x08, 0x33, 0x41, 0xd6, 0x25, 0xac, 0xd8, 0x33,
-	0xe5, 0x75, 0xf1, 0x4a, 0x8e, 0x5a, 0x46, 0x81, 0xb5, 0xe7, 0x61, 0x0a, 0xc1, 0xfc, 0xbc, 0xe2,
-	0x26, 0xaa, 0xbe, 0xa2, 0x1c, 0xc0, 0xe1, 0x7d, 0x12, 0x18, 0x23, 0xe1, 0x80, 0xb5, 0x03, 0x58,
-	0x32, 0xfb, 0x66, 0x3b, 0xa0, 0xd4, 0x9e, 0x0a, 0x6d, 0xc8, 0xf7, 0xec, 0xe7, 0x60, 0x46, 0x75,
-	0xb5, 0x3e, 0xba, 0xab, 0x8a, 0xd6, 0xf9, 0xf2, 0x24, 0x2c, 0x0b, 0x55, 0xe1, 0x23, 0x7c, 0x8a,
-	0x71, 0x15, 0xae, 0x82, 0x8d, 0xf3, 0x4d, 0x93, 0x76, 0x85, 0x16, 0x66, 0x49, 0xfc, 0x46, 0xa6,
-	0x8c, 0xdd, 0x82, 0x65, 0xb9, 0x72, 0xa8, 0x20, 0x10, 0x46, 0x64, 0x37, 0xd6, 0x1b, 0x85, 0xea,
-	0x1b, 0xf8, 0x1f, 0xea, 0x07, 0x84, 0x91, 0xd6, 0xe2, 0x5b, 0xfa, 0x4f, 0xfb, 0x05, 0xb0, 0xf8,
-	0x26, 0x90, 0xfb, 0x42, 0x54, 0x24, 0x96, 0x71, 0xb9, 0x61, 0xee, 0x97, 0xd6, 0xd2, 0xa1, 0xb9,
-	0x7f, 0x9e, 0x80, 0x79, 0x34, 0xd5, 0xd0, 0x58, 0x34, 0x77, 0x29, 0x70, 0x04, 0xf6, 0x83, 0xeb,
-	0xb3, 0x0b, 0xdd, 0x6e, 0x5b, 0x1c, 0x12, 0x5c, 0xc1, 0xd0, 0xb7, 0x2d, 0x74, 0xbb, 0x4d, 0x7e,
-	0x4e, 0x70, 0x35, 0xe3, 0x79, 0x58, 0xc0, 0xb1, 0xf4, 0xc4, 0xe6, 0x59, 0x9d, 0xc6, 0x5e, 0xac,
-	0x34, 0x4a, 0x36, 0x56, 0x6b, 0xde, 0xd7, 0x76, 0xd9, 0x87, 0xe1, 0xc1, 0x48, 0x69, 0xbe, 0x72,
-	0x3b, 0xb7, 0x69, 0xe0, 0x77, 0x7c, 0x6e, 0xa3, 0x72, 0xb3, 0x7e, 0x56, 0x59, 0x3b, 0x19, 0x99,
-	0xd8, 0xde, 0x37, 0x25, 0xd1, 0xda, 0x4f, 0xd4, 0x60, 0xd1, 0x98, 0xa5, 0xd1, 0x1b, 0x63, 0x03,
-	0xd6, 0x04, 0x41, 0x18, 0x25, 0x5d, 0x7e, 0x98, 0xc8, 0x76, 0x49, 0x12, 0x52, 0xcf, 0x10, 0x14,
-	0xa7, 0x91, 0xee, 0x55, 0x24, 0x93, 0xed, 0x22, 0x91, 0xfd, 0x21, 0x78, 0x50, 0x54, 0xb1, 0x1b,
-	0x85, 0xbd, 0xb4, 0x50, 0x83, 0xae, 0xd1, 0x9c, 0x42, 0xb2, 0xeb, 0x9c, 0x4a, 0xaf, 0xc0, 0xf9,
-	0x83, 0x1a, 0x3c, 0xb4, 0x79, 0x27, 0xed, 0x34, 0x37, 0xdb, 0x42, 0x77, 0xc1, 0x31, 0x88, 0x69,
-	0xc1, 0x51, 0x7c, 0x40, 0x19, 0xfa, 0x6d, 0xd1, 0x10, 0xae, 0x6e, 0x0d, 0xe7, 0xd5, 0x2a, 0xb2,
-	0x49, 0x4b, 0xba, 0xcb, 0x72, 0xb6, 0x7c, 0x02, 0xe6, 0x77, 0xfd, 0x90, 0x24, 0x47, 0x8a, 0xbd,
-	0x6a, 0xe7, 0x16, 0xd4, 0xba, 0x09, 0x04, 0x92, 0x15, 0xd7, 0x77, 0xa2, 0x62, 0x7d, 0x5f, 0x82,
-	0xa5, 0xae, 0x9f, 0x72, 0xfb, 0x34, 0xd8, 0x4d, 0xb1, 0x46, 0xce, 0x31, 0xf3, 0x57, 0x1f, 0x28,
-	0xdd, 0x37, 0xad, 0x02, 0xb1, 0xf3, 0x8b, 0x35, 0xb8, 0x2c, 0x06, 0xbb, 0xb9, 0xa3, 0x9c, 0xca,
-	0xfd, 0xab, 0x6d, 0xe4, 0x8e, 0x2e, 0xe1, 0x96, 0x4c, 0x73, 0xb3, 0x60, 0x00, 0xa5, 0x63, 0x58,
-	0x5b, 0x1f, 0x80, 0x59, 0xae, 0x9f, 0x1e, 0xd0, 0x23, 0xb5, 0x8f, 0xcf, 0x36, 0x46, 0x18, 0x9b,
-	0xad, 0xac, 0x84, 0xfd, 0x5e, 0x98, 0x11, 0x35, 0x0d, 0x37, 0xc2, 0xa4, 0xf1, 0xd7, 0x52, 0xc4,
-	0xce, 0xc7, 0x6a, 0xd0, 0x18, 0x35, 0x98, 0xab, 0x62, 0x4d, 0x37, 0xa3, 0x70, 0xcf, 0x4f, 0xba,
-	0xf9, 0xd9, 0x56, 0x1b, 0x38, 0xdb, 0x50, 0x4a, 0x67, 0xc6, 0x62, 0x2e, 0xa5, 0xd1, 0x4c, 0x3c,
-	0x0b, 0xb3, 0xf4, 0x50, 0x5a, 0x5e, 0x13, 0x1a, 0x27, 0x67, 0x50, 0xe7, 0x73, 0x35, 0xb8, 0x54,
-	0xd2, 0x99, 0xac, 0x07, 0x2d, 0x9a, 0xd2, 0xa4, 0x8f, 0x63, 0x12, 0xbc, 0x65, 0xbf, 0x08, 0xa7,
-	0xfa, 0x3e, 0xbd, 0x4f, 0x93, 0xb4, 0x4d, 0x0f, 0x19, 0x4d, 0x42, 0x12, 0xb4, 0x59, 0xc4, 0x48,
-	0x60, 0x74, 0x6e, 0x45, 0xd2, 0xdc, 0x94, 0x24, 0x77, 0x39, 0x45, 0x69, 0x59, 0xd4, 0x39, 0x8c,
-	0xce, 0x17, 0xcb, 0xa2, 0xe6, 0xe1, 0xfc, 0x48, 0x1d, 0x1e, 0x1b, 0x3e, 0x6d, 0xc2, 0x65, 0xf8,
-	0x08, 0xcc, 0xe7, 0x82, 0x34, 0x45, 0x8e, 0x5f, 0x6c, 0x41, 0x66, 0xc9, 0xa6, 0xa6, 0x0e, 0x5c,
-	0x2f, 0xd5, 0x81, 0x51, 0xba, 0xf1, 0xd5, 0x52, 0x32, 0x51, 0x73, 0x08, 0x0b, 0x04, 0x72, 0xff,
-	0xd3, 0xb0, 0x24, 0x74, 0xbd, 0xcc, 0x3d, 0xa3, 0xcb, 0xc1, 0x45, 0x81, 0xcb, 0xfd, 0x32, 0x96,
-	0xe6, 0x90, 0xc1, 0xd3, 0x0b, 0xc5, 0x21, 0xdf, 0x04, 0x65, 0x96, 0x52, 0x6b, 0x99, 0x99, 0x50,
-	0xf4, 0x90, 0x27, 0x5c, 0xe8, 0x46, 0x61, 0x70, 0x84, 0x6a, 0xce, 0x6c, 0xe6, 0x21, 0xe7, 0xf0,
-	0xd7, 0xc2, 0xe0, 0xc8, 0xb9, 0x01, 0xce, 0xa8, 0x59, 0x8a, 0xd0, 0x7c, 0x23, 0xbb, 0x24, 0xf4,
-	0xa2, 0xd0, 0x34, 0xc2, 0x24, 0xd0, 0xf9, 0x42, 0x1d, 0x9e, 0x19, 0x5e, 0x8d, 0x50, 0x64, 0xaf,
-	0x6a, 0x41, 0x99, 0x17, 0x60, 0x99, 0x4f, 0xa0, 0xf0, 0x05, 0xc6, 0x7e, 0xd8, 0x49, 0xa5, 0xb8,
-	0x59, 0x6e, 0x98, 0xe1, 0x9b, 0x56, 0x91, 0x8e, 0x5b, 0xe4, 0xd1, 0xde, 0x5e, 0x4a, 0x59, 0xdb,
-	0x0f, 0x3d, 0x7a, 0x68, 0xfa, 0x3d, 0x04, 0x66, 0x8b, 0x23, 0xf8, 0xc2, 0xec, 0xf9, 0x9c, 0x6b,
-	0x76, 0x71, 0xfe, 0x74, 0x41, 0x09, 0x88, 0xb8, 0x8e, 0x33, 0xf5, 0x7e, 0x38, 0xa1, 0x47, 0x6e,
-	0x44, 0x67, 0x26, 0x65, 0x67, 0xcc, 0x28, 0x4f, 0x6b, 0xd9, 0x33, 0x7e, 0xa7, 0x5c, 0xfc, 0x77,
-	0xc9, 0x21, 0x16, 0x32, 0xce, 0xab, 0x99, 0x2e, 0x39, 0xdc, 0x96, 0x6e, 0x01, 0xc6, 0x25, 0x6a,
-	0xae, 0x6e, 0xce, 0x64, 0x86, 0x18, 0x4d, 0xd9, 0x5d, 0x54, 0x39, 0x7f, 0x63, 0xb6, 0x74, 0x63,
-	0x99, 0xbb, 0x5c, 0x4c, 0xa2, 0xdc, 0x58, 0x4f, 0xc2, 0x7c, 0x37, 0xc7, 0x1a, 0xeb, 0xa2, 0x23,
-	0xec, 0x97, 0xe0, 0xcc, 0x7d, 0xe2, 0x33, 0x3f, 0xec, 0x68, 0x1a, 0x43, 0x3b, 0xa5, 0x28, 0x31,
-	0x85, 0x20, 0x5b, 0x6c, 0xad, 0x4a, 0x92, 0x4c, 0x69, 0xd8, 0x91, 0x78, 0x2e, 0x2f, 0x68, 0x92,
-	0x44, 0x89, 0xc1, 0xd5, 0x02, 0x64, 0x37, 0xe1, 0xac, 0xf4, 0xcc, 0x62, 0x83, 0xa5, 0xf5, 0x4f,
-	0x63, 0xfd, 0x0f, 0xeb, 0x74, 0x83, 0x8d, 0x5c, 0x83, 0x85, 0x0e, 0xfa, 0xb4, 0xd1, 0x45, 0x9b,
-	0xe2, 0x49, 0x3c, 0x7f, 0xf5, 0x44, 0xa3, 0xe8, 0xe8, 0x6e, 0xcd, 0x77, 0x32, 0x08, 0x57, 0x03,
-	0x1f, 0xda, 0x23, 0x7e, 0x10, 0x57, 0x0d, 0x6d, 0x16, 0x9b, 0x7e, 0x50, 0xd1, 0x0c, 0x36, 0xfb,
-	0x12, 0x9c, 0x89, 0x69, 0x48, 0x02, 0x76, 0x54, 0x5a, 0x7e, 0x4e, 0x4c, 0x8d, 0x24, 0x19, 0x2c,
-	0xbe, 0x01, 0x0f, 0xf3, 0xba, 0x13, 0x4a, 0xbc, 0xf2, 0x0a, 0x00, 0x2b, 0x58, 0xcb, 0x88, 0x4a,
-	0xab, 0xe8, 0x13, 0x77, 0x97, 0x84, 0x21, 0xf5, 0x4a, 0xab, 0x98, 0x17, 0x55, 0x64, 0x44, 0x83,
-	0x55, 0x5c, 0x87, 0x07, 0xc4, 0xde, 0x68, 0xfb, 0xb1, 0x34, 0xf5, 0xb8, 0x09, 0x7d, 0x80, 0x96,
-	0xf0, 0xfc, 0xd5, 0xa5, 0x86, 0x61, 0x61, 0xb5, 0x4e, 0x0a, 0xe2, 0x2d, 0x45, 0x8b, 0x66, 0xd7,
-	0x16, 0x4c, 0x85, 0x11, 0x57, 0xa4, 0x16, 0x91, 0xe9, 0x9f, 0x6d, 0x1c, 0x8b, 0x15, 0x1b, 0xaf,
-	0x46, 0x8c, 0xb6, 0x44, 0x0d, 0xf6, 0xcb, 0xf0, 0xe8, 0x90, 0x39, 0x6d, 0x77, 0x12, 0x4a, 0xc3,
-	0xd5, 0x25, 0xc1, 0x14, 0x55, 0x33, 0xdb, 0xe4, 0x44, 0xf6, 0x4b, 0xb0, 0xe6, 0x87, 0x69, 0x6f,
-	0x6f, 0xcf, 0x77, 0x79, 0x5b, 0x01, 0xed, 0xd3, 0x20, 0x9f, 0x98, 0x65, 0xb1, 0xb8, 0x03, 0x14,
-	0xd9, 0xbc, 0x7c, 0x08, 0x1e, 0xea, 0xa7, 0xa1, 0xbb, 0x4f, 0xdd, 0x83, 0xd2, 0x99, 0xb5, 0x44,
-	0x05, 0x8a, 0x66, 0x70, 0x62, 0x3f, 0x00, 0x6b, 0x01, 0xe9, 0x71, 0x6c, 0xd2, 0xee, 0xfa, 0xa9,
-	0x60, 0xf1, 0xac, 0xf8, 0x09, 0xc1, 0x1c, 0x8a, 0xe2, 0x8e, 0x24, 0x50, 0xa5, 0xd7, 0xfe, 0x4c,
-	0x0d, 0x26, 0xf9, 0xbc, 0x70, 0x13, 0x34, 0x8b, 0xd7, 0x66, 0xc1, 0x67, 0x0e, 0xe1, 0x27, 0x4b,
-	0x42, 0x3b, 0x32, 0xe8, 0x63, 0x78, 0xae, 0x04, 0x58, 0x44, 0x04, 0x25, 0x89, 0xd8, 0x80, 0xca,
-	0x17, 0x3a, 0x23, 0xa0, 0x2d, 0x7e, 0x64, 0x7b, 0xbe, 0x88, 0x49, 0x1b, 0xce, 0xd2, 0x0c, 0xea,
-	0x7c, 0x6a, 0x12, 0xce, 0x6d, 0x72, 0x21, 0x56, 0x58, 0xcf, 0xc2, 0x21, 0x72, 0x23, 0x21, 0x7b,
-	0x63, 0x44, 0xf6, 0x06, 0x1d, 0x65, 0xf5, 0xca, 0x60, 0x98, 0xe6, 0x76, 0xbb, 0x5c, 0xe6, 0x76,
-	0xbb, 0xac, 0x53, 0x5c, 0x31, 0x3c, 0x6e, 0x92, 0xe2, 0x0a, 0x17, 0x9e, 0x5d, 0x12, 0xa7, 0x6d,
-	0x5c, 0x1c, 0x94, 0xaf, 0x8a, 0x64, 0x8e, 0xc3, 0x37, 0x39, 0x98, 0x9f, 0x07, 0x82, 0xa8, 0x97,
-	0x24, 0x34, 0x64, 0x66, 0x28, 0x0f, 0xc9, 0x04, 0x02, 0xdd, 0x1c, 0xb2, 0x3d, 0x11, 0x4d, 0xd0,
-	0x23, 0x79, 0xf3, 0xa2, 0x4d, 0xa1, 0x16, 0x68, 0x94, 0x7d, 0xca, 0xa2, 0x2b, 0xe8, 0xfd, 0x2a,
-	0x50, 0xbe, 0xce, 0x11, 0x3a, 0x65, 0xec, 0xbb, 0x07, 0x22, 0xc8, 0x53, 0xa0, 0xdc, 0xe6, 0x08,
-	0xbb, 0x09, 0xd3, 0x1e, 0x9f, 0x65, 0x21, 0x18, 0xe6, 0xaf, 0x3e, 0xd3, 0x18, 0x77, 0x5d, 0xa4,
-	0x65, 0x28, 0x8b, 0xaf, 0x35, 0x95, 0xf9, 0xba, 0x06, 0x53, 0x5d, 0x12, 0x17, 0xd6, 0x4a, 0x80,
-	0xc6, 0x71, 0x8c, 0x3a, 0x5f, 0xad, 0xc3, 0x89, 0xcd, 0xed, 0x84, 0x62, 0x4b, 0x5b, 0xe1, 0x5e,
-	0x84, 0x7a, 0xca, 0x25, 0x58, 0x8e, 0x13, 0xea, 0xf9, 0x18, 0x72, 0x4c, 0xdb, 0xb1, 0xcb, 0x8c,
-	0xfa, 0x97, 0x34, 0xe4, 0xb6, 0xcb, 0xec, 0x0f, 0xc1, 0x14, 0xf6, 0x4b, 0xea, 0xe8, 0xe7, 0xc7,
-	0x1e, 0x55, 0x4b, 0x94, 0xb3, 0xaf, 0xa2, 0x4a, 0xca, 0x94, 0x1d, 0xff, 0x50, 0x63, 0xa0, 0x4b,
-	0x8d, 0xbb, 0x94, 0x74, 0x51, 0xe8, 0xb7, 0x04, 0xa9, 0x6d, 0xcb, 0x08, 0xc2, 0x34, 0x46, 0x54,
-	0xf0, 0xff, 0xb5, 0xef, 0xad, 0xc1, 0x5c, 0x46, 0x68, 0x5f, 0x86, 0x13, 0x62, 0xcb, 0xfa, 0xe1,
-	0x5e, 0xd4, 0xf6, 0xbd, 0x43, 0x76, 0x68, 0x8e, 0x63, 0xb9, 0xab, 0x9a, 0xd8, 0x42, 0x24, 0xe7,
-	0x6d, 0xad, 0x04, 0x27, 0xd7, 0x43, 0xd7, 0x0b, 0x19, 0xf9, 0xdd, 0x43, 0xce, 0x1f, 0x96, 0x4e,
-	0x4b, 0x49, 0x57, 0xa8, 0xf5, 0x73, 0xad, 0xa5, 0x9c, 0x8e, 0x43, 0x9d, 0x3f, 0x9c, 0x1e, 0x5b,
-	0x7f, 0x17, 0xda, 0x33, 0x7d, 0x57, 0x74, 0x52, 0x54, 0x4b, 0xb0, 0x83, 0x9e, 0xa1, 0xcb, 0x0b,
-	0x97, 0xfb, 0x96, 0x97, 0x87, 0x81, 0xcb, 0xb5, 0x51, 0x81, 0x53, 0xda, 0x68, 0xe6, 0xc5, 0xb3,
-	0x07, 0xbc, 0x78, 0x76, 0x03, 0x66, 0x13, 0x11, 0x1f, 0x52, 0x2b, 0x68, 0x37, 0x06, 0xc2, 0x46,
-	0xad, 0x8c, 0x86, 0x37, 0x4c, 0x43, 0x37, 0x39, 0x42, 0x03, 0x09, 0x1d, 0xe1, 0xd3, 0x5a, 0xff,
-	0x16, 0x73, 0xdc, 0x2b, 0xf4, 0xc8, 0xbe, 0x0a, 0xb6, 0x49, 0xdc, 0x8e, 0x7b, 0xbb, 0xb8, 0x6d,
-	0x55, 0x01, 0xcb, 0x28, 0xb0, 0xdd, 0xdb, 0xb5, 0xcf, 0xc0, 0x5c, 0x4c, 0x12, 0x76, 0x84, 0x93,
-	0x27, 0x94, 0x80, 0x59, 0x04, 0xf0, 0xa9, 0xbb, 0x08, 0x73, 0xf7, 0xf7, 0x7d, 0x46, 0x03, 0x3f,
-	0x65, 0x78, 0xc2, 0x0f, 0x1e, 0x91, 0x39, 0x41, 0xb9, 0x47, 0x1a, 0x86, 0x79, 0xa4, 0xdf, 0x6f,
-	0xe8, 0xed, 0x28, 0x0f, 0xd1, 0x85, 0xcd, 0xcd, 0xe8, 0x42, 0xec, 0x54, 0x57, 0xd9, 0x45, 0x62,
-	0xc5, 0x8b, 0x46, 0x61, 0xc1, 0x55, 0x0b, 0x52, 0x0f, 0x35, 0x53, 0x49, 0xf4, 0xb2, 0xc8, 0x67,
-	0xf6, 0x26, 0xac, 0x6b, 0x65, 0x5d, 0xec, 0x54, 0xda, 0xd6, 0xf9, 0x68, 0x11, 0xa7, 0xe2, 0x4c,
-	0x4e, 0xb5, 0x29, 0x88, 0x36, 0x72, 0xc6, 0x2a, 0xf3, 0xee, 0x2e, 0x0d, 0xf1, 0xee, 0xbe, 0x0f,
-	0xb8, 0x34, 0x10, 0xe6, 0x89, 0x30, 0x7e, 0x96, 0x71, 0xac, 0xf6, 0xe0, 0x1e, 0x6e, 0x2d, 0xc4,
-	0x12, 0x82, 0x42, 0xa6, 0x22, 0xbc, 0x6b, 0x0d, 0x0d, 0xef, 0xaa, 0xc8, 0x47, 0x14, 0xb2, 0x24,
-	0x0a, 0x0c, 0x0f, 0xf8, 0x1c, 0xeb, 0x6f, 0x0a, 0xb0, 0xf3, 0xe9, 0x19, 0x78, 0x7e, 0x84, 0x51,
-	0x53, 0x34, 0x56, 0x5b, 0x34, 0x8d, 0xa3, 0x30, 0xa5, 0xf6, 0x05, 0x58, 0x4c, 0x72, 0x70, 0xc1,
-	0xa3, 0x63, 0xa2, 0xec, 0x8f, 0xc0, 0xbc, 0x06, 0xc0, 0x7d, 0x88, 0x42, 0xfd, 0x58, 0x9b, 0xbd,
-	0xa5, 0xd7, 0xa1, 0xc7, 0xcf, 0xe6, 0xf4, 0xf8, 0xd9, 0x35, 0x38, 0xd9, 0x71, 0xdb, 0x1a, 0x65,
-	0x3b, 0xe5, 0x8c, 0xa5, 0xc7, 0xd2, 0x4e, 0x74, 0x5c, 0x6d, 0x40, 0x3b, 0x9c, 0x9d, 0x06, 0xb7,
-	0xf8, 0x54, 0xf5, 0x16, 0x3f, 0x87, 0xa1, 0x20, 0x2e, 0xce, 0x70, 0x3f, 0x72, 0x96, 0x33, 0xa3,
-	0x5c, 0xad, 0x69, 0x19, 0xed, 0xba, 0x06, 0xa7, 0x12, 0x7a, 0x9f, 0x24, 0x5e, 0x5b, 0x7a, 0x8c,
-	0x24, 0x93, 0xa5, 0x32, 0x5e, 0xb9, 0x22, 0xb0, 0x42, 0x06, 0x48, 0xe6, 0xe2, 0xf2, 0x78, 0xc5,
-	0xf7, 0x02, 0x3a, 0x50, 0x46, 0x6c, 0x50, 0x9b, 0xe3, 0x0a, 0x25, 0x3e, 0x00, 0xab, 0xb2, 0x1d,
-	0xc3, 0xf5, 0xcd, 0x65, 0x79, 0x31, 0x93, 0x82, 0x53, 0x6d, 0xe5, 0x1e, 0xf0, 0x2d, 0xef, 0xd0,
-	0x7e, 0x21, 0xeb, 0x65, 0x5e, 0x5a, 0xb8, 0xc0, 0xf5, 0x6c, 0x8a, 0x93, 0x66, 0x59, 0xf4, 0x81,
-	0xdb, 0xd7, 0xe1, 0xcc, 0x40, 0x51, 0x05, 0xf0, 0x0e, 0x8d, 0xa4, 0x8a, 0xd3, 0x66, 0xf9, 0x96,
-	0xf8, 0xe5, 0x1d, 0xf2, 0x9d, 0x24, 0x8b, 0x78, 0x49, 0x14, 0xb7, 0x51, 0xdc, 0xe8, 0xb1, 0xa9,
-	0x25, 0x81, 0xbd, 0x91, 0x44, 0xf1, 0x6d, 0x2e, 0x69, 0x9e, 0x86, 0x25, 0x7d, 0xeb, 0x93, 0x0e,
-	0xc6, 0xa6, 0x14, 0x13, 0x2c, 0x6a, 0xbb, 0x9d, 0x74, 0xec, 0x0f, 0xc3, 0x83, 0x01, 0xed, 0x10,
-	0x57, 0x6e, 0x52, 0xbe, 0xf1, 0x3a, 0x09, 0xe9, 0x8a, 0xd0, 0x8d, 0x1e, 0xa3, 0x3a, 0x2d, 0xc8,
-	0x76, 0x74, 0x2a, 0x0c, 0xe3, 0xbc, 0x00, 0x0f, 0x98, 0x45, 0x93, 0xa8, 0xc7, 0x0d, 0x40, 0x63,
-	0x4f, 0xad, 0x18, 0x24, 0x2d, 0x41, 0x51, 0xb0, 0x67, 0x97, 0x4b, 0xed, 0xd9, 0xfc, 0xc0, 0xb0,
-	0x06, 0xc3, 0x3e, 0x5f, 0xaf, 0xc3, 0xb8, 0x06, 0x86, 0x3a, 0x11, 0xcf, 0xc2, 0xac, 0xe0, 0xd6,
-	0xc2, 0x8e, 0xcc, 0xa0, 0x5c, 0x0d, 0xf3, 0xfc, 0x84, 0xba, 0x0c, 0xc3, 0xb0, 0xbe, 0xe9, 0xdf,
-	0x9a, 0x17, 0xa8, 0x7b, 0x5e, 0xbc, 0x15, 0xdb, 0x17, 0x61, 0x59, 0xa3, 0xc4, 0x29, 0xd3, 0xfd,
-	0x90, 0x8b, 0x19, 0x2d, 0x4e, 0xd4, 0x80, 0x40, 0x98, 0x1c, 0x5b, 0x20, 0x4c, 0xbd, 0x7b, 0x02,
-	0x61, 0xba, 0x28, 0x10, 0xf2, 0xad, 0xad, 0xc2, 0x7b, 0x7a, 0x1a, 0xa2, 0xdc, 0xda, 0xf2, 0x50,
-	0x73, 0xfe, 0xd7, 0xec, 0xa8, 0x09, 0x97, 0x3d, 0x88, 0x7a, 0xa1, 0x27, 0xb4, 0xa7, 0xff, 0x4f,
-	0xe5, 0xe0, 0x1a, 0x4c, 0x25, 0xbc, 0x93, 0x86, 0xb1, 0x20, 0x40, 0xf6, 0x0a, 0x4c, 0x1d, 0xf8,
-	0x41, 0x20, 0x94, 0x90, 0xa9, 0x96, 0xf8, 0x61, 0xaf, 0xc2, 0x0c, 0x49, 0x53, 0x3f, 0x65, 0x4a,
-	0x57, 0x54, 0x3f, 0xed, 0x53, 0x30, 0xed, 0x51, 0xc2, 0xf6, 0x85, 0xd8, 0x9a, 0x6a, 0xc9, 0x5f,
-	0x1c, 0x8e, 0x41, 0x2b, 0x21, 0x9a, 0xa6, 0x5a, 0xf2, 0x17, 0xaf, 0x5f, 0x78, 0x86, 0xe6, 0x44,
-	0xfd, 0x99, 0x2f, 0x0a, 0x9b, 0xe7, 0xc2, 0xb9, 0x17, 0x30, 0x14, 0x2e, 0x99, 0xf6, 0x8f, 0x98,
-	0x16, 0x22, 0x84, 0x91, 0xc2, 0x8f, 0x49, 0x49, 0x38, 0x3f, 0xe0, 0xb0, 0x91, 0x84, 0x8f, 0x00,
-	0x5a, 0x0d, 0x6d, 0xd9, 0x89, 0x05, 0x6c, 0x0d, 0x75, 0xf9, 0x1d, 0xd1, 0x91, 0x2d, 0x98, 0x71,
-	0x85, 0xa7, 0x17, 0x65, 0xc4, 0x31, 0xe6, 0x5a, 0x3a, 0x88, 0x5b, 0xaa, 0xbc, 0x7d, 0x05, 0x4e,
-	0x18, 0x87, 0x0a, 0xb7, 0xdc, 0x50, 0x80, 0xa8, 0x9e, 0x59, 0x1a, 0x1a, 0xed, 0x3a, 0xce, 0x79,
-	0xf2, 0xb8, 0xef, 0x09, 0xe7, 0x35, 0x8a, 0x00, 0x45, 0xbf, 0x88, 0xb8, 0x1b, 0x12, 0xc5, 0xc7,
-	0x42, 0x43, 0xda, 0x3d, 0x6a, 0x8b, 0x95, 0xb1, 0xc4, 0x58, 0x10, 0xf4, 0x0a, 0x2e, 0xcf, 0x53,
-	0xb0, 0x2c, 0x08, 0xf6, 0x29, 0xf1, 0xd2, 0xfd, 0x88, 0x09, 0xcb, 0x7a, 0xaa, 0xb5, 0x84, 0xe0,
-	0x97, 0x15, 0x94, 0x2b, 0x75, 0x82, 0xf0, 0xd9, 0x03, 0xae, 0x85, 0x72, 0x92, 0x59, 0x04, 0x3c,
-	0x7b, 0xa0, 0x21, 0xaf, 0x1d, 0xa4, 0xab, 0x27, 0x35, 0xe4, 0x35, 0x1d, 0xf9, 0xdc, 0x41, 0xba,
-	0xba, 0xa2, 0x21, 0x9f, 0x3b, 0x40, 0x3b, 0xa2, 0xdb, 0x8f, 0xd3, 0xd5, 0x07, 0x84, 0x1d, 0xc1,
-	0xff, 0xb7, 0x9f, 0x01, 0x2b, 0x8d, 0xa9, 0xcb, 0x08, 0x8b, 0x12, 0x65, 0x79, 0x9e, 0xd2, 0x73,
-	0x32, 0x73, 0xac, 0xb0, 0x3f, 0xaf, 0xc1, 0xc9, 0x62, 0x81, 0x36, 0xeb, 0xaf, 0x9e, 0xd6, 0x75,
-	0x99, 0x42, 0x99, 0xbb, 0x7d, 0xfb, 0xbd, 0xb0, 0x32, 0x50, 0x2a, 0x08, 0x0f, 0x56, 0x57, 0xf5,
-	0x08, 0x6d, 0xa1, 0xd8, 0xed, 0xf0, 0xc0, 0x7e, 0x52, 0x4d, 0x19, 0xce, 0x69, 0x9b, 0x74, 0x3a,
-	0xab, 0x0f, 0x62, 0xef, 0x17, 0xf3, 0x79, 0xdd, 0xe8, 0x74, 0xec, 0x7b, 0x30, 0x87, 0x47, 0x0f,
-	0x1e, 0xe9, 0x6b, 0xc8, 0x28, 0xef, 0x3b, 0xa6, 0x18, 0x68, 0xf0, 0xe3, 0x49, 0xa8, 0xef, 0x9e,
-	0xfc, 0x6f, 0xed, 0x0a, 0xcc, 0x2a, 0xa8, 0xfd, 0x44, 0x6e, 0xa8, 0x74, 0xfb, 0xb1, 0xe1, 0xd1,
-	0x57, 0xe6, 0xca, 0x9d, 0x7e, 0xec, 0xbc, 0x3d, 0x01, 0x17, 0xc7, 0x69, 0x58, 0x85, 0xef, 0xec,
-	0x9b, 0xca, 0x22, 0xac, 0x8d, 0xc5, 0xdf, 0xc5, 0x6e, 0x2b, 0x23, 0x51, 0xdb, 0x28, 0x13, 0x7f,
-	0xc4, 0x8d, 0xb2, 0x0e, 0x33, 0x09, 0x15, 0x5e, 0x78, 0x23, 0x81, 0x49, 0x02, 0x51, 0x0c, 0x50,
-	0xcc, 0xc2, 0x12, 0xc7, 0xa2, 0xae, 0x68, 0xcd, 0x0b, 0x8c, 0x38, 0x18, 0x2f, 0xf3, 0x1d, 0x17,
-	0x8b, 0xbc, 0x8f, 0x5e, 0x2a, 0x2f, 0x0a, 0xe8, 0x39, 0x08, 0xcb, 0x02, 0xbd, 0x29, 0xb0, 0x5b,
-	0x9e, 0x7d, 0x1e, 0x16, 0xc9, 0x6e, 0x94, 0x30, 0xea, 0xc9, 0x30, 0x80, 0x1e, 0xa7, 0x5c, 0x90,
-	0x28, 0xe1, 0xf2, 0x7f, 0x15, 0x56, 0xc4, 0xde, 0xa4, 0xa1, 0xa7, 0xc7, 0xf1, 0x66, 0x71, 0xf4,
-	0x0f, 0x37, 0x86, 0x45, 0x00, 0x5b, 0xc2, 0x5c, 0xbe, 0x19, 0x7a, 0x79, 0x58, 0x6f, 0x50, 0x81,
-	0x9c, 0xab, 0x54, 0x20, 0x9d, 0x8f, 0x4d, 0xc0, 0xb3, 0xc7, 0x59, 0xe5, 0x6d, 0x92, 0x30, 0x9f,
-	0x04, 0xff, 0xaf, 0x8f, 0x9a, 0x77, 0x91, 0x49, 0xbe, 0x09, 0xce, 0xb8, 0x51, 0x37, 0x0e, 0x28,
-	0x5f, 0xab, 0xc1, 0x68, 0xea, 0xe4, 0x38, 0xab, 0xb0, 0x9a, 0xd5, 0x50, 0x0c, 0xfd, 0x1f, 0x47,
-	0x9b, 0x77, 0x1a, 0xa3, 0x76, 0x9c, 0x0c, 0xc8, 0x34, 0x37, 0x5f, 0xa6, 0x41, 0x10, 0x39, 0x1f,
-	0x9b, 0x1d, 0x55, 0x20, 0xd3, 0xc9, 0xb0, 0xc0, 0x78, 0x69, 0xa0, 0x3b, 0xb0, 0xa0, 0x3b, 0xfe,
-	0xc7, 0x5f, 0x2f, 0x43, 0xfb, 0x6b, 0x19, 0x95, 0x0c, 0x04, 0x0b, 0x26, 0xc6, 0x0a, 0x16, 0x5c,
-	0x82, 0x65, 0xe5, 0x97, 0x4e, 0xa9, 0x1b, 0x85, 0x9e, 0x99, 0x38, 0xb7, 0x24, 0x91, 0x3b, 0x02,
-	0x87, 0x19, 0xf5, 0x92, 0x3c, 0xa1, 0x24, 0x2d, 0x4e, 0xb6, 0xc4, 0xb5, 0x10, 0xc5, 0xe7, 0xa2,
-	0x4f, 0xdc, 0xb6, 0xf0, 0xd0, 0x1b, 0xde, 0xc7, 0xb9, 0x3e, 0x71, 0xaf, 0x23, 0xd8, 0xbe, 0x28,
-	0x52, 0x62, 0xb9, 0x8e, 0x3d, 0x23, 0x6d, 0xe4, 0x41, 0x2f, 0x89, 0x22, 0xb1, 0xdf, 0x0f, 0x0b,
-	0xae, 0x96, 0xb1, 0x2a, 0x77, 0xf0, 0xe9, 0x46, 0x79, 0x32, 0x6b, 0xcb, 0x20, 0xb6, 0xcf, 0xc3,
-	0x74, 0x17, 0x93, 0x4b, 0x71, 0xbb, 0xf2, 0xb9, 0x29, 0x66, 0x9c, 0xb6, 0x24, 0x81, 0xfd, 0x22,
-	0x58, 0xdd, 0x23, 0xe5, 0x38, 0x95, 0xee, 0x0a, 0xa8, 0x70, 0x57, 0x2c, 0x75, 0x8f, 0xa4, 0x23,
-	0x55, 0x78, 0x2b, 0x6e, 0xc0, 0xa9, 0x62, 0x59, 0xe9, 0xb3, 0x98, 0x2f, 0xf7, 0x59, 0x9c, 0x34,
-	0x2b, 0x10, 0x7e, 0x8b, 0xe7, 0x61, 0x59, 0xab, 0x05, 0xa3, 0xbb, 0x0b, 0xd2, 0xfe, 0x2c, 0x14,
-	0x5f, 0xcc, 0x8a, 0xe3, 0x65, 0x9a, 0x26, 0x9c, 0x1e, 0x68, 0x1e, 0x15, 0x18, 0x15, 0xc6, 0x18,
-	0x1c, 0xc1, 0x8a, 0xd9, 0x01, 0x54, 0x68, 0x30, 0x73, 0x4b, 0x66, 0xae, 0xf7, 0x23, 0x46, 0xcd,
-	0x84, 0x74, 0x81, 0x78, 0x3d, 0x62, 0xd4, 0xbe, 0xa8, 0xe5, 0xc5, 0x2e, 0xcb, 0x29, 0x2a, 0xe4,
-	0xce, 0x6a, 0x39, 0xb2, 0x4f, 0xc1, 0x82, 0xba, 0x53, 0x44, 0xfb, 0x54, 0xf8, 0x2a, 0x32, 0x75,
-	0x4f, 0x5e, 0x28, 0xe2, 0x08, 0x6e, 0xb8, 0x48, 0x42, 0xb7, 0x97, 0xb4, 0x0f, 0x63, 0x74, 0xb1,
-	0x15, 0x28, 0x37, 0x7b, 0xc9, 0x9b, 0xb1, 0xfd, 0x3c, 0x3c, 0x20, 0x29, 0x0f, 0x63, 0x99, 0x08,
-	0x22, 0x6c, 0xac, 0x93, 0x5a, 0x09, 0x5b, 0x90, 0xbc, 0x19, 0x63, 0x0e, 0xc8, 0xad, 0x01, 0x0f,
-	0xdd, 0xca, 0x68, 0x0f, 0x9d, 0xf3, 0x5b, 0x35, 0x38, 0x5f, 0xb2, 0x45, 0xe5, 0x60, 0xb7, 0x13,
-	0xbf, 0xcf, 0x6d, 0x4a, 0xca, 0x18, 0x6a, 0xc0, 0xaf, 0x72, 0xdb, 0x4c, 0xfc, 0x2f, 0x23, 0xb8,
-	0x57, 0x1b, 0x63, 0x97, 0x6e, 0xc8, 0x7f, 0x5a, 0x59, 0x1d, 0x6b, 0x7f, 0x1c, 0x66, 0x24, 0x90,
-	0x4f, 0xa2, 0x04, 0x0f, 0xde, 0x79, 0x9b, 0x97, 0x18, 0x95, 0x85, 0xaa, 0x08, 0x85, 0x8d, 0xaf,
-	0x5b, 0x7f, 0xaa, 0x0e, 0x91, 0xe0, 0xf6, 0xed, 0xe3, 0x5b, 0x9f, 0x1b, 0x22, 0xbc, 0x3d, 0x9e,
-	0xac, 0x7b, 0x13, 0x96, 0x65, 0x38, 0x3c, 0x3b, 0xa8, 0xdf, 0xa1, 0xb8, 0x5b, 0xca, 0xea, 0x11,
-	0xa7, 0x7a, 0x89, 0xe8, 0x9a, 0x38, 0x96, 0xe8, 0x9a, 0xac, 0x14, 0x5d, 0xce, 0x17, 0x6b, 0xf0,
-	0xf4, 0x38, 0x87, 0xf6, 0xd5, 0xe6, 0xe6, 0x2b, 0xbe, 0x7b, 0x30, 0xde, 0x54, 0xfc, 0x1f, 0x38,
-	0xa5, 0x1f, 0x82, 0x69, 0x39, 0x98, 0x09, 0xe3, 0x3e, 0x81, 0x18, 0xc5, 0x9f, 0xaa, 0xc1, 0x95,
-	0x71, 0x6b, 0x27, 0xe1, 0x81, 0x8c, 0xa0, 0xeb, 0xdb, 0xa2, 0x36, 0x86, 0xe3, 0x5a, 0x77, 0xa9,
-	0xd7, 0x4b, 0x5c, 0xea, 0xce, 0x1b, 0xf0, 0x44, 0x49, 0x2f, 0xc4, 0xe2, 0x36, 0x37, 0xdf, 0x79,
-	0xcb, 0x4e, 0x7b, 0x94, 0x66, 0x25, 0x32, 0x89, 0x22, 0xbe, 0x4c, 0xd7, 0x83, 0xa8, 0x23, 0x9b,
-	0x19, 0xb8, 0xb2, 0x54, 0xab, 0xb8, 0xb2, 0xe4, 0x7c, 0xb2, 0x56, 0xda, 0x82, 0x98, 0xb2, 0x57,
-	0x23, 0xe6, 0xef, 0xf9, 0xe2, 0xf2, 0xe3, 0xad, 0x28, 0xb9, 0x97, 0xd2, 0x64, 0x5b, 0x30, 0xd1,
-	0x78, 0xec, 0x90, 0xaf, 0x5d, 0x7d, 0x70, 0xed, 0xb8, 0x66, 0x5d, 0xc6, 0xd5, 0x0a, 0xe8, 0xfc,
-	0x7e, 0x7d, 0xc8, 0xac, 0xb6, 0x68, 0x1c, 0x25, 0xc7, 0xba, 0x85, 0xfa, 0x18, 0x40, 0x12, 0xb3,
-	0x36, 0xf1, 0xbb, 0xbb, 0x11, 0x33, 0x3a, 0x34, 0x97, 0xc4, 0x6c, 0x03, 0xc1, 0xa8, 0xcd, 0xc7,
-	0xac, 0x7d, 0x9f, 0x04, 0xc1, 0x3e, 0x71, 0x0f, 0x8c, 0x8e, 0xcd, 0x27, 0x31, 0x7b, 0x43, 0x22,
-	0xb8, 0xdc, 0xe1, 0x84, 0x69, 0x4c, 0xa9, 0x87, 0x94, 0xfa, 0x56, 0xe3, 0x75, 0xec, 0x28, 0x8c,
-	0xaa, 0x93, 0x1f, 0x70, 0xfb, 0x24, 0xe9, 0x16, 0x2c, 0x84, 0x18, 0xcf, 0x34, 0x8e, 0x50, 0x75,
-	0x32, 0x7a, 0xc8, 0xc8, 0x6e, 0x2f, 0x35, 0x33, 0xf3, 0x17, 0x90, 0x52, 0x62, 0xf8, 0x56, 0xe7,
-	0xa4, 0xfd, 0xc8, 0x77, 0xa9, 0xa0, 0x9d, 0xd1, 0xb7, 0x7a, 0x12, 0xb3, 0xd7, 0x33, 0x94, 0xc1,
-	0xbe, 0xb3, 0x65, 0xec, 0xfb, 0xe5, 0x1a, 0x3c, 0x59, 0x39, 0xd3, 0x52, 0xe5, 0x38, 0xce, 0x54,
-	0x8f, 0x6a, 0x70, 0x40, 0xc9, 0x99, 0x3b, 0x8e, 0x92, 0xf3, 0x10, 0x4c, 0xa3, 0xa9, 0x95, 0x1a,
-	0xfe, 0x5c, 0x09, 0x73, 0x7e, 0x7c, 0x34, 0xd7, 0x08, 0xe6, 0x56, 0xeb, 0x12, 0x47, 0x51, 0x12,
-	0xd3, 0x64, 0xcf, 0x3c, 0x63, 0x92, 0x98, 0x6d, 0x4b, 0x04, 0xfa, 0x4a, 0x38, 0xe7, 0xec, 0xf6,
-	0x52, 0xbf, 0x4f, 0xbb, 0x91, 0x47, 0x83, 0xd4, 0x60, 0x20, 0x8b, 0x33, 0x90, 0x8e, 0xc5, 0xdb,
-	0x4c, 0x31, 0x6b, 0xef, 0x12, 0xaf, 0x1b, 0x31, 0xcf, 0x4c, 0x77, 0x4c, 0x62, 0x76, 0x5d, 0xc0,
-	0xed, 0x67, 0x80, 0x17, 0x45, 0x1f, 0xb1, 0x1f, 0x76, 0xc4, 0x42, 0x4e, 0x1a, 0x26, 0x61, 0xcc,
-	0x6e, 0x6b, 0x48, 0xfb, 0x59, 0xb0, 0x79, 0x01, 0x3f, 0xe4, 0x5a, 0x4c, 0x94, 0x1c, 0x89, 0x22,
-	0x53, 0x46, 0xec, 0x24, 0x66, 0x5b, 0x06, 0x7a, 0xf4, 0xfa, 0x7f, 0xb2, 0x0e, 0x4f, 0x8d, 0x98,
-	0xb3, 0x2c, 0x4e, 0x72, 0x09, 0x96, 0xa5, 0xd5, 0x43, 0xd4, 0xb5, 0x55, 0xdd, 0x6c, 0x5b, 0xd2,
-	0x91, 0x62, 0xd7, 0x69, 0xfc, 0x52, 0x2f, 0xe7, 0x97, 0x47, 0x61, 0x2e, 0xcb, 0x64, 0x31, 0xe6,
-	0x6a, 0x56, 0xe5, 0xac, 0xe0, 0xde, 0x90, 0x5d, 0x10, 0x1a, 0x81, 0xb9, 0xdf, 0x24, 0x0a, 0x55,
-	0x82, 0x4b, 0xb0, 0x9c, 0x91, 0x4a, 0x97, 0xdb, 0x94, 0xe9, 0x7f, 0x17, 0x48, 0xe9, 0x75, 0xcb,
-	0xd9, 0x69, 0xba, 0x84, 0x9d, 0x3e, 0x5b, 0x2f, 0xcd, 0x28, 0x55, 0x53, 0x83, 0x46, 0xde, 0x1b,
-	0x2a, 0xda, 0x25, 0x6e, 0x8e, 0xe1, 0x0d, 0x9c, 0x84, 0x1a, 0xa9, 0xc1, 0xb9, 0xa8, 0xa1, 0x2a,
-	0x39, 0xb8, 0x10, 0xeb, 0xad, 0x0f, 0xc4, 0x7a, 0x75, 0xd7, 0xf7, 0x44, 0xa9, 0xeb, 0x7b, 0xc4,
-	0xe5, 0x2a, 0x5c, 0x2b, 0x91, 0x77, 0xa8, 0xb2, 0x55, 0xcc, 0x99, 0x10, 0xc8, 0xdb, 0x12, 0x57,
-	0x9e, 0x0d, 0x37, 0x3d, 0x5e, 0x36, 0x9c, 0xf3, 0x43, 0x93, 0x60, 0xe3, 0x44, 0x90, 0xdd, 0x20,
-	0x8f, 0xff, 0x99, 0x4b, 0x5b, 0x2b, 0x5d, 0x5a, 0x71, 0x15, 0x09, 0xdd, 0xf1, 0x75, 0xf3, 0x2a,
-	0x12, 0xfa, 0xe1, 0xcf, 0xe3, 0x0d, 0x95, 0xdc, 0x1d, 0x66, 0x30, 0xc8, 0x02, 0xeb, 0xef, 0x64,
-	0x18, 0xfd, 0x52, 0xd3, 0x64, 0xc9, 0xa5, 0x26, 0x11, 0xd3, 0x15, 0xd7, 0xb0, 0x62, 0x92, 0xa6,
-	0xf7, 0xa3, 0x44, 0xdc, 0x13, 0x5f, 0xc8, 0x63, 0xba, 0x38, 0x84, 0x6d, 0x89, 0xb4, 0xaf, 0x82,
-	0xed, 0x06, 0x6d, 0x8f, 0x62, 0x9c, 0x19, 0xe7, 0xa6, 0x18, 0xb5, 0xb6, 0xdc, 0xe0, 0x46, 0x8e,
-	0x7e, 0x85, 0x1e, 0xd9, 0x2f, 0xc0, 0xa9, 0xc1, 0x32, 0x03, 0xc1, 0xeb, 0x93, 0xc5, 0x72, 0xdb,
-	0xbd, 0xdd, 0xff, 0xdb, 0xb7, 0xae, 0xb4, 0xb5, 0x31, 0x6f, 0x5b, 0xa9, 0xb5, 0x31, 0x25, 0xf9,
-	0x42, 0x45, 0x32, 0x81, 0xee, 0x47, 0x96, 0x37, 0x80, 0xcb, 0x9c, 0x38, 0x5b, 0x9e, 0xf3, 0x99,
-	0x72, 0x0d, 0xdc, 0xd8, 0x4c, 0xdf, 0x10, 0xf9, 0xa1, 0xd8, 0x47, 0xe8, 0xfb, 0x58, 0x87, 0x19,
-	0xe5, 0xf4, 0xd0, 0xb9, 0x47, 0x01, 0xc7, 0x93, 0x2f, 0x8f, 0x01, 0x7c, 0x4b, 0xe4, 0x87, 0xd2,
-	0x43, 0xa7, 0xf3, 0xcf, 0x1c, 0x87, 0x0b, 0xff, 0xdc, 0x23, 0x30, 0x8b, 0x44, 0x7e, 0x1c, 0x1b,
-	0xdc, 0x33, 0xc3, 0xa1, 0x5b, 0x71, 0x6c, 0x6f, 0xc0, 0x44, 0x22, 0x2f, 0xd4, 0xbd, 0x03, 0xdd,
-	0x9e, 0x97, 0xc5, 0x3c, 0xeb, 0x24, 0x89, 0x92, 0x6e, 0xda, 0x31, 0x22, 0x3b, 0x19, 0xd4, 0xf9,
-	0xf9, 0x31, 0xa7, 0x48, 0x9c, 0x61, 0xef, 0xde, 0x14, 0x8d, 0x16, 0x36, 0xc6, 0x4e, 0x9e, 0x2c,
-	0xdd, 0xc9, 0xdc, 0x9e, 0x16, 0x24, 0xb8, 0x9b, 0x8d, 0x4b, 0x1c, 0x02, 0x81, 0x3b, 0x5a, 0x4e,
-	0xe4, 0xf4, 0xbb, 0x34, 0x91, 0x33, 0xa5, 0x13, 0x79, 0x08, 0x17, 0x64, 0xc5, 0x69, 0xd5, 0x3c,
-	0xbe, 0x4a, 0xef, 0xdf, 0x11, 0xf7, 0x07, 0x30, 0xdb, 0x4c, 0xfc, 0x3b, 0x30, 0x47, 0x12, 0x2e,
-	0xee, 0xaf, 0xbb, 0xa4, 0x1b, 0x13, 0xbf, 0x13, 0xaa, 0xe4, 0x9a, 0x6c, 0x78, 0x0a, 0xb1, 0xe5,
-	0x39, 0xbf, 0x54, 0xcf, 0x3c, 0x6a, 0x69, 0x59, 0xaa, 0x3c, 0x6a, 0xd5, 0x47, 0x6f, 0x6e, 0x8b,
-	0x90, 0x31, 0xf5, 0x4a, 0xaf, 0xd7, 0xd4, 0xc6, 0xbb, 0x5e, 0x33, 0x2e, 0xfb, 0x2b, 0x67, 0xc9,
-	0xa1, 0x79, 0xbe, 0xce, 0x49, 0xf8, 0x9b, 0x78, 0xc0, 0x2a, 0x22, 0xe1, 0xb7, 0x30, 0x0e, 0x58,
-	0x89, 0x12, 0x8e, 0x8b, 0x4b, 0xb0, 0xdc, 0x8b, 0x3b, 0x09, 0xf1, 0xa8, 0xa7, 0x6e, 0xa4, 0x19,
-	0xc7, 0x8a, 0x42, 0xca, 0x1b, 0x69, 0x1f, 0x84, 0xd5, 0x81, 0xab, 0x37, 0x44, 0x0c, 0xdd, 0x38,
-	0x72, 0x4f, 0x15, 0x6e, 0xde, 0x6c, 0x08, 0x1a, 0xe7, 0xed, 0x1a, 0x3c, 0x5a, 0xc2, 0x23, 0xd9,
-	0xa9, 0xcb, 0xcd, 0x92, 0x77, 0xeb, 0xcc, 0x6d, 0xc2, 0x03, 0xf7, 0xd5, 0x21, 0xd6, 0xce, 0xf3,
-	0xbc, 0xd4, 0xcd, 0x8d, 0x93, 0x8d, 0xc1, 0x23, 0xae, 0x75, 0xf2, 0xfe, 0x00, 0x0c, 0x43, 0x49,
-	0xf4, 0x90, 0xd1, 0x90, 0xcf, 0x11, 0x3f, 0x90, 0xa2, 0x9e, 0xb9, 0x1f, 0x96, 0x15, 0xf6, 0xae,
-	0x40, 0x3a, 0xff, 0xad, 0x36, 0x7a, 0xe3, 0x0b, 0xf5, 0x38, 0xdd, 0x4e, 0xa2, 0x3d, 0x3f, 0xa0,
-	0x22, 0xd1, 0x41, 0x0d, 0xb9, 0xdd, 0xf6, 0x68, 0x9c, 0x50, 0x97, 0x30, 0x6a, 0x0e, 0xff, 0x64,
-	0x36, 0xfc, 0x1b, 0x19, 0x81, 0xfd, 0x1c, 0x9c, 0xd6, 0x26, 0xc2, 0x28, 0x2b, 0x26, 0x65, 0x25,
-	0x9f, 0x14, 0xad, 0x98, 0xc9, 0x6e, 0x13, 0xe5, 0xec, 0x86, 0xaa, 0x9a, 0xe8, 0x56, 0x09, 0x27,
-	0x49, 0x14, 0x72, 0x92, 0xf3, 0xc3, 0xe5, 0x4b, 0x5b, 0x18, 0xe7, 0x58, 0x4b, 0xfb, 0x26, 0x58,
-	0xaa, 0x6b, 0xb1, 0x28, 0xa7, 0xee, 0xea, 0x5c, 0x6a, 0x1c, 0xc7, 0xbf, 0xdd, 0x5a, 0x26, 0xca,
-	0x5f, 0x25, 0x6a, 0x71, 0x7e, 0xbd, 0x5e, 0xea, 0x21, 0x17, 0x9d, 0x7c, 0xad, 0x4f, 0x13, 0x5c,
-	0xff, 0x4d, 0x92, 0x52, 0x69, 0x7d, 0x3f, 0x04, 0xd3, 0x2e, 0x49, 0x69, 0xf1, 0x42, 0xa7, 0x80,
-	0xd9, 0x0e, 0xcc, 0xa5, 0x3d, 0x54, 0x67, 0x8a, 0x53, 0x98, 0x81, 0xed, 0xc7, 0x01, 0xf6, 0x12,
-	0xe2, 0x6a, 0x89, 0x07, 0x99, 0xa0, 0xc9, 0xe1, 0x05, 0x8b, 0x76, 0x6a, 0x3c, 0x8b, 0x76, 0x7a,
-	0x6c, 0x8b, 0x76, 0x66, 0x6c, 0x8b, 0x76, 0xb6, 0xca, 0xa2, 0xcd, 0x1d, 0x00, 0x73, 0x25, 0xce,
-	0x9b, 0x5f, 0x99, 0x28, 0xd5, 0xad, 0x4b, 0x26, 0x76, 0x23, 0x4d, 0xfd, 0x4e, 0x88, 0xef, 0x6d,
-	0x0c, 0x9f, 0xdc, 0x75, 0x98, 0xe1, 0xff, 0xf5, 0x92, 0xc0, 0x7c, 0x47, 0x49, 0x02, 0xe5, 0x59,
-	0xe9, 0xf9, 0xae, 0x99, 0xfa, 0xa1, 0x80, 0x7c, 0x71, 0xf2, 0x57, 0x35, 0xcc, 0xfb, 0xf5, 0xd9,
-	0x9b, 0x1a, 0x0d, 0x58, 0x66, 0xfb, 0x49, 0xc4, 0x58, 0x40, 0x95, 0xff, 0xc2, 0xd8, 0xd9, 0x05,
-	0xa4, 0xb9, 0xe0, 0xd3, 0xe3, 0x2c, 0xf8, 0x4c, 0xc5, 0x82, 0x3b, 0x30, 0x17, 0xf6, 0xba, 0x98,
-	0x5b, 0x90, 0x1a, 0x93, 0x9e, 0x83, 0xed, 0x8b, 0xb0, 0xa4, 0x4a, 0x48, 0x42, 0x7d, 0xea, 0x0b,
-	0x38, 0xfb, 0x2a, 0x9c, 0xc0, 0x97, 0x63, 0x0e, 0xdc, 0x28, 0xec, 0xcb, 0xd4, 0x5e, 0x23, 0x93,
-	0x61, 0x10, 0xad, 0x2d, 0xea, 0x7c, 0xc9, 0xa2, 0x86, 0xe3, 0x6e, 0x96, 0x1d, 0x46, 0x58, 0x2f,
-	0x1d, 0xb1, 0x9e, 0x5c, 0x2d, 0x41, 0xba, 0xc2, 0xe9, 0x96, 0x41, 0x9d, 0x5d, 0x58, 0xdf, 0x54,
-	0xdb, 0x97, 0x78, 0x5a, 0x1b, 0x37, 0xfb, 0xbe, 0x47, 0x43, 0x97, 0x8e, 0x75, 0x51, 0x37, 0xef,
-	0x45, 0x7d, 0xb0, 0x17, 0x4e, 0xaf, 0xf4, 0x46, 0x59, 0x26, 0x37, 0xee, 0xd2, 0x43, 0x76, 0x27,
-	0x15, 0x6f, 0xca, 0x99, 0x2d, 0xd4, 0x7d, 0x2f, 0xbb, 0x06, 0x60, 0xbc, 0x99, 0x81, 0xd7, 0x00,
-	0xd6, 0x61, 0x26, 0x26, 0x47, 0x41, 0x44, 0x84, 0x1c, 0x50, 0x56, 0x88, 0x02, 0x3a, 0xaf, 0x95,
-	0x36, 0x9b, 0xc5, 0xef, 0x86, 0x37, 0x6b, 0xc3, 0x24, 0x49, 0x3a, 0x42, 0x06, 0x2e, 0xb4, 0xf0,
-	0x7f, 0xe7, 0xd7, 0xca, 0xdd, 0xfb, 0x2a, 0x2e, 0xdb, 0xea, 0x85, 0xad, 0x2c, 0x37, 0x2d, 0xb5,
-	0xdf, 0x04, 0xa9, 0xb4, 0x61, 0x1e, 0xc1, 0xc4, 0x71, 0xf2, 0x08, 0x06, 0xf3, 0x2b, 0x5b, 0x5a,
-	0x5d, 0x95, 0xd1, 0xea, 0xc9, 0x77, 0x16, 0xad, 0x76, 0xfe, 0xec, 0x0c, 0x3c, 0xb8, 0x79, 0xd3,
-	0x8d, 0xc2, 0x2d, 0x46, 0xbb, 0xdb, 0x09, 0xed, 0xfb, 0xf4, 0x3e, 0x47, 0x5c, 0x0f, 0x22, 0xf7,
-	0x60, 0xdc, 0xf5, 0xf7, 0x19, 0xed, 0x16, 0xd7, 0x5f, 0xc0, 0xf0, 0xae, 0x05, 0xdd, 0x13, 0x57,
-	0xce, 0x0c, 0xf7, 0x84, 0x82, 0xf2, 0xfd, 0x1b, 0x13, 0x3f, 0x64, 0x82, 0xc6, 0x10, 0xd8, 0x39,
-	0x1c, 0x77, 0x0e, 0x49, 0x7c, 0x76, 0x64, 0x08, 0x0c, 0x09, 0xe3, 0xec, 0xf0, 0x56, 0x8f, 0x04,
-	0x1c, 0xad, 0x4b, 0x09, 0x05, 0xe4, 0xe3, 0xc0, 0xba, 0xee, 0x53, 0x92, 0x18, 0x22, 0x22, 0x07,
-	0x67, 0x34, 0x29, 0xa5, 0x9e, 0x29, 0x21, 0x32, 0xb0, 0x7d, 0x0d, 0xec, 0x03, 0x3f, 0x08, 0x28,
-	0x61, 0x34, 0xc1, 0x54, 0x23, 0x64, 0x4f, 0x5d, 0x4a, 0x94, 0xe0, 0xb9, 0x5c, 0xc9, 0xa0, 0x83,
-	0xd9, 0x94, 0x05, 0x1c, 0x9f, 0x0f, 0xb7, 0x97, 0xb2, 0xa8, 0x8b, 0x0f, 0x2e, 0xcd, 0xeb, 0xf7,
-	0x22, 0x73, 0xb8, 0xfd, 0x41, 0xbe, 0xbb, 0x7d, 0xf7, 0x80, 0x26, 0x2a, 0xdb, 0xd9, 0x69, 0x54,
-	0xae, 0x63, 0x63, 0x47, 0x90, 0xb6, 0xb2, 0x32, 0xe2, 0xb9, 0x46, 0xe9, 0xea, 0x32, 0x5e, 0xf4,
-	0xc8, 0xc1, 0x7c, 0xce, 0xa3, 0xc4, 0xef, 0xf8, 0xa1, 0x11, 0xde, 0x93, 0x30, 0x31, 0xe7, 0x34,
-	0xe5, 0x9c, 0xb1, 0x6c, 0xce, 0x39, 0x02, 0xf9, 0x38, 0xbc, 0x24, 0x8a, 0xa5, 0xbc, 0xd3, 0x53,
-	0x1a, 0x35, 0x38, 0xa7, 0xea, 0xf6, 0x52, 0xdf, 0x15, 0xab, 0x7f, 0xc2, 0xb8, 0xdb, 0x9c, 0xc1,
-	0xd1, 0x66, 0x51, 0x1c, 0xa2, 0x87, 0xfa, 0x32, 0xe8, 0xda, 0x17, 0x6a, 0x30, 0x23, 0x47, 0xc9,
-	0xc5, 0x46, 0x1a, 0x44, 0xe6, 0x93, 0x32, 0x08, 0xe1, 0xc7, 0xbe, 0x9c, 0x81, 0x01, 0x9d, 0x5f,
-	0xc2, 0xe5, 0x43, 0x4b, 0x9c, 0x4f, 0xf4, 0xbb, 0x43, 0x08, 0x11, 0xaf, 0x35, 0x90, 0xc0, 0xbc,
-	0x35, 0x24, 0x40, 0xbc, 0x8b, 0x49, 0xc4, 0xf2, 0x24, 0xc6, 0xec, 0x52, 0x91, 0x82, 0xa2, 0x8b,
-	0xc5, 0x17, 0xea, 0x9f, 0xe9, 0x2e, 0xf3, 0xb9, 0xee, 0xe7, 0x04, 0x70, 0x75, 0x98, 0x80, 0xc9,
-	0xa5, 0x8b, 0x1e, 0x5a, 0xb0, 0xdf, 0x0b, 0xb3, 0xb8, 0xd3, 0xf2, 0x14, 0xe4, 0xb5, 0x6a, 0x3e,
-	0x68, 0x65, 0xb4, 0xce, 0xab, 0xb0, 0xc2, 0x5b, 0xc3, 0x1c, 0x5c, 0xf7, 0x20, 0x8c, 0xee, 0x07,
-	0xd4, 0xeb, 0x50, 0xcf, 0xa8, 0xaf, 0x76, 0x8c, 0xfa, 0x7e, 0xb2, 0x56, 0x9a, 0x3f, 0x9e, 0x49,
-	0x5c, 0x5e, 0xc5, 0x40, 0x71, 0xa1, 0x81, 0xf2, 0x89, 0x89, 0x49, 0x42, 0xba, 0xed, 0xd4, 0x3c,
-	0xc8, 0x10, 0xb8, 0x93, 0xa3, 0x89, 0x29, 0x61, 0x10, 0xb8, 0x91, 0xa3, 0x4d, 0xeb, 0x5b, 0xa0,
-	0x6f, 0xe4, 0xe8, 0xae, 0xe1, 0xe8, 0x13, 0xe8, 0x3b, 0x4e, 0x02, 0xef, 0x3b, 0x7e, 0xb7, 0xa5,
-	0x3f, 0xf7, 0x9d, 0xce, 0xd5, 0x0f, 0xd6, 0xe0, 0x85, 0x92, 0x46, 0x0b, 0xd7, 0x7d, 0xaa, 0x56,
-	0x5c, 0xf7, 0x2b, 0xd5, 0xca, 0xfc, 0x4a, 0xba, 0xc8, 0xad, 0x97, 0x8a, 0xdc, 0xf5, 0xcc, 0x16,
-	0xf1, 0x3d, 0x61, 0x9f, 0xe5, 0xa6, 0x9c, 0xef, 0xa5, 0xce, 0x73, 0xd5, 0xa1, 0xb3, 0xdb, 0x7e,
-	0xaa, 0x4c, 0x2a, 0x99, 0x15, 0x70, 0xdb, 0xef, 0xd3, 0x26, 0xe9, 0xd2, 0xd4, 0x79, 0xb3, 0x9a,
-	0x83, 0xb5, 0x62, 0x8a, 0x5e, 0x46, 0xc5, 0xc6, 0x39, 0x63, 0xc6, 0xac, 0xb9, 0x45, 0x5d, 0x1a,
-	0x32, 0x5e, 0x29, 0xf6, 0x67, 0xac, 0x9a, 0xef, 0x8e, 0x55, 0x73, 0xbe, 0x34, 0xa2, 0xe6, 0x75,
-	0x98, 0x51, 0xcf, 0xfd, 0x0d, 0xde, 0xf8, 0xf3, 0x3d, 0xe7, 0xbb, 0x6b, 0xd5, 0x77, 0xbb, 0xb5,
-	0x6a, 0x6f, 0xf5, 0x82, 0x80, 0x57, 0x88, 0xee, 0x5e, 0xcd, 0x23, 0x5d, 0x2b, 0xf3, 0x48, 0x3b,
-	0x30, 0x17, 0xf5, 0x98, 0x1b, 0x75, 0x8b, 0xaa, 0x56, 0x0e, 0xce, 0x1f, 0x31, 0x98, 0x18, 0x7c,
-	0xa0, 0xe7, 0x77, 0xea, 0xf0, 0x70, 0xd9, 0x2d, 0xb3, 0xdc, 0xe1, 0x3c, 0x46, 0x0f, 0xf0, 0x5f,
-	0xf4, 0x12, 0x9b, 0x8e, 0x1e, 0x05, 0xb6, 0x37, 0xc1, 0xce, 0x6c, 0x7a, 0x51, 0x2e, 0xd7, 0x80,
-	0x4a, 0x5d, 0x00, 0x25, 0xe4, 0xf6, 0x37, 0xc1, 0x09, 0xa1, 0x64, 0x33, 0xc2, 0xd2, 0xb6, 0x48,
-	0xc7, 0x97, 0x1a, 0xce, 0xb1, 0xd3, 0x1a, 0xad, 0xbc, 0xa6, 0xdb, 0x58, 0x91, 0x7d, 0x0f, 0x16,
-	0x73, 0x18, 0x09, 0x02, 0x79, 0x01, 0xeb, 0xd8, 0x35, 0x9b, 0xb5, 0x38, 0x1f, 0xaf, 0xc1, 0x53,
-	0xc3, 0x6f, 0xf1, 0x35, 0x93, 0xa8, 0x17, 0x8f, 0xf3, 0xa6, 0xad, 0xf1, 0x2a, 0xd0, 0x94, 0xf9,
-	0x2a, 0xd0, 0x93, 0x30, 0xef, 0x46, 0x49, 0x42, 0x5d, 0x16, 0xfb, 0x32, 0xca, 0x3a, 0x9b, 0x3d,
-	0xac, 0x97, 0x23, 0x9c, 0xff, 0x39, 0x01, 0x8f, 0x8d, 0xd1, 0x1d, 0xbe, 0xe8, 0xe8, 0xf3, 0x2e,
-	0x6c, 0x11, 0x05, 0xcc, 0x5e, 0x7e, 0xac, 0x0f, 0xbc, 0xfc, 0xb8, 0x0a, 0x93, 0xf8, 0x96, 0xaf,
-	0x9e, 0x70, 0x8e, 0x10, 0xfb, 0x32, 0x9c, 0xe0, 0x7d, 0x30, 0x9d, 0x23, 0x46, 0x70, 0x0e, 0xd1,
-	0x9a, 0x77, 0xe4, 0x83, 0x30, 0x25, 0xb2, 0xa0, 0xc4, 0x5a, 0x9c, 0x6b, 0x8c, 0x39, 0x93, 0x2d,
-	0x51, 0xcc, 0x3e, 0x03, 0x73, 0xea, 0x72, 0xad, 0xca, 0x59, 0x9f, 0x4d, 0xc5, 0x95, 0x5a, 0xb4,
-	0xd4, 0x78, 0x7b, 0x5c, 0x0a, 0xf3, 0x4d, 0x1f, 0x20, 0xff, 0xce, 0x18, 0xcf, 0x83, 0x16, 0xd1,
-	0xf6, 0x05, 0x58, 0xe4, 0x40, 0xce, 0x97, 0xa2, 0x63, 0xb3, 0x46, 0x3e, 0x88, 0x8e, 0xb2, 0x2f,
-	0xc2, 0xb2, 0xf4, 0xcd, 0xc5, 0x18, 0xfb, 0x71, 0x0f, 0xcc, 0x94, 0x4f, 0x81, 0xdc, 0xa6, 0xc9,
-	0xb6, 0xef, 0x1e, 0xd8, 0x1f, 0x86, 0x29, 0x1c, 0xbd, 0xbc, 0xd0, 0x7a, 0x61, 0x9c, 0xa1, 0x36,
-	0x78, 0xc9, 0xb4, 0x25, 0x0a, 0xae, 0x3d, 0x0a, 0x53, 0xf8, 0xdb, 0x5e, 0x85, 0x19, 0x0e, 0x51,
-	0xf7, 0x1d, 0xa7, 0x5a, 0xea, 0xa7, 0xf3, 0xf9, 0x1a, 0x3c, 0x31, 0xbc, 0x5e, 0xf9, 0x3a, 0x2e,
-	0x9a, 0xd8, 0xd4, 0xd5, 0xb2, 0x48, 0x73, 0x8d, 0x48, 0x81, 0xdf, 0x11, 0x0f, 0x7c, 0x00, 0xa6,
-	0x91, 0x85, 0xd4, 0xa3, 0x10, 0x8f, 0x8f, 0x33, 0xce, 0x96, 0x2c, 0xe3, 0x7c, 0xb5, 0x06, 0xce,
-	0x70, 0x7a, 0x94, 0x58, 0xd7, 0x61, 0x56, 0xf6, 0x52, 0xe5, 0x84, 0x3c, 0xd9, 0x18, 0x6b, 0xd8,
-	0xad, 0xac, 0x5c, 0xe9, 0xe5, 0xc3, 0xfa, 0x1f, 0xe5, 0xf2, 0xe1, 0xc4, 0x78, 0x97, 0x0f, 0x9d,
-	0x9f, 0xab, 0xab, 0xe7, 0x85, 0xca, 0x0f, 0x09, 0xfb, 0x1c, 0x2c, 0x74, 0xd3, 0x8e, 0xf4, 0xd3,
-	0x15, 0x56, 0xc7, 0xc0, 0x98, 0x27, 0x5d, 0xbd, 0xdc, 0x4e, 0x7b, 0x5c, 0xd9, 0xa4, 0xc8, 0xfe,
-	0x13, 0x83, 0x01, 0x06, 0xe4, 0xfb, 0xf7, 0xc9, 0x33, 0x80, 0xaa, 0x75, 0x5b, 0x6f, 0x0c, 0x3d,
-	0x34, 0x5a, 0x8a, 0xdc, 0x3e, 0x0f, 0x33, 0xf2, 0x25, 0x5d, 0xb9, 0x89, 0x07, 0x66, 0x40, 0xe1,
-	0xed, 0x57, 0xf4, 0x7b, 0x5b, 0x9a, 0xea, 0xfa, 0x58, 0x63, 0xf4, 0x9a, 0xb7, 0x0a, 0x45, 0xf9,
-	0xb9, 0xb6, 0x5e, 0xe6, 0x36, 0xc9, 0x2f, 0x5c, 0x0f, 0xdc, 0xd0, 0x5f, 0x2c, 0xde, 0xd0, 0xef,
-	0xc0, 0x29, 0x64, 0x3c, 0xe9, 0xb7, 0x46, 0xd9, 0x2c, 0x36, 0x69, 0xbd, 0x3a, 0x39, 0x4f, 0x6b,
-	0xa1, 0x81, 0xdc, 0x8b, 0x93, 0xc2, 0x47, 0xca, 0x77, 0x67, 0xeb, 0x64, 0x67, 0x00, 0x96, 0xae,
-	0xfd, 0x58, 0x0d, 0xec, 0x41, 0xda, 0xc1, 0x4d, 0x38, 0x35, 0xb8, 0x09, 0x35, 0x41, 0xad, 0x1f,
-	0x0b, 0x99, 0xa0, 0x5e, 0x83, 0xa9, 0xdc, 0xcc, 0xce, 0x0e, 0x8d, 0xcc, 0x7a, 0x96, 0x17, 0x53,
-	0x27, 0x8b, 0xc7, 0x8d, 0x61, 0xc1, 0x4f, 0x0d, 0x5a, 0xf0, 0xce, 0x2f, 0xd7, 0x61, 0xad, 0x64,
-	0xf4, 0xb7, 0x48, 0xc8, 0x48, 0x7a, 0x34, 0x7a, 0x6e, 0x5f, 0x52, 0xa2, 0x5d, 0x4c, 0xe5, 0x53,
-	0x8d, 0xea, 0xca, 0x1a, 0xf2, 0xaf, 0x26, 0xd9, 0xd7, 0xda, 0x30, 0x2f, 0xa1, 0x3b, 0xdc, 0x82,
-	0xab, 0x7e, 0x19, 0x02, 0x3f, 0x0f, 0x20, 0xdf, 0xc8, 0xd7, 0x3e, 0x58, 0xe0, 0x1e, 0x68, 0xe3,
-	0x9b, 0x18, 0x1c, 0xdf, 0x5a, 0x9c, 0x35, 0x80, 0x47, 0xf3, 0x38, 0x4b, 0xf1, 0x12, 0x4c, 0x89,
-	0xc7, 0x2a, 0xc7, 0x1f, 0x12, 0xef, 0x7c, 0x4b, 0x94, 0x72, 0x9e, 0x81, 0x13, 0x9b, 0x1b, 0x8c,
-	0x25, 0xfe, 0x6e, 0x8f, 0xd1, 0x36, 0x2f, 0x14, 0x76, 0xf8, 0xf2, 0x09, 0xdf, 0x80, 0x9e, 0x6f,
-	0x26, 0x40, 0xce, 0x1a, 0xac, 0x8a, 0x16, 0xee, 0x46, 0xcd, 0xcd, 0x16, 0x0d, 0x22, 0xa2, 0xde,
-	0xbe, 0x4e, 0x9d, 0xdf, 0xae, 0x0b, 0x4b, 0x2e, 0x6b, 0xfc, 0x0d, 0x1a, 0x70, 0x6d, 0x10, 0x1f,
-	0xfc, 0x66, 0x51, 0x42, 0xc5, 0x7d, 0xcc, 0x7d, 0x92, 0xee, 0x17, 0x72, 0xdf, 0x39, 0x92, 0x5b,
-	0x2a, 0x2f, 0x93, 0x74, 0xdf, 0xfe, 0x20, 0x9c, 0xe6, 0xfb, 0x5f, 0xbc, 0x35, 0xe7, 0x72, 0xf3,
-	0xc6, 0xed, 0x31, 0xbf, 0x4f, 0x03, 0xd3, 0xa3, 0x52, 0x45, 0x84, 0x09, 0x02, 0x7e, 0x97, 0xb6,
-	0xf7, 0xfc, 0x24, 0x65, 0xea, 0xc9, 0x3a, 0x30, 0x7c, 0xbb, 0x7e, 0x97, 0xde, 0xe2, 0x58, 0xf9,
-	0x6e, 0x5d, 0x03, 0xac, 0x80, 0xa4, 0xf2, 0xd9, 0x79, 0x59, 0xc0, 0xb8, 0xec, 0xc9, 0xb1, 0x77,
-	0xf3, 0x77, 0xee, 0x2e, 0xc2, 0x32, 0xd2, 0xfb, 0x71, 0x76, 0x7d, 0x4f, 0xf7, 0x5b, 0x2c, 0x72,
-	0x64, 0x76, 0x27, 0x9d, 0x4b, 0xbb, 0x4e, 0xea, 0x46, 0xd1, 0x81, 0xcf, 0xd5, 0x65, 0x5b, 0x7f,
-	0x35, 0x36, 0x87, 0x73, 0x53, 0xa9, 0x17, 0xfa, 0x6f, 0xf5, 0x38, 0xcd, 0x49, 0x3d, 0x74, 0xab,
-	0xa0, 0xce, 0xd7, 0xcb, 0xb3, 0x24, 0x85, 0x81, 0xf8, 0x3a, 0x11, 0x97, 0x5f, 0x75, 0xfb, 0x4c,
-	0xbd, 0xf7, 0x8c, 0x2b, 0xd7, 0x1e, 0x78, 0x34, 0x7b, 0x0e, 0xe1, 0xf8, 0x8e, 0xfd, 0xa3, 0x20,
-	0x7e, 0xb4, 0xfd, 0xb0, 0xf0, 0xd6, 0x04, 0x82, 0xb7, 0x42, 0xa6, 0x45, 0x85, 0xf9, 0x90, 0xcb,
-	0x84, 0x36, 0x1f, 0x6f, 0x31, 0x78, 0x3c, 0x59, 0x11, 0x3c, 0x7e, 0x0c, 0x16, 0xdd, 0xfd, 0xe8,
-	0x80, 0x7a, 0xed, 0x5d, 0xae, 0xec, 0x08, 0x39, 0x3d, 0xd7, 0x5a, 0x10, 0x40, 0xb4, 0x52, 0x53,
-	0x6e, 0xba, 0x5c, 0xa9, 0xcc, 0x6a, 0xac, 0x1c, 0xf0, 0x38, 0x8e, 0xc2, 0x75, 0x00, 0x6e, 0x11,
-	0x93, 0xb0, 0xa3, 0x02, 0x4c, 0x8b, 0x2d, 0x0d, 0x92, 0x6d, 0xed, 0x89, 0xa2, 0xb7, 0xd7, 0xb9,
-	0x98, 0x05, 0xa4, 0x0d, 0x27, 0xb2, 0xbf, 0xc7, 0x75, 0xfa, 0xec, 0x59, 0x3b, 0x69, 0x54, 0x39,
-	0x9f, 0x9a, 0x28, 0x4d, 0xcf, 0x1d, 0x24, 0x97, 0x66, 0xbc, 0x79, 0x30, 0xd6, 0x2a, 0x0e, 0xc6,
-	0x6b, 0x70, 0x52, 0x70, 0x2a, 0x4d, 0xfc, 0xc8, 0xcb, 0x92, 0x8a, 0xf5, 0xc3, 0x16, 0x77, 0xc0,
-	0x36, 0xe2, 0x55, 0x5e, 0x31, 0xdf, 0x16, 0x11, 0x23, 0x41, 0xbb, 0xc3, 0x5b, 0x6f, 0x77, 0xfc,
-	0x7e, 0xc1, 0x38, 0x5b, 0x46, 0x34, 0xf6, 0xad, 0xc9, 0x91, 0xf6, 0x53, 0xb0, 0xa0, 0x4a, 0xf4,
-	0x69, 0x62, 0x5e, 0xb8, 0x98, 0x97, 0xc4, 0x1c, 0x61, 0xff, 0xb1, 0xfc, 0x81, 0x4c, 0x71, 0xde,
-	0x6e, 0x34, 0x8e, 0x31, 0xea, 0x06, 0x47, 0x68, 0x70, 0xf3, 0x19, 0xcd, 0xb5, 0xd7, 0x61, 0xa5,
-	0x8c, 0x60, 0xac, 0x75, 0x5e, 0x83, 0x29, 0x1c, 0xad, 0xf9, 0x5a, 0x1e, 0x82, 0x9c, 0xfd, 0x52,
-	0x2f, 0xba, 0xd8, 0x4d, 0x3b, 0xbd, 0xdd, 0xae, 0xcf, 0x76, 0xf2, 0xcb, 0x03, 0x8f, 0xc2, 0x5c,
-	0xfe, 0x3a, 0xbe, 0x99, 0x00, 0xa5, 0xde, 0xc5, 0x5f, 0x85, 0x49, 0xbc, 0x7f, 0x60, 0x44, 0x08,
-	0x38, 0xc4, 0xd9, 0x2e, 0xe5, 0x99, 0x2c, 0x31, 0x5b, 0x6d, 0xe0, 0xc0, 0xc7, 0xb8, 0xe3, 0x38,
-	0xae, 0x82, 0xbf, 0x56, 0x2f, 0x75, 0x71, 0x65, 0x55, 0x6e, 0xf7, 0x12, 0xaa, 0xb6, 0x09, 0x56,
-	0xeb, 0x47, 0xe1, 0x2d, 0xe2, 0x07, 0xbd, 0x64, 0xbc, 0x40, 0x0a, 0x3f, 0xc0, 0x08, 0xdb, 0x37,
-	0xb5, 0x6c, 0x0e, 0xe1, 0x98, 0x3d, 0x3f, 0x30, 0xbf, 0xf8, 0x81, 0x10, 0xfb, 0x14, 0x4c, 0xb8,
-	0x89, 0x6b, 0x30, 0x09, 0x07, 0xf0, 0x12, 0x99, 0xc4, 0xcf, 0x0e, 0x43, 0x0e, 0xe1, 0x25, 0x02,
-	0xf9, 0xa4, 0x9a, 0x42, 0x70, 0x00, 0x7e, 0xa0, 0x85, 0xb8, 0x07, 0xed, 0xb0, 0xd7, 0xdd, 0xa5,
-	0x89, 0xf1, 0xc8, 0x0f, 0x70, 0xc4, 0xab, 0x08, 0xb7, 0x9f, 0x84, 0x05, 0x24, 0xe3, 0xad, 0xab,
-	0xa4, 0x48, 0x83, 0xee, 0x96, 0x1f, 0xd0, 0x2d, 0x7c, 0x9c, 0xfc, 0xc2, 0xd0, 0xc0, 0x8f, 0xa9,
-	0xc8, 0x17, 0x1c, 0x2a, 0x8b, 0x05, 0x87, 0x0a, 0x26, 0x3c, 0x73, 0x73, 0xae, 0xa0, 0xde, 0x2a,
-	0xa0, 0x99, 0xfe, 0x35, 0x51, 0x9a, 0xfe, 0xb5, 0x2a, 0x6c, 0x6e, 0xdf, 0x13, 0x9a, 0xed, 0x62,
-	0x4b, 0xfd, 0x74, 0x3e, 0x5a, 0x83, 0xc5, 0xcd, 0x9d, 0xd7, 0x6e, 0xba, 0x51, 0xb8, 0x19, 0xf5,
-	0x62, 0x34, 0x8a, 0x70, 0x27, 0x1c, 0x65, 0xdd, 0x99, 0xfd, 0xe8, 0x67, 0x1f, 0xae, 0xc9, 0x2e,
-	0x09, 0x04, 0xd7, 0x2a, 0x4a, 0xde, 0x14, 0x96, 0x30, 0xfb, 0x12, 0x2c, 0xd3, 0xc3, 0xd8, 0x97,
-	0xa9, 0x1b, 0x9c, 0xc7, 0xb0, 0x5b, 0x33, 0xd9, 0x37, 0x4d, 0x32, 0xe4, 0x0d, 0xc2, 0xa8, 0xf3,
-	0x89, 0x1a, 0x58, 0x9b, 0x3b, 0xaf, 0x61, 0x5c, 0x46, 0x65, 0xa9, 0xf0, 0x5e, 0x98, 0xaa, 0xbf,
-	0xd6, 0x0b, 0xa5, 0xf9, 0x3f, 0x03, 0x96, 0xb4, 0x3d, 0x13, 0xda, 0x25, 0x7e, 0x58, 0x7c, 0x6c,
-	0x5f, 0x5a, 0xa6, 0x2d, 0x85, 0xe4, 0xf2, 0x45, 0x7f, 0x0f, 0xd5, 0x4c, 0xd3, 0xde, 0xcd, 0xdf,
-	0x40, 0xc5, 0xe7, 0xe5, 0x37, 0x77, 0x5e, 0xdb, 0xa6, 0x49, 0x1a, 0x85, 0x84, 0xeb, 0xe5, 0xf2,
-	0xdb, 0x2e, 0xc5, 0x5b, 0x3a, 0xb5, 0xaa, 0x5b, 0x3a, 0xc5, 0x54, 0xe5, 0xfa, 0x71, 0x52, 0x95,
-	0x9f, 0x86, 0x25, 0x1a, 0xd0, 0x3e, 0x61, 0x14, 0xdf, 0x9d, 0x92, 0xf3, 0x37, 0x9b, 0xbd, 0x78,
-	0x23, 0x71, 0x3b, 0x1c, 0xe5, 0xbc, 0x1f, 0xce, 0xc8, 0xc0, 0x97, 0xb8, 0xd0, 0xd6, 0xe4, 0xbc,
-	0xb0, 0xd3, 0xdb, 0x4d, 0xdd, 0xc4, 0xdf, 0xc5, 0xac, 0x02, 0xf1, 0xa4, 0xa4, 0xe9, 0x5f, 0x16,
-	0x30, 0x67, 0x03, 0x1e, 0x1e, 0x2c, 0x7c, 0x2f, 0x4c, 0xb3, 0xe2, 0x67, 0x61, 0x96, 0x4b, 0xf6,
-	0x80, 0xee, 0x99, 0x8f, 0x21, 0x65, 0x50, 0xe7, 0x4f, 0xd6, 0xe0, 0xd4, 0x60, 0x1d, 0x7c, 0x9c,
-	0xc3, 0xdb, 0x46, 0x35, 0x85, 0x74, 0x29, 0xaf, 0xa8, 0x6b, 0x0a, 0x4c, 0x0d, 0x8e, 0x41, 0x92,
-	0x34, 0xa6, 0x09, 0x2e, 0x59, 0xe1, 0x01, 0xd8, 0x0c, 0xee, 0x7c, 0xa4, 0x34, 0x7b, 0x5b, 0xf3,
-	0xee, 0xab, 0x0b, 0x08, 0xe7, 0x60, 0x81, 0x68, 0x3e, 0x7f, 0x63, 0x4c, 0x06, 0xc6, 0xf9, 0x7c,
-	0xb9, 0x1f, 0x33, 0x73, 0x8f, 0xab, 0xe4, 0x9a, 0x84, 0x33, 0x63, 0x87, 0x6e, 0x46, 0x7e, 0x68,
-	0xb8, 0x9f, 0x6b, 0xa5, 0xee, 0x67, 0x07, 0xe6, 0x64, 0x9e, 0x53, 0xd1, 0x93, 0x99, 0x81, 0xf9,
-	0x21, 0xb2, 0x1f, 0xf5, 0x0a, 0x29, 0xab, 0x02, 0xc4, 0xfb, 0x1f, 0xcb, 0x16, 0x07, 0x12, 0x56,
-	0x0d, 0x8c, 0xf3, 0xcd, 0x70, 0x6e, 0x58, 0xf7, 0xc5, 0x67, 0x5d, 0xee, 0x85, 0x18, 0xeb, 0x1c,
-	0xbe, 0x50, 0x2b, 0x50, 0x8f, 0x52, 0x43, 0x4b, 0xab, 0x47, 0xa9, 0xb3, 0x55, 0xaa, 0x69, 0x28,
-	0x09, 0x97, 0xcd, 0xcf, 0x4d, 0xc1, 0xac, 0xf2, 0x1d, 0xd9, 0x8e, 0xa9, 0x64, 0x08, 0x90, 0xf3,
-	0xcd, 0xa5, 0xe9, 0x3c, 0x79, 0x55, 0x9b, 0xfb, 0x84, 0x8d, 0xf6, 0xfd, 0x73, 0x1d, 0x8a, 0x16,
-	0x1e, 0xe1, 0x42, 0x88, 0xb3, 0x5b, 0x5a, 0x3f, 0x37, 0x29, 0xe4, 0x5d, 0x05, 0x5e, 0xff, 0x58,
-	0x57, 0x14, 0xaa, 0xdb, 0xf8, 0x13, 0xf0, 0x78, 0xe5, 0x18, 0x36, 0x7a, 0x6c, 0xff, 0x15, 0x7a,
-	0xb4, 0x19, 0x79, 0x74, 0xa4, 0xb4, 0x5f, 0x85, 0x49, 0x57, 0x7d, 0x2b, 0x23, 0x6b, 0x81, 0x43,
-	0x9c, 0xdb, 0x83, 0xb6, 0x7e, 0x33, 0x62, 0xfd, 0x9d, 0xa3, 0xd0, 0x95, 0x1f, 0x80, 0xb9, 0x00,
-	0x93, 0x32, 0x0b, 0x90, 0x0b, 0x9b, 0x53, 0x8d, 0xcd, 0x9b, 0xf8, 0xc9, 0x3b, 0x93, 0xaa, 0x85,
-	0x34, 0xce, 0xaf, 0x4e, 0xc0, 0x99, 0x6d, 0xf9, 0xf1, 0x31, 0x97, 0x04, 0x37, 0xfc, 0x8e, 0xcf,
-	0x48, 0xb0, 0xe3, 0x77, 0x42, 0xfc, 0xf6, 0x07, 0xda, 0x82, 0xea, 0x07, 0x56, 0xb8, 0x90, 0xd9,
-	0x82, 0x3a, 0xcd, 0x48, 0xd7, 0xcb, 0x1a, 0x4c, 0x0d, 0x7a, 0x5d, 0x04, 0xc8, 0x3e, 0x05, 0xd3,
-	0x34, 0xf4, 0xe2, 0x48, 0x9c, 0x4a, 0xf5, 0x96, 0xfc, 0x65, 0xaf, 0x61, 0xfa, 0x46, 0xc2, 0x38,
-	0x66, 0x0a, 0x31, 0xd9, 0x6f, 0x7b, 0x05, 0xa6, 0x12, 0xbf, 0xb3, 0xcf, 0xd0, 0xd3, 0x59, 0x6f,
-	0x89, 0x1f, 0xf8, 0x15, 0xb3, 0xc3, 0xfc, 0x2b, 0x28, 0xda, 0xce, 0x63, 0x87, 0x32, 0x9f, 0x50,
-	0x8f, 0xa3, 0xce, 0x96, 0xc5, 0x51, 0xf9, 0x2e, 0xd8, 0xf7, 0xd9, 0x6e, 0x64, 0xbe, 0x16, 0x24,
-	0x61, 0x7c, 0xe7, 0xb9, 0x09, 0x45, 0x01, 0x8d, 0xe3, 0x01, 0x2d, 0xd0, 0x69, 0x60, 0xf8, 0xb4,
-	0xd0, 0xb7, 0x7a, 0x7e, 0x8c, 0x81, 0x58, 0x3d, 0xd9, 0x25, 0x07, 0xe3, 0x37, 0x3b, 0x12, 0xe2,
-	0xd2, 0x76, 0xe1, 0xab, 0x49, 0x33, 0x08, 0xdd, 0xf2, 0xf8, 0xdc, 0x88, 0xe7, 0xbf, 0xf1, 0x72,
-	0x6a, 0xbd, 0x25, 0x7f, 0xe9, 0x91, 0xd4, 0xa5, 0x92, 0x48, 0xea, 0xb7, 0x0e, 0xd9, 0xf5, 0xda,
-	0x72, 0xf3, 0x75, 0xb6, 0x2f, 0x1b, 0xec, 0xf2, 0x50, 0x63, 0x08, 0x3b, 0x08, 0xa6, 0x19, 0x9e,
-	0xef, 0xe0, 0x7c, 0x67, 0x6d, 0x48, 0xe3, 0xb7, 0xa3, 0x0e, 0x57, 0x09, 0x19, 0x09, 0x6e, 0xe2,
-	0x5b, 0xb0, 0x7c, 0x92, 0xf8, 0x3f, 0xc8, 0xec, 0xc6, 0x6e, 0xcb, 0xc0, 0x18, 0x94, 0x11, 0xdf,
-	0x6a, 0x34, 0xd3, 0xb2, 0x24, 0x10, 0xd3, 0xb6, 0x38, 0x9b, 0x25, 0x47, 0x86, 0x7a, 0xa8, 0x80,
-	0x4e, 0x5c, 0xfa, 0x58, 0xb1, 0x9c, 0x8c, 0x28, 0xc0, 0x9b, 0xde, 0x78, 0x42, 0xc6, 0x51, 0x10,
-	0x14, 0x36, 0xa4, 0x84, 0x71, 0x7e, 0xe3, 0xba, 0x9a, 0x30, 0xda, 0xe6, 0x5a, 0xe2, 0x87, 0xf6,
-	0x89, 0xa6, 0x09, 0xe3, 0x13, 0x4d, 0xdf, 0x53, 0x2f, 0xcf, 0x4c, 0xc4, 0xe7, 0xe5, 0x5a, 0xb4,
-	0xe3, 0xa7, 0x8c, 0x26, 0x15, 0xa9, 0x37, 0xa7, 0x60, 0xa2, 0x4f, 0x93, 0xc2, 0x37, 0x04, 0x68,
-	0xc2, 0xe1, 0x24, 0x36, 0x0d, 0x60, 0x0e, 0x40, 0x78, 0x62, 0xde, 0x20, 0xe3, 0x00, 0x0e, 0x0f,
-	0x77, 0xcd, 0x5c, 0x11, 0x0e, 0xe0, 0xf0, 0x4e, 0x12, 0x1b, 0x2e, 0x0d, 0x0e, 0x40, 0xc9, 0x8c,
-	0x1e, 0x9d, 0x19, 0x43, 0x32, 0xe3, 0x77, 0x47, 0xce, 0xc2, 0x6c, 0x76, 0x07, 0xc4, 0xb8, 0x59,
-	0xa0, 0xa0, 0xa6, 0xf6, 0x39, 0x57, 0xa6, 0x7d, 0x3a, 0x3f, 0x5b, 0x83, 0x47, 0x2a, 0x27, 0x45,
-	0x7c, 0x48, 0x4d, 0x0d, 0xbe, 0x56, 0x31, 0xf8, 0x7a, 0xc5, 0xe0, 0x27, 0x8a, 0x83, 0xb7, 0x61,
-	0xb2, 0x93, 0xc4, 0x4a, 0xcd, 0xc5, 0xff, 0x8d, 0x41, 0x4c, 0x8d, 0x1e, 0xc4, 0x74, 0xe9, 0x20,
-	0xbe, 0xaf, 0x5e, 0x7a, 0xd9, 0x4d, 0x1f, 0x84, 0xb8, 0x19, 0x94, 0xda, 0xcd, 0xdc, 0x3a, 0xad,
-	0x55, 0xa7, 0x92, 0x96, 0x94, 0x2c, 0x7c, 0xd0, 0x61, 0xed, 0x33, 0x35, 0xf5, 0x70, 0x67, 0x25,
-	0xc7, 0xf0, 0x15, 0xad, 0x17, 0x57, 0x74, 0x0c, 0x8b, 0x40, 0xce, 0xeb, 0x64, 0xc5, 0xbc, 0x4e,
-	0x95, 0x30, 0x55, 0x10, 0xb9, 0x26, 0xf3, 0x04, 0x91, 0xeb, 0xd0, 0x21, 0x4b, 0xbb, 0x15, 0xf6,
-	0xfd, 0xf1, 0xec, 0x4b, 0xbe, 0x93, 0x83, 0x68, 0x77, 0xf7, 0xa8, 0x68, 0xe3, 0x48, 0xa0, 0xf3,
-	0xf1, 0xfa, 0xb0, 0x1b, 0xe6, 0x6d, 0x15, 0x95, 0x8f, 0xb8, 0x08, 0x4b, 0xed, 0x57, 0xf2, 0x0f,
-	0x56, 0x8a, 0x05, 0xb8, 0xd2, 0x18, 0xbb, 0x70, 0xa3, 0xf0, 0x41, 0xcb, 0x82, 0x8b, 0xa4, 0x5e,
-	0xee, 0x22, 0x59, 0x0b, 0x60, 0x5a, 0x5e, 0x75, 0x1c, 0x67, 0xb8, 0x67, 0x61, 0x36, 0x61, 0x6e,
-	0xc4, 0x9b, 0x30, 0xd3, 0x14, 0x14, 0x14, 0xf3, 0x8f, 0xc4, 0xc7, 0x16, 0x75, 0xd5, 0x5f, 0xc2,
-	0xf8, 0x74, 0x5c, 0x18, 0x47, 0xf9, 0xba, 0x2b, 0x14, 0xb8, 0x6b, 0x70, 0x92, 0xf4, 0xd8, 0x7e,
-	0x94, 0xf8, 0xdf, 0x8a, 0x16, 0x85, 0x16, 0x7e, 0x9d, 0x56, 0xfe, 0x9b, 0x9c, 0x60, 0x47, 0x46,
-	0x62, 0xdf, 0x0b, 0x2b, 0x5a, 0x29, 0xf1, 0xd5, 0x4b, 0xf5, 0x50, 0x9a, 0xb2, 0xe5, 0xec, 0x9c,
-	0x42, 0x58, 0x48, 0x5b, 0xe2, 0xed, 0xc1, 0xec, 0x01, 0xf6, 0xbc, 0xb5, 0x09, 0xbd, 0xb5, 0x9c,
-	0x40, 0xb5, 0xf6, 0x22, 0x9c, 0xd2, 0x4b, 0x79, 0x49, 0xf6, 0xc2, 0x9c, 0xfe, 0x15, 0xd6, 0x15,
-	0xad, 0xa0, 0x97, 0xc8, 0x17, 0xe6, 0x9c, 0xd7, 0x95, 0xd0, 0x55, 0x0a, 0x1d, 0x56, 0x7a, 0x43,
-	0xbe, 0x43, 0x27, 0x27, 0x41, 0x7c, 0x66, 0xd2, 0x27, 0x01, 0x0e, 0x47, 0xaa, 0xbb, 0x96, 0xa6,
-	0xd8, 0x58, 0x39, 0x5a, 0x14, 0x71, 0xce, 0x0d, 0xb9, 0xdf, 0x2a, 0x67, 0xf8, 0xb5, 0xbd, 0x3d,
-	0x9a, 0xa4, 0xce, 0xb7, 0x0d, 0x3b, 0xfa, 0x24, 0xe9, 0x4e, 0xd4, 0xeb, 0xd3, 0xd0, 0x4f, 0xb4,
-	0x53, 0xb4, 0x56, 0x92, 0x35, 0xa8, 0x65, 0x22, 0xd4, 0xcb, 0x32, 0x11, 0x34, 0x05, 0x72, 0xa2,
-	0x2c, 0xff, 0xa2, 0x3d, 0xe4, 0x52, 0xa6, 0x64, 0xf9, 0xeb, 0x24, 0x20, 0xa1, 0x8b, 0x27, 0x1f,
-	0xe9, 0xe2, 0x73, 0x55, 0x46, 0x47, 0x04, 0x8c, 0x6f, 0xf9, 0x62, 0x42, 0x34, 0x07, 0x38, 0xdd,
-	0x21, 0x0d, 0xe0, 0xc6, 0xff, 0x86, 0xc8, 0x0f, 0xf1, 0xd5, 0xcf, 0x77, 0xb2, 0xf5, 0x27, 0x8b,
-	0x5b, 0xff, 0xa7, 0x6b, 0x43, 0x6e, 0xe6, 0x62, 0x7b, 0x6f, 0x90, 0x04, 0xcd, 0xfb, 0x5b, 0x45,
-	0xb9, 0x7b, 0xb1, 0x31, 0x56, 0xc1, 0xa2, 0xd8, 0xbd, 0xa3, 0xa4, 0xee, 0x98, 0x5b, 0xf9, 0x3e,
-	0x49, 0xc2, 0xc1, 0x97, 0x75, 0x15, 0xd4, 0x09, 0x4a, 0xf3, 0x71, 0x77, 0xa8, 0x08, 0xa3, 0xde,
-	0x22, 0xfd, 0x28, 0xe1, 0x62, 0xb2, 0x60, 0x18, 0x4c, 0x16, 0x0d, 0x83, 0x27, 0x60, 0xde, 0x4f,
-	0xdb, 0x7b, 0x92, 0x1c, 0xdb, 0x52, 0x62, 0x01, 0xfc, 0x54, 0x55, 0xe3, 0xec, 0x94, 0x26, 0x52,
-	0x37, 0x0b, 0xad, 0xa5, 0x4a, 0xea, 0xa1, 0xd9, 0x13, 0x04, 0x22, 0xf6, 0x2b, 0x72, 0xd0, 0xb2,
-	0xaf, 0x3e, 0x90, 0x20, 0xc0, 0x42, 0xa9, 0xf3, 0xa3, 0x15, 0x9f, 0xf1, 0x28, 0xa9, 0x55, 0x3a,
-	0x96, 0xc7, 0xa9, 0xd6, 0x7e, 0x1a, 0x96, 0xbe, 0x25, 0x8d, 0xc2, 0x6c, 0x50, 0xa9, 0xc1, 0x6c,
-	0x8b, 0x1c, 0x97, 0x55, 0x6d, 0x9f, 0x87, 0x45, 0x41, 0x2c, 0x3f, 0x4e, 0x68, 0xa8, 0x7c, 0x0b,
-	0x48, 0x2b, 0x31, 0x17, 0x7e, 0xea, 0x29, 0x80, 0x9b, 0x9b, 0x69, 0x27, 0x6a, 0xf2, 0x4e, 0xdb,
-	0xeb, 0xf0, 0xe0, 0x41, 0xfb, 0xe6, 0x60, 0xf7, 0xaf, 0x93, 0x94, 0x5a, 0x1f, 0x6f, 0xda, 0x17,
-	0xe0, 0x89, 0x52, 0x7c, 0xf1, 0xdb, 0x1f, 0xd6, 0x77, 0x34, 0xed, 0xf3, 0xf0, 0xf8, 0x68, 0xda,
-	0x28, 0xb6, 0xbe, 0xb3, 0x69, 0xbf, 0x17, 0xae, 0x8c, 0x22, 0x1d, 0xf8, 0xca, 0x85, 0xf5, 0x5d,
-	0x4d, 0xfb, 0x1a, 0x3c, 0x33, 0xaa, 0x5c, 0xe1, 0x41, 0x7d, 0xeb, 0x13, 0x4d, 0xfb, 0x39, 0xb8,
-	0x3c, 0x46, 0x29, 0xe3, 0xc5, 0x0c, 0xeb, 0xbb, 0x9b, 0xf6, 0x07, 0xe1, 0x85, 0x91, 0xe3, 0xa9,
-	0x4a, 0xce, 0xb6, 0xbe, 0x67, 0xdc, 0x66, 0x8d, 0xab, 0x78, 0xd6, 0xf7, 0x8e, 0x55, 0xac, 0x98,
-	0x73, 0x64, 0x7d, 0x5f, 0xd3, 0x7e, 0x16, 0x1a, 0x63, 0x4e, 0xa9, 0x7c, 0xa7, 0xca, 0xfa, 0xfe,
-	0xb1, 0x0a, 0x99, 0x77, 0x79, 0xac, 0x3f, 0x37, 0x56, 0x21, 0xf3, 0x75, 0x32, 0xeb, 0xcf, 0x1f,
-	0x6f, 0x32, 0xe4, 0x5b, 0x31, 0xd6, 0x27, 0x9b, 0xf6, 0x55, 0xb8, 0x34, 0x5e, 0x5b, 0xf2, 0x51,
-	0x15, 0xeb, 0x53, 0xc7, 0x5b, 0x6e, 0xf9, 0xee, 0x98, 0xf5, 0x17, 0x9a, 0xf6, 0xf3, 0x70, 0x75,
-	0x74, 0xb1, 0xe2, 0xa7, 0x8e, 0xac, 0xbf, 0xd8, 0xb4, 0xdf, 0x07, 0xcf, 0x8e, 0xcf, 0x5e, 0xd9,
-	0xc3, 0x25, 0xd6, 0x0f, 0x34, 0xed, 0x17, 0xe1, 0xb9, 0x51, 0x25, 0x4b, 0x5f, 0x23, 0xb1, 0x3e,
-	0x5d, 0x5d, 0x76, 0xd4, 0x3b, 0x23, 0xd6, 0x0f, 0x36, 0xed, 0x8b, 0xf0, 0x54, 0x69, 0xd9, 0xc1,
-	0x87, 0x40, 0xac, 0xbf, 0x34, 0x1e, 0xb5, 0x68, 0xd5, 0xfa, 0x4c, 0xd3, 0xbe, 0x04, 0xe7, 0x86,
-	0x50, 0x1b, 0x4f, 0x5f, 0x58, 0x3f, 0xd4, 0xb4, 0x1b, 0x70, 0x7e, 0x64, 0xe5, 0xd9, 0xa6, 0xfa,
-	0xe1, 0x6a, 0x86, 0x28, 0xa9, 0xfe, 0x23, 0x3d, 0x9a, 0x1c, 0x59, 0x7f, 0xb9, 0x7a, 0xaa, 0xaa,
-	0xca, 0x64, 0xed, 0xfd, 0x48, 0xd3, 0x3e, 0x07, 0x8f, 0x95, 0x96, 0x35, 0xef, 0x4a, 0x5a, 0x9f,
-	0xad, 0x66, 0xbb, 0x21, 0xf7, 0x0d, 0xad, 0xcf, 0x55, 0x37, 0x50, 0xa0, 0xfc, 0x2b, 0xd5, 0xfb,
-	0xae, 0xf2, 0xda, 0x9c, 0xf5, 0xd7, 0xab, 0xb9, 0x7a, 0xe8, 0x95, 0x30, 0xeb, 0x47, 0x8f, 0xd5,
-	0x9a, 0xb8, 0x77, 0x64, 0xfd, 0x8d, 0xea, 0xe3, 0xa2, 0x78, 0xb1, 0xc7, 0xfa, 0x9b, 0xd5, 0xb4,
-	0xc5, 0xdb, 0x38, 0xd6, 0xdf, 0x6a, 0xda, 0x97, 0xe1, 0xe9, 0xea, 0x8d, 0x32, 0x70, 0xcf, 0xc6,
-	0xfa, 0xb1, 0xa6, 0xfd, 0x02, 0x5c, 0x1b, 0x5e, 0xa2, 0x3c, 0x8d, 0xda, 0xfa, 0xf1, 0xea, 0xfd,
-	0x3c, 0xf4, 0x85, 0x8a, 0xab, 0xd6, 0xdf, 0x6e, 0xda, 0x8f, 0xc1, 0x7a, 0x75, 0xa3, 0xb7, 0xfd,
-	0x94, 0x59, 0x7f, 0x67, 0xc4, 0xa6, 0x1f, 0x92, 0x47, 0x6d, 0xfd, 0xc4, 0x88, 0x51, 0x55, 0xa7,
-	0x3c, 0x5b, 0x3f, 0x59, 0x7d, 0x74, 0x56, 0x7f, 0x6f, 0xcc, 0xfa, 0xbb, 0xa3, 0xe6, 0xa2, 0x2a,
-	0xee, 0x6f, 0xfd, 0xbd, 0xa6, 0xfd, 0x61, 0x78, 0x7f, 0x69, 0xc9, 0xf1, 0xd2, 0xd9, 0xad, 0x9f,
-	0x1a, 0x7f, 0xb0, 0x85, 0x2c, 0x6c, 0xeb, 0xef, 0x8f, 0xd0, 0x2f, 0x2a, 0x32, 0xad, 0xad, 0x7f,
-	0xd0, 0xb4, 0xaf, 0xc0, 0xc5, 0xf2, 0x49, 0x2a, 0xcf, 0x29, 0xb0, 0xfe, 0x61, 0xb5, 0x40, 0xaa,
-	0x8a, 0xb0, 0x5b, 0x9f, 0xaf, 0x9e, 0xd5, 0xe1, 0xd9, 0x14, 0xd6, 0x4f, 0x37, 0xed, 0x47, 0x60,
-	0x4d, 0x95, 0x1c, 0x4c, 0xfa, 0xb1, 0xbe, 0x50, 0xbd, 0x53, 0xca, 0x63, 0xe9, 0xd6, 0xcf, 0x54,
-	0x8f, 0xb9, 0x22, 0x26, 0x6e, 0xfd, 0xa3, 0xf1, 0x57, 0xa6, 0x90, 0xd3, 0x6f, 0xfd, 0x6c, 0xb5,
-	0x52, 0x35, 0x4e, 0xb8, 0xdc, 0xfa, 0x62, 0x75, 0xf9, 0x71, 0x6e, 0x94, 0x58, 0x3f, 0xd7, 0xb4,
-	0x3f, 0x04, 0x2f, 0xbe, 0x93, 0xf2, 0x72, 0xed, 0xfe, 0x71, 0xf5, 0x04, 0x97, 0xbf, 0xc9, 0x67,
-	0xfd, 0x7c, 0xd3, 0x7e, 0x1a, 0x9e, 0xac, 0x18, 0x32, 0xbb, 0x73, 0xa4, 0x9e, 0x3b, 0xc4, 0x05,
-	0xfe, 0x85, 0xea, 0xfe, 0x55, 0x33, 0xbd, 0x96, 0x6f, 0x68, 0x7d, 0x69, 0x84, 0xd6, 0xca, 0x2b,
-	0xb8, 0x17, 0x73, 0x9e, 0x29, 0x2f, 0xff, 0xe5, 0xa6, 0xfd, 0x24, 0x3c, 0x5a, 0x5a, 0x1e, 0x3f,
-	0x33, 0xb3, 0xd3, 0xeb, 0x76, 0x49, 0x72, 0x64, 0xfd, 0xe2, 0x98, 0xc7, 0x9d, 0xf9, 0xf4, 0x88,
-	0xf5, 0x4f, 0x8e, 0x51, 0x2c, 0x7f, 0x8e, 0xc3, 0xfa, 0xa7, 0xd5, 0x4c, 0x5a, 0xf1, 0xfa, 0x84,
-	0xf5, 0x4b, 0xd5, 0x07, 0x58, 0xe5, 0xab, 0x11, 0xd6, 0x3f, 0x1b, 0x22, 0x00, 0xca, 0x13, 0x14,
-	0xac, 0x5f, 0x6e, 0xda, 0x0e, 0x3c, 0xac, 0x8a, 0x94, 0x86, 0x99, 0xad, 0xaf, 0x34, 0xed, 0xc7,
-	0xe1, 0x91, 0x32, 0x1a, 0x2d, 0x9a, 0x6c, 0xfd, 0xf3, 0xa6, 0xfd, 0x30, 0xac, 0x96, 0x51, 0xf1,
-	0x13, 0xd7, 0xfa, 0x95, 0x6a, 0x3d, 0x6c, 0x30, 0x94, 0x6b, 0xfd, 0x8b, 0x6a, 0x11, 0x38, 0x2c,
-	0x48, 0x6b, 0xfd, 0x6a, 0xb5, 0x42, 0x96, 0xcd, 0x40, 0xfe, 0x36, 0x6c, 0x6a, 0xfd, 0x5a, 0xd3,
-	0x7e, 0x06, 0x2e, 0x0c, 0x6f, 0x47, 0x8f, 0xa6, 0x5a, 0xbf, 0x5e, 0x2d, 0x30, 0x65, 0x46, 0xa4,
-	0x3a, 0xfd, 0xb0, 0x38, 0x2e, 0xff, 0x6f, 0x54, 0x2f, 0x8b, 0x2c, 0x23, 0xce, 0x2b, 0xad, 0xc8,
-	0x6f, 0x56, 0x2b, 0x3e, 0x05, 0x4f, 0x98, 0x67, 0x78, 0xc2, 0xac, 0x7f, 0x39, 0x8a, 0xd5, 0x06,
-	0x3c, 0x88, 0xd6, 0x6f, 0x8d, 0x52, 0x4a, 0x07, 0x23, 0xbe, 0xd6, 0xbf, 0x32, 0x58, 0xc2, 0xec,
-	0x1f, 0x4e, 0xef, 0xe6, 0x3e, 0x61, 0xd6, 0x6f, 0x0f, 0x59, 0x8d, 0x8c, 0x4a, 0x63, 0xb4, 0xdf,
-	0xa9, 0x96, 0x4e, 0x1a, 0xbd, 0xce, 0x74, 0xbf, 0x5b, 0x2d, 0x9d, 0x06, 0xc2, 0xb3, 0xd6, 0xbf,
-	0xae, 0x56, 0x56, 0xcd, 0x30, 0xaa, 0xf5, 0x7b, 0xa3, 0xd8, 0xa2, 0x10, 0x6e, 0xb3, 0xbe, 0x3a,
-	0xaa, 0x40, 0x21, 0x44, 0x66, 0xbd, 0x5d, 0xed, 0x6e, 0x28, 0xc4, 0xb0, 0xac, 0x7f, 0x33, 0x44,
-	0xc7, 0x36, 0x62, 0x4f, 0xd6, 0xbf, 0xad, 0x56, 0x4a, 0x05, 0xe5, 0xbd, 0x30, 0x51, 0xb4, 0xff,
-	0xae, 0x5a, 0x52, 0xea, 0xd1, 0x0b, 0xeb, 0xf7, 0x47, 0xd1, 0x89, 0x48, 0x80, 0xf5, 0xef, 0x47,
-	0x9e, 0x2c, 0x05, 0x67, 0xbc, 0xf5, 0x1f, 0x46, 0x59, 0x66, 0xfa, 0x33, 0x99, 0xd6, 0x1f, 0x8c,
-	0xb2, 0xcc, 0x0c, 0xa7, 0xad, 0xf5, 0x1f, 0x47, 0x59, 0x66, 0xa6, 0xbb, 0xd4, 0xfa, 0x4f, 0xa3,
-	0xe8, 0x4d, 0xef, 0xa7, 0xf5, 0x9f, 0x47, 0x75, 0x5e, 0x77, 0x42, 0x5a, 0x5f, 0x1f, 0xcb, 0x54,
-	0x2e, 0x7d, 0x12, 0xdd, 0xfa, 0xc3, 0xea, 0x45, 0x2d, 0xfa, 0x19, 0xad, 0xff, 0x32, 0xe4, 0xd4,
-	0xa8, 0xf2, 0x12, 0x5a, 0xff, 0x75, 0x88, 0x6e, 0x5d, 0xe9, 0x04, 0xb4, 0xfe, 0xfb, 0x28, 0x8e,
-	0x2f, 0x78, 0xc6, 0xad, 0xff, 0xd1, 0xbc, 0xee, 0x7c, 0xe9, 0x6b, 0xeb, 0xb5, 0xaf, 0x7c, 0x6d,
-	0xbd, 0xf6, 0x7b, 0x5f, 0x5b, 0xaf, 0x7d, 0xe2, 0xed, 0xf5, 0xf7, 0x7c, 0xe5, 0xed, 0xf5, 0xf7,
-	0xfc, 0xe6, 0xdb, 0xeb, 0xef, 0x79, 0xb9, 0xf6, 0x8d, 0x13, 0xdd, 0xb4, 0xf3, 0xd1, 0xda, 0x7b,
-	0xfe, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x19, 0xcc, 0xde, 0xfc, 0x8f, 0x00, 0x00,
+	// 10325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x6b, 0x94, 0x24, 0xd7,
+	0x79, 0x90, 0xbb, 0xe7, 0x7d, 0xe7, 0x55, 0x5b, 0x3b, 0x5a, 0xb5, 0x46, 0xab, 0x95, 0x54, 0x7a,
+	0xad, 0x56, 0xab, 0xd6, 0x6a, 0xb5, 0xb2, 0x2c, 0xd9, 0xb2, 0xbd, 0x3b, 0xbb, 0xdb, 0x1a, 0xbc,
+	0x2b, 0x8d, 0x7b, 0x76, 0x25, 0x9d, 0x10, 0x52, 0xdc, 0xa9, 0xba, 0xd3, 0x53, 0x99, 0xea, 0xaa,
+	0x72, 0x55, 0x75, 0xef, 0x4c, 0x7e, 0xf9, 0x70, 0x78, 0xe4, 0x38, 0x4e, 0x62, 0xe7, 0x01, 0x36,
+	0xc4, 0xc1, 0x09, 0x39, 0x36, 0x81, 0x00, 0x09, 0x24, 0x1c, 0x02, 0x38, 0x0e, 0x49, 0x48, 0xec,
+	0x40, 0x88, 0x09, 0x79, 0x11, 0x92, 0x10, 0x5b, 0x40, 0x20, 0x80, 0xc3, 0xeb, 0x1f, 0x87, 0x73,
+	0x38, 0xdf, 0x77, 0xef, 0xad, 0xba, 0xb7, 0x1e, 0xdd, 0xb5, 0x8a, 0x78, 0xfc, 0x9a, 0xe9, 0xef,
+	0xfb, 0xee, 0xfb, 0xbb, 0xdf, 0xfd, 0x5e, 0xf7, 0x16, 0xd9, 0x74, 0x92, 0x34, 0xf6, 0x0e, 0xd9,
+	0xb3, 0xcf, 0xdb, 0x03, 0x67, 0xc8, 0x92, 0x84, 0x0e, 0x58, 0xd2, 0x8d, 0xe2, 0x30, 0x0d, 0x37,
+	0x4f, 0x26, 0x29, 0xa3, 0xc3, 0x02, 0xf0, 0x5e, 0x16, 0x0c, 0xbc, 0x80, 0x95, 0xa8, 0x2d, 0x97,
+	0xac, 0xf5, 0xe8, 0x90, 0xed, 0xb2, 0x78, 0xcc, 0xe2, 0x1d, 0x2f, 0x18, 0x98, 0x1d, 0x32, 0x1b,
+	0x79, 0xc1, 0xa0, 0xd3, 0x7e, 0xa8, 0x75, 0x76, 0xee, 0xca, 0xec, 0x97, 0x7e, 0xf7, 0xc1, 0x77,
+	0xf5, 0x11, 0x62, 0x6e, 0x90, 0xb6, 0x17, 0x75, 0x66, 0x1e, 0x6a, 0x9d, 0x5d, 0x15, 0xf0, 0xb6,
+	0x17, 0x99, 0x16, 0x59, 0xf2, 0x82, 0x24, 0xa5, 0x81, 0xc3, 0x92, 0xce, 0x9c, 0x82, 0xcc, 0xc1,
+	0xd6, 0xeb, 0x64, 0xed, 0x2a, 0x4d, 0xe9, 0x16, 0x0b, 0x52, 0xd1, 0xca, 0x39, 0xb2, 0xe6, 0xd2,
+	0x94, 0xda, 0x0e, 0x82, 0x6c, 0xcf, 0xed, 0xb4, 0x1e, 0x6a, 0x9d, 0x5d, 0x10, 0x45, 0x57, 0xdc,
+	0x8c, 0x7a, 0xdb, 0xd5, 0x7a, 0x74, 0x42, 0xed, 0x91, 0xf5, 0x99, 0x16, 0xb9, 0xf7, 0x2a, 0x4b,
+	0xa9, 0xe7, 0x33, 0x77, 0x97, 0xd1, 0xd8, 0x39, 0xd8, 0x4d, 0x69, 0xea, 0x25, 0xa9, 0xe7, 0x98,
+	0x0f, 0x93, 0xa5, 0x01, 0x1d, 0x32, 0x3b, 0x3d, 0x8e, 0x58, 0xa7, 0xa5, 0xf4, 0x6b, 0x11, 0xc0,
+	0xb7, 0x8e, 0x23, 0x66, 0x9e, 0x27, 0xeb, 0x09, 0x96, 0xb2, 0x53, 0x6f, 0xc8, 0x6c, 0x3a, 0xe6,
+	0x6d, 0x48, 0xc2, 0x55, 0x8e, 0xbc, 0xe5, 0x0d, 0xd9, 0xe5, 0xf1, 0xc0, 0x7c, 0x96, 0x9c, 0x88,
+	0x7c, 0x7a, 0xcc, 0xe2, 0xc4, 0xe6, 0x08, 0xe8, 0xd3, 0xac, 0x42, 0x6f, 0x08, 0xf4, 0xae, 0xc4,
+	0x5a, 0x9f, 0x6f, 0x13, 0xe3, 0x56, 0x38, 0x8a, 0x03, 0x3a, 0x64, 0x41, 0xba, 0x83, 0x68, 0xf3,
+	0x11, 0x42, 0xa8, 0xe3, 0x84, 0xa3, 0x20, 0x95, 0xc3, 0xce, 0x66, 0x4c, 0xc0, 0xb7, 0x5d, 0xf3,
+	0x31, 0xb2, 0xcc, 0x6b, 0xb3, 0x03, 0xcf, 0x39, 0xc4, 0x6e, 0x2d, 0x09, 0x2a, 0xc2, 0x11, 0xaf,
+	0x7a, 0xce, 0xa1, 0x4a, 0x46, 0x87, 0xac, 0x33, 0x53, 0x41, 0x46, 0x87, 0x0c, 0x9a, 0x14, 0x64,
+	0x6e, 0xb8, 0xa7, 0xf5, 0x79, 0x89, 0xc3, 0xaf, 0x86, 0x7b, 0x4a, 0x5d, 0xfb, 0x3e, 0x1d, 0x74,
+	0xe6, 0xca, 0x75, 0x5d, 0xf7, 0xe9, 0xc0, 0x7c, 0x9a, 0xac, 0x0b, 0x32, 0x3f, 0x74, 0x68, 0xea,
+	0x85, 0x41, 0x67, 0x5e, 0x21, 0x5d, 0xe3, 0xc8, 0x1b, 0x02, 0xa7, 0xd4, 0xea, 0xb2, 0xc4, 0xe9,
+	0x2c, 0x94, 0x6b, 0xbd, 0xca, 0x12, 0xc7, 0xfa, 0xd9, 0x16, 0x59, 0xcb, 0x67, 0xea, 0x16, 0xa3,
+	0x43, 0xf3, 0x01, 0xb2, 0x00, 0x9c, 0x2c, 0x27, 0x49, 0xf2, 0xe2, 0x3c, 0x00, 0xb7, 0x5d, 0xf3,
+	0x41, 0xb2, 0x88, 0xe8, 0x94, 0x0e, 0xb4, 0xe9, 0xc1, 0x42, 0xb7, 0xe8, 0x00, 0x18, 0x00, 0x09,
+	0x70, 0x34, 0xea, 0xcc, 0x60, 0xb9, 0xeb, 0xbe, 0x42, 0x82, 0x93, 0x37, 0x5b, 0x24, 0xc1, 0xa9,
+	0x7b, 0x8a, 0x2c, 0x88, 0x65, 0xed, 0xcc, 0x3d, 0x34, 0x73, 0x76, 0xf9, 0xe2, 0x89, 0x6e, 0x71,
+	0x45, 0xfb, 0x92, 0xc2, 0xfa, 0xf6, 0x19, 0xb2, 0x9e, 0x63, 0xaf, 0x8d, 0x59, 0x90, 0x42, 0x3f,
+	0x19, 0xfc, 0x53, 0x1c, 0xc7, 0x02, 0x42, 0xb7, 0x5d, 0xe8, 0x04, 0x27, 0x28, 0x8e, 0x84, 0x97,
+	0x83, 0xa1, 0x3c, 0x42, 0x08, 0x27, 0x29, 0xad, 0x32, 0x2f, 0x8a, 0x3d, 0xed, 0x12, 0x43, 0xd4,
+	0x03, 0xcc, 0x9c, 0xa4, 0x34, 0x4e, 0xb5, 0xa5, 0x5e, 0xe3, 0xd5, 0x79, 0x43, 0xb6, 0x0b, 0x38,
+	0xd8, 0x82, 0x0a, 0x3d, 0x0b, 0x5c, 0x6d, 0xf7, 0xae, 0x64, 0xd4, 0xd7, 0x02, 0xd7, 0x7c, 0x82,
+	0xf0, 0xdf, 0x76, 0x34, 0xda, 0xf3, 0x3d, 0xa7, 0x33, 0xaf, 0x0c, 0x64, 0x19, 0x31, 0x3b, 0x88,
+	0xc8, 0x2b, 0x4d, 0x52, 0x3a, 0x60, 0x30, 0xe6, 0x05, 0x85, 0x94, 0x57, 0xb2, 0x0b, 0xa8, 0x6d,
+	0x37, 0xef, 0x30, 0xa7, 0xc5, 0xb1, 0x2d, 0xaa, 0xac, 0x94, 0x53, 0xe3, 0x00, 0x2f, 0x90, 0x13,
+	0xd4, 0x49, 0xbd, 0x31, 0xb3, 0x13, 0xe6, 0x00, 0x73, 0x41, 0xf5, 0x4b, 0x4a, 0x9f, 0xd7, 0x39,
+	0x7a, 0x97, 0x63, 0xb7, 0x5d, 0xeb, 0xfb, 0xe6, 0x89, 0xd1, 0xf3, 0xc3, 0x3d, 0xea, 0x67, 0x72,
+	0x21, 0x31, 0x9f, 0x22, 0x6b, 0x72, 0x1f, 0x87, 0x81, 0xef, 0x05, 0xba, 0x74, 0x58, 0x15, 0xb8,
+	0xd7, 0x10, 0x05, 0xc4, 0x09, 0xca, 0xc6, 0x8c, 0xb8, 0x20, 0x21, 0xe2, 0x71, 0x4e, 0x5c, 0x29,
+	0x21, 0x66, 0x26, 0x49, 0x08, 0x28, 0x22, 0xeb, 0xa7, 0x63, 0xea, 0xf9, 0x74, 0xcf, 0x67, 0xba,
+	0x50, 0x11, 0xe8, 0xcb, 0x12, 0x0b, 0x1b, 0x30, 0x0c, 0x06, 0xa1, 0x17, 0x0c, 0xec, 0x21, 0x4d,
+	0x9d, 0x83, 0x82, 0xd8, 0x5d, 0x13, 0xc8, 0x9b, 0x1c, 0x57, 0x25, 0xe4, 0xe6, 0xeb, 0x85, 0xdc,
+	0x35, 0x72, 0x82, 0x03, 0xec, 0x24, 0x9b, 0xb1, 0xce, 0x02, 0x32, 0x7e, 0xa7, 0x5b, 0x23, 0x6a,
+	0xa1, 0x8f, 0x1a, 0x20, 0x31, 0xcf, 0x92, 0xd5, 0x21, 0xf5, 0x02, 0x3b, 0x0a, 0x93, 0xd4, 0x1e,
+	0xc5, 0xbe, 0xb6, 0xae, 0xcb, 0x80, 0xda, 0x09, 0x93, 0xf4, 0x76, 0xec, 0x9b, 0x2f, 0x91, 0x53,
+	0x31, 0xfb, 0xc8, 0xc8, 0x8b, 0x99, 0x6b, 0xd3, 0x28, 0xf2, 0x5c, 0x1b, 0x46, 0x0b, 0x52, 0x45,
+	0x5d, 0xd9, 0x0d, 0x49, 0x73, 0x19, 0x48, 0x5e, 0xe7, 0x14, 0xe6, 0x73, 0xc4, 0x8c, 0x62, 0xcf,
+	0x61, 0xc9, 0x01, 0x63, 0x69, 0x56, 0x8e, 0x28, 0xe5, 0x4e, 0xe4, 0x78, 0x59, 0xe8, 0x25, 0x72,
+	0x2a, 0xbd, 0xe3, 0xa5, 0x38, 0xc2, 0x98, 0xd1, 0x61, 0x92, 0x15, 0x5c, 0x56, 0x1b, 0xe4, 0x34,
+	0xbb, 0x9c, 0x44, 0x96, 0xfd, 0x20, 0xb9, 0x4f, 0x70, 0x60, 0x9a, 0xed, 0x72, 0x1b, 0xb9, 0xd4,
+	0x73, 0x3b, 0x2b, 0x4a, 0xf1, 0x7b, 0x39, 0x59, 0x41, 0x16, 0x78, 0xc8, 0xf3, 0x92, 0x87, 0x47,
+	0xf1, 0x98, 0x1d, 0x03, 0x0b, 0xaf, 0xaa, 0xab, 0x27, 0x58, 0x18, 0x91, 0xfc, 0x1c, 0x88, 0x61,
+	0xdd, 0x9e, 0xbb, 0x68, 0x3b, 0xa3, 0xb8, 0xb3, 0xa6, 0x90, 0x12, 0x81, 0xd8, 0x1a, 0xc5, 0xe6,
+	0x25, 0x72, 0x52, 0x92, 0x65, 0x5b, 0x2a, 0x4e, 0x3b, 0xeb, 0xea, 0x54, 0x08, 0x82, 0x6b, 0x62,
+	0x53, 0xc5, 0xa9, 0xf5, 0x06, 0x79, 0xf0, 0xb5, 0x88, 0xc5, 0x28, 0xa8, 0x95, 0x2d, 0x02, 0x02,
+	0x39, 0xf6, 0x22, 0x80, 0xc2, 0xd9, 0x8b, 0xfb, 0xb2, 0xa5, 0xac, 0x1f, 0x42, 0xcc, 0x4d, 0x32,
+	0xe7, 0xb9, 0x87, 0xec, 0x58, 0xdb, 0x10, 0x1c, 0x64, 0x7d, 0x98, 0xdc, 0x5f, 0x55, 0xf1, 0x35,
+	0x9f, 0xc1, 0x5c, 0xe4, 0x45, 0x5b, 0xa5, 0xa2, 0xe6, 0x29, 0x32, 0x3f, 0xa6, 0xfe, 0x88, 0x25,
+	0x9d, 0xf6, 0x43, 0x33, 0x67, 0xe7, 0xfa, 0xe2, 0x17, 0x1c, 0xf5, 0x95, 0x75, 0x26, 0x3b, 0xd4,
+	0x39, 0x64, 0xa9, 0xf9, 0x10, 0x59, 0x8c, 0xf0, 0xbf, 0x82, 0x98, 0xcd, 0xa0, 0xe6, 0xe3, 0x64,
+	0x79, 0x98, 0xc0, 0x1c, 0x24, 0x29, 0x1d, 0x46, 0x9a, 0x7e, 0xa3, 0x22, 0xcc, 0x4b, 0x59, 0x0f,
+	0x66, 0x90, 0xef, 0x4f, 0x77, 0x27, 0x8c, 0x25, 0xeb, 0xdf, 0x4f, 0xb7, 0xc8, 0x09, 0x71, 0x1c,
+	0xd0, 0xe0, 0xd0, 0x0b, 0x06, 0xdb, 0xc1, 0x7e, 0xd8, 0xec, 0xac, 0x7f, 0x80, 0x2c, 0xc4, 0x34,
+	0x38, 0x04, 0x0a, 0x75, 0x2e, 0xe7, 0x01, 0xc8, 0xd5, 0x9f, 0x3b, 0x5e, 0x90, 0x68, 0x82, 0x04,
+	0x21, 0xc8, 0x1c, 0x50, 0xd0, 0x39, 0xa0, 0xc1, 0x80, 0x8b, 0x8d, 0x76, 0xc6, 0x1c, 0x34, 0x38,
+	0xdc, 0x42, 0xb8, 0xf9, 0x38, 0x59, 0x41, 0x32, 0xd0, 0x84, 0xa0, 0x91, 0x79, 0x8d, 0x89, 0x68,
+	0x70, 0x08, 0xca, 0xd0, 0xb6, 0x6b, 0x7d, 0x67, 0x8b, 0x9c, 0xe2, 0x43, 0xd8, 0x0a, 0x87, 0x43,
+	0x16, 0xb8, 0x38, 0x66, 0x1c, 0xc7, 0x13, 0x64, 0xc5, 0x19, 0xba, 0xf6, 0x7e, 0xec, 0xb1, 0xc0,
+	0xf5, 0xf5, 0x85, 0x5b, 0x76, 0x86, 0xee, 0x75, 0x81, 0x90, 0x84, 0x29, 0xa3, 0x5c, 0xfa, 0xb5,
+	0x0b, 0x84, 0xb7, 0x04, 0x02, 0x66, 0x06, 0x08, 0x7d, 0x46, 0x5d, 0x16, 0xeb, 0x2a, 0x89, 0x33,
+	0x74, 0x6f, 0x20, 0xd8, 0xfa, 0xb6, 0x16, 0x31, 0x78, 0x8f, 0x6e, 0x32, 0x97, 0xfa, 0x09, 0xf6,
+	0xe5, 0x02, 0xd9, 0x70, 0xbd, 0x04, 0x24, 0xa9, 0xed, 0xa5, 0x6c, 0x98, 0xd8, 0x2e, 0xdb, 0xf7,
+	0xdc, 0x23, 0x94, 0x52, 0xab, 0x7d, 0x53, 0xe0, 0xb6, 0x01, 0x75, 0x15, 0x31, 0xe6, 0x35, 0x72,
+	0x7a, 0x9f, 0xd1, 0x74, 0x04, 0x32, 0x46, 0x2d, 0x2a, 0x4b, 0x2e, 0x2a, 0xad, 0xdf, 0x27, 0x29,
+	0xaf, 0xe6, 0xf5, 0xf0, 0x6a, 0xac, 0x3f, 0xd3, 0x22, 0xeb, 0x97, 0xf9, 0xaa, 0x5d, 0x86, 0x5d,
+	0xea, 0xa5, 0xc7, 0xc0, 0x76, 0x54, 0xfc, 0xaf, 0x2b, 0x99, 0x12, 0x0a, 0xcb, 0x37, 0x0c, 0x5d,
+	0xfd, 0xdc, 0x40, 0x88, 0x79, 0x8a, 0xcc, 0x0c, 0xa9, 0xae, 0x50, 0x03, 0xc0, 0x3c, 0x43, 0x16,
+	0x50, 0xb0, 0x7b, 0x2e, 0xce, 0xcb, 0xac, 0x54, 0x18, 0x04, 0xd0, 0xfa, 0xe1, 0x16, 0xd9, 0xc8,
+	0x25, 0x0b, 0xca, 0xf9, 0x5d, 0x96, 0x8e, 0xa2, 0xe9, 0xaa, 0x86, 0x45, 0x88, 0x50, 0xa9, 0x6c,
+	0x27, 0xd5, 0x76, 0xc0, 0x22, 0xd7, 0xaa, 0xb6, 0x60, 0x23, 0x2d, 0x49, 0x9a, 0xb4, 0x33, 0xa3,
+	0x90, 0x2c, 0x70, 0x92, 0x5b, 0x15, 0x67, 0xfc, 0x6c, 0xdd, 0x19, 0x6f, 0xfd, 0xc2, 0x3c, 0x59,
+	0xe3, 0xc6, 0xc5, 0x2b, 0x7e, 0x3a, 0xc6, 0xf5, 0x7b, 0x94, 0x2c, 0xa7, 0x63, 0x7b, 0xe4, 0x46,
+	0x76, 0x14, 0xc6, 0xa9, 0xbe, 0x29, 0xd2, 0xf1, 0x6d, 0x37, 0xda, 0x09, 0xe3, 0x14, 0x98, 0x36,
+	0x1d, 0xdb, 0x77, 0x60, 0x70, 0xb6, 0x94, 0x32, 0x72, 0x26, 0x48, 0x3a, 0x7e, 0x03, 0x10, 0x1f,
+	0x62, 0xc7, 0x30, 0xe6, 0x74, 0x6c, 0x27, 0x7e, 0x98, 0xea, 0x3b, 0x64, 0x21, 0x1d, 0xef, 0x02,
+	0x10, 0x18, 0x2d, 0x1d, 0xdb, 0x8e, 0xef, 0xb1, 0x20, 0x4d, 0x74, 0x46, 0x4b, 0xc7, 0x5b, 0x1c,
+	0x2c, 0x88, 0xa2, 0x38, 0x3c, 0xf2, 0x8a, 0x56, 0x4c, 0x3a, 0xde, 0xe1, 0x60, 0x54, 0x48, 0xc7,
+	0x78, 0x8a, 0x6a, 0x5b, 0x68, 0x3e, 0x1d, 0xc3, 0xe9, 0xa9, 0x1b, 0x1c, 0x8b, 0x95, 0x06, 0xc7,
+	0x93, 0x64, 0x15, 0x49, 0x86, 0x34, 0x1a, 0xc4, 0xe1, 0x28, 0xea, 0x2c, 0x29, 0x62, 0x75, 0x05,
+	0x50, 0x37, 0x05, 0x06, 0xc6, 0x25, 0x49, 0x3b, 0x44, 0xa1, 0x5a, 0x10, 0x54, 0xa0, 0x0d, 0xa5,
+	0x63, 0x7b, 0x48, 0x13, 0xb0, 0x9f, 0xd0, 0xe6, 0xf3, 0xdc, 0xce, 0xb2, 0x32, 0x4b, 0xeb, 0xe9,
+	0xf8, 0x26, 0x62, 0x77, 0x39, 0x12, 0xd6, 0x2d, 0x1d, 0xa3, 0xd6, 0xee, 0x8b, 0x09, 0x53, 0x8f,
+	0xac, 0x95, 0x74, 0x0c, 0x4a, 0xbb, 0xcf, 0x67, 0xad, 0x4b, 0x8c, 0x8c, 0x56, 0xce, 0x9d, 0x76,
+	0x4e, 0x09, 0x6a, 0x39, 0x81, 0x2a, 0xbd, 0x9c, 0xc6, 0xb5, 0x0a, 0x7a, 0x39, 0x97, 0xbc, 0x2f,
+	0x31, 0x83, 0xad, 0xc8, 0xfb, 0xb2, 0xae, 0xf7, 0xa5, 0x0f, 0x28, 0xb5, 0x2f, 0x9c, 0x56, 0xf6,
+	0xc5, 0xd0, 0xeb, 0x46, 0x6a, 0xbd, 0x2f, 0x9c, 0x5e, 0xf6, 0xe5, 0x44, 0x05, 0xbd, 0xec, 0x8b,
+	0x4a, 0x4f, 0x5d, 0x37, 0x66, 0x49, 0xd2, 0x31, 0x2b, 0xe8, 0x2f, 0x73, 0x1c, 0x28, 0x37, 0x79,
+	0xfd, 0xc0, 0xc2, 0x27, 0x55, 0x21, 0x27, 0x2b, 0x07, 0x26, 0x56, 0x6b, 0x96, 0x4b, 0xb4, 0xa1,
+	0x2c, 0x91, 0xac, 0x59, 0xae, 0xd0, 0x26, 0x99, 0x03, 0x6b, 0x25, 0xe9, 0xdc, 0xa3, 0x1e, 0x8c,
+	0x08, 0xb2, 0xbe, 0xb7, 0x45, 0x56, 0xb7, 0x23, 0xd1, 0x87, 0x9b, 0x34, 0x39, 0x34, 0x4d, 0xd2,
+	0xa2, 0xda, 0xf6, 0x69, 0x51, 0x80, 0xed, 0x69, 0xa2, 0xa6, 0xb5, 0x07, 0x30, 0x47, 0xdb, 0x1b,
+	0x2d, 0x07, 0x60, 0xae, 0xb6, 0x19, 0x5a, 0x78, 0xd0, 0xec, 0x79, 0xa9, 0xce, 0xfe, 0x08, 0x81,
+	0x7e, 0xa5, 0xe1, 0x21, 0x0b, 0x34, 0xbe, 0xe7, 0x20, 0xeb, 0x1b, 0xc8, 0xda, 0x9b, 0xd1, 0x4e,
+	0x1c, 0x0e, 0xa0, 0x5f, 0x60, 0xe5, 0xc3, 0x46, 0x38, 0x82, 0xcd, 0xed, 0xc1, 0x42, 0x69, 0x42,
+	0xf1, 0x28, 0xda, 0x41, 0x28, 0x9c, 0x5c, 0x47, 0x91, 0xed, 0xd0, 0x94, 0x0d, 0xc2, 0xf8, 0x58,
+	0x93, 0x44, 0xe4, 0x28, 0xda, 0x12, 0x70, 0x38, 0xf4, 0x4f, 0xa2, 0x7c, 0xbb, 0x16, 0xb8, 0x20,
+	0x88, 0x6f, 0x47, 0x2e, 0x4d, 0xf9, 0x4e, 0x44, 0xf9, 0x2d, 0xe4, 0x9c, 0x9c, 0xce, 0x79, 0x00,
+	0x72, 0xc3, 0x02, 0xd1, 0x34, 0x4d, 0x63, 0x29, 0xe3, 0xd5, 0x39, 0x59, 0x03, 0xec, 0xe5, 0x34,
+	0x8d, 0xc5, 0xf9, 0xf0, 0x02, 0xb9, 0x47, 0xa5, 0xf7, 0x53, 0x6a, 0xe3, 0xa9, 0xae, 0x4d, 0x9a,
+	0x99, 0x17, 0xf2, 0x53, 0xfa, 0x3a, 0xe0, 0xad, 0x6f, 0x9d, 0x21, 0x1b, 0xbb, 0x4e, 0x18, 0x33,
+	0x7e, 0x5e, 0xed, 0x85, 0x34, 0x76, 0x71, 0x0a, 0x1e, 0x24, 0x8b, 0x1f, 0x19, 0xb1, 0x24, 0x2d,
+	0xf6, 0x70, 0x01, 0xa1, 0xdb, 0xb8, 0xd2, 0x09, 0x14, 0xd4, 0xb5, 0x27, 0x04, 0x99, 0x37, 0xc8,
+	0x9a, 0x50, 0x0e, 0x58, 0x90, 0xc6, 0x5e, 0xa6, 0x88, 0x3c, 0xda, 0xad, 0x6a, 0xab, 0x2b, 0x8e,
+	0xa4, 0x6b, 0x9c, 0xb6, 0x5f, 0x28, 0x6b, 0x7e, 0x80, 0xac, 0x70, 0x33, 0x41, 0xd4, 0xc5, 0xad,
+	0xd8, 0xfb, 0xab, 0xeb, 0x82, 0x4a, 0x8e, 0xfb, 0x5a, 0x81, 0xcd, 0x17, 0xc8, 0x1c, 0x82, 0xe1,
+	0xbc, 0x02, 0x13, 0x55, 0x5d, 0x51, 0x00, 0x00, 0x7c, 0x4c, 0x7d, 0x6d, 0x24, 0x00, 0xd8, 0x3c,
+	0x24, 0x6b, 0x7a, 0xdf, 0xc0, 0x57, 0x24, 0x7a, 0x57, 0xa3, 0x0d, 0x79, 0xae, 0xf9, 0x3c, 0x59,
+	0x90, 0x5d, 0x6d, 0x4f, 0xef, 0xaa, 0xa4, 0xb5, 0xbe, 0x3c, 0x4b, 0xd6, 0xb9, 0xaa, 0xf0, 0x61,
+	0x98, 0x62, 0x5c, 0x85, 0x8b, 0xc4, 0xc4, 0xf9, 0x66, 0xb1, 0x5d, 0xa3, 0x85, 0x19, 0x02, 0x7f,
+	0x39, 0x53, 0xc6, 0xae, 0x93, 0x75, 0xb1, 0x72, 0xa8, 0x20, 0xd0, 0x94, 0x8a, 0x6e, 0x9c, 0xe9,
+	0x16, 0xaa, 0xef, 0xe2, 0x7f, 0xa8, 0x1f, 0xd0, 0x94, 0xf6, 0x57, 0x3f, 0xa2, 0xfe, 0x34, 0x5f,
+	0x24, 0x06, 0x6c, 0x02, 0xb1, 0x2f, 0x78, 0x45, 0x7c, 0x19, 0xd7, 0xbb, 0xfa, 0x7e, 0xe9, 0xaf,
+	0x1d, 0xe9, 0xfb, 0xe7, 0x31, 0xb2, 0x8c, 0xa6, 0x1a, 0x1a, 0x8b, 0xfa, 0x2e, 0x25, 0x80, 0xc0,
+	0x7e, 0x80, 0x3e, 0xbb, 0x32, 0x1c, 0xda, 0xfc, 0x90, 0x00, 0x05, 0x43, 0xdd, 0xb6, 0x64, 0x38,
+	0x04, 0xb7, 0xde, 0x4d, 0x50, 0x33, 0x5e, 0x20, 0x2b, 0x38, 0x96, 0x11, 0xdf, 0x3c, 0x9d, 0x79,
+	0xec, 0xc5, 0x46, 0xb7, 0x62, 0x63, 0xf5, 0x97, 0xbd, 0xfc, 0x07, 0x58, 0x3b, 0xa1, 0xd4, 0x7c,
+	0xc5, 0x76, 0xb6, 0x99, 0xef, 0x0d, 0x3c, 0xb0, 0x51, 0xc1, 0xac, 0x5f, 0x94, 0xd6, 0x4e, 0x46,
+	0xc6, 0xb7, 0xf7, 0x35, 0x41, 0xb4, 0xf9, 0xe3, 0x2d, 0xb2, 0xaa, 0xcd, 0xd2, 0xf4, 0x8d, 0x71,
+	0x99, 0x6c, 0x72, 0x82, 0x20, 0x8c, 0x87, 0x70, 0x98, 0x88, 0x76, 0x69, 0x1c, 0x30, 0x57, 0x13,
+	0x14, 0xf7, 0x22, 0xdd, 0xab, 0x48, 0x26, 0xda, 0x45, 0x22, 0xf3, 0x03, 0xe4, 0x3e, 0x5e, 0xc5,
+	0x5e, 0x18, 0x8c, 0x92, 0x42, 0x0d, 0xaa, 0x46, 0x73, 0x0a, 0xc9, 0xae, 0x00, 0x95, 0x5a, 0x81,
+	0xf5, 0x07, 0x2d, 0x72, 0x7a, 0xeb, 0x66, 0x32, 0xe8, 0x6d, 0xd9, 0x5c, 0x77, 0xc1, 0x31, 0xf0,
+	0x69, 0xc1, 0x51, 0xbc, 0x4f, 0x1a, 0xfa, 0x36, 0x6f, 0x08, 0x57, 0xb7, 0x85, 0xf3, 0x6a, 0x14,
+	0xd9, 0xa4, 0xbf, 0x1e, 0xe9, 0x00, 0x58, 0xdf, 0x3d, 0x2f, 0xa0, 0xf1, 0xb1, 0x64, 0xaf, 0xd6,
+	0xd9, 0x15, 0xb9, 0x6e, 0x1c, 0x81, 0x64, 0xc5, 0xf5, 0x9d, 0xa9, 0x59, 0xdf, 0x97, 0xc9, 0xda,
+	0xd0, 0x4b, 0xc0, 0x3e, 0xf5, 0xf7, 0x12, 0xac, 0x11, 0x38, 0x66, 0xf9, 0xe2, 0x3d, 0x95, 0xfb,
+	0xa6, 0x5f, 0x20, 0xb6, 0x7e, 0xb1, 0x45, 0x2e, 0xf0, 0xc1, 0x6e, 0xed, 0x4a, 0xa7, 0xf2, 0xf8,
+	0xa2, 0x8d, 0xdc, 0x31, 0xa4, 0x60, 0xc9, 0xf4, 0xb6, 0x0a, 0x06, 0x50, 0xd2, 0xc0, 0xda, 0x7a,
+	0x1f, 0x59, 0x04, 0xfd, 0xf4, 0x90, 0x1d, 0xcb, 0x7d, 0xfc, 0x50, 0x77, 0x8a, 0xb1, 0xd9, 0xcf,
+	0x4a, 0x98, 0xef, 0x26, 0x0b, 0xbc, 0xa6, 0xc9, 0x46, 0x98, 0x30, 0xfe, 0xfa, 0x92, 0xd8, 0xfa,
+	0x58, 0x8b, 0x74, 0xa7, 0x0d, 0xe6, 0x22, 0x5f, 0xd3, 0xad, 0x30, 0xd8, 0xf7, 0xe2, 0x61, 0x7e,
+	0xb6, 0xb5, 0x4a, 0x67, 0x1b, 0xe0, 0x72, 0x63, 0x31, 0xc3, 0x21, 0x08, 0xa6, 0x80, 0x1d, 0x09,
+	0xcb, 0x6b, 0x46, 0xe1, 0xe4, 0x0c, 0x6a, 0x7d, 0xbe, 0x45, 0x9e, 0xae, 0xe8, 0x4c, 0xd6, 0x83,
+	0x3e, 0x03, 0xdf, 0x0e, 0x8e, 0x89, 0xf3, 0x16, 0xf8, 0x26, 0xc6, 0x1e, 0xbb, 0x03, 0xde, 0x20,
+	0x76, 0x94, 0xb2, 0x38, 0xa0, 0xbe, 0x9d, 0x86, 0x29, 0xf5, 0xb5, 0xce, 0x6d, 0x08, 0x9a, 0x6b,
+	0x82, 0xe4, 0x16, 0x50, 0x54, 0x96, 0x45, 0x9d, 0xa3, 0xd3, 0x9e, 0x50, 0x16, 0x35, 0x0f, 0xeb,
+	0x87, 0xda, 0xe4, 0x91, 0xc9, 0xd3, 0xc6, 0x5d, 0x86, 0x0f, 0x92, 0xe5, 0x5c, 0x90, 0x26, 0xc8,
+	0xf1, 0xab, 0x7d, 0x92, 0x59, 0xb2, 0x89, 0xae, 0x03, 0xb7, 0x2b, 0x75, 0x60, 0x94, 0x6e, 0xb0,
+	0x5a, 0x52, 0x26, 0x2a, 0x0e, 0x61, 0x8e, 0x40, 0xee, 0x7f, 0x8a, 0xac, 0x71, 0x5d, 0x2f, 0x73,
+	0xcf, 0xa8, 0x72, 0x70, 0x95, 0xe3, 0x72, 0xbf, 0x8c, 0xa1, 0x38, 0x64, 0xf0, 0xf4, 0xea, 0xcc,
+	0x89, 0x4d, 0x50, 0x65, 0x29, 0xf5, 0xd7, 0x53, 0x1d, 0x8a, 0x1e, 0xf2, 0x18, 0x84, 0x6e, 0x18,
+	0xf8, 0xc7, 0x9d, 0x79, 0x45, 0xb8, 0x2d, 0x21, 0xfc, 0xb5, 0xc0, 0x3f, 0xb6, 0xae, 0x12, 0x6b,
+	0xda, 0x2c, 0x85, 0x68, 0xbe, 0xd1, 0x3d, 0x1a, 0xb8, 0x61, 0xa0, 0x1b, 0x61, 0x02, 0x68, 0x7d,
+	0xb1, 0x4d, 0x9e, 0x99, 0x5c, 0x0d, 0x57, 0x64, 0x2f, 0x2a, 0x41, 0x99, 0x17, 0xc9, 0x3a, 0x4c,
+	0x20, 0xf7, 0x05, 0x42, 0xe8, 0x23, 0x11, 0xe2, 0x66, 0xbd, 0xab, 0x87, 0x6f, 0xfa, 0x45, 0x3a,
+	0xb0, 0xc8, 0xc3, 0xfd, 0xfd, 0x84, 0xa5, 0xb6, 0x17, 0xb8, 0xec, 0x48, 0xf7, 0x7b, 0x70, 0xcc,
+	0x36, 0x20, 0x60, 0x61, 0xf6, 0x3d, 0xe0, 0x9a, 0x3d, 0x9c, 0x3f, 0x55, 0x50, 0x12, 0x44, 0x5c,
+	0xc1, 0x99, 0x7a, 0x2f, 0x39, 0xa1, 0x46, 0x6e, 0x78, 0x67, 0x66, 0x45, 0x67, 0xf4, 0x28, 0x4f,
+	0x7f, 0xdd, 0xd5, 0x7e, 0x27, 0x20, 0xfe, 0x87, 0xf4, 0x08, 0x0b, 0x69, 0xe7, 0xd5, 0xc2, 0x90,
+	0x1e, 0xed, 0x08, 0xb7, 0x40, 0x0a, 0x12, 0x35, 0x57, 0x37, 0x17, 0x32, 0x43, 0x8c, 0x25, 0xe9,
+	0x2d, 0x54, 0x39, 0x7f, 0x63, 0x91, 0x3c, 0x3d, 0x79, 0x06, 0x7b, 0x5b, 0x17, 0xf9, 0x24, 0x8a,
+	0x8d, 0x05, 0xbe, 0x9f, 0x1c, 0xab, 0xad, 0x8b, 0x8a, 0x30, 0x5f, 0x26, 0xf7, 0xdf, 0xa1, 0x5e,
+	0x0a, 0xbe, 0xd5, 0x9c, 0xd1, 0xed, 0x84, 0xa1, 0xc4, 0xe4, 0x82, 0x6c, 0xb5, 0xdf, 0x11, 0x24,
+	0x99, 0xd2, 0xb0, 0x2b, 0xf0, 0x20, 0x2f, 0x58, 0x1c, 0x87, 0xb1, 0xc6, 0xd5, 0x1c, 0x64, 0xf6,
+	0xc8, 0x43, 0xc2, 0x33, 0x8b, 0x0d, 0x56, 0xd6, 0x3f, 0x8f, 0xf5, 0x3f, 0xa0, 0xd2, 0x95, 0x1b,
+	0xb9, 0x44, 0x56, 0x06, 0xe8, 0xd3, 0x46, 0x17, 0x6d, 0x82, 0x27, 0x31, 0x84, 0x25, 0x8a, 0x8e,
+	0xee, 0xfe, 0xf2, 0x20, 0x83, 0x80, 0x1a, 0x78, 0x7a, 0x9f, 0x7a, 0x7e, 0x54, 0x37, 0xb4, 0x45,
+	0x6c, 0xfa, 0x3e, 0x49, 0x53, 0x6e, 0xf6, 0x65, 0x72, 0x7f, 0xc4, 0x02, 0xea, 0xa7, 0xc7, 0x95,
+	0xe5, 0x97, 0xf8, 0xd4, 0x08, 0x92, 0x72, 0xf1, 0xcb, 0xe4, 0x01, 0xa8, 0x3b, 0x66, 0xd4, 0xad,
+	0xae, 0x80, 0x60, 0x05, 0x9b, 0x19, 0x51, 0x65, 0x15, 0x63, 0xea, 0xec, 0xd1, 0x20, 0x60, 0x6e,
+	0x65, 0x15, 0xcb, 0xbc, 0x8a, 0x8c, 0xa8, 0x5c, 0xc5, 0x15, 0x72, 0x0f, 0xdf, 0x1b, 0xb6, 0x17,
+	0x09, 0x53, 0x0f, 0x4c, 0xe8, 0x43, 0xb4, 0x84, 0x97, 0x2f, 0xae, 0x75, 0x35, 0x0b, 0xab, 0x7f,
+	0x92, 0x13, 0x6f, 0x47, 0x34, 0x07, 0x9a, 0xdb, 0x64, 0x2e, 0x08, 0x41, 0x91, 0x5a, 0x45, 0xa6,
+	0x7f, 0xae, 0x7b, 0x57, 0xac, 0xd8, 0x7d, 0x35, 0x4c, 0x59, 0x9f, 0xd7, 0x60, 0xbe, 0x42, 0x1e,
+	0x9e, 0x30, 0xa7, 0xf6, 0x20, 0x66, 0x2c, 0xe8, 0xac, 0x71, 0xa6, 0xa8, 0x9b, 0xd9, 0x1e, 0x10,
+	0x99, 0x2f, 0x93, 0x4d, 0x2f, 0x48, 0x46, 0xfb, 0xfb, 0x9e, 0x03, 0x6d, 0xf9, 0x6c, 0xcc, 0xfc,
+	0x7c, 0x62, 0xd6, 0xf9, 0xe2, 0x96, 0x28, 0xb2, 0x79, 0xf9, 0x00, 0x39, 0x3d, 0x4e, 0x02, 0xe7,
+	0x80, 0x39, 0x87, 0x95, 0x33, 0x6b, 0xf0, 0x0a, 0x24, 0x4d, 0x79, 0x62, 0xdf, 0x47, 0x36, 0x7d,
+	0x3a, 0x02, 0x6c, 0x6c, 0x0f, 0xbd, 0x84, 0xb3, 0x78, 0x56, 0xfc, 0x04, 0x67, 0x0e, 0x49, 0x71,
+	0x53, 0x10, 0xc8, 0xd2, 0x9b, 0x7f, 0xb6, 0x45, 0x66, 0x61, 0x5e, 0xc0, 0x04, 0xcd, 0xe2, 0xb5,
+	0x59, 0xf0, 0x19, 0x20, 0x70, 0xb2, 0xc4, 0x6c, 0x20, 0x82, 0x3e, 0x9a, 0xe7, 0x8a, 0x83, 0x79,
+	0x44, 0x50, 0x90, 0xf0, 0x0d, 0x28, 0x7d, 0xa1, 0x0b, 0x1c, 0xda, 0x87, 0x23, 0xdb, 0xf5, 0x78,
+	0x4c, 0x5a, 0x73, 0x96, 0x66, 0x50, 0xeb, 0xd3, 0xb3, 0xe4, 0xec, 0x16, 0x08, 0xb1, 0xc2, 0x7a,
+	0x16, 0x0e, 0x91, 0xab, 0x31, 0xdd, 0x6f, 0x10, 0xd9, 0x2b, 0x3b, 0xca, 0xda, 0xb5, 0xc1, 0x30,
+	0xc5, 0xed, 0x76, 0xa1, 0xca, 0xed, 0x76, 0x41, 0xa5, 0x78, 0xb6, 0x33, 0x5b, 0xa6, 0x78, 0x16,
+	0x84, 0xe7, 0x90, 0x46, 0x89, 0x8d, 0x8b, 0xd3, 0x99, 0x53, 0x48, 0x96, 0x00, 0xbe, 0x05, 0x60,
+	0x38, 0x0f, 0x38, 0xd1, 0x28, 0x8e, 0x59, 0x90, 0xea, 0xa1, 0x3c, 0x24, 0xe3, 0x08, 0x74, 0x73,
+	0x88, 0xf6, 0x78, 0x34, 0x41, 0x8d, 0xe4, 0x2d, 0xf3, 0x36, 0xb9, 0x5a, 0xa0, 0x50, 0x8e, 0x59,
+	0x1a, 0x3e, 0xdb, 0x59, 0x2c, 0x53, 0xbe, 0x0e, 0x08, 0x95, 0x32, 0xf2, 0x9c, 0x43, 0x1e, 0xe4,
+	0x29, 0x50, 0xee, 0x00, 0xc2, 0xec, 0x91, 0x79, 0x17, 0x66, 0x99, 0x0b, 0x86, 0xe5, 0x8b, 0xcf,
+	0x74, 0x9b, 0xae, 0x8b, 0xb0, 0x0c, 0x45, 0xf1, 0xcd, 0x9e, 0x34, 0x5f, 0x37, 0xc9, 0xdc, 0x90,
+	0x46, 0x85, 0xb5, 0xe2, 0xa0, 0x26, 0x8e, 0x51, 0xeb, 0xab, 0x6d, 0x72, 0x62, 0x6b, 0x27, 0x66,
+	0xd8, 0x12, 0x78, 0x32, 0x51, 0x4f, 0x81, 0x70, 0x78, 0xcc, 0x5c, 0x0f, 0x43, 0x8e, 0x89, 0x1d,
+	0x39, 0xa9, 0x56, 0xff, 0x9a, 0x82, 0xdc, 0x71, 0x52, 0xf3, 0x03, 0x64, 0x0e, 0xfb, 0x25, 0x74,
+	0xf4, 0x27, 0x1b, 0x8f, 0xaa, 0xcf, 0xcb, 0x99, 0x17, 0x51, 0x25, 0x4d, 0xa5, 0x1d, 0x7f, 0xba,
+	0x5b, 0xea, 0x52, 0x17, 0xa2, 0xe7, 0x28, 0xf4, 0xfb, 0x9c, 0xd4, 0x34, 0x45, 0x04, 0x61, 0x1e,
+	0x23, 0x2a, 0xf8, 0xff, 0xe6, 0x77, 0xb7, 0xc8, 0x52, 0x46, 0x08, 0xce, 0x44, 0xbe, 0x65, 0xbd,
+	0x60, 0x3f, 0xb4, 0x3d, 0xf7, 0x28, 0x3d, 0xd2, 0xc7, 0xb1, 0x3e, 0x94, 0x4d, 0x6c, 0x23, 0x12,
+	0x78, 0x5b, 0x29, 0x91, 0x1e, 0xf1, 0x59, 0xcb, 0x7c, 0x99, 0x19, 0xf9, 0xad, 0x23, 0xe0, 0x0f,
+	0x43, 0xa5, 0x85, 0x88, 0x1a, 0xaa, 0xf5, 0x4b, 0xfd, 0xb5, 0x9c, 0x0e, 0xa0, 0xd6, 0x1f, 0xce,
+	0x37, 0xd6, 0xdf, 0xb9, 0xf6, 0xcc, 0xde, 0x11, 0x9d, 0x14, 0xd5, 0x12, 0xec, 0xa0, 0xdb, 0x99,
+	0x29, 0xb9, 0xdc, 0xb7, 0xdd, 0x3c, 0x0c, 0x5c, 0xad, 0x8d, 0x72, 0x9c, 0xd4, 0x46, 0x33, 0x2f,
+	0x9e, 0x59, 0xf2, 0xe2, 0x99, 0x5d, 0xb2, 0x18, 0xf3, 0xf8, 0x90, 0x5c, 0x41, 0xb3, 0x5b, 0x0a,
+	0x1b, 0xf5, 0x33, 0x1a, 0x68, 0x98, 0x05, 0x4e, 0x7c, 0x8c, 0x06, 0x12, 0x3a, 0xc2, 0xe7, 0x95,
+	0xfe, 0xad, 0xe6, 0x38, 0xf0, 0x85, 0x5f, 0x24, 0xa6, 0x4e, 0x0c, 0xe1, 0xfa, 0xce, 0x82, 0x52,
+	0xc0, 0xd0, 0x0a, 0xec, 0x8c, 0xf6, 0xcc, 0xfb, 0xc9, 0x52, 0x44, 0xe3, 0xf4, 0x18, 0x27, 0x8f,
+	0x2b, 0x01, 0x8b, 0x08, 0x80, 0xa9, 0x3b, 0x4f, 0x96, 0xee, 0x1c, 0x78, 0x29, 0xf3, 0xbd, 0x24,
+	0xc5, 0x13, 0xbe, 0x7c, 0x44, 0xe6, 0x04, 0xd5, 0x1e, 0x69, 0x32, 0xc9, 0x23, 0xfd, 0x5e, 0x4d,
+	0x6f, 0x47, 0x79, 0x88, 0x2e, 0x6c, 0x30, 0xa3, 0x0b, 0xb1, 0x53, 0x55, 0x65, 0x47, 0x80, 0xf9,
+	0x92, 0x56, 0x98, 0x73, 0xd5, 0x8a, 0xd0, 0x43, 0xf5, 0x54, 0x12, 0xb5, 0x2c, 0xfc, 0x4e, 0xcc,
+	0x2d, 0x72, 0x46, 0x29, 0xeb, 0x60, 0xa7, 0x12, 0x5b, 0xe5, 0xa3, 0x55, 0x9c, 0x8a, 0xfb, 0x73,
+	0xaa, 0x2d, 0x4e, 0x74, 0x39, 0x67, 0xac, 0x2a, 0xef, 0xee, 0xda, 0x04, 0xef, 0xee, 0x7b, 0x08,
+	0x48, 0x03, 0x6e, 0x9e, 0x70, 0xe3, 0x67, 0x1d, 0xc7, 0x6a, 0x96, 0xf7, 0x70, 0x7f, 0x25, 0x12,
+	0x10, 0xf8, 0x55, 0x17, 0xde, 0x35, 0x26, 0x86, 0x77, 0x65, 0xe4, 0x23, 0x0c, 0xd2, 0x38, 0xf4,
+	0x3b, 0x27, 0x0a, 0x91, 0x0f, 0x0e, 0xb6, 0x3e, 0xb3, 0x40, 0x5e, 0x98, 0x62, 0xd4, 0x14, 0x8d,
+	0xd5, 0x3e, 0x4b, 0xa2, 0x30, 0x48, 0x98, 0x79, 0x8e, 0xac, 0xc6, 0x39, 0xb8, 0xe0, 0xd1, 0xd1,
+	0x51, 0xe6, 0x87, 0xc9, 0xb2, 0x02, 0xc0, 0x7d, 0x88, 0x42, 0xfd, 0xae, 0x36, 0x7b, 0x5f, 0xad,
+	0x43, 0x8d, 0x9f, 0x2d, 0xa9, 0xf1, 0xb3, 0x4b, 0xe4, 0xe4, 0xc0, 0xb1, 0x15, 0x4a, 0x3b, 0x01,
+	0xc6, 0x52, 0x63, 0x69, 0x27, 0x06, 0x8e, 0x32, 0xa0, 0x5d, 0x60, 0xa7, 0xf2, 0x16, 0x9f, 0xab,
+	0xdf, 0xe2, 0x67, 0x31, 0x14, 0x04, 0xe2, 0x0c, 0xf7, 0x23, 0xb0, 0x9c, 0x1e, 0xe5, 0xea, 0xcf,
+	0xf3, 0xbf, 0xe6, 0x25, 0xc8, 0x6f, 0xb8, 0x43, 0x63, 0xd7, 0x16, 0x1e, 0x23, 0xc1, 0x64, 0x89,
+	0x88, 0x57, 0x6e, 0x70, 0x2c, 0x97, 0x01, 0x82, 0xb9, 0x40, 0x1e, 0x6f, 0x78, 0xae, 0xcf, 0x4a,
+	0x65, 0xf8, 0x06, 0x35, 0x01, 0x57, 0x28, 0xf1, 0x3e, 0xd2, 0x11, 0xed, 0x68, 0xae, 0x6f, 0x90,
+	0xe5, 0xc5, 0x4c, 0x0a, 0xa0, 0xda, 0xce, 0x3d, 0xe0, 0xdb, 0xee, 0x91, 0xf9, 0x22, 0x39, 0x55,
+	0x2a, 0xcd, 0x5d, 0xe0, 0x6a, 0x36, 0xc5, 0x49, 0xbd, 0x2c, 0xfa, 0xc0, 0xcd, 0x2b, 0xe4, 0xfe,
+	0x52, 0x51, 0x09, 0x70, 0x8f, 0xb4, 0xa4, 0x8a, 0x7b, 0xf5, 0xf2, 0x7d, 0xfe, 0xcb, 0x3d, 0x82,
+	0x9d, 0x24, 0x8a, 0xb8, 0x71, 0x18, 0xd9, 0x28, 0x6e, 0xd4, 0xd8, 0xd4, 0x1a, 0xc7, 0x5e, 0x8d,
+	0xc3, 0xe8, 0x06, 0x48, 0x9a, 0xa7, 0xc8, 0x9a, 0xba, 0xf5, 0xe9, 0xa0, 0xb3, 0xaa, 0x30, 0xc1,
+	0xaa, 0xb2, 0xdb, 0xe9, 0x00, 0xdc, 0x98, 0x3e, 0x1b, 0x50, 0x47, 0x6c, 0x52, 0xd8, 0x78, 0x83,
+	0x98, 0x0e, 0x79, 0xe8, 0x46, 0x8d, 0x51, 0xdd, 0xcb, 0xc9, 0x76, 0x55, 0x2a, 0x0c, 0xe3, 0xbc,
+	0x48, 0xee, 0xd1, 0x8b, 0xc6, 0xe1, 0x08, 0x0c, 0x40, 0x6d, 0x4f, 0x6d, 0x68, 0x24, 0x7d, 0x4e,
+	0x51, 0xb0, 0x67, 0xd7, 0x2b, 0xed, 0xd9, 0xfc, 0xc0, 0x30, 0xca, 0x61, 0x9f, 0xaf, 0xb7, 0x49,
+	0x53, 0x03, 0x43, 0x9e, 0x88, 0x0f, 0x91, 0x45, 0xfc, 0x27, 0x2e, 0xec, 0xc8, 0x0c, 0x0a, 0x6a,
+	0x98, 0xeb, 0xc5, 0xcc, 0x49, 0x31, 0x0c, 0xeb, 0xe9, 0xfe, 0xad, 0x65, 0x8e, 0xba, 0xed, 0x46,
+	0xdb, 0x11, 0x64, 0x0f, 0x29, 0x94, 0x38, 0x65, 0xaa, 0x1f, 0x72, 0x35, 0xa3, 0xc5, 0x89, 0x2a,
+	0x09, 0x84, 0xd9, 0xc6, 0x02, 0x61, 0xee, 0x9d, 0x13, 0x08, 0xf3, 0x45, 0x81, 0x90, 0x6f, 0x6d,
+	0x19, 0xde, 0x53, 0xd3, 0x10, 0xc5, 0xd6, 0x16, 0x87, 0x9a, 0xf5, 0xbf, 0x16, 0xa7, 0x4d, 0xb8,
+	0xe8, 0x41, 0x38, 0x0a, 0x5c, 0xae, 0x3d, 0xfd, 0x7f, 0x2a, 0x07, 0x37, 0xc9, 0x5c, 0x0c, 0x9d,
+	0xd4, 0x8c, 0x05, 0x0e, 0x32, 0x37, 0xc8, 0xdc, 0xa1, 0xe7, 0xfb, 0x5c, 0x09, 0x99, 0xeb, 0xf3,
+	0x1f, 0x66, 0x87, 0x2c, 0xd0, 0x24, 0xf1, 0x92, 0x54, 0xea, 0x8a, 0xf2, 0x27, 0xa4, 0xe5, 0xb8,
+	0x8c, 0xa6, 0x07, 0x5c, 0x6c, 0xcd, 0xf5, 0xc5, 0x2f, 0x80, 0x63, 0xd0, 0x8a, 0x8b, 0xa6, 0xb9,
+	0xbe, 0xf8, 0x05, 0xf5, 0x73, 0xcf, 0xd0, 0x12, 0xaf, 0x3f, 0xf3, 0x45, 0x61, 0xf3, 0x20, 0x9c,
+	0x47, 0x7e, 0xda, 0x21, 0x4a, 0xc7, 0x96, 0x11, 0xd3, 0x47, 0x04, 0x37, 0x52, 0xe0, 0x98, 0x14,
+	0x84, 0xcb, 0x25, 0x87, 0x8d, 0x20, 0x7c, 0x90, 0xa0, 0xd5, 0x60, 0x8b, 0x4e, 0xac, 0x60, 0x6b,
+	0xa8, 0xcb, 0xef, 0xf2, 0x8e, 0x6c, 0x93, 0x05, 0x87, 0x7b, 0x7a, 0x3b, 0xab, 0x77, 0x37, 0xd7,
+	0xc2, 0x41, 0xdc, 0x97, 0xe5, 0x21, 0x57, 0x4f, 0x3b, 0x54, 0xc0, 0x72, 0xeb, 0xac, 0x29, 0x3d,
+	0x33, 0x14, 0x34, 0xda, 0x75, 0xc0, 0x79, 0xe2, 0xb8, 0x1f, 0x71, 0xe7, 0x75, 0x67, 0x5d, 0xa1,
+	0x5f, 0x45, 0xdc, 0x55, 0x81, 0x82, 0xb1, 0xb0, 0x80, 0x0d, 0x8f, 0x6d, 0xbe, 0x32, 0x06, 0x1f,
+	0x0b, 0x82, 0x3e, 0x84, 0xcb, 0xf3, 0x04, 0x59, 0xe7, 0x04, 0x07, 0x8c, 0xba, 0xc9, 0x41, 0x98,
+	0x72, 0xcb, 0x7a, 0xae, 0xbf, 0x86, 0xe0, 0x57, 0x24, 0x14, 0x94, 0x3a, 0x4e, 0xf8, 0xdc, 0x21,
+	0x68, 0xa1, 0x40, 0xb2, 0x88, 0x80, 0xe7, 0x0e, 0x15, 0xe4, 0xa5, 0xc3, 0xa4, 0x73, 0x52, 0x41,
+	0x5e, 0x52, 0x91, 0xcf, 0x1f, 0x26, 0x9d, 0x0d, 0x05, 0xf9, 0xfc, 0x21, 0xda, 0x11, 0xc3, 0x71,
+	0x04, 0xd1, 0x69, 0x80, 0xe3, 0xff, 0xe6, 0x33, 0xc4, 0x48, 0x22, 0xe6, 0xa4, 0x34, 0x0d, 0x63,
+	0x69, 0x79, 0x9e, 0x52, 0x73, 0x32, 0x73, 0x2c, 0xb7, 0x3f, 0x2f, 0x91, 0x93, 0xc5, 0x02, 0x76,
+	0x3a, 0xee, 0xdc, 0xab, 0xea, 0x32, 0x85, 0x32, 0xb7, 0xc6, 0xe6, 0xbb, 0xc9, 0x46, 0xa9, 0x94,
+	0x1f, 0x1c, 0x76, 0x3a, 0x4a, 0x31, 0xb3, 0x50, 0xec, 0x46, 0x70, 0x68, 0x3e, 0x4e, 0xd6, 0x95,
+	0x39, 0xb5, 0xe9, 0x60, 0xd0, 0xb9, 0x0f, 0x7b, 0xbf, 0x9a, 0xcf, 0xeb, 0xe5, 0xc1, 0xc0, 0xbc,
+	0x4d, 0x96, 0xf0, 0xe8, 0xc1, 0x23, 0x7d, 0x13, 0x19, 0xe5, 0x3d, 0x77, 0x29, 0x06, 0xba, 0x70,
+	0x3c, 0x71, 0xf5, 0xdd, 0x15, 0xff, 0x6d, 0x3e, 0x4b, 0x16, 0x25, 0x14, 0xfc, 0xab, 0x52, 0xc1,
+	0x1c, 0x8e, 0x23, 0xcd, 0xa3, 0x2f, 0xcd, 0x95, 0x9b, 0xe3, 0xc8, 0x7a, 0x6b, 0x86, 0x9c, 0x6f,
+	0xd2, 0xb0, 0x0c, 0xdf, 0x99, 0xd7, 0xa4, 0x45, 0xd8, 0x6a, 0xc4, 0xdf, 0xc5, 0x6e, 0x4b, 0x23,
+	0x51, 0xd9, 0x28, 0x33, 0x7f, 0xc4, 0x8d, 0x72, 0x86, 0x2c, 0xc4, 0x8c, 0x7b, 0xe1, 0xb5, 0x04,
+	0x26, 0x01, 0x44, 0x31, 0xc0, 0x30, 0x0b, 0x8b, 0x1f, 0x8b, 0xaa, 0xa2, 0xb5, 0xcc, 0x31, 0xfc,
+	0x60, 0xbc, 0x40, 0x4e, 0xf0, 0x9f, 0xb6, 0xe3, 0x8f, 0x12, 0x71, 0x51, 0x40, 0xcd, 0x41, 0x58,
+	0xe7, 0xe8, 0x2d, 0x8e, 0xdd, 0x76, 0x21, 0xc3, 0x86, 0xee, 0x85, 0x71, 0xca, 0x5c, 0x11, 0x06,
+	0x50, 0xe3, 0x94, 0x2b, 0x02, 0xc5, 0x5d, 0xfe, 0xaf, 0x92, 0x0d, 0xbe, 0x37, 0x59, 0xe0, 0xaa,
+	0x71, 0xbc, 0x45, 0x1c, 0xfd, 0x03, 0xdd, 0x49, 0x11, 0xc0, 0x3e, 0x37, 0x97, 0xaf, 0x05, 0x6e,
+	0x1e, 0xd6, 0x2b, 0x2b, 0x90, 0x4b, 0xb5, 0x0a, 0xa4, 0xf5, 0xb1, 0x19, 0xf2, 0xdc, 0xdd, 0xac,
+	0xf2, 0x0e, 0x8d, 0x53, 0x8f, 0xfa, 0xff, 0xaf, 0x8f, 0x9a, 0x77, 0x90, 0x49, 0xbe, 0x91, 0xdc,
+	0xef, 0x84, 0xc3, 0xc8, 0x67, 0xb0, 0x56, 0xe5, 0x68, 0xea, 0x6c, 0x93, 0x55, 0xe8, 0x64, 0x35,
+	0x14, 0x43, 0xff, 0x77, 0xa3, 0xcd, 0x5b, 0xdd, 0x69, 0x3b, 0x4e, 0x04, 0x64, 0x7a, 0x5b, 0xaf,
+	0x30, 0xdf, 0x0f, 0xad, 0x8f, 0x2d, 0x92, 0xf3, 0x0d, 0x75, 0x32, 0x2c, 0xd0, 0x2c, 0x0d, 0x74,
+	0x97, 0xac, 0xa8, 0x8e, 0xff, 0xe6, 0xeb, 0xa5, 0x69, 0x7f, 0x7d, 0xad, 0x92, 0x52, 0xb0, 0x60,
+	0xa6, 0x51, 0xb0, 0x00, 0x9c, 0x5a, 0xc2, 0x2f, 0x9d, 0x30, 0x27, 0x0c, 0x5c, 0x3d, 0x71, 0x6e,
+	0x4d, 0x20, 0x77, 0x39, 0x0e, 0x33, 0xea, 0x05, 0x79, 0xcc, 0x68, 0x52, 0x9c, 0x6c, 0x81, 0xeb,
+	0x23, 0x0a, 0xe6, 0x62, 0x4c, 0x1d, 0x9b, 0x7b, 0xe8, 0x35, 0xef, 0xe3, 0xd2, 0x98, 0x3a, 0x57,
+	0x10, 0x6c, 0x9e, 0xe7, 0x29, 0xb1, 0xa0, 0x63, 0x2f, 0x08, 0x1b, 0xb9, 0xec, 0x25, 0x91, 0x24,
+	0xe6, 0x7b, 0xc9, 0x8a, 0xa3, 0x64, 0xac, 0x8a, 0x1d, 0x7c, 0x6f, 0xb7, 0x3a, 0x99, 0xb5, 0xaf,
+	0x11, 0x9b, 0x4f, 0x92, 0xf9, 0x21, 0x26, 0x97, 0x76, 0x96, 0xc4, 0xdc, 0x14, 0x33, 0x4e, 0xfb,
+	0x82, 0x00, 0x3c, 0x0e, 0xc3, 0x63, 0xe9, 0x38, 0x15, 0xee, 0x0a, 0x52, 0xe3, 0xae, 0x58, 0x1b,
+	0x1e, 0x0b, 0x47, 0x2a, 0xfe, 0x36, 0xaf, 0x92, 0x53, 0xc5, 0xb2, 0xc2, 0x67, 0xb1, 0x5c, 0xed,
+	0xb3, 0x38, 0xa9, 0x57, 0xc0, 0xfd, 0x16, 0x2f, 0x90, 0x75, 0xa5, 0x16, 0x28, 0x2f, 0x22, 0x17,
+	0xa5, 0xe2, 0xab, 0x59, 0x71, 0xf8, 0x69, 0xf6, 0xc8, 0xbd, 0xa5, 0xe6, 0x51, 0x81, 0x91, 0x61,
+	0x8c, 0xf2, 0x08, 0x36, 0xf4, 0x0e, 0xa0, 0x42, 0x83, 0x99, 0x5b, 0x22, 0x73, 0x7d, 0x1c, 0xa6,
+	0x4c, 0x4f, 0x48, 0xe7, 0x88, 0xd7, 0xc1, 0x91, 0x7f, 0x5e, 0xc9, 0x8b, 0x5d, 0x17, 0x53, 0x54,
+	0xc8, 0x9d, 0x55, 0x72, 0x64, 0x9f, 0x20, 0x2b, 0xf2, 0x4e, 0x11, 0x84, 0x25, 0x3a, 0x27, 0x14,
+	0xae, 0x10, 0xd7, 0x87, 0x6e, 0x00, 0x02, 0x0c, 0x17, 0x41, 0xe8, 0x8c, 0x62, 0xfb, 0x28, 0xea,
+	0x98, 0x65, 0xca, 0xad, 0x51, 0xfc, 0x66, 0x04, 0x39, 0x5d, 0x82, 0xf2, 0x28, 0x12, 0x89, 0x20,
+	0xdc, 0xc6, 0x3a, 0xa9, 0x94, 0x30, 0x39, 0xc9, 0x9b, 0x11, 0xe6, 0x80, 0x5c, 0x2f, 0x79, 0xe8,
+	0x36, 0xa6, 0x7b, 0xe8, 0xac, 0xdf, 0x6a, 0x91, 0x27, 0x2b, 0xb6, 0xa8, 0x18, 0xec, 0x4e, 0xec,
+	0x8d, 0xc1, 0xa6, 0x64, 0x29, 0x98, 0x83, 0x89, 0xf9, 0x2a, 0xd8, 0x66, 0xfc, 0x7f, 0x11, 0xc1,
+	0xbd, 0xd8, 0x6d, 0x5c, 0xba, 0x2b, 0xfe, 0xe9, 0x67, 0x75, 0x6c, 0xfe, 0x09, 0xb2, 0x20, 0x80,
+	0x30, 0x89, 0x02, 0x5c, 0xbe, 0xf3, 0xb6, 0x2c, 0x30, 0x32, 0x0b, 0x55, 0x12, 0x72, 0x1b, 0x5f,
+	0xb5, 0xfe, 0x64, 0x1d, 0x3c, 0xc1, 0xed, 0xdb, 0x9a, 0x5b, 0x9f, 0x97, 0x79, 0x78, 0xbb, 0x99,
+	0xac, 0x7b, 0x93, 0xac, 0x8b, 0x70, 0x78, 0x76, 0x50, 0xbf, 0x4d, 0x71, 0xb7, 0x96, 0xd5, 0xc3,
+	0x4f, 0xf5, 0x0a, 0xd1, 0x35, 0x73, 0x57, 0xa2, 0x6b, 0xb6, 0x56, 0x74, 0xc1, 0x25, 0xb5, 0xa7,
+	0x9a, 0x1c, 0xda, 0x17, 0x7b, 0x5b, 0x1f, 0x82, 0xdb, 0x79, 0x8d, 0xa6, 0xe2, 0xff, 0xc0, 0x29,
+	0x7d, 0x9a, 0xcc, 0x8b, 0xc1, 0xcc, 0x68, 0xf7, 0x09, 0xf8, 0x28, 0xfe, 0x74, 0x8b, 0x3c, 0xdb,
+	0xb4, 0x76, 0x1a, 0x1c, 0x8a, 0x08, 0xba, 0xba, 0x2d, 0x5a, 0x0d, 0x1c, 0xd7, 0xaa, 0x4b, 0xbd,
+	0x5d, 0xe1, 0x52, 0xb7, 0xde, 0x20, 0x8f, 0x55, 0xf4, 0x82, 0x2f, 0x6e, 0x6f, 0xeb, 0xed, 0xb7,
+	0x6c, 0xd9, 0xd3, 0x34, 0x2b, 0x9e, 0x49, 0x14, 0xc2, 0x32, 0x5d, 0xf1, 0xc3, 0x81, 0x68, 0xa6,
+	0x74, 0x65, 0xa9, 0x55, 0x73, 0x65, 0xc9, 0xfa, 0x54, 0xab, 0xb2, 0x05, 0x3e, 0x65, 0xaf, 0x86,
+	0xa9, 0xb7, 0xef, 0xf1, 0xcb, 0x8f, 0xd7, 0xc3, 0xf8, 0x76, 0xc2, 0xe2, 0x1d, 0xce, 0x44, 0xcd,
+	0xd8, 0x21, 0x5f, 0xbb, 0x76, 0x79, 0xed, 0x40, 0xb3, 0xae, 0xe2, 0x6a, 0x09, 0xb4, 0x7e, 0xbf,
+	0x3d, 0x61, 0x56, 0xfb, 0x0c, 0xfc, 0x37, 0x77, 0x73, 0x0b, 0xf5, 0x11, 0x42, 0xe2, 0x28, 0xb5,
+	0xa9, 0x37, 0xdc, 0x0b, 0x53, 0xad, 0x43, 0x4b, 0x71, 0x94, 0x5e, 0x46, 0x30, 0x6a, 0xf3, 0x51,
+	0x6a, 0xdf, 0xa1, 0xbe, 0x7f, 0x40, 0x9d, 0x43, 0xad, 0x63, 0xcb, 0x71, 0x94, 0xbe, 0x21, 0x10,
+	0x20, 0x77, 0x80, 0x30, 0x89, 0x18, 0x73, 0x91, 0x52, 0xdd, 0x6a, 0x50, 0xc7, 0xae, 0xc4, 0xc8,
+	0x3a, 0xe1, 0x80, 0x3b, 0xa0, 0xf1, 0xb0, 0x60, 0x21, 0x44, 0xe9, 0x2d, 0x81, 0x90, 0x75, 0xa6,
+	0xec, 0x28, 0xa5, 0x7b, 0xa3, 0x44, 0xcf, 0xcc, 0x5f, 0x41, 0x4a, 0x81, 0x81, 0xad, 0x0e, 0xa4,
+	0xe3, 0xd0, 0x73, 0x18, 0xa7, 0x5d, 0x50, 0xb7, 0x7a, 0x1c, 0xa5, 0xaf, 0x67, 0x28, 0x8d, 0x7d,
+	0x17, 0xab, 0xd8, 0xf7, 0xcb, 0x2d, 0xf2, 0x78, 0xed, 0x4c, 0x0b, 0x95, 0xe3, 0x6e, 0xa6, 0x7a,
+	0x5a, 0x83, 0x25, 0x25, 0x67, 0xe9, 0x6e, 0x94, 0x9c, 0xd3, 0x64, 0x1e, 0x4d, 0xad, 0x44, 0xf3,
+	0xe7, 0x0a, 0x98, 0xf5, 0x63, 0xd3, 0xb9, 0x86, 0x33, 0xb7, 0x5c, 0x97, 0x28, 0x0c, 0xe3, 0x88,
+	0xc5, 0xfb, 0xfa, 0x19, 0x13, 0x47, 0xe9, 0x8e, 0x40, 0xa0, 0xaf, 0x04, 0x38, 0x67, 0x6f, 0x94,
+	0x78, 0x63, 0x06, 0xf9, 0x8b, 0x7e, 0xa2, 0x31, 0x90, 0x01, 0x0c, 0xa4, 0x62, 0xf1, 0x36, 0x53,
+	0x94, 0xda, 0x7b, 0xd4, 0x1d, 0x86, 0xa9, 0xab, 0xa7, 0x3b, 0xc6, 0x51, 0x7a, 0x85, 0xc3, 0xc1,
+	0xe1, 0x00, 0x64, 0xe0, 0x23, 0xf6, 0x82, 0x01, 0x5f, 0xc8, 0x59, 0xcd, 0x24, 0x8c, 0xd2, 0x1b,
+	0x0a, 0x12, 0x6e, 0x09, 0x42, 0x01, 0x2f, 0x00, 0x2d, 0x26, 0x8c, 0x8f, 0x79, 0x91, 0x39, 0x2d,
+	0x76, 0x12, 0xa5, 0xdb, 0x1a, 0x7a, 0xfa, 0xfa, 0x7f, 0xaa, 0x4d, 0x9e, 0x98, 0x32, 0x67, 0x59,
+	0x9c, 0xe4, 0x69, 0xb2, 0x2e, 0xac, 0x1e, 0x2a, 0xaf, 0xad, 0xaa, 0x66, 0xdb, 0x9a, 0x8a, 0xe4,
+	0xbb, 0x4e, 0xe1, 0x97, 0x76, 0x35, 0xbf, 0x3c, 0x4c, 0x96, 0xb2, 0x4c, 0x16, 0x6d, 0xae, 0x16,
+	0x65, 0xce, 0x0a, 0xee, 0x0d, 0xd1, 0x05, 0xae, 0x11, 0xe8, 0xfb, 0x4d, 0xa0, 0x50, 0x25, 0x78,
+	0x9a, 0xac, 0x67, 0xa4, 0xc2, 0xe5, 0x36, 0xa7, 0xfb, 0xdf, 0x39, 0x52, 0x78, 0xdd, 0x72, 0x76,
+	0x9a, 0xaf, 0x60, 0xa7, 0xcf, 0xb5, 0xc9, 0x85, 0x09, 0x53, 0x83, 0x46, 0xde, 0x1b, 0x32, 0xda,
+	0xc5, 0x6f, 0x8e, 0xe1, 0x0d, 0x9c, 0x98, 0x69, 0xa9, 0xc1, 0xb9, 0xa8, 0x61, 0x32, 0x39, 0xb8,
+	0x10, 0xeb, 0x6d, 0x97, 0x62, 0xbd, 0xaa, 0xeb, 0x7b, 0xa6, 0xd2, 0xf5, 0x3d, 0xe5, 0x72, 0x15,
+	0xae, 0x15, 0x76, 0xd4, 0x96, 0xd9, 0x2a, 0xfa, 0x4c, 0x70, 0xe4, 0x0d, 0x81, 0xab, 0xce, 0x86,
+	0x9b, 0x6f, 0x96, 0x0d, 0x67, 0xfd, 0xc0, 0x2c, 0x31, 0x71, 0x22, 0xe0, 0x5e, 0x6f, 0x16, 0xff,
+	0xd3, 0x97, 0xb6, 0x55, 0xb9, 0xb4, 0xfc, 0x2a, 0x12, 0xba, 0xe3, 0xdb, 0xfa, 0x55, 0x24, 0xf4,
+	0xc3, 0x3f, 0x89, 0x37, 0x54, 0x72, 0x77, 0x98, 0xc6, 0x20, 0x2b, 0xe9, 0x78, 0x37, 0xc3, 0xa8,
+	0x97, 0x9a, 0x66, 0x2b, 0x2e, 0x35, 0xf1, 0x98, 0x2e, 0xbf, 0x86, 0x15, 0xd1, 0x24, 0xb9, 0x13,
+	0xc6, 0xfc, 0x9e, 0xf8, 0x4a, 0x1e, 0xd3, 0xc5, 0x21, 0xec, 0x08, 0x24, 0x04, 0xa1, 0x1d, 0xdf,
+	0x76, 0x19, 0xc6, 0x99, 0x71, 0x6e, 0x8a, 0x51, 0x6b, 0xc3, 0xf1, 0xaf, 0xe6, 0x68, 0x08, 0x5c,
+	0xbf, 0x48, 0x4e, 0x95, 0xcb, 0x94, 0x82, 0xd7, 0x27, 0x8b, 0xe5, 0x20, 0x7e, 0xfd, 0x7f, 0xf9,
+	0xd6, 0x95, 0xb2, 0x36, 0xfa, 0x6d, 0x2b, 0xb9, 0x36, 0xba, 0x24, 0x5f, 0xa9, 0x49, 0x26, 0x50,
+	0xfd, 0xc8, 0xe2, 0x06, 0x70, 0x95, 0x13, 0x67, 0xdb, 0xb5, 0x3e, 0x5b, 0xad, 0x81, 0x6b, 0x9b,
+	0xe9, 0x8f, 0x85, 0x5e, 0xc0, 0xf7, 0x11, 0xfa, 0x3e, 0xce, 0x90, 0x05, 0xe9, 0xf4, 0x50, 0xb9,
+	0x47, 0x02, 0x9b, 0xc9, 0x97, 0x47, 0x08, 0xf9, 0xe6, 0xd0, 0x0b, 0x84, 0x87, 0x4e, 0xe5, 0x9f,
+	0x25, 0x80, 0x73, 0xff, 0xdc, 0x83, 0x64, 0x11, 0x89, 0xbc, 0x28, 0xd2, 0xb8, 0x67, 0x01, 0xa0,
+	0xdb, 0x51, 0x64, 0x5e, 0x26, 0x33, 0xb1, 0xb8, 0x50, 0xf7, 0x36, 0x74, 0x7b, 0x28, 0x0b, 0x5b,
+	0x1a, 0x13, 0x28, 0x87, 0xc9, 0x40, 0x8b, 0xec, 0x64, 0x50, 0xeb, 0xe7, 0x1b, 0x4e, 0x11, 0x3f,
+	0xc3, 0xde, 0xb9, 0x29, 0x9a, 0x2e, 0x6c, 0xb4, 0x9d, 0x3c, 0x5b, 0xb9, 0x93, 0xc1, 0x9e, 0xe6,
+	0x24, 0xb8, 0x9b, 0xb5, 0x4b, 0x1c, 0x1c, 0x81, 0x3b, 0x5a, 0x4c, 0xe4, 0xfc, 0x3b, 0x34, 0x91,
+	0x0b, 0x95, 0x13, 0x79, 0x44, 0xce, 0x89, 0x8a, 0x93, 0xba, 0x79, 0x7c, 0x95, 0xdd, 0xb9, 0xe9,
+	0x25, 0x72, 0x8e, 0xc4, 0x55, 0x82, 0xd2, 0x1c, 0x09, 0x38, 0xbf, 0xbf, 0xee, 0xd0, 0x61, 0x44,
+	0xbd, 0x41, 0x20, 0x93, 0x6b, 0xb2, 0xe1, 0x49, 0xc4, 0xb6, 0x6b, 0xfd, 0x52, 0x9b, 0x9c, 0xaf,
+	0x68, 0x3a, 0x33, 0x44, 0x50, 0xab, 0x3e, 0x7e, 0x73, 0x87, 0x87, 0x8c, 0x99, 0x5b, 0x79, 0xbd,
+	0xa6, 0xd5, 0xec, 0x7a, 0x4d, 0x53, 0xf6, 0x97, 0xce, 0x92, 0x23, 0xfd, 0x7c, 0x5d, 0x12, 0xf0,
+	0x37, 0xf1, 0x80, 0x95, 0x44, 0xdc, 0x6f, 0xa1, 0x1d, 0xb0, 0x02, 0xc5, 0x1d, 0x17, 0x4f, 0x93,
+	0xf5, 0x51, 0x34, 0x88, 0xa9, 0xcb, 0x5c, 0x79, 0x23, 0x4d, 0x3b, 0x56, 0x24, 0x52, 0xdc, 0x48,
+	0x7b, 0x3f, 0xe9, 0x94, 0xae, 0xde, 0x50, 0x3e, 0x74, 0xed, 0xc8, 0x3d, 0x55, 0xb8, 0x79, 0x73,
+	0x99, 0xd3, 0x58, 0x6f, 0xb5, 0xc8, 0xc3, 0x15, 0x3c, 0x92, 0x9d, 0xba, 0x60, 0x96, 0xbc, 0x53,
+	0x67, 0x6e, 0x8f, 0xdc, 0x73, 0x47, 0x1e, 0x62, 0x76, 0x9e, 0xe7, 0x25, 0x6f, 0x6e, 0x9c, 0xec,
+	0x96, 0x8f, 0xb8, 0xfe, 0xc9, 0x3b, 0x25, 0x18, 0x86, 0x92, 0xe0, 0xe6, 0x42, 0x00, 0x73, 0x04,
+	0x07, 0x52, 0x38, 0xd2, 0xf7, 0xc3, 0xba, 0xc4, 0xde, 0xe2, 0x48, 0xeb, 0xbf, 0xb5, 0xa6, 0x6f,
+	0x7c, 0xae, 0x1e, 0x27, 0x3b, 0x71, 0xb8, 0xef, 0xf9, 0x8c, 0x27, 0x3a, 0xc8, 0x21, 0xdb, 0xb6,
+	0xcb, 0xa2, 0x98, 0x39, 0x34, 0x65, 0xfa, 0xf0, 0x4f, 0x66, 0xc3, 0xbf, 0x9a, 0x11, 0x98, 0xcf,
+	0x93, 0x7b, 0x95, 0x89, 0xd0, 0xca, 0xf2, 0x49, 0xd9, 0xc8, 0x27, 0x45, 0x29, 0xa6, 0xb3, 0xdb,
+	0x4c, 0x35, 0xbb, 0xa1, 0xaa, 0xc6, 0xbb, 0x55, 0xc1, 0x49, 0x02, 0x85, 0x9c, 0x64, 0xfd, 0x60,
+	0xf5, 0xd2, 0x16, 0xc6, 0xd9, 0x68, 0x69, 0xdf, 0x24, 0x86, 0xe8, 0x82, 0x1d, 0xf1, 0x72, 0xf2,
+	0xae, 0xce, 0xd3, 0xdd, 0xbb, 0xf1, 0x6f, 0xf7, 0xd7, 0x45, 0x35, 0xa2, 0xf5, 0xc4, 0xfa, 0xf5,
+	0x36, 0x39, 0x5f, 0xdb, 0xc9, 0xd7, 0xc6, 0x2c, 0xc6, 0xf5, 0xdf, 0xa2, 0x09, 0x13, 0xd6, 0xf7,
+	0x69, 0x32, 0xef, 0xd0, 0x84, 0x15, 0x2f, 0x74, 0x72, 0x18, 0xdc, 0x1b, 0x4c, 0x46, 0xa8, 0xce,
+	0x14, 0xa7, 0x30, 0x03, 0x9b, 0x8f, 0x12, 0xb2, 0x1f, 0x53, 0x47, 0x49, 0x3c, 0x58, 0xcd, 0x6e,
+	0x2f, 0x64, 0xf0, 0x82, 0x45, 0x3b, 0xd7, 0xcc, 0xa2, 0x9d, 0x6f, 0x6c, 0xd1, 0x2e, 0x34, 0xb6,
+	0x68, 0x17, 0xeb, 0x2c, 0xda, 0xdc, 0x01, 0xb0, 0x54, 0xe1, 0xbc, 0xf9, 0x95, 0x19, 0x72, 0xa1,
+	0xd9, 0xc4, 0x5e, 0x4e, 0x12, 0x6f, 0x10, 0xe0, 0x7b, 0x1b, 0x93, 0x27, 0xf7, 0x0c, 0x59, 0x80,
+	0xff, 0xc0, 0xe5, 0xa1, 0xbd, 0xa3, 0x24, 0x80, 0xe2, 0xac, 0x74, 0x3d, 0x47, 0x4f, 0xfd, 0x90,
+	0x40, 0x58, 0x9c, 0xfc, 0x55, 0x0d, 0xfd, 0x7e, 0xbd, 0x04, 0x9b, 0x5d, 0xb2, 0x9e, 0x1e, 0xc4,
+	0x61, 0x9a, 0xfa, 0x4c, 0xfa, 0x2f, 0xb4, 0x9d, 0x5d, 0x40, 0xea, 0x0b, 0x3e, 0xdf, 0x64, 0xc1,
+	0x17, 0x6a, 0x16, 0xdc, 0x22, 0x4b, 0xc1, 0x68, 0x88, 0xb9, 0x05, 0x89, 0x36, 0xe9, 0x39, 0xd8,
+	0x3c, 0x4f, 0xd6, 0x64, 0x09, 0x41, 0xa8, 0x4e, 0x7d, 0x01, 0x67, 0x5e, 0x24, 0x27, 0xf0, 0xe5,
+	0x98, 0x43, 0x27, 0x0c, 0xc6, 0x22, 0xb5, 0x57, 0xcb, 0x64, 0x28, 0xa3, 0x95, 0x45, 0x5d, 0xae,
+	0x58, 0xd4, 0xa0, 0xe9, 0x66, 0x81, 0xe8, 0xcc, 0x28, 0x99, 0xb2, 0x9e, 0xa0, 0x96, 0x20, 0x5d,
+	0xe1, 0x74, 0xcb, 0xa0, 0xd6, 0x1e, 0x39, 0xb3, 0x25, 0xb7, 0x2f, 0x75, 0x95, 0x36, 0xae, 0x8d,
+	0x3d, 0x97, 0x05, 0x0e, 0x6b, 0x74, 0x51, 0x37, 0xef, 0x45, 0xbb, 0xdc, 0x0b, 0x6b, 0x54, 0x79,
+	0xa3, 0x2c, 0x93, 0x1b, 0xe0, 0x96, 0xb9, 0x99, 0xf0, 0x37, 0xe5, 0xf4, 0x16, 0xda, 0x9e, 0x9b,
+	0x5d, 0x03, 0x50, 0xbb, 0x8f, 0x10, 0x60, 0xc6, 0x88, 0x1e, 0xfb, 0x21, 0xe5, 0x72, 0x40, 0x5a,
+	0x21, 0x12, 0x68, 0xbd, 0x56, 0xd9, 0x6c, 0x16, 0xbf, 0x9b, 0xdc, 0xac, 0x49, 0x66, 0x69, 0x3c,
+	0xe0, 0x32, 0x70, 0xa5, 0x8f, 0xff, 0x5b, 0xbf, 0x56, 0xed, 0xde, 0x97, 0x71, 0xd9, 0xfe, 0x28,
+	0xe8, 0x67, 0xb9, 0x69, 0x89, 0xf9, 0x26, 0x11, 0x4a, 0x1b, 0xe6, 0x11, 0xcc, 0xdc, 0x4d, 0x1e,
+	0x41, 0x39, 0xbf, 0xb2, 0xaf, 0xd4, 0x55, 0x1b, 0xad, 0x9e, 0x7d, 0x7b, 0xd1, 0x6a, 0xeb, 0xcf,
+	0x2d, 0x90, 0xfb, 0xb6, 0xae, 0x39, 0x61, 0x00, 0xd9, 0x78, 0x3b, 0x31, 0x83, 0x7b, 0x81, 0x80,
+	0xb8, 0xe2, 0x87, 0xce, 0x61, 0xd3, 0xf5, 0xf7, 0x52, 0x36, 0x2c, 0xae, 0x3f, 0x87, 0xe1, 0x5d,
+	0x0b, 0xb6, 0xcf, 0xaf, 0x9c, 0x69, 0xee, 0x09, 0x09, 0x85, 0xfd, 0x1b, 0x51, 0x2f, 0x48, 0x39,
+	0x8d, 0x26, 0xb0, 0x73, 0x38, 0xee, 0x1c, 0x1a, 0x43, 0x18, 0x69, 0x4e, 0xdb, 0x39, 0x08, 0x03,
+	0x76, 0xf8, 0xc8, 0x88, 0xfa, 0x80, 0x56, 0xa5, 0x84, 0x04, 0xc2, 0x38, 0xb0, 0xae, 0x3b, 0x8c,
+	0xc6, 0x9a, 0x88, 0xc8, 0xc1, 0x19, 0x4d, 0xc2, 0x98, 0xab, 0x4b, 0x88, 0x0c, 0x6c, 0x5e, 0x22,
+	0x26, 0x24, 0x90, 0x30, 0x9a, 0xb2, 0x18, 0x53, 0x8d, 0x90, 0x3d, 0x55, 0x29, 0x51, 0x81, 0x07,
+	0xb9, 0x92, 0x41, 0xcb, 0xd9, 0x94, 0x05, 0x1c, 0xcc, 0x87, 0x33, 0x4a, 0xd2, 0x70, 0x88, 0x0f,
+	0x2e, 0x2d, 0xab, 0xf7, 0x22, 0x73, 0xb8, 0xf9, 0x7e, 0xd8, 0xdd, 0x70, 0x4d, 0x32, 0x96, 0xd9,
+	0xce, 0x56, 0xb7, 0x76, 0x1d, 0xbb, 0xbb, 0x9c, 0xb4, 0x9f, 0x95, 0xe1, 0xcf, 0x35, 0x0a, 0x57,
+	0x97, 0xf6, 0xa2, 0x47, 0x0e, 0x86, 0x39, 0x0f, 0x63, 0x6f, 0xe0, 0x05, 0x5a, 0x78, 0x4f, 0xc0,
+	0xf8, 0x9c, 0xb3, 0x04, 0x38, 0x63, 0x5d, 0x9f, 0x73, 0x04, 0xc2, 0x38, 0x20, 0xff, 0x45, 0xc8,
+	0x3b, 0x35, 0xa5, 0x51, 0x81, 0x03, 0xd5, 0x70, 0x94, 0x78, 0x0e, 0x5f, 0x7d, 0x35, 0x91, 0x52,
+	0x81, 0xa3, 0xcd, 0x22, 0x39, 0x44, 0x0d, 0xf5, 0x65, 0xd0, 0xcd, 0x2f, 0xb6, 0xc8, 0x82, 0x18,
+	0x25, 0x88, 0x8d, 0xc4, 0x0f, 0xf5, 0x27, 0x65, 0x10, 0x02, 0xc7, 0xbe, 0x98, 0x81, 0x92, 0xce,
+	0x2f, 0xe0, 0xe2, 0xa1, 0x25, 0xe0, 0x13, 0xf5, 0xee, 0x10, 0x42, 0xf8, 0x6b, 0x0d, 0xd4, 0xd7,
+	0x6f, 0x0d, 0x71, 0x10, 0x74, 0x31, 0x0e, 0xd3, 0x3c, 0x89, 0x51, 0xa2, 0x33, 0x28, 0xba, 0x58,
+	0x3c, 0xae, 0xfe, 0xe9, 0xee, 0x32, 0x0f, 0x74, 0x3f, 0xcb, 0x27, 0x17, 0x27, 0x09, 0x98, 0x5c,
+	0xba, 0xa8, 0xa1, 0x05, 0xf3, 0xdd, 0x64, 0x11, 0x77, 0x5a, 0x9e, 0x82, 0xbc, 0x59, 0xcf, 0x07,
+	0xfd, 0x8c, 0xd6, 0x7a, 0x95, 0x6c, 0x40, 0x6b, 0x98, 0x83, 0xeb, 0x1c, 0x06, 0xe1, 0x1d, 0x9f,
+	0xb9, 0x03, 0xe6, 0x6a, 0xf5, 0xb5, 0xee, 0xa2, 0xbe, 0x9f, 0x68, 0x91, 0x17, 0x26, 0x49, 0x5c,
+	0xa8, 0xa2, 0x54, 0x9c, 0x6b, 0xa0, 0x30, 0x31, 0x11, 0x85, 0x7c, 0xda, 0x44, 0x3f, 0xc8, 0x10,
+	0xb8, 0x9b, 0xa3, 0xa9, 0x2e, 0x61, 0x10, 0x78, 0x39, 0x47, 0xeb, 0xd6, 0x37, 0x47, 0x5f, 0xcd,
+	0xd1, 0xc3, 0xce, 0x6c, 0x09, 0x7d, 0xd3, 0x8a, 0xc9, 0x7b, 0xee, 0xbe, 0xdb, 0xc2, 0x9f, 0xfb,
+	0x76, 0xe7, 0xea, 0xfb, 0x5b, 0xe4, 0xc5, 0x8a, 0x46, 0x0b, 0xd7, 0x7d, 0xea, 0x56, 0x5c, 0xf5,
+	0x2b, 0xb5, 0xaa, 0xfc, 0x4a, 0xaa, 0xc8, 0x6d, 0x57, 0x8a, 0xdc, 0x33, 0x99, 0x2d, 0xe2, 0xb9,
+	0xdc, 0x3e, 0xcb, 0x4d, 0x39, 0xcf, 0x4d, 0xac, 0xe7, 0xeb, 0x43, 0x67, 0xe0, 0x52, 0x17, 0xeb,
+	0x26, 0xb2, 0x02, 0x6e, 0x78, 0x63, 0x06, 0x97, 0x8f, 0x13, 0xeb, 0x4d, 0x72, 0xb1, 0x41, 0x31,
+	0x49, 0x2f, 0xa2, 0x62, 0x4d, 0xce, 0x98, 0x86, 0x35, 0xf7, 0x99, 0x03, 0xf7, 0x2b, 0x13, 0x16,
+	0x63, 0x7f, 0x1a, 0xd5, 0x7c, 0xab, 0x51, 0xcd, 0xf9, 0xd2, 0xf0, 0x9a, 0xcf, 0x90, 0x05, 0xf9,
+	0xdc, 0x5f, 0xf9, 0xc6, 0x9f, 0xe7, 0x5a, 0x9f, 0x6c, 0x91, 0x67, 0x1a, 0x54, 0x7b, 0x7d, 0xe4,
+	0xfb, 0x50, 0x21, 0xba, 0x7b, 0x15, 0x8f, 0x74, 0xab, 0xca, 0x23, 0x6d, 0x91, 0xa5, 0x70, 0x94,
+	0x3a, 0xe1, 0xb0, 0xa8, 0x6a, 0xe5, 0xe0, 0xfc, 0x11, 0x83, 0x99, 0xf2, 0x03, 0x3d, 0xbf, 0xd3,
+	0x26, 0x0f, 0x54, 0xdd, 0x32, 0xcb, 0x1d, 0xce, 0x0d, 0x7a, 0x80, 0xff, 0xa2, 0x97, 0x58, 0x77,
+	0xf4, 0x48, 0xb0, 0xb9, 0x45, 0xcc, 0xcc, 0xa6, 0xe7, 0xe5, 0x72, 0x0d, 0xa8, 0xd2, 0x05, 0x50,
+	0x41, 0x6e, 0x7e, 0x23, 0x39, 0xc1, 0x95, 0xec, 0x94, 0xa6, 0x89, 0xcd, 0xd3, 0xf1, 0x3b, 0xb3,
+	0x8d, 0x7c, 0x5e, 0xa5, 0xb4, 0x46, 0x23, 0xaf, 0xe9, 0x06, 0x56, 0x64, 0xde, 0x26, 0xab, 0x39,
+	0x8c, 0xfa, 0xbe, 0xb8, 0x80, 0x75, 0xd7, 0x35, 0xeb, 0xb5, 0x58, 0x1f, 0x6f, 0x91, 0x27, 0x26,
+	0xdf, 0xe2, 0xeb, 0x81, 0x6f, 0xb9, 0xc9, 0x9b, 0xb6, 0xda, 0xab, 0x40, 0x73, 0xfa, 0xab, 0x40,
+	0x8f, 0x93, 0x65, 0x27, 0x8c, 0x63, 0xe6, 0xa4, 0x70, 0x73, 0xb2, 0x33, 0xa3, 0xe4, 0x35, 0xaa,
+	0x08, 0xeb, 0x7f, 0xce, 0x90, 0x47, 0x1a, 0x74, 0x07, 0x16, 0x1d, 0x7d, 0xde, 0x85, 0x2d, 0x22,
+	0x81, 0xd9, 0xcb, 0x8f, 0xed, 0xd2, 0xcb, 0x8f, 0x1d, 0x32, 0x8b, 0x6f, 0xf9, 0xaa, 0x09, 0xe7,
+	0x08, 0x81, 0x68, 0x01, 0xf4, 0x41, 0x77, 0x8e, 0x68, 0xc1, 0x39, 0x44, 0x2b, 0xde, 0x91, 0xf7,
+	0x93, 0x39, 0x9e, 0x05, 0xc5, 0xd7, 0xe2, 0x6c, 0xb7, 0xe1, 0x4c, 0xf6, 0x79, 0x31, 0xc8, 0x57,
+	0x96, 0x97, 0x6b, 0x65, 0xce, 0xfa, 0x62, 0xc2, 0xaf, 0xd4, 0xa2, 0xa5, 0x06, 0xed, 0x81, 0x14,
+	0x86, 0x4d, 0xef, 0x23, 0xff, 0x2e, 0x68, 0xcf, 0x83, 0x16, 0xd1, 0x90, 0x60, 0x09, 0x40, 0xe0,
+	0x4b, 0xde, 0xb1, 0x45, 0x2d, 0x1f, 0x44, 0x45, 0xc1, 0xe5, 0x08, 0xe1, 0x9b, 0x8b, 0x30, 0xf6,
+	0xe3, 0x1c, 0xea, 0x29, 0x9f, 0x1c, 0xb9, 0x03, 0xe1, 0x1e, 0xe7, 0xd0, 0xfc, 0x20, 0xa4, 0xc4,
+	0x3b, 0x87, 0xf2, 0x42, 0xeb, 0xb9, 0x26, 0x43, 0xed, 0x42, 0xc9, 0xa4, 0xcf, 0x0b, 0x6e, 0x3e,
+	0x4c, 0xe6, 0xf0, 0x37, 0xe4, 0xe9, 0x03, 0x44, 0xde, 0x77, 0x9c, 0xeb, 0xcb, 0x9f, 0xd6, 0x17,
+	0x5a, 0xe4, 0xb1, 0xc9, 0xf5, 0x8a, 0xd7, 0x71, 0xd1, 0xc4, 0x66, 0x8e, 0x92, 0x45, 0x9a, 0x6b,
+	0x44, 0x12, 0xfc, 0xb6, 0x78, 0xe0, 0x7d, 0x64, 0x1e, 0x59, 0x48, 0x3e, 0x0a, 0xf1, 0x68, 0x93,
+	0x71, 0xf6, 0x45, 0x19, 0xeb, 0xab, 0x2d, 0x62, 0x4d, 0xa6, 0x47, 0x89, 0x75, 0x85, 0x2c, 0x8a,
+	0x5e, 0xca, 0x9c, 0x90, 0xc7, 0xbb, 0x8d, 0x86, 0xdd, 0xcf, 0xca, 0x55, 0x5e, 0x3e, 0x6c, 0xff,
+	0x51, 0x2e, 0x1f, 0xce, 0x34, 0xbb, 0x7c, 0x68, 0xfd, 0x5c, 0x5b, 0x3e, 0x2f, 0x54, 0x7d, 0x48,
+	0x98, 0x67, 0xc9, 0xca, 0x30, 0x19, 0x08, 0x3f, 0x5d, 0x61, 0x75, 0x34, 0x8c, 0x7e, 0xd2, 0xb5,
+	0xab, 0xed, 0xb4, 0x47, 0xa5, 0x4d, 0x8a, 0xec, 0x3f, 0x53, 0x0e, 0x30, 0x20, 0xdf, 0xbf, 0x47,
+	0x9c, 0x01, 0x4c, 0xae, 0xdb, 0x99, 0xee, 0xc4, 0x43, 0xa3, 0x2f, 0xc9, 0xcd, 0x27, 0xc9, 0x82,
+	0x78, 0x49, 0xb7, 0x33, 0x57, 0x3d, 0x03, 0x12, 0x6f, 0x7e, 0x48, 0xbd, 0xb7, 0xa5, 0xa8, 0xae,
+	0x8f, 0x74, 0xa7, 0xaf, 0x79, 0xbf, 0x50, 0x14, 0xce, 0xb5, 0x33, 0x55, 0x6e, 0x93, 0xfc, 0xc2,
+	0x75, 0xe9, 0x86, 0xfe, 0x6a, 0xf1, 0x86, 0xfe, 0x80, 0x9c, 0x42, 0xc6, 0x13, 0x7e, 0x6b, 0x94,
+	0xcd, 0x7c, 0x93, 0xb6, 0xeb, 0x93, 0xf3, 0x94, 0x16, 0xba, 0xc8, 0xbd, 0x38, 0x29, 0x30, 0x52,
+	0xd8, 0x9d, 0xfd, 0x93, 0x83, 0x12, 0x2c, 0xd9, 0xfc, 0xd1, 0x16, 0x31, 0xcb, 0xb4, 0xe5, 0x4d,
+	0x38, 0x57, 0xde, 0x84, 0x8a, 0xa0, 0x56, 0x8f, 0x05, 0x09, 0xc4, 0xd7, 0x74, 0x33, 0x33, 0x3b,
+	0x3b, 0x34, 0x32, 0xeb, 0x59, 0x5c, 0x4c, 0x9d, 0x2d, 0x1e, 0x37, 0x9a, 0x05, 0x3f, 0x57, 0xb6,
+	0xe0, 0xad, 0x5f, 0x6e, 0x93, 0xcd, 0x8a, 0xd1, 0x5f, 0xa7, 0x41, 0x4a, 0x93, 0xe3, 0xe9, 0x73,
+	0xfb, 0xb2, 0x14, 0xed, 0x7c, 0x2a, 0x9f, 0xe8, 0xd6, 0x57, 0xd6, 0x15, 0x7f, 0x15, 0xc9, 0xbe,
+	0x69, 0x93, 0x65, 0x01, 0x85, 0x57, 0x20, 0x27, 0xbc, 0x0c, 0x81, 0x9f, 0x07, 0x10, 0x6f, 0xe4,
+	0xcf, 0xe5, 0x9f, 0x07, 0x70, 0x0e, 0x95, 0xf1, 0xcd, 0x94, 0xc7, 0xb7, 0x19, 0x91, 0x65, 0xa5,
+	0xd9, 0x46, 0x4b, 0xf1, 0x32, 0x99, 0xe3, 0x8f, 0x55, 0x36, 0x1f, 0x12, 0x74, 0xbe, 0xcf, 0x4b,
+	0x59, 0xcf, 0x90, 0x13, 0x5b, 0x70, 0xf1, 0xd1, 0xdb, 0x1b, 0xa5, 0xcc, 0x86, 0x42, 0xc1, 0x00,
+	0x96, 0x8f, 0xfb, 0x06, 0xd4, 0x7c, 0x33, 0x0e, 0xb2, 0x36, 0x49, 0x87, 0xb7, 0x70, 0x2b, 0xec,
+	0x6d, 0xf5, 0x19, 0x78, 0xb8, 0x44, 0xce, 0x7a, 0x62, 0xfd, 0x76, 0x9b, 0x5b, 0x72, 0x59, 0xe3,
+	0x6f, 0x30, 0x1f, 0xb4, 0x41, 0x7c, 0xf0, 0x3b, 0x0d, 0x63, 0xc6, 0xef, 0x63, 0x1e, 0xd0, 0xe4,
+	0xa0, 0x90, 0xfb, 0x0e, 0x48, 0xb0, 0x54, 0x5e, 0xa1, 0xc9, 0x81, 0xf9, 0x7e, 0x72, 0x2f, 0xec,
+	0x7f, 0xfe, 0xd6, 0x9c, 0x03, 0xe6, 0x8d, 0x33, 0x4a, 0xbd, 0x31, 0xf3, 0x75, 0x8f, 0x4a, 0x1d,
+	0x11, 0x26, 0x08, 0x78, 0x43, 0x66, 0xef, 0x7b, 0x71, 0x92, 0xca, 0x27, 0xeb, 0x88, 0xe6, 0xdb,
+	0xf5, 0x86, 0xec, 0x3a, 0x60, 0xc5, 0xbb, 0x75, 0x5d, 0x62, 0xf8, 0x34, 0x11, 0xcf, 0xce, 0x8b,
+	0x02, 0xda, 0x65, 0x4f, 0xc0, 0xde, 0xca, 0xdf, 0xb9, 0x3b, 0x4f, 0xd6, 0x91, 0xde, 0x8b, 0xb2,
+	0xeb, 0x7b, 0xaa, 0xdf, 0x62, 0x15, 0x90, 0xd9, 0x9d, 0x74, 0x90, 0x76, 0x83, 0xc4, 0x09, 0xc3,
+	0x43, 0x0f, 0xd4, 0x65, 0x53, 0x59, 0x77, 0x05, 0x0e, 0xa6, 0xd2, 0x28, 0xf0, 0x3e, 0x32, 0x02,
+	0x9a, 0x93, 0x6a, 0xe8, 0x56, 0x42, 0xad, 0xaf, 0x57, 0x67, 0x49, 0x72, 0x03, 0xf1, 0x75, 0xca,
+	0x2f, 0xbf, 0xaa, 0xf6, 0x99, 0x7c, 0xef, 0x19, 0x57, 0xce, 0x2e, 0x3d, 0x9a, 0xbd, 0x84, 0x70,
+	0x7c, 0xc7, 0xfe, 0x61, 0xc2, 0x7f, 0xd8, 0x5e, 0x50, 0x78, 0x6b, 0x02, 0xc1, 0xdb, 0x41, 0xaa,
+	0x44, 0x85, 0x61, 0xc8, 0x55, 0x42, 0x1b, 0xc6, 0x5b, 0x0c, 0x1e, 0xcf, 0xd6, 0x04, 0x8f, 0x1f,
+	0x21, 0xab, 0xce, 0x41, 0x78, 0xc8, 0x5c, 0x7b, 0x0f, 0x94, 0x1d, 0x2e, 0xa7, 0x97, 0xfa, 0x2b,
+	0x1c, 0x88, 0x56, 0x6a, 0x62, 0x7d, 0xb2, 0x7a, 0xc0, 0x5c, 0xfb, 0xad, 0x1d, 0x70, 0x13, 0x47,
+	0xe1, 0x19, 0x42, 0xc0, 0x22, 0xa6, 0xc1, 0x40, 0x06, 0x98, 0x56, 0xfb, 0x0a, 0x24, 0xdb, 0xda,
+	0x33, 0x45, 0x6f, 0xaf, 0x75, 0x9e, 0x9c, 0xab, 0xe8, 0x52, 0xcf, 0xdb, 0x07, 0x9d, 0x3e, 0x7b,
+	0xd6, 0x4e, 0x18, 0x55, 0xd6, 0xa7, 0x67, 0xc8, 0x53, 0x8d, 0xc8, 0x85, 0x19, 0xaf, 0x1f, 0x8c,
+	0xad, 0x9a, 0x83, 0xf1, 0x12, 0x39, 0xc9, 0x39, 0x95, 0xc5, 0x5e, 0xe8, 0x66, 0x49, 0xc5, 0xea,
+	0x61, 0x8b, 0x3b, 0x60, 0x07, 0xf1, 0x32, 0xaf, 0x18, 0xb6, 0x45, 0x98, 0x52, 0xdf, 0x1e, 0x40,
+	0xeb, 0xf6, 0xc0, 0x1b, 0x17, 0x8c, 0xb3, 0x75, 0x44, 0x63, 0xdf, 0x7a, 0x80, 0x84, 0x00, 0x91,
+	0x2c, 0x01, 0x59, 0x08, 0xda, 0x62, 0x2e, 0x0b, 0x62, 0x40, 0x98, 0x7f, 0x3c, 0x7f, 0x20, 0x93,
+	0x9f, 0xb7, 0x97, 0xbb, 0x77, 0x31, 0xea, 0x2e, 0x20, 0x14, 0xb8, 0xfe, 0x8c, 0xe6, 0xe6, 0xeb,
+	0x64, 0xa3, 0x8a, 0xa0, 0xd1, 0x3a, 0x6f, 0x92, 0x39, 0x1c, 0xad, 0xfe, 0x5a, 0x1e, 0x82, 0xac,
+	0x03, 0xf2, 0x64, 0xed, 0x6e, 0xda, 0x1d, 0xed, 0x0d, 0xbd, 0x74, 0x37, 0xbf, 0x3c, 0x00, 0x69,
+	0x13, 0xd9, 0xeb, 0xf8, 0x7a, 0x02, 0x94, 0x7c, 0x17, 0xbf, 0x43, 0x66, 0xf1, 0xfe, 0x81, 0xda,
+	0x14, 0x42, 0xac, 0x1d, 0x72, 0xae, 0x96, 0x8d, 0x2f, 0xf6, 0xb6, 0xe4, 0x06, 0xf6, 0x3d, 0x8c,
+	0x3b, 0x36, 0x71, 0x15, 0xfc, 0xb5, 0x36, 0x79, 0x61, 0x52, 0x95, 0x3b, 0xa3, 0x98, 0xc9, 0x6d,
+	0x82, 0xd5, 0x42, 0xea, 0x2f, 0xf5, 0xfc, 0x51, 0xdc, 0xa8, 0x7e, 0x3c, 0xc0, 0x68, 0x7a, 0xa0,
+	0x6b, 0xd9, 0x00, 0x01, 0x0c, 0xc4, 0x53, 0x75, 0x2d, 0x1b, 0x20, 0x70, 0xe7, 0xd7, 0x89, 0x1d,
+	0x8d, 0x49, 0x00, 0x00, 0x25, 0x32, 0x89, 0x9f, 0x1d, 0x86, 0x00, 0x81, 0x12, 0xbe, 0x78, 0x52,
+	0x4d, 0x22, 0x00, 0x80, 0x1f, 0x68, 0xa1, 0xce, 0xa1, 0x1d, 0x8c, 0x86, 0x7b, 0x2c, 0xd6, 0x1e,
+	0xf9, 0x21, 0x80, 0x78, 0x15, 0xe1, 0xf0, 0x1a, 0x25, 0x92, 0x41, 0xeb, 0x32, 0x29, 0x52, 0xa3,
+	0xbb, 0xee, 0xf9, 0xf0, 0xe0, 0xf7, 0x0f, 0xb7, 0xc8, 0xb9, 0x89, 0x81, 0x1f, 0x5d, 0x91, 0x2f,
+	0x38, 0x54, 0x56, 0x0b, 0x0e, 0x15, 0xc0, 0xa3, 0x39, 0x57, 0x50, 0x6f, 0x25, 0x50, 0x4f, 0xff,
+	0x9a, 0xa9, 0x4c, 0xff, 0xea, 0x70, 0x9b, 0xdb, 0x73, 0xb9, 0x66, 0xbb, 0xda, 0x97, 0x3f, 0xad,
+	0x8f, 0xb6, 0xc8, 0xea, 0xd6, 0xee, 0x6b, 0xe0, 0xb9, 0xdb, 0x0a, 0x47, 0x11, 0x1a, 0x45, 0xb8,
+	0x13, 0x8e, 0xb3, 0xee, 0x2c, 0x7e, 0xf4, 0x73, 0x0f, 0xb4, 0x44, 0x97, 0x38, 0x02, 0xb4, 0x8a,
+	0x8a, 0x37, 0x85, 0x05, 0x0c, 0x12, 0x3d, 0xd8, 0x51, 0xe4, 0x89, 0xd4, 0x0d, 0xe0, 0xb1, 0xce,
+	0x8c, 0x72, 0xeb, 0x7f, 0x2d, 0x47, 0x5e, 0xa5, 0x29, 0xb3, 0x3e, 0xd1, 0x22, 0xc6, 0xd6, 0xee,
+	0x6b, 0x18, 0x97, 0x91, 0x59, 0x2a, 0xd0, 0x0b, 0x5d, 0xf5, 0x57, 0x7a, 0x21, 0x10, 0x90, 0x2c,
+	0x21, 0x6c, 0xcf, 0x98, 0x41, 0x72, 0x7a, 0xf1, 0xb1, 0x7d, 0x61, 0x99, 0xf6, 0x25, 0x12, 0xe4,
+	0x8b, 0xfa, 0x1e, 0xaa, 0x9e, 0xa6, 0xbd, 0x97, 0xbf, 0x81, 0x8a, 0xcf, 0xcb, 0x6f, 0xed, 0xbe,
+	0xb6, 0xc3, 0xe2, 0x24, 0x0c, 0x28, 0xe8, 0xe5, 0xe2, 0xdb, 0x2e, 0xc5, 0x5b, 0x3a, 0xad, 0xba,
+	0x5b, 0x3a, 0xc5, 0x54, 0xe5, 0xf6, 0xdd, 0xa4, 0x2a, 0xc3, 0x8b, 0x37, 0x3e, 0x1b, 0xd3, 0x94,
+	0xe1, 0xbb, 0x53, 0x29, 0xd3, 0x5c, 0x1d, 0xab, 0x12, 0x07, 0xae, 0x18, 0x66, 0xbd, 0x97, 0xdc,
+	0x2f, 0x02, 0x5f, 0xfc, 0x42, 0x1b, 0x38, 0xd5, 0xec, 0xdd, 0xd1, 0x5e, 0xe2, 0xc4, 0xde, 0x1e,
+	0x66, 0x15, 0xf0, 0x27, 0x25, 0x75, 0xff, 0x32, 0x87, 0x59, 0x97, 0xc9, 0x03, 0xe5, 0xc2, 0xb7,
+	0x83, 0x24, 0x2b, 0xfe, 0x10, 0x59, 0x04, 0xc9, 0xee, 0xb3, 0x7d, 0xfd, 0x31, 0xa4, 0x0c, 0x6a,
+	0xfd, 0xa9, 0x16, 0x39, 0x55, 0xae, 0x03, 0xc6, 0x39, 0xb9, 0x6d, 0x54, 0x53, 0xe8, 0x90, 0x41,
+	0x45, 0x43, 0x5d, 0x60, 0x2a, 0x70, 0xa0, 0x1a, 0x26, 0x11, 0x8b, 0x71, 0xc9, 0x0a, 0x0f, 0xc0,
+	0x66, 0x70, 0xeb, 0xc3, 0x95, 0xd9, 0xdb, 0x8a, 0x77, 0x5f, 0x5e, 0x40, 0x38, 0x4b, 0x56, 0x68,
+	0x0e, 0xd5, 0xb5, 0x5c, 0x0d, 0x63, 0x7d, 0xa1, 0xda, 0x8f, 0x99, 0xb9, 0xc7, 0x65, 0x72, 0x4d,
+	0x0c, 0xcc, 0x38, 0x60, 0x5b, 0xa1, 0x17, 0x68, 0xee, 0xe7, 0x56, 0xa5, 0xfb, 0xd9, 0x22, 0x4b,
+	0x22, 0xcf, 0xa9, 0xe8, 0xc9, 0xcc, 0xc0, 0x70, 0x88, 0x1c, 0x84, 0xa3, 0x42, 0xca, 0x2a, 0x07,
+	0x41, 0xff, 0x23, 0xd1, 0x62, 0x29, 0x61, 0x55, 0xc3, 0x58, 0xdf, 0x44, 0xce, 0x4e, 0xea, 0x3e,
+	0xff, 0xac, 0xcb, 0xed, 0x00, 0x63, 0x9d, 0x93, 0x17, 0x6a, 0x83, 0xb4, 0xc3, 0x44, 0xd3, 0xd2,
+	0xda, 0x61, 0x62, 0x6d, 0x93, 0xa7, 0x6a, 0xeb, 0xbf, 0x15, 0x66, 0xf3, 0x73, 0x8d, 0x33, 0xab,
+	0x78, 0x47, 0x76, 0xa0, 0x2b, 0x19, 0x1c, 0x64, 0x7d, 0x13, 0x79, 0x78, 0x62, 0x55, 0x5b, 0x07,
+	0x34, 0x9d, 0xee, 0xfb, 0x07, 0x1d, 0x8a, 0x15, 0x1e, 0xe1, 0x42, 0x88, 0xb5, 0x57, 0x59, 0x3f,
+	0x98, 0x14, 0xbc, 0x0d, 0xac, 0xbf, 0xd1, 0x15, 0x85, 0xfa, 0x36, 0xfe, 0x24, 0x79, 0xb4, 0x76,
+	0x0c, 0x97, 0x47, 0x29, 0x7c, 0xa8, 0x61, 0x2b, 0x74, 0xd9, 0x54, 0x69, 0xdf, 0x21, 0xb3, 0x8e,
+	0xfc, 0x56, 0x46, 0xd6, 0x02, 0x40, 0xac, 0x1b, 0x65, 0x5b, 0xbf, 0x17, 0xa6, 0xe3, 0xdd, 0xe3,
+	0xc0, 0x11, 0x1f, 0x80, 0x39, 0x47, 0x66, 0x45, 0x16, 0x20, 0x08, 0x9b, 0x53, 0xdd, 0xad, 0x6b,
+	0xf8, 0xc9, 0x3b, 0x9d, 0xaa, 0x8f, 0x34, 0xd6, 0xaf, 0xce, 0x90, 0xfb, 0x77, 0xc4, 0xc7, 0xc7,
+	0x1c, 0xea, 0x5f, 0xf5, 0x06, 0x5e, 0x4a, 0xfd, 0x5d, 0x6f, 0x10, 0xe0, 0xb7, 0x3f, 0xd0, 0x16,
+	0x94, 0x3f, 0x3a, 0x2d, 0x25, 0xcf, 0x60, 0x29, 0x51, 0x69, 0xa6, 0xba, 0x5e, 0xe0, 0x05, 0x8e,
+	0x92, 0xd7, 0x85, 0x83, 0xe0, 0xe5, 0x0c, 0x16, 0xb8, 0x51, 0xc8, 0x4f, 0xa5, 0x76, 0x5f, 0xfc,
+	0x32, 0x37, 0x31, 0x7d, 0x23, 0x4e, 0xa3, 0x90, 0xeb, 0x77, 0xed, 0x7e, 0xf6, 0x1b, 0x5e, 0xd5,
+	0x88, 0xbd, 0xc1, 0x41, 0x8a, 0x9e, 0xce, 0x76, 0x9f, 0xff, 0x80, 0x33, 0x30, 0x3d, 0xca, 0xbf,
+	0x82, 0xa2, 0xec, 0xbc, 0xf4, 0x48, 0xe4, 0x13, 0xaa, 0x71, 0xd4, 0xc5, 0xaa, 0x38, 0x2a, 0xec,
+	0x82, 0x03, 0x2f, 0xdd, 0x0b, 0xf5, 0xd7, 0x82, 0x04, 0x0c, 0x76, 0x9e, 0x13, 0x33, 0x14, 0xd0,
+	0x38, 0x1e, 0xa2, 0x04, 0x3a, 0x35, 0x0c, 0x4c, 0x0b, 0x7c, 0xaa, 0x29, 0xc2, 0x40, 0xac, 0x9a,
+	0xec, 0x92, 0x83, 0x81, 0x9b, 0xd3, 0x98, 0x3a, 0xcc, 0x2e, 0x7c, 0x35, 0x69, 0x01, 0xa1, 0xdb,
+	0x2e, 0xcc, 0x0d, 0x7f, 0xfe, 0x1b, 0x2f, 0xa7, 0xb6, 0xfb, 0xe2, 0x97, 0x1a, 0x49, 0x5d, 0xab,
+	0x88, 0xa4, 0x7e, 0xcb, 0x84, 0x5d, 0xaf, 0x2c, 0x37, 0xac, 0xb3, 0x79, 0x41, 0x63, 0x97, 0xd3,
+	0xdd, 0x09, 0xec, 0xc0, 0x99, 0x66, 0x72, 0xbe, 0x83, 0xf5, 0x1d, 0xad, 0x09, 0x8d, 0xdf, 0x08,
+	0x07, 0xa0, 0x12, 0xa6, 0xd4, 0xbf, 0x86, 0x6f, 0xc1, 0xc2, 0x24, 0xc1, 0x3f, 0xc8, 0xec, 0xda,
+	0x6e, 0xcb, 0xc0, 0x18, 0x94, 0xe1, 0xdf, 0x6a, 0xd4, 0xd3, 0xb2, 0x04, 0x10, 0xf0, 0xc8, 0x66,
+	0xf1, 0xb1, 0xa6, 0x1e, 0x4a, 0xa0, 0x15, 0x11, 0xab, 0xb6, 0x3f, 0x3b, 0xa1, 0x8f, 0x37, 0xbd,
+	0xf1, 0x84, 0x8c, 0x42, 0xdf, 0x2f, 0x6c, 0x48, 0x01, 0x03, 0x7e, 0x03, 0x5d, 0x8d, 0x1b, 0x6d,
+	0x4b, 0x7d, 0xfe, 0x43, 0xf9, 0x44, 0xd3, 0x8c, 0xf6, 0x89, 0xa6, 0xef, 0x6a, 0x57, 0x67, 0x26,
+	0xe2, 0xf3, 0x72, 0x7d, 0x36, 0xf0, 0x92, 0x94, 0xc5, 0x35, 0xa9, 0x37, 0xf0, 0x0d, 0x01, 0x16,
+	0x17, 0xbe, 0x21, 0xc0, 0x62, 0x80, 0xd3, 0x48, 0x37, 0x80, 0x01, 0x80, 0xf0, 0x58, 0xbf, 0x41,
+	0x06, 0x00, 0x80, 0x07, 0x7b, 0x7a, 0xae, 0x08, 0x00, 0x00, 0x3e, 0x88, 0x23, 0xcd, 0xa5, 0x01,
+	0x00, 0x94, 0xcc, 0xe8, 0xd1, 0x59, 0xd0, 0x24, 0x33, 0x80, 0x60, 0xd3, 0x64, 0x77, 0x40, 0xb4,
+	0x9b, 0x05, 0x12, 0xaa, 0x6b, 0x9f, 0x4b, 0x55, 0xda, 0xa7, 0xf5, 0x33, 0x2d, 0xf2, 0x60, 0xed,
+	0xa4, 0xf0, 0x0f, 0xa9, 0xc9, 0xc1, 0xb7, 0x6a, 0x06, 0xdf, 0xae, 0x19, 0xfc, 0x4c, 0x71, 0xf0,
+	0x26, 0x99, 0x1d, 0xc4, 0x91, 0x54, 0x73, 0xf1, 0x7f, 0x6d, 0x10, 0x73, 0xd3, 0x07, 0x31, 0x5f,
+	0x39, 0x88, 0xef, 0x69, 0x93, 0xc7, 0xa7, 0x0c, 0x82, 0xdf, 0x0c, 0x82, 0x6c, 0xe0, 0xcc, 0x3a,
+	0x6d, 0xd5, 0xa7, 0x92, 0x56, 0x94, 0x2c, 0x7c, 0xd0, 0x61, 0xf3, 0xb3, 0x2d, 0xf9, 0x70, 0x67,
+	0x2d, 0xc7, 0xc0, 0x8a, 0xb6, 0x8b, 0x2b, 0xda, 0xc0, 0x22, 0x10, 0xf3, 0x3a, 0x5b, 0x33, 0xaf,
+	0x73, 0x15, 0x4c, 0xe5, 0x87, 0x8e, 0xce, 0x3c, 0x7e, 0xe8, 0x58, 0x6c, 0xc2, 0xd2, 0x6e, 0x07,
+	0x63, 0xaf, 0x99, 0x7d, 0x09, 0x3b, 0xd9, 0x0f, 0xf7, 0xf6, 0x8e, 0x8b, 0x36, 0x8e, 0x00, 0x5a,
+	0x1f, 0x6f, 0x4f, 0xba, 0x61, 0x6e, 0xcb, 0xa8, 0x7c, 0x08, 0x22, 0x0c, 0x7c, 0xec, 0xd9, 0x07,
+	0x2b, 0xf9, 0x02, 0x3c, 0xdb, 0x6d, 0x5c, 0xb8, 0x5b, 0xf8, 0xa0, 0x65, 0xc1, 0x45, 0xd2, 0xae,
+	0x76, 0x91, 0x6c, 0xfa, 0x64, 0x9e, 0x17, 0x6c, 0x34, 0x5c, 0x48, 0x98, 0x49, 0x9d, 0x10, 0x9a,
+	0xd0, 0xd3, 0x14, 0x24, 0x14, 0xf3, 0x8f, 0xf8, 0xc7, 0x16, 0x55, 0xd5, 0x5f, 0xc0, 0x60, 0x3a,
+	0xce, 0x35, 0x51, 0xbe, 0x6e, 0x71, 0x05, 0xee, 0x12, 0x39, 0x49, 0x47, 0xe9, 0x41, 0x18, 0x7b,
+	0xdf, 0x82, 0x16, 0x85, 0x12, 0x7e, 0x9d, 0x97, 0xfe, 0x9b, 0x9c, 0x60, 0x57, 0x44, 0x62, 0xdf,
+	0x4d, 0x36, 0x94, 0x52, 0xfc, 0xab, 0x97, 0xf2, 0xa1, 0x34, 0x69, 0xcb, 0x99, 0x39, 0x05, 0xb7,
+	0x90, 0xb6, 0xf9, 0xdb, 0x83, 0xd9, 0x03, 0xec, 0x79, 0x6b, 0x33, 0x6a, 0x6b, 0x39, 0x81, 0x6c,
+	0xed, 0x25, 0x72, 0x4a, 0x2d, 0xe5, 0xc6, 0xd9, 0x0b, 0x73, 0xea, 0x57, 0x58, 0x37, 0x94, 0x82,
+	0x6e, 0x2c, 0x5e, 0x98, 0xb3, 0x5e, 0x97, 0x42, 0x57, 0x2a, 0x74, 0x58, 0xe9, 0x55, 0xf1, 0x0e,
+	0x9d, 0x98, 0x04, 0xfe, 0x99, 0x49, 0x8f, 0xfa, 0x38, 0x1c, 0xa1, 0xee, 0x1a, 0x8a, 0x62, 0x63,
+	0xe4, 0x68, 0x5e, 0xc4, 0x3a, 0x3b, 0xe1, 0x7e, 0xab, 0x98, 0xe1, 0xd7, 0xf6, 0xf7, 0xe1, 0xab,
+	0xa7, 0xdf, 0x3a, 0xe9, 0xe8, 0x13, 0xa4, 0xbb, 0xe1, 0x68, 0xcc, 0x02, 0x2f, 0x56, 0x4e, 0xd1,
+	0x56, 0x45, 0xd6, 0xa0, 0x92, 0x89, 0xd0, 0xae, 0xca, 0x44, 0x50, 0x14, 0xc8, 0x99, 0xaa, 0xfc,
+	0x0b, 0x7b, 0xc2, 0xa5, 0x4c, 0xc1, 0xf2, 0x57, 0xa8, 0x4f, 0x21, 0xc5, 0xf5, 0x34, 0x99, 0xa7,
+	0x43, 0x00, 0xe8, 0x1d, 0xe1, 0x30, 0xd8, 0xf2, 0xc5, 0x84, 0x68, 0x00, 0x58, 0xc3, 0x09, 0x0d,
+	0xe0, 0xc6, 0x87, 0x8b, 0x46, 0xf8, 0xea, 0xe7, 0xdb, 0xd9, 0xfa, 0xb3, 0xc5, 0xad, 0xff, 0x53,
+	0x2d, 0xf2, 0xd8, 0xe4, 0xf6, 0xde, 0xa0, 0x31, 0x9a, 0xf7, 0xd7, 0x8b, 0x72, 0xf7, 0x7c, 0xb7,
+	0x51, 0xc1, 0xa2, 0xd8, 0xbd, 0x29, 0xa5, 0x6e, 0xc3, 0xad, 0x7c, 0x87, 0xc6, 0x41, 0xf9, 0x65,
+	0x5d, 0x09, 0xb5, 0xfc, 0xca, 0x7c, 0xdc, 0x5d, 0xc6, 0xc3, 0xa8, 0xd7, 0xe9, 0x38, 0x8c, 0x41,
+	0x4c, 0x16, 0x0c, 0x83, 0xd9, 0xa2, 0x61, 0xf0, 0x18, 0x59, 0xf6, 0x12, 0x7b, 0x5f, 0x90, 0x77,
+	0xda, 0x8a, 0x58, 0x20, 0x5e, 0x22, 0xab, 0xb1, 0x76, 0x2b, 0x13, 0xa9, 0x7b, 0x85, 0xd6, 0x12,
+	0x29, 0xf5, 0xd0, 0xec, 0xf1, 0x7d, 0x1e, 0xfb, 0xe5, 0x39, 0x68, 0x8b, 0xd9, 0x20, 0x7d, 0x1f,
+	0x0b, 0x25, 0xd6, 0x8f, 0xd4, 0x7c, 0xc6, 0xa3, 0xa2, 0x56, 0xe1, 0x58, 0x6e, 0x52, 0x2d, 0xf8,
+	0x39, 0xbe, 0x39, 0x09, 0x83, 0x6c, 0x50, 0x89, 0xc6, 0x6c, 0xab, 0x80, 0xcb, 0xaa, 0x86, 0x8b,
+	0x06, 0x9c, 0x58, 0x7c, 0x9c, 0x50, 0x53, 0xf9, 0x56, 0x90, 0x56, 0x60, 0xce, 0xfd, 0xe4, 0x13,
+	0x84, 0x5c, 0xdb, 0x4a, 0x06, 0x61, 0x0f, 0x3a, 0x6d, 0x9e, 0x21, 0xf7, 0x1d, 0xda, 0xd7, 0xca,
+	0xdd, 0xbf, 0x42, 0x13, 0x66, 0x7c, 0xbc, 0x67, 0x9e, 0x23, 0x8f, 0x55, 0xe2, 0x8b, 0xdf, 0xfe,
+	0x30, 0xbe, 0xbd, 0x67, 0x3e, 0x49, 0x1e, 0x9d, 0x4e, 0x1b, 0x46, 0xc6, 0x77, 0xf4, 0xcc, 0x77,
+	0x93, 0x67, 0xa7, 0x91, 0x96, 0xbe, 0x72, 0x61, 0x7c, 0x67, 0xcf, 0xbc, 0x44, 0x9e, 0x99, 0x56,
+	0xae, 0xf0, 0xa0, 0xbe, 0xf1, 0x89, 0x9e, 0xf9, 0x3c, 0xb9, 0xd0, 0xa0, 0x94, 0xf6, 0x62, 0x86,
+	0xf1, 0xc9, 0x9e, 0xf9, 0x7e, 0xf2, 0xe2, 0xd4, 0xf1, 0xd4, 0x25, 0x67, 0x1b, 0xdf, 0xd5, 0xb4,
+	0x59, 0xed, 0x2a, 0x9e, 0xf1, 0xdd, 0x8d, 0x8a, 0x15, 0x73, 0x8e, 0x8c, 0xef, 0xe9, 0x99, 0xcf,
+	0x91, 0x6e, 0xc3, 0x29, 0x15, 0xef, 0x54, 0x19, 0xdf, 0xdb, 0xa8, 0x90, 0x7e, 0x97, 0xc7, 0xf8,
+	0xf3, 0x8d, 0x0a, 0xe9, 0xaf, 0x93, 0x19, 0x7f, 0xe1, 0xee, 0x26, 0x43, 0xbc, 0x15, 0x63, 0x7c,
+	0xaa, 0x67, 0x5e, 0x24, 0x4f, 0x37, 0x6b, 0x4b, 0x3c, 0xaa, 0x62, 0x7c, 0xfa, 0xee, 0x96, 0x5b,
+	0xbc, 0x3b, 0x66, 0xfc, 0xc5, 0x9e, 0xf9, 0x02, 0xb9, 0x38, 0xbd, 0x58, 0xf1, 0x53, 0x47, 0xc6,
+	0x5f, 0xea, 0x99, 0xef, 0x21, 0xcf, 0x35, 0x67, 0xaf, 0xec, 0xe1, 0x12, 0xe3, 0xfb, 0x7a, 0xe6,
+	0x4b, 0xe4, 0xf9, 0x69, 0x25, 0x2b, 0x5f, 0x23, 0x31, 0x3e, 0x53, 0x5f, 0x76, 0xda, 0x3b, 0x23,
+	0xc6, 0xf7, 0xf7, 0xcc, 0xf3, 0xe4, 0x89, 0xca, 0xb2, 0xe5, 0x87, 0x40, 0x8c, 0xbf, 0xdc, 0x8c,
+	0x9a, 0xb7, 0x6a, 0x7c, 0xb6, 0x67, 0x3e, 0x4d, 0xce, 0x4e, 0xa0, 0xd6, 0x9e, 0xbe, 0x30, 0x7e,
+	0xa0, 0x67, 0x76, 0xc9, 0x93, 0x53, 0x2b, 0xcf, 0x36, 0xd5, 0x0f, 0xd6, 0x33, 0x44, 0x45, 0xf5,
+	0x1f, 0x1e, 0xb1, 0xf8, 0xd8, 0xf8, 0x2b, 0xf5, 0x53, 0x55, 0x57, 0x26, 0x6b, 0xef, 0x87, 0x7a,
+	0xe6, 0x59, 0xf2, 0x48, 0x65, 0x59, 0xfd, 0xae, 0xa4, 0xf1, 0xb9, 0x7a, 0xb6, 0x9b, 0x70, 0xdf,
+	0xd0, 0xf8, 0x7c, 0x7d, 0x03, 0x05, 0xca, 0xbf, 0x5a, 0xbf, 0xef, 0x6a, 0xaf, 0xcd, 0x19, 0x7f,
+	0xbd, 0x9e, 0xab, 0x27, 0x5e, 0x09, 0x33, 0x7e, 0xe4, 0xae, 0x5a, 0xe3, 0xf7, 0x8e, 0x8c, 0xbf,
+	0x51, 0x7f, 0x5c, 0x14, 0x2f, 0xf6, 0x18, 0x7f, 0xb3, 0x9e, 0xb6, 0x78, 0x1b, 0xc7, 0xf8, 0x5b,
+	0x3d, 0xf3, 0x02, 0x79, 0xaa, 0x7e, 0xa3, 0x94, 0xee, 0xd9, 0x18, 0x3f, 0xda, 0x33, 0x5f, 0x24,
+	0x97, 0x26, 0x97, 0xa8, 0x4e, 0xa3, 0x36, 0x7e, 0xac, 0x7e, 0x3f, 0x4f, 0x7c, 0xa1, 0xe2, 0xa2,
+	0xf1, 0xb7, 0x7b, 0xe6, 0x23, 0xe4, 0x4c, 0x7d, 0xa3, 0x90, 0xbb, 0x65, 0xfc, 0x9d, 0x29, 0x9b,
+	0x7e, 0x42, 0x1e, 0xb5, 0xf1, 0xe3, 0x53, 0x46, 0x55, 0x9f, 0xf2, 0x6c, 0xfc, 0x44, 0xfd, 0xd1,
+	0x59, 0xff, 0xbd, 0x31, 0xe3, 0xef, 0x4e, 0x9b, 0x8b, 0xba, 0xb8, 0xbf, 0xf1, 0xf7, 0x7a, 0xe6,
+	0x07, 0xc9, 0x7b, 0x2b, 0x4b, 0x36, 0x4b, 0x67, 0x37, 0x7e, 0xb2, 0xf9, 0x60, 0x0b, 0x59, 0xd8,
+	0xc6, 0xdf, 0x9f, 0xa2, 0x5f, 0xd4, 0x64, 0x5a, 0x1b, 0xff, 0xa0, 0x67, 0x3e, 0x4b, 0xce, 0x57,
+	0x4f, 0x52, 0x75, 0x4e, 0x81, 0xf1, 0x0f, 0xeb, 0x05, 0x52, 0x5d, 0x84, 0xdd, 0xf8, 0x42, 0xfd,
+	0xac, 0x4e, 0xce, 0xa6, 0x30, 0x7e, 0xaa, 0x67, 0x3e, 0x48, 0x36, 0x65, 0xc9, 0x72, 0xd2, 0x8f,
+	0xf1, 0xc5, 0xfa, 0x9d, 0x52, 0x1d, 0x4b, 0x37, 0x7e, 0xba, 0x7e, 0xcc, 0x35, 0x31, 0x71, 0xe3,
+	0x1f, 0x35, 0x5f, 0x99, 0x42, 0x4e, 0xbf, 0xf1, 0x33, 0xf5, 0x4a, 0x55, 0x93, 0x70, 0xb9, 0xf1,
+	0xb3, 0xf5, 0xe5, 0x9b, 0xdc, 0x28, 0x31, 0x7e, 0xae, 0x67, 0x7e, 0x80, 0xbc, 0xf4, 0x76, 0xca,
+	0x8b, 0xb5, 0xfb, 0xc7, 0xf5, 0x13, 0x5c, 0xfd, 0x26, 0x9f, 0xf1, 0xf3, 0x3d, 0xf3, 0x29, 0xf2,
+	0x78, 0xcd, 0x90, 0xd3, 0x9b, 0xc7, 0xf2, 0xb9, 0x43, 0x5c, 0xe0, 0x5f, 0xa8, 0xef, 0x5f, 0x3d,
+	0xd3, 0x2b, 0xf9, 0x86, 0xc6, 0x97, 0xa6, 0x68, 0xad, 0x50, 0xc1, 0xed, 0x08, 0x78, 0xa6, 0xba,
+	0xfc, 0x97, 0x7b, 0xe6, 0xe3, 0xe4, 0xe1, 0xca, 0xf2, 0xf8, 0x99, 0x99, 0xdd, 0xd1, 0x70, 0x48,
+	0xe3, 0x63, 0xe3, 0x17, 0x1b, 0x1e, 0x77, 0xfa, 0xd3, 0x23, 0xc6, 0x3f, 0xb9, 0x8b, 0x62, 0xf9,
+	0x73, 0x1c, 0xc6, 0x3f, 0xad, 0x67, 0xd2, 0x9a, 0xd7, 0x27, 0x8c, 0x5f, 0xaa, 0x3f, 0xc0, 0x6a,
+	0x5f, 0x8d, 0x30, 0xfe, 0xd9, 0x04, 0x01, 0x50, 0x9d, 0xa0, 0x60, 0xfc, 0x72, 0xcf, 0xb4, 0xc8,
+	0x03, 0xb2, 0x48, 0x65, 0x98, 0xd9, 0xf8, 0x4a, 0xcf, 0x7c, 0x94, 0x3c, 0x58, 0x45, 0xa3, 0x44,
+	0x93, 0x8d, 0x7f, 0xde, 0x33, 0x1f, 0x20, 0x9d, 0x2a, 0x2a, 0x38, 0x71, 0x8d, 0x5f, 0xa9, 0xd7,
+	0xc3, 0xca, 0xa1, 0x5c, 0xe3, 0x5f, 0xd4, 0x8b, 0xc0, 0x49, 0x41, 0x5a, 0xe3, 0x57, 0xeb, 0x15,
+	0xb2, 0x6c, 0x06, 0xf2, 0xb7, 0x61, 0x13, 0xe3, 0xd7, 0x7a, 0xe6, 0x33, 0xe4, 0xdc, 0xe4, 0x76,
+	0xd4, 0x68, 0xaa, 0xf1, 0xeb, 0xf5, 0x02, 0x53, 0x64, 0x44, 0xca, 0xd3, 0x0f, 0x8b, 0xe3, 0xf2,
+	0xff, 0x46, 0xfd, 0xb2, 0x88, 0x32, 0xfc, 0xbc, 0x52, 0x8a, 0xfc, 0x66, 0xbd, 0xe2, 0x53, 0xf0,
+	0x84, 0xb9, 0x9a, 0x27, 0xcc, 0xf8, 0x97, 0xd3, 0x58, 0xad, 0xe4, 0x41, 0x34, 0x7e, 0x6b, 0x9a,
+	0x52, 0x5a, 0x8e, 0xf8, 0x1a, 0xff, 0x4a, 0x63, 0x09, 0xbd, 0x7f, 0x38, 0xbd, 0x10, 0x76, 0x35,
+	0x7e, 0x7b, 0xc2, 0x6a, 0x64, 0x54, 0x0a, 0xa3, 0xfd, 0x4e, 0xbd, 0x74, 0x52, 0xe8, 0x55, 0xa6,
+	0xfb, 0xdd, 0x7a, 0xe9, 0x54, 0x0a, 0xcf, 0x1a, 0xff, 0xba, 0x5e, 0x59, 0xd5, 0xc3, 0xa8, 0xc6,
+	0xef, 0x4d, 0x63, 0x8b, 0x42, 0xb8, 0xcd, 0xf8, 0xea, 0xb4, 0x02, 0x85, 0x10, 0x99, 0xf1, 0x56,
+	0xbd, 0xbb, 0xa1, 0x10, 0xc3, 0x32, 0xfe, 0xcd, 0x04, 0x1d, 0x5b, 0x8b, 0x3d, 0x19, 0xff, 0xb6,
+	0x5e, 0x29, 0xe5, 0x94, 0xb7, 0x83, 0x58, 0xd2, 0xfe, 0xbb, 0x7a, 0x49, 0xa9, 0x46, 0x2f, 0x8c,
+	0xdf, 0x9f, 0x46, 0xc7, 0x23, 0x01, 0xc6, 0xbf, 0x9f, 0x7a, 0xb2, 0x14, 0x9c, 0xf1, 0xc6, 0x7f,
+	0x98, 0x66, 0x99, 0xa9, 0xcf, 0x64, 0x1a, 0x7f, 0x30, 0xcd, 0x32, 0xd3, 0x9c, 0xb6, 0xc6, 0x7f,
+	0x9c, 0x66, 0x99, 0xe9, 0xee, 0x52, 0xe3, 0x3f, 0x4d, 0xa3, 0xd7, 0xbd, 0x9f, 0xc6, 0x7f, 0x9e,
+	0xd6, 0x79, 0xd5, 0x09, 0x69, 0x7c, 0xbd, 0x91, 0xa9, 0x5c, 0xf9, 0x24, 0xba, 0xf1, 0x87, 0xf5,
+	0x8b, 0x5a, 0xf4, 0x33, 0x1a, 0xff, 0x65, 0xc2, 0xa9, 0x51, 0xe7, 0x25, 0x34, 0xfe, 0xeb, 0x04,
+	0xdd, 0xba, 0xd6, 0x09, 0x68, 0xfc, 0xf7, 0x69, 0x1c, 0x5f, 0xf0, 0x8c, 0x1b, 0xff, 0xa3, 0x77,
+	0xc5, 0xfa, 0xd2, 0xd7, 0xce, 0xb4, 0xbe, 0xf2, 0xb5, 0x33, 0xad, 0xdf, 0xfb, 0xda, 0x99, 0xd6,
+	0x27, 0xde, 0x3a, 0xf3, 0xae, 0xaf, 0xbc, 0x75, 0xe6, 0x5d, 0xbf, 0xf9, 0xd6, 0x99, 0x77, 0xbd,
+	0xd2, 0xfa, 0x86, 0x99, 0x61, 0x32, 0xf8, 0x68, 0xeb, 0x5d, 0xff, 0x7b, 0x00, 0x85, 0x19, 0xcc,
+	0xde, 0xfc, 0x8f, 0x00, 0x00,
 }
 
 func (m *GameServerPing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -11698,26 +11703,31 @@ func (m *GameServerPing) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *GameServerPing) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameServerPing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ping))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ip))
-	dAtA[i] = 0x28
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Instances))
-	return i, nil
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ip))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ping))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
 }
 
 func (m *DataCenterPing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -11725,24 +11735,29 @@ func (m *DataCenterPing) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *DataCenterPing) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataCenterPing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xd
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.DataCenterId))
-	i += 4
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64((uint32(m.Ping)<<1)^uint32((m.Ping>>31))))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.DataCenterId))
+	i--
+	dAtA[i] = 0xd
+	return len(dAtA) - i, nil
 }
 
 func (m *DetailedSearchStatistic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -11750,26 +11765,31 @@ func (m *DetailedSearchStatistic) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *DetailedSearchStatistic) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetailedSearchStatistic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SearchTimeAvg))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayersSearching))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SearchTimeAvg))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *TournamentPlayer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -11777,43 +11797,53 @@ func (m *TournamentPlayer) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *TournamentPlayer) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentPlayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerNick)))
-	i += copy(dAtA[i:], m.PlayerNick)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerName)))
-	i += copy(dAtA[i:], m.PlayerName)
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerDob))
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerFlag)))
-	i += copy(dAtA[i:], m.PlayerFlag)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerLocation)))
-	i += copy(dAtA[i:], m.PlayerLocation)
-	dAtA[i] = 0x3a
-	i++
+	i -= len(m.PlayerDesc)
+	copy(dAtA[i:], m.PlayerDesc)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerDesc)))
-	i += copy(dAtA[i:], m.PlayerDesc)
-	return i, nil
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.PlayerLocation)
+	copy(dAtA[i:], m.PlayerLocation)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerLocation)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.PlayerFlag)
+	copy(dAtA[i:], m.PlayerFlag)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerFlag)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerDob))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.PlayerName)
+	copy(dAtA[i:], m.PlayerName)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.PlayerNick)
+	copy(dAtA[i:], m.PlayerNick)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.PlayerNick)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *TournamentTeam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -11821,44 +11851,54 @@ func (m *TournamentTeam) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *TournamentTeam) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamId))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TeamTag)))
-	i += copy(dAtA[i:], m.TeamTag)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TeamFlag)))
-	i += copy(dAtA[i:], m.TeamFlag)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TeamName)))
-	i += copy(dAtA[i:], m.TeamName)
 	if len(m.Players) > 0 {
-		for _, msg := range m.Players {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Players) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Players[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return i, nil
+	i -= len(m.TeamName)
+	copy(dAtA[i:], m.TeamName)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TeamName)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.TeamFlag)
+	copy(dAtA[i:], m.TeamFlag)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TeamFlag)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TeamTag)
+	copy(dAtA[i:], m.TeamTag)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TeamTag)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *TournamentEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -11866,47 +11906,55 @@ func (m *TournamentEvent) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *TournamentEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.EventTag)))
-	i += copy(dAtA[i:], m.EventTag)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.EventName)))
-	i += copy(dAtA[i:], m.EventName)
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventTimeStart))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventTimeEnd))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventPublic))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventStageId))
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.EventStageName)))
-	i += copy(dAtA[i:], m.EventStageName)
-	dAtA[i] = 0x48
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ActiveSectionId))
-	return i, nil
+	i--
+	dAtA[i] = 0x48
+	i -= len(m.EventStageName)
+	copy(dAtA[i:], m.EventStageName)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.EventStageName)))
+	i--
+	dAtA[i] = 0x42
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventStageId))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventPublic))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventTimeEnd))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventTimeStart))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.EventName)
+	copy(dAtA[i:], m.EventName)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.EventName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.EventTag)
+	copy(dAtA[i:], m.EventTag)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.EventTag)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *GlobalStatistics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -11914,72 +11962,80 @@ func (m *GlobalStatistics) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *GlobalStatistics) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayersOnline))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServersOnline))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayersSearching))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServersAvailable))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.OngoingMatches))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SearchTimeAvg))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtime32EventStart))
+	i--
+	dAtA[i] = 0x78
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtime32Cur))
+	i--
+	dAtA[i] = 0x70
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ActiveSurveyId))
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ActiveTournamentEventid))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TwitchStreamsVersion))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PricesheetVersion))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequiredAppidVersion))
+	i--
+	dAtA[i] = 0x48
+	i -= len(m.MainPostUrl)
+	copy(dAtA[i:], m.MainPostUrl)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.MainPostUrl)))
+	i--
+	dAtA[i] = 0x42
 	if len(m.SearchStatistics) > 0 {
-		for _, msg := range m.SearchStatistics {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.SearchStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SearchStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.MainPostUrl)))
-	i += copy(dAtA[i:], m.MainPostUrl)
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequiredAppidVersion))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PricesheetVersion))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TwitchStreamsVersion))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ActiveTournamentEventid))
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ActiveSurveyId))
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtime32Cur))
-	dAtA[i] = 0x78
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtime32EventStart))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SearchTimeAvg))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.OngoingMatches))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServersAvailable))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayersSearching))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServersOnline))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayersOnline))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *OperationalStatisticDescription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -11987,24 +12043,30 @@ func (m *OperationalStatisticDescription) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *OperationalStatisticDescription) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationalStatisticDescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Idkey))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *OperationalStatisticElement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12012,27 +12074,32 @@ func (m *OperationalStatisticElement) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *OperationalStatisticElement) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationalStatisticElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Idkey))
 	if len(m.Values) > 0 {
-		for _, num := range m.Values {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Values[iNdEx]))
+			i--
 			dAtA[i] = 0x10
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Idkey))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *OperationalStatisticsPacket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12040,35 +12107,42 @@ func (m *OperationalStatisticsPacket) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *OperationalStatisticsPacket) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationalStatisticsPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Packetid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Mstimestamp))
 	if len(m.Values) > 0 {
-		for _, msg := range m.Values {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Mstimestamp))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Packetid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *PlayerRankingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12076,33 +12150,38 @@ func (m *PlayerRankingInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *PlayerRankingInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerRankingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RankId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Wins))
-	dAtA[i] = 0x25
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RankChange))))
-	i += 4
-	dAtA[i] = 0x30
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RankTypeId))
-	return i, nil
+	i--
+	dAtA[i] = 0x30
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RankChange))))
+	i--
+	dAtA[i] = 0x25
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Wins))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RankId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *PlayerCommendationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12110,26 +12189,31 @@ func (m *PlayerCommendationInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *PlayerCommendationInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerCommendationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CmdFriendly))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CmdTeaching))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CmdLeader))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CmdTeaching))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CmdFriendly))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *PlayerMedalsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12137,27 +12221,32 @@ func (m *PlayerMedalsInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *PlayerMedalsInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerMedalsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.FeaturedDisplayItemDefidx))
+	i--
+	dAtA[i] = 0x40
 	if len(m.DisplayItemsDefidx) > 0 {
-		for _, num := range m.DisplayItemsDefidx {
+		for iNdEx := len(m.DisplayItemsDefidx) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.DisplayItemsDefidx[iNdEx]))
+			i--
 			dAtA[i] = 0x38
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.FeaturedDisplayItemDefidx))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AccountActivity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12165,29 +12254,34 @@ func (m *AccountActivity) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AccountActivity) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Activity))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Mode))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Map))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Map))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Mode))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Activity))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *TournamentMatchSetup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12195,29 +12289,34 @@ func (m *TournamentMatchSetup) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *TournamentMatchSetup) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentMatchSetup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdCt))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdT))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventStageId))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdT))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdCt))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *ServerHltvInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12225,91 +12324,98 @@ func (m *ServerHltvInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ServerHltvInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServerHltvInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvUdpPort))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvWatchKey))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvSlots))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvClients))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvProxies))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvTime))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameMapgroup)))
-	i += copy(dAtA[i:], m.GameMapgroup)
-	dAtA[i] = 0x52
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameMap)))
-	i += copy(dAtA[i:], m.GameMap)
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvMasterSteamid))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvLocalSlots))
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvLocalClients))
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvLocalProxies))
-	dAtA[i] = 0x78
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelaySlots))
-	dAtA[i] = 0x80
-	i++
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Flags))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelayClients))
-	dAtA[i] = 0x88
-	i++
+	i--
+	dAtA[i] = 0xa8
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelaySteamid))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelayProxies))
-	dAtA[i] = 0x90
-	i++
+	i--
+	dAtA[i] = 0xa0
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelayPort))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelayAddress))
+	i--
 	dAtA[i] = 0x98
-	i++
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelayAddress))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelayPort))
-	dAtA[i] = 0xa0
-	i++
+	i--
+	dAtA[i] = 0x90
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelayProxies))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelaySteamid))
-	dAtA[i] = 0xa8
-	i++
+	i--
+	dAtA[i] = 0x88
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelayClients))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Flags))
-	return i, nil
+	i--
+	dAtA[i] = 0x80
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelaySlots))
+	i--
+	dAtA[i] = 0x78
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvLocalProxies))
+	i--
+	dAtA[i] = 0x70
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvLocalClients))
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvLocalSlots))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvMasterSteamid))
+	i--
+	dAtA[i] = 0x58
+	i -= len(m.GameMap)
+	copy(dAtA[i:], m.GameMap)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameMap)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.GameMapgroup)
+	copy(dAtA[i:], m.GameMapgroup)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameMapgroup)))
+	i--
+	dAtA[i] = 0x4a
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvTime))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvProxies))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvClients))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvSlots))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvWatchKey))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvUdpPort))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *IpAddressMask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12317,35 +12423,40 @@ func (m *IpAddressMask) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *IpAddressMask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IpAddressMask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.A))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.B))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.C))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.D))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Bits))
-	dAtA[i] = 0x30
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Token))
-	return i, nil
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Bits))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.D))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.C))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.B))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.A))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *XpProgressData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12353,23 +12464,28 @@ func (m *XpProgressData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *XpProgressData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *XpProgressData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.XpPoints))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.XpCategory))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.XpPoints))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *MatchEndItemUpdates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12377,26 +12493,31 @@ func (m *MatchEndItemUpdates) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *MatchEndItemUpdates) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchEndItemUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ItemId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ItemAttrDefidx))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ItemAttrDeltaValue))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ItemAttrDefidx))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ItemId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *ScoreLeaderboardData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12404,47 +12525,56 @@ func (m *ScoreLeaderboardData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ScoreLeaderboardData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreLeaderboardData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuestId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Score))
-	if len(m.Accountentries) > 0 {
-		for _, msg := range m.Accountentries {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Matchentries) > 0 {
+		for iNdEx := len(m.Matchentries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Matchentries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.Matchentries) > 0 {
-		for _, msg := range m.Matchentries {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Accountentries) > 0 {
+		for iNdEx := len(m.Accountentries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accountentries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Score))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuestId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *ScoreLeaderboardData_Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12452,23 +12582,28 @@ func (m *ScoreLeaderboardData_Entry) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ScoreLeaderboardData_Entry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreLeaderboardData_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Tag))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Val))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Tag))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *ScoreLeaderboardData_AccountEntries) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12476,32 +12611,39 @@ func (m *ScoreLeaderboardData_AccountEntries) Marshal() (dAtA []byte, err error)
 }
 
 func (m *ScoreLeaderboardData_AccountEntries) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreLeaderboardData_AccountEntries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
 	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *PlayerQuestData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12509,70 +12651,81 @@ func (m *PlayerQuestData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *PlayerQuestData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerQuestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuesterAccountId))
-	if len(m.QuestItemData) > 0 {
-		for _, msg := range m.QuestItemData {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	i--
+	if m.OperationPointsEligible {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if len(m.ItemUpdates) > 0 {
+		for iNdEx := len(m.ItemUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x32
 		}
 	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MmGameMode))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TimePlayed))
+	i--
+	dAtA[i] = 0x20
 	if len(m.XpProgressData) > 0 {
-		for _, msg := range m.XpProgressData {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.XpProgressData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.XpProgressData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TimePlayed))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MmGameMode))
-	if len(m.ItemUpdates) > 0 {
-		for _, msg := range m.ItemUpdates {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.QuestItemData) > 0 {
+		for iNdEx := len(m.QuestItemData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuestItemData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	dAtA[i] = 0x38
-	i++
-	if m.OperationPointsEligible {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuesterAccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *PlayerQuestData_QuestItemData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12580,26 +12733,31 @@ func (m *PlayerQuestData_QuestItemData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *PlayerQuestData_QuestItemData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerQuestData_QuestItemData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuestId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuestNormalPointsEarned))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuestBonusPointsEarned))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuestNormalPointsEarned))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.QuestId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGC_ServerQuestUpdateData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12607,48 +12765,58 @@ func (m *CMsgGC_ServerQuestUpdateData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGC_ServerQuestUpdateData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGC_ServerQuestUpdateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PlayerQuestData) > 0 {
-		for _, msg := range m.PlayerQuestData {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.Missionlbsdata != nil {
+		{
+			size, err := m.Missionlbsdata.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MmGameMode))
+	i--
+	dAtA[i] = 0x18
 	if m.BinaryData != nil {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.BinaryData)
+		copy(dAtA[i:], m.BinaryData)
 		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.BinaryData)))
-		i += copy(dAtA[i:], m.BinaryData)
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MmGameMode))
-	if m.Missionlbsdata != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Missionlbsdata.Size()))
-		n1, err1 := m.Missionlbsdata.MarshalTo(dAtA[i:])
-		if err1 != nil {
-			return 0, err1
+	if len(m.PlayerQuestData) > 0 {
+		for iNdEx := len(m.PlayerQuestData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerQuestData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i += n1
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGCOperationalStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12656,44 +12824,53 @@ func (m *CMsgGCCStrike15V2_MatchmakingGCOperationalStats) Marshal() (dAtA []byte
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGCOperationalStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGCOperationalStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Packetid))
-	if len(m.Namekeys) > 0 {
-		for _, msg := range m.Namekeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Packets) > 0 {
-		for _, msg := range m.Packets {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Namekeys) > 0 {
+		for iNdEx := len(m.Namekeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namekeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Packetid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerConfirm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12701,26 +12878,31 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerConfirm) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerConfirm) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Token))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Stamp))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Exchange))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Stamp))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Token))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GC2ServerReservationUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12728,23 +12910,28 @@ func (m *CMsgGCCStrike15V2_GC2ServerReservationUpdate) Marshal() (dAtA []byte, e
 }
 
 func (m *CMsgGCCStrike15V2_GC2ServerReservationUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GC2ServerReservationUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ViewersExternalTotal))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ViewersExternalSteam))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ViewersExternalTotal))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingStart) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12752,52 +12939,60 @@ func (m *CMsgGCCStrike15V2_MatchmakingStart) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingStart) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AccountIds) > 0 {
-		for _, num := range m.AccountIds {
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
-		}
-	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TicketData)))
-	i += copy(dAtA[i:], m.TicketData)
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ClientVersion))
-	if m.TournamentMatch != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TournamentMatch.Size()))
-		n2, err2 := m.TournamentMatch.MarshalTo(dAtA[i:])
-		if err2 != nil {
-			return 0, err2
-		}
-		i += n2
-	}
-	dAtA[i] = 0x30
-	i++
+	i--
 	if m.PrimeOnly {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x30
+	if m.TournamentMatch != nil {
+		{
+			size, err := m.TournamentMatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ClientVersion))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.TicketData)
+	copy(dAtA[i:], m.TicketData)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TicketData)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
+	i--
+	dAtA[i] = 0x10
+	if len(m.AccountIds) > 0 {
+		for iNdEx := len(m.AccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountIds[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingStop) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12805,20 +13000,25 @@ func (m *CMsgGCCStrike15V2_MatchmakingStop) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingStop) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingStop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Abandon))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingClient2ServerPing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12826,54 +13026,63 @@ func (m *CMsgGCCStrike15V2_MatchmakingClient2ServerPing) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingClient2ServerPing) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingClient2ServerPing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Gameserverpings) > 0 {
-		for _, msg := range m.Gameserverpings {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.TestToken))
+	i--
+	dAtA[i] = 0x35
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MaxPing))
+	i--
+	dAtA[i] = 0x28
+	if len(m.DataCenterPings) > 0 {
+		for iNdEx := len(m.DataCenterPings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataCenterPings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.OffsetIndex))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.FinalBatch))
-	if len(m.DataCenterPings) > 0 {
-		for _, msg := range m.DataCenterPings {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.OffsetIndex))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Gameserverpings) > 0 {
+		for iNdEx := len(m.Gameserverpings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gameserverpings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MaxPing))
-	dAtA[i] = 0x35
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.TestToken))
-	i += 4
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -12881,130 +13090,142 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate) Marshal() (dAtA []byte, e
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchmaking))
-	if len(m.WaitingAccountIdSessions) > 0 {
-		for _, num := range m.WaitingAccountIdSessions {
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.LauncherMismatchSessions) > 0 {
+		for iNdEx := len(m.LauncherMismatchSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.LauncherMismatchSessions[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
 		}
 	}
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Error)))
-	i += copy(dAtA[i:], m.Error)
-	if len(m.OngoingmatchAccountIdSessions) > 0 {
-		for _, num := range m.OngoingmatchAccountIdSessions {
-			dAtA[i] = 0x30
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.VsncheckAccountIdSessions) > 0 {
+		for iNdEx := len(m.VsncheckAccountIdSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.VsncheckAccountIdSessions[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
 		}
 	}
-	if m.GlobalStats != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GlobalStats.Size()))
-		n3, err3 := m.GlobalStats.MarshalTo(dAtA[i:])
-		if err3 != nil {
-			return 0, err3
+	if len(m.InsufficientlevelSessions) > 0 {
+		for iNdEx := len(m.InsufficientlevelSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.InsufficientlevelSessions[iNdEx]))
+			i--
+			dAtA[i] = 0x78
 		}
-		i += n3
 	}
-	if len(m.FailpingAccountIdSessions) > 0 {
-		for _, num := range m.FailpingAccountIdSessions {
-			dAtA[i] = 0x40
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.PenaltyAccountIdSessionsGreen) > 0 {
+		for iNdEx := len(m.PenaltyAccountIdSessionsGreen) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltyAccountIdSessionsGreen[iNdEx]))
+			i--
+			dAtA[i] = 0x70
 		}
 	}
-	if len(m.PenaltyAccountIdSessions) > 0 {
-		for _, num := range m.PenaltyAccountIdSessions {
-			dAtA[i] = 0x48
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.Notes) > 0 {
+		for iNdEx := len(m.Notes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Notes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	if len(m.FailreadyAccountIdSessions) > 0 {
-		for _, num := range m.FailreadyAccountIdSessions {
-			dAtA[i] = 0x50
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if m.ServerIpaddressMask != nil {
+		{
+			size, err := m.ServerIpaddressMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
 	if len(m.VacbannedAccountIdSessions) > 0 {
-		for _, num := range m.VacbannedAccountIdSessions {
+		for iNdEx := len(m.VacbannedAccountIdSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.VacbannedAccountIdSessions[iNdEx]))
+			i--
 			dAtA[i] = 0x58
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	if m.ServerIpaddressMask != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerIpaddressMask.Size()))
-		n4, err4 := m.ServerIpaddressMask.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
+	if len(m.FailreadyAccountIdSessions) > 0 {
+		for iNdEx := len(m.FailreadyAccountIdSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.FailreadyAccountIdSessions[iNdEx]))
+			i--
+			dAtA[i] = 0x50
 		}
-		i += n4
 	}
-	if len(m.Notes) > 0 {
-		for _, msg := range m.Notes {
-			dAtA[i] = 0x6a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if len(m.PenaltyAccountIdSessions) > 0 {
+		for iNdEx := len(m.PenaltyAccountIdSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltyAccountIdSessions[iNdEx]))
+			i--
+			dAtA[i] = 0x48
 		}
 	}
-	if len(m.PenaltyAccountIdSessionsGreen) > 0 {
-		for _, num := range m.PenaltyAccountIdSessionsGreen {
-			dAtA[i] = 0x70
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.FailpingAccountIdSessions) > 0 {
+		for iNdEx := len(m.FailpingAccountIdSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.FailpingAccountIdSessions[iNdEx]))
+			i--
+			dAtA[i] = 0x40
 		}
 	}
-	if len(m.InsufficientlevelSessions) > 0 {
-		for _, num := range m.InsufficientlevelSessions {
-			dAtA[i] = 0x78
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if m.GlobalStats != nil {
+		{
+			size, err := m.GlobalStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.VsncheckAccountIdSessions) > 0 {
-		for _, num := range m.VsncheckAccountIdSessions {
-			dAtA[i] = 0x80
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.OngoingmatchAccountIdSessions) > 0 {
+		for iNdEx := len(m.OngoingmatchAccountIdSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.OngoingmatchAccountIdSessions[iNdEx]))
+			i--
+			dAtA[i] = 0x30
 		}
 	}
-	if len(m.LauncherMismatchSessions) > 0 {
-		for _, num := range m.LauncherMismatchSessions {
-			dAtA[i] = 0x88
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	i -= len(m.Error)
+	copy(dAtA[i:], m.Error)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Error)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.WaitingAccountIdSessions) > 0 {
+		for iNdEx := len(m.WaitingAccountIdSessions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.WaitingAccountIdSessions[iNdEx]))
+			i--
+			dAtA[i] = 0x10
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchmaking))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate_Note) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13012,31 +13233,36 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate_Note) Marshal() (dAtA []by
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate_Note) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientUpdate_Note) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Type))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RegionId))
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RegionR))))
-	i += 4
-	dAtA[i] = 0x25
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Distance))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x25
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RegionR))))
+	i--
+	dAtA[i] = 0x1d
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RegionId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CDataGCCStrike15V2_TournamentMatchDraft) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13044,56 +13270,63 @@ func (m *CDataGCCStrike15V2_TournamentMatchDraft) Marshal() (dAtA []byte, err er
 }
 
 func (m *CDataGCCStrike15V2_TournamentMatchDraft) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDataGCCStrike15V2_TournamentMatchDraft) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventStageId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamId_0))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamId_1))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MapsCount))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MapsCurrent))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdStart))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdVeto1))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdPickn))
 	if len(m.Drafts) > 0 {
-		for _, msg := range m.Drafts {
-			dAtA[i] = 0x52
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Drafts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Drafts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x52
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdPickn))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdVeto1))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdStart))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MapsCurrent))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MapsCount))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamId_1))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamId_0))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventStageId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CDataGCCStrike15V2_TournamentMatchDraft_Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13101,23 +13334,28 @@ func (m *CDataGCCStrike15V2_TournamentMatchDraft_Entry) Marshal() (dAtA []byte,
 }
 
 func (m *CDataGCCStrike15V2_TournamentMatchDraft_Entry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDataGCCStrike15V2_TournamentMatchDraft_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Mapid))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamIdCt))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Mapid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CPreMatchInfoData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13125,49 +13363,58 @@ func (m *CPreMatchInfoData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CPreMatchInfoData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CPreMatchInfoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PredictionsPct))
-	if m.Draft != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Draft.Size()))
-		n5, err5 := m.Draft.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
+	if len(m.Wins) > 0 {
+		for iNdEx := len(m.Wins) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Wins[iNdEx]))
+			i--
+			dAtA[i] = 0x30
 		}
-		i += n5
 	}
 	if len(m.Stats) > 0 {
-		for _, msg := range m.Stats {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+		}
+	}
+	if m.Draft != nil {
+		{
+			size, err := m.Draft.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
-		}
-	}
-	if len(m.Wins) > 0 {
-		for _, num := range m.Wins {
-			dAtA[i] = 0x30
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PredictionsPct))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CPreMatchInfoData_TeamStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13175,39 +13422,39 @@ func (m *CPreMatchInfoData_TeamStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CPreMatchInfoData_TeamStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CPreMatchInfoData_TeamStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchInfoIdxtxt))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.MatchInfoTxt)))
-	i += copy(dAtA[i:], m.MatchInfoTxt)
 	if len(m.MatchInfoTeams) > 0 {
-		for _, s := range m.MatchInfoTeams {
+		for iNdEx := len(m.MatchInfoTeams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MatchInfoTeams[iNdEx])
+			copy(dAtA[i:], m.MatchInfoTeams[iNdEx])
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.MatchInfoTeams[iNdEx])))
+			i--
 			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	i -= len(m.MatchInfoTxt)
+	copy(dAtA[i:], m.MatchInfoTxt)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.MatchInfoTxt)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchInfoIdxtxt))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerReserve) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13215,130 +13462,145 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerReserve) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerReserve) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AccountIds) > 0 {
-		for _, num := range m.AccountIds {
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
-		}
-	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerVersion))
-	if len(m.Rankings) > 0 {
-		for _, msg := range m.Rankings {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Flags))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x90
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvControl))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtime32EventStart))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	if m.PreMatchData != nil {
+		{
+			size, err := m.PreMatchData.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EncryptionKey))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EncryptionKeyPub))
-	if len(m.PartyIds) > 0 {
-		for _, num := range m.PartyIds {
-			dAtA[i] = 0x40
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelaySteamid))
+	i--
+	dAtA[i] = 0x70
+	if len(m.TournamentCastersAccountIds) > 0 {
+		for iNdEx := len(m.TournamentCastersAccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TournamentCastersAccountIds[iNdEx]))
+			i--
+			dAtA[i] = 0x68
 		}
 	}
-	if len(m.Whitelist) > 0 {
-		for _, msg := range m.Whitelist {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if len(m.TournamentTeams) > 0 {
+		for iNdEx := len(m.TournamentTeams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TournamentTeams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.TournamentEvent != nil {
+		{
+			size, err := m.TournamentEvent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	dAtA[i] = 0x50
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvMasterSteamid))
-	if m.TournamentEvent != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TournamentEvent.Size()))
-		n6, err6 := m.TournamentEvent.MarshalTo(dAtA[i:])
-		if err6 != nil {
-			return 0, err6
+	i--
+	dAtA[i] = 0x50
+	if len(m.Whitelist) > 0 {
+		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Whitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
-		i += n6
 	}
-	if len(m.TournamentTeams) > 0 {
-		for _, msg := range m.TournamentTeams {
-			dAtA[i] = 0x62
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if len(m.PartyIds) > 0 {
+		for iNdEx := len(m.PartyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PartyIds[iNdEx]))
+			i--
+			dAtA[i] = 0x40
 		}
 	}
-	if len(m.TournamentCastersAccountIds) > 0 {
-		for _, num := range m.TournamentCastersAccountIds {
-			dAtA[i] = 0x68
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EncryptionKeyPub))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EncryptionKey))
+	i--
+	dAtA[i] = 0x30
+	if len(m.Rankings) > 0 {
+		for iNdEx := len(m.Rankings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rankings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvRelaySteamid))
-	if m.PreMatchData != nil {
-		dAtA[i] = 0x7a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PreMatchData.Size()))
-		n7, err7 := m.PreMatchData.MarshalTo(dAtA[i:])
-		if err7 != nil {
-			return 0, err7
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerVersion))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
+	i--
+	dAtA[i] = 0x10
+	if len(m.AccountIds) > 0 {
+		for iNdEx := len(m.AccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountIds[iNdEx]))
+			i--
+			dAtA[i] = 0x8
 		}
-		i += n7
 	}
-	dAtA[i] = 0x80
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtime32EventStart))
-	dAtA[i] = 0x88
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvControl))
-	dAtA[i] = 0x90
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Flags))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerReservationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13346,97 +13608,108 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerReservationResponse) Marshal() (dAtA
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerReservationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingServerReservationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservationid))
-	if m.Reservation != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservation.Size()))
-		n8, err8 := m.Reservation.MarshalTo(dAtA[i:])
-		if err8 != nil {
-			return 0, err8
-		}
-		i += n8
-	}
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Map)))
-	i += copy(dAtA[i:], m.Map)
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GcReservationSent))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerVersion))
-	if m.TvInfo != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvInfo.Size()))
-		n9, err9 := m.TvInfo.MarshalTo(dAtA[i:])
-		if err9 != nil {
-			return 0, err9
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SteamdatagramRouting))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Flags))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.TestToken))
+	i--
+	dAtA[i] = 0x7d
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.LegacySteamdatagramPort))
+	i--
+	dAtA[i] = 0x70
+	i -= len(m.TournamentTag)
+	copy(dAtA[i:], m.TournamentTag)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TournamentTag)))
+	i--
+	dAtA[i] = 0x6a
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardDropList))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardItemAttrRewardIdx))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardItemAttrValue))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardItemAttrDefIdx))
+	i--
+	dAtA[i] = 0x48
+	if len(m.IdlePlayerAccounts) > 0 {
+		for iNdEx := len(m.IdlePlayerAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.IdlePlayerAccounts[iNdEx]))
+			i--
+			dAtA[i] = 0x40
 		}
-		i += n9
 	}
 	if len(m.RewardPlayerAccounts) > 0 {
-		for _, num := range m.RewardPlayerAccounts {
+		for iNdEx := len(m.RewardPlayerAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardPlayerAccounts[iNdEx]))
+			i--
 			dAtA[i] = 0x38
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	if len(m.IdlePlayerAccounts) > 0 {
-		for _, num := range m.IdlePlayerAccounts {
-			dAtA[i] = 0x40
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if m.TvInfo != nil {
+		{
+			size, err := m.TvInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardItemAttrDefIdx))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardItemAttrValue))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardItemAttrRewardIdx))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RewardDropList))
-	dAtA[i] = 0x6a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TournamentTag)))
-	i += copy(dAtA[i:], m.TournamentTag)
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.LegacySteamdatagramPort))
-	dAtA[i] = 0x7d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.TestToken))
-	i += 4
-	dAtA[i] = 0x80
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Flags))
-	dAtA[i] = 0x88
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SteamdatagramRouting))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerVersion))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GcReservationSent))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Map)
+	copy(dAtA[i:], m.Map)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Map)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Reservation != nil {
+		{
+			size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservationid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientReserve) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13444,47 +13717,56 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientReserve) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientReserve) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Serverid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.DirectUdpIp))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.DirectUdpPort))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservationid))
+	i -= len(m.ServerAddress)
+	copy(dAtA[i:], m.ServerAddress)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.ServerAddress)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Map)
+	copy(dAtA[i:], m.Map)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Map)))
+	i--
+	dAtA[i] = 0x32
 	if m.Reservation != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservation.Size()))
-		n10, err10 := m.Reservation.MarshalTo(dAtA[i:])
-		if err10 != nil {
-			return 0, err10
+		{
+			size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n10
+		i--
+		dAtA[i] = 0x2a
 	}
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Map)))
-	i += copy(dAtA[i:], m.Map)
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.ServerAddress)))
-	i += copy(dAtA[i:], m.ServerAddress)
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservationid))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.DirectUdpPort))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.DirectUdpIp))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Serverid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13492,191 +13774,203 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservationid))
-	if m.Reservation != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservation.Size()))
-		n11, err11 := m.Reservation.MarshalTo(dAtA[i:])
-		if err11 != nil {
-			return 0, err11
+	if m.DropInfo != nil {
+		{
+			size, err := m.DropInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n11
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Map)))
-	i += copy(dAtA[i:], m.Map)
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Round))
-	if len(m.Kills) > 0 {
-		for _, num := range m.Kills {
-			dAtA[i] = 0x28
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.EnemyKillsAgg) > 0 {
+		for iNdEx := len(m.EnemyKillsAgg) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EnemyKillsAgg[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc8
 		}
 	}
-	if len(m.Assists) > 0 {
-		for _, num := range m.Assists {
-			dAtA[i] = 0x30
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SpectatorsCountLnk))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc0
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SpectatorsCountTv))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb8
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SpectatorsCount))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb0
+	if len(m.Mvps) > 0 {
+		for iNdEx := len(m.Mvps) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Mvps[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
 		}
 	}
-	if len(m.Deaths) > 0 {
-		for _, num := range m.Deaths {
-			dAtA[i] = 0x38
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.Enemy_5Ks) > 0 {
+		for iNdEx := len(m.Enemy_5Ks) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Enemy_5Ks[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
 		}
 	}
-	if len(m.Scores) > 0 {
-		for _, num := range m.Scores {
-			dAtA[i] = 0x40
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.Enemy_4Ks) > 0 {
+		for iNdEx := len(m.Enemy_4Ks) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Enemy_4Ks[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
 		}
 	}
-	if len(m.Pings) > 0 {
-		for _, num := range m.Pings {
-			dAtA[i] = 0x48
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.Enemy_3Ks) > 0 {
+		for iNdEx := len(m.Enemy_3Ks) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Enemy_3Ks[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
 		}
 	}
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RoundResult))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchResult))
-	if len(m.TeamScores) > 0 {
-		for _, num := range m.TeamScores {
-			dAtA[i] = 0x60
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.EnemyHeadshots) > 0 {
+		for iNdEx := len(m.EnemyHeadshots) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EnemyHeadshots[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
 		}
 	}
-	if m.Confirm != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Confirm.Size()))
-		n12, err12 := m.Confirm.MarshalTo(dAtA[i:])
-		if err12 != nil {
-			return 0, err12
+	if len(m.EnemyKills) > 0 {
+		for iNdEx := len(m.EnemyKills) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EnemyKills[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
 		}
-		i += n12
 	}
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ReservationStage))
-	dAtA[i] = 0x78
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchDuration))
-	if len(m.EnemyKills) > 0 {
-		for _, num := range m.EnemyKills {
-			dAtA[i] = 0x80
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	i--
+	dAtA[i] = 0x78
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ReservationStage))
+	i--
+	dAtA[i] = 0x70
+	if m.Confirm != nil {
+		{
+			size, err := m.Confirm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if len(m.EnemyHeadshots) > 0 {
-		for _, num := range m.EnemyHeadshots {
-			dAtA[i] = 0x88
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.TeamScores) > 0 {
+		for iNdEx := len(m.TeamScores) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamScores[iNdEx]))
+			i--
+			dAtA[i] = 0x60
 		}
 	}
-	if len(m.Enemy_3Ks) > 0 {
-		for _, num := range m.Enemy_3Ks {
-			dAtA[i] = 0x90
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchResult))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RoundResult))
+	i--
+	dAtA[i] = 0x50
+	if len(m.Pings) > 0 {
+		for iNdEx := len(m.Pings) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Pings[iNdEx]))
+			i--
+			dAtA[i] = 0x48
 		}
 	}
-	if len(m.Enemy_4Ks) > 0 {
-		for _, num := range m.Enemy_4Ks {
-			dAtA[i] = 0x98
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Scores[iNdEx]))
+			i--
+			dAtA[i] = 0x40
 		}
 	}
-	if len(m.Enemy_5Ks) > 0 {
-		for _, num := range m.Enemy_5Ks {
-			dAtA[i] = 0xa0
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.Deaths) > 0 {
+		for iNdEx := len(m.Deaths) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Deaths[iNdEx]))
+			i--
+			dAtA[i] = 0x38
 		}
 	}
-	if len(m.Mvps) > 0 {
-		for _, num := range m.Mvps {
-			dAtA[i] = 0xa8
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.Assists) > 0 {
+		for iNdEx := len(m.Assists) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Assists[iNdEx]))
+			i--
+			dAtA[i] = 0x30
 		}
 	}
-	dAtA[i] = 0xb0
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SpectatorsCount))
-	dAtA[i] = 0xb8
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SpectatorsCountTv))
-	dAtA[i] = 0xc0
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SpectatorsCountLnk))
-	if len(m.EnemyKillsAgg) > 0 {
-		for _, num := range m.EnemyKillsAgg {
-			dAtA[i] = 0xc8
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.Kills) > 0 {
+		for iNdEx := len(m.Kills) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Kills[iNdEx]))
+			i--
+			dAtA[i] = 0x28
 		}
 	}
-	if m.DropInfo != nil {
-		dAtA[i] = 0xd2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.DropInfo.Size()))
-		n13, err13 := m.DropInfo.MarshalTo(dAtA[i:])
-		if err13 != nil {
-			return 0, err13
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Round))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Map)
+	copy(dAtA[i:], m.Map)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Map)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Reservation != nil {
+		{
+			size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n13
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservationid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats_DropInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13684,20 +13978,25 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats_DropInfo) Marshal() (dAtA
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats_DropInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingServerRoundStats_DropInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountMvp))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEnd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13705,67 +14004,78 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEnd) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEnd) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Stats != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Stats.Size()))
-		n14, err14 := m.Stats.MarshalTo(dAtA[i:])
-		if err14 != nil {
-			return 0, err14
-		}
-		i += n14
-	}
-	if m.Confirm != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Confirm.Size()))
-		n15, err15 := m.Confirm.MarshalTo(dAtA[i:])
-		if err15 != nil {
-			return 0, err15
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerVersion))
+	i--
+	dAtA[i] = 0x48
+	if m.MatchEndQuestData != nil {
+		{
+			size, err := m.MatchEndQuestData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n15
+		i--
+		dAtA[i] = 0x42
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rematch))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ReplayToken))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ReplayClusterId))
-	dAtA[i] = 0x38
-	i++
+	i--
 	if m.AbortedMatch {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if m.MatchEndQuestData != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchEndQuestData.Size()))
-		n16, err16 := m.MatchEndQuestData.MarshalTo(dAtA[i:])
-		if err16 != nil {
-			return 0, err16
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ReplayClusterId))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ReplayToken))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rematch))
+	i--
+	dAtA[i] = 0x20
+	if m.Confirm != nil {
+		{
+			size, err := m.Confirm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n16
+		i--
+		dAtA[i] = 0x1a
 	}
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerVersion))
-	return i, nil
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEndPartial) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13773,53 +14083,64 @@ func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEndPartial) Marshal() (dAtA []b
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEndPartial) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingServerMatchEndPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservationid))
-	if m.Reservation != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservation.Size()))
-		n17, err17 := m.Reservation.MarshalTo(dAtA[i:])
-		if err17 != nil {
-			return 0, err17
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerVersion))
+	i--
+	dAtA[i] = 0x28
+	if m.CompletedPlayerQuestData != nil {
+		{
+			size, err := m.CompletedPlayerQuestData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n17
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Confirm != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Confirm.Size()))
-		n18, err18 := m.Confirm.MarshalTo(dAtA[i:])
-		if err18 != nil {
-			return 0, err18
+		{
+			size, err := m.Confirm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n18
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CompletedPlayerQuestData != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CompletedPlayerQuestData.Size()))
-		n19, err19 := m.CompletedPlayerQuestData.MarshalTo(dAtA[i:])
-		if err19 != nil {
-			return 0, err19
+	if m.Reservation != nil {
+		{
+			size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n19
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerVersion))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservationid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingClient2GCHello) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13827,17 +14148,22 @@ func (m *CMsgGCCStrike15V2_MatchmakingClient2GCHello) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingClient2GCHello) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingClient2GCHello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientHello) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -13845,165 +14171,192 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientHello) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientHello) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientHello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	if m.Ongoingmatch != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ongoingmatch.Size()))
-		n20, err20 := m.Ongoingmatch.MarshalTo(dAtA[i:])
-		if err20 != nil {
-			return 0, err20
+	if len(m.Rankings) > 0 {
+		for iNdEx := len(m.Rankings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rankings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
-		i += n20
 	}
-	if m.GlobalStats != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GlobalStats.Size()))
-		n21, err21 := m.GlobalStats.MarshalTo(dAtA[i:])
-		if err21 != nil {
-			return 0, err21
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerXpBonusFlags))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x98
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerCurXp))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x90
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerLevel))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	if m.Activity != nil {
+		{
+			size, err := m.Activity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n21
+		i--
+		dAtA[i] = 0x7a
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltySeconds))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltyReason))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.VacBanned))
-	if m.Ranking != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ranking.Size()))
-		n22, err22 := m.Ranking.MarshalTo(dAtA[i:])
-		if err22 != nil {
-			return 0, err22
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SurveyVote))
+	i--
+	dAtA[i] = 0x70
+	if len(m.MyCurrentEventStages) > 0 {
+		for iNdEx := len(m.MyCurrentEventStages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MyCurrentEventStages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
-		i += n22
 	}
-	if m.Commendation != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Commendation.Size()))
-		n23, err23 := m.Commendation.MarshalTo(dAtA[i:])
-		if err23 != nil {
-			return 0, err23
+	if m.MyCurrentTeam != nil {
+		{
+			size, err := m.MyCurrentTeam.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n23
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.Medals != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Medals.Size()))
-		n24, err24 := m.Medals.MarshalTo(dAtA[i:])
-		if err24 != nil {
-			return 0, err24
+	if len(m.MyCurrentEventTeams) > 0 {
+		for iNdEx := len(m.MyCurrentEventTeams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MyCurrentEventTeams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
-		i += n24
 	}
 	if m.MyCurrentEvent != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MyCurrentEvent.Size()))
-		n25, err25 := m.MyCurrentEvent.MarshalTo(dAtA[i:])
-		if err25 != nil {
-			return 0, err25
+		{
+			size, err := m.MyCurrentEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n25
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.MyCurrentEventTeams) > 0 {
-		for _, msg := range m.MyCurrentEventTeams {
-			dAtA[i] = 0x5a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.Medals != nil {
+		{
+			size, err := m.Medals.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.MyCurrentTeam != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MyCurrentTeam.Size()))
-		n26, err26 := m.MyCurrentTeam.MarshalTo(dAtA[i:])
-		if err26 != nil {
-			return 0, err26
+	if m.Commendation != nil {
+		{
+			size, err := m.Commendation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n26
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.MyCurrentEventStages) > 0 {
-		for _, msg := range m.MyCurrentEventStages {
-			dAtA[i] = 0x6a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.Ranking != nil {
+		{
+			size, err := m.Ranking.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SurveyVote))
-	if m.Activity != nil {
-		dAtA[i] = 0x7a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Activity.Size()))
-		n27, err27 := m.Activity.MarshalTo(dAtA[i:])
-		if err27 != nil {
-			return 0, err27
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.VacBanned))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltyReason))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltySeconds))
+	i--
+	dAtA[i] = 0x20
+	if m.GlobalStats != nil {
+		{
+			size, err := m.GlobalStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n27
+		i--
+		dAtA[i] = 0x1a
 	}
-	dAtA[i] = 0x88
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerLevel))
-	dAtA[i] = 0x90
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerCurXp))
-	dAtA[i] = 0x98
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerXpBonusFlags))
-	if len(m.Rankings) > 0 {
-		for _, msg := range m.Rankings {
-			dAtA[i] = 0xa2
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.Ongoingmatch != nil {
+		{
+			size, err := m.Ongoingmatch.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_AccountPrivacySettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14011,29 +14364,36 @@ func (m *CMsgGCCStrike15V2_AccountPrivacySettings) Marshal() (dAtA []byte, err e
 }
 
 func (m *CMsgGCCStrike15V2_AccountPrivacySettings) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_AccountPrivacySettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Settings) > 0 {
-		for _, msg := range m.Settings {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Settings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_AccountPrivacySettings_Setting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14041,23 +14401,28 @@ func (m *CMsgGCCStrike15V2_AccountPrivacySettings_Setting) Marshal() (dAtA []byt
 }
 
 func (m *CMsgGCCStrike15V2_AccountPrivacySettings_Setting) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_AccountPrivacySettings_Setting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SettingType))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SettingValue))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SettingType))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientAbandon) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14065,36 +14430,43 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientAbandon) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientAbandon) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGC2ClientAbandon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltyReason))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltySeconds))
+	i--
+	dAtA[i] = 0x18
 	if m.AbandonedMatch != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AbandonedMatch.Size()))
-		n28, err28 := m.AbandonedMatch.MarshalTo(dAtA[i:])
-		if err28 != nil {
-			return 0, err28
+		{
+			size, err := m.AbandonedMatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n28
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltySeconds))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PenaltyReason))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServer2GCKick) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14102,33 +14474,40 @@ func (m *CMsgGCCStrike15V2_MatchmakingServer2GCKick) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingServer2GCKick) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingServer2GCKick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reason))
+	i--
+	dAtA[i] = 0x18
 	if m.Reservation != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reservation.Size()))
-		n29, err29 := m.Reservation.MarshalTo(dAtA[i:])
-		if err29 != nil {
-			return 0, err29
+		{
+			size, err := m.Reservation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n29
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reason))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerRankUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14136,32 +14515,39 @@ func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerRankUpdate) Marshal() (dAtA []byt
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerRankUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingGC2ServerRankUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
+	i--
+	dAtA[i] = 0x10
 	if len(m.Rankings) > 0 {
-		for _, msg := range m.Rankings {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Rankings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rankings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientGCRankUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14169,29 +14555,36 @@ func (m *CMsgGCCStrike15V2_ClientGCRankUpdate) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgGCCStrike15V2_ClientGCRankUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientGCRankUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Rankings) > 0 {
-		for _, msg := range m.Rankings {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Rankings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rankings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingOperator2GCBlogUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14199,21 +14592,27 @@ func (m *CMsgGCCStrike15V2_MatchmakingOperator2GCBlogUpdate) Marshal() (dAtA []b
 }
 
 func (m *CMsgGCCStrike15V2_MatchmakingOperator2GCBlogUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchmakingOperator2GCBlogUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.MainPostUrl)
+	copy(dAtA[i:], m.MainPostUrl)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.MainPostUrl)))
-	i += copy(dAtA[i:], m.MainPostUrl)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ServerNotificationForUserPenalty) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14221,26 +14620,31 @@ func (m *CMsgGCCStrike15V2_ServerNotificationForUserPenalty) Marshal() (dAtA []b
 }
 
 func (m *CMsgGCCStrike15V2_ServerNotificationForUserPenalty) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ServerNotificationForUserPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reason))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Seconds))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reason))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientReportPlayer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14248,41 +14652,46 @@ func (m *CMsgGCCStrike15V2_ClientReportPlayer) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgGCCStrike15V2_ClientReportPlayer) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientReportPlayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptAimbot))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptWallhack))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptSpeedhack))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptTeamharm))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptTextabuse))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptVoiceabuse))
-	dAtA[i] = 0x40
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
-	return i, nil
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptVoiceabuse))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptTextabuse))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptTeamharm))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptSpeedhack))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptWallhack))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptAimbot))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientCommendPlayer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14290,36 +14699,43 @@ func (m *CMsgGCCStrike15V2_ClientCommendPlayer) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CMsgGCCStrike15V2_ClientCommendPlayer) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientCommendPlayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Tokens))
+	i--
+	dAtA[i] = 0x50
 	if m.Commendation != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Commendation.Size()))
-		n30, err30 := m.Commendation.MarshalTo(dAtA[i:])
-		if err30 != nil {
-			return 0, err30
+		{
+			size, err := m.Commendation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n30
+		i--
+		dAtA[i] = 0x4a
 	}
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Tokens))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientReportServer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14327,35 +14743,40 @@ func (m *CMsgGCCStrike15V2_ClientReportServer) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgGCCStrike15V2_ClientReportServer) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientReportServer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptPoorperf))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptAbusivemodels))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptBadmotd))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptListingabuse))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptInventoryabuse))
-	dAtA[i] = 0x40
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
-	return i, nil
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptInventoryabuse))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptListingabuse))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptBadmotd))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptAbusivemodels))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptPoorperf))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientReportResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14363,35 +14784,40 @@ func (m *CMsgGCCStrike15V2_ClientReportResponse) Marshal() (dAtA []byte, err err
 }
 
 func (m *CMsgGCCStrike15V2_ClientReportResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ConfirmationId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerIp))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ResponseType))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ResponseResult))
-	dAtA[i] = 0x30
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Tokens))
-	return i, nil
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ResponseResult))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ResponseType))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerIp))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ConfirmationId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestWatchInfoFriends) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14399,48 +14825,55 @@ func (m *CMsgGCCStrike15V2_ClientRequestWatchInfoFriends) Marshal() (dAtA []byte
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestWatchInfoFriends) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientRequestWatchInfoFriends) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestId))
-	if len(m.AccountIds) > 0 {
-		for _, num := range m.AccountIds {
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
+	if len(m.DataCenterPings) > 0 {
+		for iNdEx := len(m.DataCenterPings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataCenterPings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Serverid))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
-	dAtA[i] = 0x28
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ClientLauncher))
-	if len(m.DataCenterPings) > 0 {
-		for _, msg := range m.DataCenterPings {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Serverid))
+	i--
+	dAtA[i] = 0x18
+	if len(m.AccountIds) > 0 {
+		for iNdEx := len(m.AccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountIds[iNdEx]))
+			i--
+			dAtA[i] = 0x10
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *WatchableMatchInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14448,61 +14881,69 @@ func (m *WatchableMatchInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *WatchableMatchInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchableMatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerIp))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvPort))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvSpectators))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvTime))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ReservationId))
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerId))
+	i--
+	dAtA[i] = 0x58
+	i -= len(m.GameMap)
+	copy(dAtA[i:], m.GameMap)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameMap)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.GameMapgroup)
+	copy(dAtA[i:], m.GameMapgroup)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameMapgroup)))
+	i--
+	dAtA[i] = 0x4a
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ClDecryptdataKeyPub))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ClDecryptdataKey))
+	i--
+	dAtA[i] = 0x30
 	if m.TvWatchPassword != nil {
-		dAtA[i] = 0x2a
-		i++
+		i -= len(m.TvWatchPassword)
+		copy(dAtA[i:], m.TvWatchPassword)
 		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.TvWatchPassword)))
-		i += copy(dAtA[i:], m.TvWatchPassword)
+		i--
+		dAtA[i] = 0x2a
 	}
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ClDecryptdataKey))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ClDecryptdataKeyPub))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameMapgroup)))
-	i += copy(dAtA[i:], m.GameMapgroup)
-	dAtA[i] = 0x52
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameMap)))
-	i += copy(dAtA[i:], m.GameMap)
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerId))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ReservationId))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvTime))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvSpectators))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TvPort))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerIp))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestJoinFriendData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14510,43 +14951,51 @@ func (m *CMsgGCCStrike15V2_ClientRequestJoinFriendData) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestJoinFriendData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientRequestJoinFriendData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Version))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.JoinToken))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.JoinIpp))
+	i -= len(m.Errormsg)
+	copy(dAtA[i:], m.Errormsg)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Errormsg)))
+	i--
+	dAtA[i] = 0x32
 	if m.Res != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Res.Size()))
-		n31, err31 := m.Res.MarshalTo(dAtA[i:])
-		if err31 != nil {
-			return 0, err31
+		{
+			size, err := m.Res.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n31
+		i--
+		dAtA[i] = 0x2a
 	}
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Errormsg)))
-	i += copy(dAtA[i:], m.Errormsg)
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.JoinIpp))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.JoinToken))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestJoinServerData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14554,46 +15003,54 @@ func (m *CMsgGCCStrike15V2_ClientRequestJoinServerData) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestJoinServerData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientRequestJoinServerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Version))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Serverid))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerIp))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerPort))
+	i -= len(m.Errormsg)
+	copy(dAtA[i:], m.Errormsg)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Errormsg)))
+	i--
+	dAtA[i] = 0x3a
 	if m.Res != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Res.Size()))
-		n32, err32 := m.Res.MarshalTo(dAtA[i:])
-		if err32 != nil {
-			return 0, err32
+		{
+			size, err := m.Res.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n32
+		i--
+		dAtA[i] = 0x32
 	}
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Errormsg)))
-	i += copy(dAtA[i:], m.Errormsg)
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerPort))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerIp))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Serverid))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCstrike15V2_ClientRequestNewMission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14601,23 +15058,28 @@ func (m *CMsgGCCstrike15V2_ClientRequestNewMission) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCstrike15V2_ClientRequestNewMission) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCstrike15V2_ClientRequestNewMission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MissionId))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CampaignId))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MissionId))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14625,44 +15087,51 @@ func (m *CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.OperationPointsAwarded))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.UpgradedDefidx))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CurrentLevel))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CurrentXp))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x10
 	if len(m.XpProgressData) > 0 {
-		for _, msg := range m.XpProgressData {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.XpProgressData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.XpProgressData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CurrentXp))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.CurrentLevel))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.UpgradedDefidx))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.OperationPointsAwarded))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_WatchInfoUsers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14670,42 +15139,49 @@ func (m *CMsgGCCStrike15V2_WatchInfoUsers) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_WatchInfoUsers) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_WatchInfoUsers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestId))
-	if len(m.AccountIds) > 0 {
-		for _, num := range m.AccountIds {
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
-		}
-	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ExtendedTimeout))
+	i--
+	dAtA[i] = 0x28
 	if len(m.WatchableMatchInfos) > 0 {
-		for _, msg := range m.WatchableMatchInfos {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.WatchableMatchInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WatchableMatchInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ExtendedTimeout))
-	return i, nil
+	if len(m.AccountIds) > 0 {
+		for iNdEx := len(m.AccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountIds[iNdEx]))
+			i--
+			dAtA[i] = 0x10
+		}
+	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestPlayersProfile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14713,33 +15189,38 @@ func (m *CMsgGCCStrike15V2_ClientRequestPlayersProfile) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestPlayersProfile) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientRequestPlayersProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestId_Deprecated))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestLevel))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x18
 	if len(m.AccountIds_Deprecated) > 0 {
-		for _, num := range m.AccountIds_Deprecated {
+		for iNdEx := len(m.AccountIds_Deprecated) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountIds_Deprecated[iNdEx]))
+			i--
 			dAtA[i] = 0x10
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestLevel))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestId_Deprecated))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_PlayersProfile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14747,32 +15228,39 @@ func (m *CMsgGCCStrike15V2_PlayersProfile) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_PlayersProfile) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_PlayersProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestId))
 	if len(m.AccountProfiles) > 0 {
-		for _, msg := range m.AccountProfiles {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.AccountProfiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountProfiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RequestId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14780,41 +15268,46 @@ func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseUpdate) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Caseid))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Suspectid))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Fractionid))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptAimbot))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptWallhack))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptSpeedhack))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptTeamharm))
-	dAtA[i] = 0x48
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reason))
-	return i, nil
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptTeamharm))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptSpeedhack))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptWallhack))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RptAimbot))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Fractionid))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Suspectid))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Caseid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseAssignment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14822,51 +15315,57 @@ func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseAssignment) Marshal() (dAtA []byte
 }
 
 func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseAssignment) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Caseid))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Caseurl)))
-	i += copy(dAtA[i:], m.Caseurl)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Verdict))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Timestamp))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Throttleseconds))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Suspectid))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Fractionid))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Numrounds))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Fractionrounds))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Streakconvictions))
-	dAtA[i] = 0x58
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Reason))
-	return i, nil
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Streakconvictions))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Fractionrounds))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Numrounds))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Fractionid))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Suspectid))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Throttleseconds))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Timestamp))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Verdict))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Caseurl)
+	copy(dAtA[i:], m.Caseurl)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Caseurl)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Caseid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14874,23 +15373,28 @@ func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseStatus) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseStatus) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_PlayerOverwatchCaseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Caseid))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Statusid))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Caseid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CClientHeaderOverwatchEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14898,23 +15402,28 @@ func (m *CClientHeaderOverwatchEvidence) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CClientHeaderOverwatchEvidence) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CClientHeaderOverwatchEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Caseid))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GC2ClientTextMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14922,29 +15431,35 @@ func (m *CMsgGCCStrike15V2_GC2ClientTextMsg) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CMsgGCCStrike15V2_GC2ClientTextMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GC2ClientTextMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Id))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Type))
 	if m.Payload != nil {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
 		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Payload)))
-		i += copy(dAtA[i:], m.Payload)
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCTextMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14952,28 +15467,34 @@ func (m *CMsgGCCStrike15V2_Client2GCTextMsg) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCTextMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Client2GCTextMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Id))
 	if len(m.Args) > 0 {
-		for _, b := range m.Args {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
 			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchEndRunRewardDrops) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -14981,37 +15502,46 @@ func (m *CMsgGCCStrike15V2_MatchEndRunRewardDrops) Marshal() (dAtA []byte, err e
 }
 
 func (m *CMsgGCCStrike15V2_MatchEndRunRewardDrops) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchEndRunRewardDrops) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Serverinfo != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Serverinfo.Size()))
-		n33, err33 := m.Serverinfo.MarshalTo(dAtA[i:])
-		if err33 != nil {
-			return 0, err33
-		}
-		i += n33
-	}
 	if m.MatchEndQuestData != nil {
+		{
+			size, err := m.MatchEndQuestData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchEndQuestData.Size()))
-		n34, err34 := m.MatchEndQuestData.MarshalTo(dAtA[i:])
-		if err34 != nil {
-			return 0, err34
+	}
+	if m.Serverinfo != nil {
+		{
+			size, err := m.Serverinfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n34
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CEconItemPreviewDataBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15019,87 +15549,95 @@ func (m *CEconItemPreviewDataBlock) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CEconItemPreviewDataBlock) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CEconItemPreviewDataBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Itemid))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Defindex))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Paintindex))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rarity))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Quality))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Paintwear))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Paintseed))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Killeaterscoretype))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Killeatervalue))
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Customname)))
-	i += copy(dAtA[i:], m.Customname)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Entindex))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x90
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Musicindex))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Dropreason))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Questid))
+	i--
+	dAtA[i] = 0x78
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Origin))
+	i--
+	dAtA[i] = 0x70
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Inventory))
+	i--
+	dAtA[i] = 0x68
 	if len(m.Stickers) > 0 {
-		for _, msg := range m.Stickers {
-			dAtA[i] = 0x62
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Stickers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stickers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Inventory))
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Origin))
-	dAtA[i] = 0x78
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Questid))
-	dAtA[i] = 0x80
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Dropreason))
-	dAtA[i] = 0x88
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Musicindex))
-	dAtA[i] = 0x90
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Entindex))
-	return i, nil
+	i -= len(m.Customname)
+	copy(dAtA[i:], m.Customname)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Customname)))
+	i--
+	dAtA[i] = 0x5a
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Killeatervalue))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Killeaterscoretype))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Paintseed))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Paintwear))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Quality))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rarity))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Paintindex))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Defindex))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Itemid))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CEconItemPreviewDataBlock_Sticker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15107,38 +15645,43 @@ func (m *CEconItemPreviewDataBlock_Sticker) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CEconItemPreviewDataBlock_Sticker) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CEconItemPreviewDataBlock_Sticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Slot))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.StickerId))
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Wear))))
-	i += 4
-	dAtA[i] = 0x25
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale))))
-	i += 4
-	dAtA[i] = 0x2d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rotation))))
-	i += 4
-	dAtA[i] = 0x30
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TintId))
-	return i, nil
+	i--
+	dAtA[i] = 0x30
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rotation))))
+	i--
+	dAtA[i] = 0x2d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale))))
+	i--
+	dAtA[i] = 0x25
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Wear))))
+	i--
+	dAtA[i] = 0x1d
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.StickerId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchEndRewardDropsNotification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15146,27 +15689,34 @@ func (m *CMsgGCCStrike15V2_MatchEndRewardDropsNotification) Marshal() (dAtA []by
 }
 
 func (m *CMsgGCCStrike15V2_MatchEndRewardDropsNotification) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchEndRewardDropsNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Iteminfo != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Iteminfo.Size()))
-		n35, err35 := m.Iteminfo.MarshalTo(dAtA[i:])
-		if err35 != nil {
-			return 0, err35
+		{
+			size, err := m.Iteminfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n35
+		i--
+		dAtA[i] = 0x32
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgItemAcknowledged) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15174,27 +15724,34 @@ func (m *CMsgItemAcknowledged) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgItemAcknowledged) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgItemAcknowledged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Iteminfo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Iteminfo.Size()))
-		n36, err36 := m.Iteminfo.MarshalTo(dAtA[i:])
-		if err36 != nil {
-			return 0, err36
+		{
+			size, err := m.Iteminfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n36
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15202,29 +15759,34 @@ func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockRequest) Marshal() (dAtA
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ParamS))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ParamA))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ParamD))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ParamM))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ParamD))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ParamA))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ParamS))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15232,27 +15794,34 @@ func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockResponse) Marshal() (dAt
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Client2GCEconPreviewDataBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Iteminfo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Iteminfo.Size()))
-		n37, err37 := m.Iteminfo.MarshalTo(dAtA[i:])
-		if err37 != nil {
-			return 0, err37
+		{
+			size, err := m.Iteminfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n37
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_TournamentMatchRewardDropsNotification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15260,30 +15829,35 @@ func (m *CMsgGCCStrike15V2_TournamentMatchRewardDropsNotification) Marshal() (dA
 }
 
 func (m *CMsgGCCStrike15V2_TournamentMatchRewardDropsNotification) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_TournamentMatchRewardDropsNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Defindex))
 	if len(m.Accountids) > 0 {
-		for _, num := range m.Accountids {
+		for iNdEx := len(m.Accountids) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountids[iNdEx]))
+			i--
 			dAtA[i] = 0x18
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Defindex))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestCurrentLiveGames) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15291,17 +15865,22 @@ func (m *CMsgGCCStrike15V2_MatchListRequestCurrentLiveGames) Marshal() (dAtA []b
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestCurrentLiveGames) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchListRequestCurrentLiveGames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestLiveGameForUser) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15309,20 +15888,25 @@ func (m *CMsgGCCStrike15V2_MatchListRequestLiveGameForUser) Marshal() (dAtA []by
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestLiveGameForUser) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchListRequestLiveGameForUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestRecentUserGames) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15330,20 +15914,25 @@ func (m *CMsgGCCStrike15V2_MatchListRequestRecentUserGames) Marshal() (dAtA []by
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestRecentUserGames) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchListRequestRecentUserGames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestTournamentGames) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15351,20 +15940,25 @@ func (m *CMsgGCCStrike15V2_MatchListRequestTournamentGames) Marshal() (dAtA []by
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestTournamentGames) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchListRequestTournamentGames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Eventid))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestFullGameInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15372,26 +15966,31 @@ func (m *CMsgGCCStrike15V2_MatchListRequestFullGameInfo) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_MatchListRequestFullGameInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchListRequestFullGameInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Outcomeid))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Token))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Outcomeid))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CDataGCCStrike15V2_MatchInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15399,55 +15998,66 @@ func (m *CDataGCCStrike15V2_MatchInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CDataGCCStrike15V2_MatchInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDataGCCStrike15V2_MatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchtime))
-	if m.Watchablematchinfo != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Watchablematchinfo.Size()))
-		n38, err38 := m.Watchablematchinfo.MarshalTo(dAtA[i:])
-		if err38 != nil {
-			return 0, err38
+	if len(m.Roundstatsall) > 0 {
+		for iNdEx := len(m.Roundstatsall) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roundstatsall[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
-		i += n38
 	}
 	if m.RoundstatsLegacy != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.RoundstatsLegacy.Size()))
-		n39, err39 := m.RoundstatsLegacy.MarshalTo(dAtA[i:])
-		if err39 != nil {
-			return 0, err39
+		{
+			size, err := m.RoundstatsLegacy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n39
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Roundstatsall) > 0 {
-		for _, msg := range m.Roundstatsall {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.Watchablematchinfo != nil {
+		{
+			size, err := m.Watchablematchinfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchtime))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CDataGCCStrike15V2_TournamentGroupTeam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15455,31 +16065,36 @@ func (m *CDataGCCStrike15V2_TournamentGroupTeam) Marshal() (dAtA []byte, err err
 }
 
 func (m *CDataGCCStrike15V2_TournamentGroupTeam) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDataGCCStrike15V2_TournamentGroupTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Score))
-	dAtA[i] = 0x18
-	i++
+	i--
 	if m.Correctpick {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Score))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TeamId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CDataGCCStrike15V2_TournamentGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15487,71 +16102,82 @@ func (m *CDataGCCStrike15V2_TournamentGroup) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CDataGCCStrike15V2_TournamentGroup) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDataGCCStrike15V2_TournamentGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Groupid))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Desc)))
-	i += copy(dAtA[i:], m.Desc)
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Picks_Deprecated))
-	if len(m.Teams) > 0 {
-		for _, msg := range m.Teams {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Picks) > 0 {
+		for iNdEx := len(m.Picks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Picks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x52
 		}
 	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PointsPerPick))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Pickableteams))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Picklockuntiltime))
+	i--
+	dAtA[i] = 0x38
 	if len(m.StageIds) > 0 {
-		for _, num := range m.StageIds {
+		for iNdEx := len(m.StageIds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.StageIds[iNdEx]))
+			i--
 			dAtA[i] = 0x30
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Picklockuntiltime))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Pickableteams))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PointsPerPick))
-	if len(m.Picks) > 0 {
-		for _, msg := range m.Picks {
-			dAtA[i] = 0x52
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Teams) > 0 {
+		for iNdEx := len(m.Teams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Teams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Picks_Deprecated))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Desc)
+	copy(dAtA[i:], m.Desc)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Desc)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Groupid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CDataGCCStrike15V2_TournamentGroup_Picks) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15559,24 +16185,29 @@ func (m *CDataGCCStrike15V2_TournamentGroup_Picks) Marshal() (dAtA []byte, err e
 }
 
 func (m *CDataGCCStrike15V2_TournamentGroup_Picks) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDataGCCStrike15V2_TournamentGroup_Picks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Pickids) > 0 {
-		for _, num := range m.Pickids {
+		for iNdEx := len(m.Pickids) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Pickids[iNdEx]))
+			i--
 			dAtA[i] = 0x8
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CDataGCCStrike15V2_TournamentSection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15584,40 +16215,49 @@ func (m *CDataGCCStrike15V2_TournamentSection) Marshal() (dAtA []byte, err error
 }
 
 func (m *CDataGCCStrike15V2_TournamentSection) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDataGCCStrike15V2_TournamentSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Sectionid))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Desc)))
-	i += copy(dAtA[i:], m.Desc)
 	if len(m.Groups) > 0 {
-		for _, msg := range m.Groups {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return i, nil
+	i -= len(m.Desc)
+	copy(dAtA[i:], m.Desc)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Desc)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Sectionid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CDataGCCStrike15V2_TournamentInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15625,51 +16265,62 @@ func (m *CDataGCCStrike15V2_TournamentInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CDataGCCStrike15V2_TournamentInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDataGCCStrike15V2_TournamentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sections) > 0 {
-		for _, msg := range m.Sections {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.TournamentTeams) > 0 {
+		for iNdEx := len(m.TournamentTeams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TournamentTeams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
 	if m.TournamentEvent != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TournamentEvent.Size()))
-		n40, err40 := m.TournamentEvent.MarshalTo(dAtA[i:])
-		if err40 != nil {
-			return 0, err40
-		}
-		i += n40
-	}
-	if len(m.TournamentTeams) > 0 {
-		for _, msg := range m.TournamentTeams {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+		{
+			size, err := m.TournamentEvent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sections) > 0 {
+		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_MatchList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15677,60 +16328,71 @@ func (m *CMsgGCCStrike15V2_MatchList) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_MatchList) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_MatchList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Msgrequestid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Servertime))
-	if len(m.Matches) > 0 {
-		for _, msg := range m.Matches {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.Tournamentinfo != nil {
+		{
+			size, err := m.Tournamentinfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.Streams) > 0 {
-		for _, msg := range m.Streams {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.Tournamentinfo != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Tournamentinfo.Size()))
-		n41, err41 := m.Tournamentinfo.MarshalTo(dAtA[i:])
-		if err41 != nil {
-			return 0, err41
+	if len(m.Matches) > 0 {
+		for iNdEx := len(m.Matches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Matches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i += n41
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Servertime))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Msgrequestid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Predictions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15738,32 +16400,39 @@ func (m *CMsgGCCStrike15V2_Predictions) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_Predictions) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Predictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
 	if len(m.GroupMatchTeamPicks) > 0 {
-		for _, msg := range m.GroupMatchTeamPicks {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.GroupMatchTeamPicks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupMatchTeamPicks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Predictions_GroupMatchTeamPick) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15771,32 +16440,37 @@ func (m *CMsgGCCStrike15V2_Predictions_GroupMatchTeamPick) Marshal() (dAtA []byt
 }
 
 func (m *CMsgGCCStrike15V2_Predictions_GroupMatchTeamPick) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Predictions_GroupMatchTeamPick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Sectionid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Groupid))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Index))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Teamid))
-	dAtA[i] = 0x28
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Itemid))
-	return i, nil
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Teamid))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Groupid))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Sectionid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Fantasy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15804,32 +16478,39 @@ func (m *CMsgGCCStrike15V2_Fantasy) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_Fantasy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Fantasy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
 	if len(m.Teams) > 0 {
-		for _, msg := range m.Teams {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Teams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Teams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.EventId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Fantasy_FantasySlot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15837,26 +16518,31 @@ func (m *CMsgGCCStrike15V2_Fantasy_FantasySlot) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CMsgGCCStrike15V2_Fantasy_FantasySlot) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Fantasy_FantasySlot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Type))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Pick))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Itemid))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Pick))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Fantasy_FantasyTeam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15864,32 +16550,39 @@ func (m *CMsgGCCStrike15V2_Fantasy_FantasyTeam) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CMsgGCCStrike15V2_Fantasy_FantasyTeam) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Fantasy_FantasyTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Sectionid))
 	if len(m.Slots) > 0 {
-		for _, msg := range m.Slots {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Slots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Sectionid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CAttribute_String) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15897,21 +16590,27 @@ func (m *CAttribute_String) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CAttribute_String) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CAttribute_String) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCToGCReloadVersions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15919,17 +16618,22 @@ func (m *CMsgGCToGCReloadVersions) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCToGCReloadVersions) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCToGCReloadVersions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgCStrike15Welcome) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15937,42 +16641,47 @@ func (m *CMsgCStrike15Welcome) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgCStrike15Welcome) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgCStrike15Welcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.StoreItemHash))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Timeplayedconsecutively))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TimeFirstPlayed))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.LastTimePlayed))
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.LastIpAddress))
-	dAtA[i] = 0x90
-	i++
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Uniqueid))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Gscookieid))
+	i--
 	dAtA[i] = 0x98
-	i++
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Gscookieid))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Uniqueid))
-	return i, nil
+	i--
+	dAtA[i] = 0x90
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.LastIpAddress))
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.LastTimePlayed))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TimeFirstPlayed))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Timeplayedconsecutively))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.StoreItemHash))
+	i--
+	dAtA[i] = 0x28
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientVarValueNotificationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -15980,45 +16689,45 @@ func (m *CMsgGCCStrike15V2_ClientVarValueNotificationInfo) Marshal() (dAtA []byt
 }
 
 func (m *CMsgGCCStrike15V2_ClientVarValueNotificationInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientVarValueNotificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.ValueName)))
-	i += copy(dAtA[i:], m.ValueName)
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ValueInt))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerAddr))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerPort))
 	if len(m.ChokedBlocks) > 0 {
-		for _, s := range m.ChokedBlocks {
+		for iNdEx := len(m.ChokedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChokedBlocks[iNdEx])
+			copy(dAtA[i:], m.ChokedBlocks[iNdEx])
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.ChokedBlocks[iNdEx])))
+			i--
 			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerPort))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ServerAddr))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.ValueInt))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.ValueName)
+	copy(dAtA[i:], m.ValueName)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.ValueName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ServerVarValueNotificationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16026,30 +16735,35 @@ func (m *CMsgGCCStrike15V2_ServerVarValueNotificationInfo) Marshal() (dAtA []byt
 }
 
 func (m *CMsgGCCStrike15V2_ServerVarValueNotificationInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ServerVarValueNotificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x18
 	if len(m.Viewangles) > 0 {
-		for _, num := range m.Viewangles {
+		for iNdEx := len(m.Viewangles) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Viewangles[iNdEx]))
+			i--
 			dAtA[i] = 0x10
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Type))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GiftsLeaderboardRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16057,17 +16771,22 @@ func (m *CMsgGCCStrike15V2_GiftsLeaderboardRequest) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCStrike15V2_GiftsLeaderboardRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GiftsLeaderboardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16075,41 +16794,48 @@ func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Servertime))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TimePeriodSeconds))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TotalGiftsGiven))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TotalGivers))
 	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TotalGivers))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TotalGiftsGiven))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TimePeriodSeconds))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Servertime))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse_GiftLeaderboardEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16117,23 +16843,28 @@ func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse_GiftLeaderboardEntry) Marsha
 }
 
 func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse_GiftLeaderboardEntry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GiftsLeaderboardResponse_GiftLeaderboardEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Gifts))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientSubmitSurveyVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16141,23 +16872,28 @@ func (m *CMsgGCCStrike15V2_ClientSubmitSurveyVote) Marshal() (dAtA []byte, err e
 }
 
 func (m *CMsgGCCStrike15V2_ClientSubmitSurveyVote) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientSubmitSurveyVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SurveyId))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Vote))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.SurveyId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Server2GCClientValidate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16165,20 +16901,25 @@ func (m *CMsgGCCStrike15V2_Server2GCClientValidate) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCStrike15V2_Server2GCClientValidate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Server2GCClientValidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Server2GCPureServerValidationFailure) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16186,43 +16927,50 @@ func (m *CMsgGCCStrike15V2_Server2GCPureServerValidationFailure) Marshal() (dAtA
 }
 
 func (m *CMsgGCCStrike15V2_Server2GCPureServerValidationFailure) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Server2GCPureServerValidationFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.File)))
-	i += copy(dAtA[i:], m.File)
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Crc))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Hash))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Len))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PackNumber))
-	dAtA[i] = 0x40
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PackFileId))
-	return i, nil
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PackNumber))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Len))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Hash))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Crc))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.File)
+	copy(dAtA[i:], m.File)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.File)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GC2ClientTournamentInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16230,33 +16978,38 @@ func (m *CMsgGCCStrike15V2_GC2ClientTournamentInfo) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCStrike15V2_GC2ClientTournamentInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GC2ClientTournamentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Eventid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Stageid))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
 	if len(m.Teamids) > 0 {
-		for _, num := range m.Teamids {
+		for iNdEx := len(m.Teamids) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Teamids[iNdEx]))
+			i--
 			dAtA[i] = 0x20
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Stageid))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Eventid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSOEconCoupon) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16264,27 +17017,32 @@ func (m *CSOEconCoupon) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSOEconCoupon) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSOEconCoupon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Entryid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Defidx))
-	dAtA[i] = 0x1d
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.ExpirationDate))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x1d
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Defidx))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Entryid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSOQuestProgress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16292,26 +17050,31 @@ func (m *CSOQuestProgress) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSOQuestProgress) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSOQuestProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Questid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PointsRemaining))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.BonusPoints))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PointsRemaining))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Questid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSOPersonaDataPublic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16319,38 +17082,45 @@ func (m *CSOPersonaDataPublic) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSOPersonaDataPublic) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSOPersonaDataPublic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerLevel))
-	if m.Commendation != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Commendation.Size()))
-		n42, err42 := m.Commendation.MarshalTo(dAtA[i:])
-		if err42 != nil {
-			return 0, err42
-		}
-		i += n42
-	}
-	dAtA[i] = 0x18
-	i++
+	i--
 	if m.ElevatedState {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	if m.Commendation != nil {
+		{
+			size, err := m.Commendation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.PlayerLevel))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGC_GlobalGame_Subscribe) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16358,20 +17128,25 @@ func (m *CMsgGC_GlobalGame_Subscribe) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGC_GlobalGame_Subscribe) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGC_GlobalGame_Subscribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ticket))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGC_GlobalGame_Unsubscribe) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16379,20 +17154,25 @@ func (m *CMsgGC_GlobalGame_Unsubscribe) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGC_GlobalGame_Unsubscribe) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGC_GlobalGame_Unsubscribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Timeleft))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGC_GlobalGame_Play) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16400,26 +17180,31 @@ func (m *CMsgGC_GlobalGame_Play) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGC_GlobalGame_Play) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGC_GlobalGame_Play) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ticket))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Gametimems))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Msperpoint))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Gametimems))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ticket))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_AcknowledgePenalty) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16427,20 +17212,25 @@ func (m *CMsgGCCStrike15V2_AcknowledgePenalty) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgGCCStrike15V2_AcknowledgePenalty) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_AcknowledgePenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Acknowledged))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCRequestPrestigeCoin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16448,29 +17238,34 @@ func (m *CMsgGCCStrike15V2_Client2GCRequestPrestigeCoin) Marshal() (dAtA []byte,
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCRequestPrestigeCoin) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Client2GCRequestPrestigeCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Defindex))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Upgradeid))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Hours))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Prestigetime))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Hours))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Upgradeid))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Defindex))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCStreamUnlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16478,23 +17273,28 @@ func (m *CMsgGCCStrike15V2_Client2GCStreamUnlock) Marshal() (dAtA []byte, err er
 }
 
 func (m *CMsgGCCStrike15V2_Client2GCStreamUnlock) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Client2GCStreamUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ticket))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Os))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ticket))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientToGCRequestElevate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16502,20 +17302,25 @@ func (m *CMsgGCCStrike15V2_ClientToGCRequestElevate) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCStrike15V2_ClientToGCRequestElevate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientToGCRequestElevate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Stage))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientToGCChat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16523,24 +17328,30 @@ func (m *CMsgGCCStrike15V2_ClientToGCChat) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_ClientToGCChat) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientToGCChat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.MatchId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GCToClientChat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16548,24 +17359,30 @@ func (m *CMsgGCCStrike15V2_GCToClientChat) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_GCToClientChat) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GCToClientChat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientAuthKeyCode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16573,24 +17390,30 @@ func (m *CMsgGCCStrike15V2_ClientAuthKeyCode) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CMsgGCCStrike15V2_ClientAuthKeyCode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientAuthKeyCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Eventid))
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Code)
+	copy(dAtA[i:], m.Code)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Code)))
-	i += copy(dAtA[i:], m.Code)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Eventid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15_GotvSyncPacket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16598,27 +17421,34 @@ func (m *CMsgGCCStrike15_GotvSyncPacket) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15_GotvSyncPacket) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15_GotvSyncPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Data != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Data.Size()))
-		n43, err43 := m.Data.MarshalTo(dAtA[i:])
-		if err43 != nil {
-			return 0, err43
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n43
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *PlayerDecalDigitalSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16626,87 +17456,93 @@ func (m *PlayerDecalDigitalSignature) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *PlayerDecalDigitalSignature) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerDecalDigitalSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Signature != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Signature)))
-		i += copy(dAtA[i:], m.Signature)
-	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtime))
-	if len(m.Endpos) > 0 {
-		for _, num := range m.Endpos {
-			dAtA[i] = 0x25
-			i++
-			f44 := math.Float32bits(float32(num))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TintId))
+	i--
+	dAtA[i] = 0x70
+	if len(m.Normal) > 0 {
+		for iNdEx := len(m.Normal) - 1; iNdEx >= 0; iNdEx-- {
+			f44 := math.Float32bits(float32(m.Normal[iNdEx]))
+			i -= 4
 			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f44))
-			i += 4
+			i--
+			dAtA[i] = 0x6d
 		}
 	}
-	if len(m.Startpos) > 0 {
-		for _, num := range m.Startpos {
-			dAtA[i] = 0x2d
-			i++
-			f45 := math.Float32bits(float32(num))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TraceId))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Equipslot))
+	i--
+	dAtA[i] = 0x58
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Creationtime))))
+	i--
+	dAtA[i] = 0x55
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Hitbox))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Entindex))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TxDefidx))
+	i--
+	dAtA[i] = 0x38
+	if len(m.Right) > 0 {
+		for iNdEx := len(m.Right) - 1; iNdEx >= 0; iNdEx-- {
+			f45 := math.Float32bits(float32(m.Right[iNdEx]))
+			i -= 4
 			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f45))
-			i += 4
+			i--
+			dAtA[i] = 0x35
 		}
 	}
-	if len(m.Right) > 0 {
-		for _, num := range m.Right {
-			dAtA[i] = 0x35
-			i++
-			f46 := math.Float32bits(float32(num))
+	if len(m.Startpos) > 0 {
+		for iNdEx := len(m.Startpos) - 1; iNdEx >= 0; iNdEx-- {
+			f46 := math.Float32bits(float32(m.Startpos[iNdEx]))
+			i -= 4
 			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f46))
-			i += 4
+			i--
+			dAtA[i] = 0x2d
 		}
 	}
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TxDefidx))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Entindex))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Hitbox))
-	dAtA[i] = 0x55
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Creationtime))))
-	i += 4
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Equipslot))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TraceId))
-	if len(m.Normal) > 0 {
-		for _, num := range m.Normal {
-			dAtA[i] = 0x6d
-			i++
-			f47 := math.Float32bits(float32(num))
+	if len(m.Endpos) > 0 {
+		for iNdEx := len(m.Endpos) - 1; iNdEx >= 0; iNdEx-- {
+			f47 := math.Float32bits(float32(m.Endpos[iNdEx]))
+			i -= 4
 			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f47))
-			i += 4
+			i--
+			dAtA[i] = 0x25
 		}
 	}
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.TintId))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtime))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x10
+	if m.Signature != nil {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientPlayerDecalSign) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16714,30 +17550,37 @@ func (m *CMsgGCCStrike15V2_ClientPlayerDecalSign) Marshal() (dAtA []byte, err er
 }
 
 func (m *CMsgGCCStrike15V2_ClientPlayerDecalSign) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientPlayerDecalSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Itemid))
+	i--
+	dAtA[i] = 0x10
 	if m.Data != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Data.Size()))
-		n48, err48 := m.Data.MarshalTo(dAtA[i:])
-		if err48 != nil {
-			return 0, err48
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 		}
-		i += n48
+		i--
+		dAtA[i] = 0xa
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Itemid))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientLogonFatalError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16745,28 +17588,35 @@ func (m *CMsgGCCStrike15V2_ClientLogonFatalError) Marshal() (dAtA []byte, err er
 }
 
 func (m *CMsgGCCStrike15V2_ClientLogonFatalError) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientLogonFatalError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Errorcode))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Message)))
-	i += copy(dAtA[i:], m.Message)
-	dAtA[i] = 0x1a
-	i++
+	i -= len(m.Country)
+	copy(dAtA[i:], m.Country)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Country)))
-	i += copy(dAtA[i:], m.Country)
-	return i, nil
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Errorcode))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientPollState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16774,42 +17624,41 @@ func (m *CMsgGCCStrike15V2_ClientPollState) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_ClientPollState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientPollState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Pollid))
-	if len(m.Names) > 0 {
-		for _, s := range m.Names {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
 	if len(m.Values) > 0 {
-		for _, num := range m.Values {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Values[iNdEx]))
+			i--
 			dAtA[i] = 0x18
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	return i, nil
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Pollid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Party_Register) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16817,44 +17666,49 @@ func (m *CMsgGCCStrike15V2_Party_Register) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_Party_Register) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Party_Register) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Id))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ver))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Apr))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ark))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Nby))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Grp))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Slots))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Launcher))
-	dAtA[i] = 0x48
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
-	return i, nil
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Launcher))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Slots))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Grp))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Nby))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ark))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Apr))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ver))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Party_Search) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16862,39 +17716,44 @@ func (m *CMsgGCCStrike15V2_Party_Search) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_Party_Search) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Party_Search) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ver))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Apr))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ark))
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Launcher))
+	i--
+	dAtA[i] = 0x28
 	if len(m.Grps) > 0 {
-		for _, num := range m.Grps {
+		for iNdEx := len(m.Grps) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Grps[iNdEx]))
+			i--
 			dAtA[i] = 0x20
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(num))
 		}
 	}
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Launcher))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
-	return i, nil
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ark))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Apr))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ver))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Party_SearchResults) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16902,29 +17761,36 @@ func (m *CMsgGCCStrike15V2_Party_SearchResults) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CMsgGCCStrike15V2_Party_SearchResults) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Party_SearchResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Party_SearchResults_Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16932,35 +17798,40 @@ func (m *CMsgGCCStrike15V2_Party_SearchResults_Entry) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCCStrike15V2_Party_SearchResults_Entry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Party_SearchResults_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Id))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Grp))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Apr))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ark))
-	dAtA[i] = 0x30
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Loc))
-	return i, nil
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Ark))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Apr))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.GameType))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Grp))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Party_Invite) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16968,23 +17839,28 @@ func (m *CMsgGCCStrike15V2_Party_Invite) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCStrike15V2_Party_Invite) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Party_Invite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Lobbyid))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Account_RequestCoPlays) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -16992,32 +17868,39 @@ func (m *CMsgGCCStrike15V2_Account_RequestCoPlays) Marshal() (dAtA []byte, err e
 }
 
 func (m *CMsgGCCStrike15V2_Account_RequestCoPlays) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Account_RequestCoPlays) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Servertime))
+	i--
+	dAtA[i] = 0x10
 	if len(m.Players) > 0 {
-		for _, msg := range m.Players {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Players) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Players[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Servertime))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_Account_RequestCoPlays_Player) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17025,31 +17908,36 @@ func (m *CMsgGCCStrike15V2_Account_RequestCoPlays_Player) Marshal() (dAtA []byte
 }
 
 func (m *CMsgGCCStrike15V2_Account_RequestCoPlays_Player) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_Account_RequestCoPlays_Player) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtcoplay))
-	dAtA[i] = 0x18
-	i++
+	i--
 	if m.Online {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Rtcoplay))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientToGCRequestTicket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17057,33 +17945,39 @@ func (m *CMsgGCCStrike15V2_ClientToGCRequestTicket) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCStrike15V2_ClientToGCRequestTicket) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientToGCRequestTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AuthorizedSteamId))
-	i += 8
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.AuthorizedPublicIp))
-	i += 4
-	dAtA[i] = 0x19
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GameserverSteamId))
-	i += 8
-	dAtA[i] = 0x2a
-	i++
+	i -= len(m.GameserverSdrRouting)
+	copy(dAtA[i:], m.GameserverSdrRouting)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.GameserverSdrRouting)))
-	i += copy(dAtA[i:], m.GameserverSdrRouting)
-	return i, nil
+	i--
+	dAtA[i] = 0x2a
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GameserverSteamId))
+	i--
+	dAtA[i] = 0x19
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.AuthorizedPublicIp))
+	i--
+	dAtA[i] = 0x15
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AuthorizedSteamId))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCToClientSteamDatagramTicket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17091,25 +17985,31 @@ func (m *CMsgGCToClientSteamDatagramTicket) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCToClientSteamDatagramTicket) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCToClientSteamDatagramTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.SerializedTicket != nil {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x1
-		i++
+		i -= len(m.SerializedTicket)
+		copy(dAtA[i:], m.SerializedTicket)
 		i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.SerializedTicket)))
-		i += copy(dAtA[i:], m.SerializedTicket)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestOffers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17117,17 +18017,22 @@ func (m *CMsgGCCStrike15V2_ClientRequestOffers) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestOffers) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientRequestOffers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestSouvenir) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17135,26 +18040,31 @@ func (m *CMsgGCCStrike15V2_ClientRequestSouvenir) Marshal() (dAtA []byte, err er
 }
 
 func (m *CMsgGCCStrike15V2_ClientRequestSouvenir) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientRequestSouvenir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Itemid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Eventid))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Matchid))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Itemid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientAccountBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17162,24 +18072,30 @@ func (m *CMsgGCCStrike15V2_ClientAccountBalance) Marshal() (dAtA []byte, err err
 }
 
 func (m *CMsgGCCStrike15V2_ClientAccountBalance) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientAccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Amount))
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Url)
+	copy(dAtA[i:], m.Url)
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.Url)))
-	i += copy(dAtA[i:], m.Url)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Amount))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientPartyJoinRelay) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17187,23 +18103,28 @@ func (m *CMsgGCCStrike15V2_ClientPartyJoinRelay) Marshal() (dAtA []byte, err err
 }
 
 func (m *CMsgGCCStrike15V2_ClientPartyJoinRelay) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientPartyJoinRelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Lobbyid))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientPartyWarning) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17211,29 +18132,36 @@ func (m *CMsgGCCStrike15V2_ClientPartyWarning) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgGCCStrike15V2_ClientPartyWarning) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientPartyWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_ClientPartyWarning_Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17241,23 +18169,28 @@ func (m *CMsgGCCStrike15V2_ClientPartyWarning_Entry) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCCStrike15V2_ClientPartyWarning_Entry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_ClientPartyWarning_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Warntype))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_SetEventFavorite) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17265,28 +18198,33 @@ func (m *CMsgGCCStrike15V2_SetEventFavorite) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CMsgGCCStrike15V2_SetEventFavorite) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_SetEventFavorite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Eventid))
-	dAtA[i] = 0x10
-	i++
+	i--
 	if m.IsFavorite {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(m.Eventid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GetEventFavorites_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17294,25 +18232,30 @@ func (m *CMsgGCCStrike15V2_GetEventFavorites_Request) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCCStrike15V2_GetEventFavorites_Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GetEventFavorites_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	i--
 	if m.AllEvents {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCStrike15V2_GetEventFavorites_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -17320,37 +18263,46 @@ func (m *CMsgGCCStrike15V2_GetEventFavorites_Response) Marshal() (dAtA []byte, e
 }
 
 func (m *CMsgGCCStrike15V2_GetEventFavorites_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCStrike15V2_GetEventFavorites_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	i -= len(m.JsonFeatured)
+	copy(dAtA[i:], m.JsonFeatured)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.JsonFeatured)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.JsonFavorites)
+	copy(dAtA[i:], m.JsonFavorites)
+	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.JsonFavorites)))
+	i--
+	dAtA[i] = 0x12
+	i--
 	if m.AllEvents {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.JsonFavorites)))
-	i += copy(dAtA[i:], m.JsonFavorites)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Gcmessages(dAtA, i, uint64(len(m.JsonFeatured)))
-	i += copy(dAtA[i:], m.JsonFeatured)
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintCstrike15Gcmessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCstrike15Gcmessages(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *GameServerPing) Size() (n int) {
 	if m == nil {
@@ -44195,6 +45147,7 @@ func (m *CMsgGCCStrike15V2_GetEventFavorites_Response) Unmarshal(dAtA []byte) er
 func skipCstrike15Gcmessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -44226,10 +45179,8 @@ func skipCstrike15Gcmessages(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -44250,55 +45201,30 @@ func skipCstrike15Gcmessages(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthCstrike15Gcmessages
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthCstrike15Gcmessages
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowCstrike15Gcmessages
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipCstrike15Gcmessages(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthCstrike15Gcmessages
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCstrike15Gcmessages
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCstrike15Gcmessages
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthCstrike15Gcmessages = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowCstrike15Gcmessages   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthCstrike15Gcmessages        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCstrike15Gcmessages          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCstrike15Gcmessages = fmt.Errorf("proto: unexpected end of group")
 )
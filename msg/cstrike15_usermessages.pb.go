@@ -22,7 +22,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ECstrike15UserMessages int32
 
@@ -97,6 +97,7 @@ const (
 	ECstrike15UserMessages_CS_UM_SSUI                         ECstrike15UserMessages = 72
 	ECstrike15UserMessages_CS_UM_SurvivalStats                ECstrike15UserMessages = 73
 	ECstrike15UserMessages_CS_UM_DisconnectToLobby2           ECstrike15UserMessages = 74
+	ECstrike15UserMessages_CS_UM_EndOfMatchAllPlayersData     ECstrike15UserMessages = 75
 )
 
 var ECstrike15UserMessages_name = map[int32]string{
@@ -170,6 +171,7 @@ var ECstrike15UserMessages_name = map[int32]string{
 	72: "CS_UM_SSUI",
 	73: "CS_UM_SurvivalStats",
 	74: "CS_UM_DisconnectToLobby2",
+	75: "CS_UM_EndOfMatchAllPlayersData",
 }
 
 var ECstrike15UserMessages_value = map[string]int32{
@@ -243,6 +245,7 @@ var ECstrike15UserMessages_value = map[string]int32{
 	"CS_UM_SSUI":                         72,
 	"CS_UM_SurvivalStats":                73,
 	"CS_UM_DisconnectToLobby2":           74,
+	"CS_UM_EndOfMatchAllPlayersData":     75,
 }
 
 func (x ECstrike15UserMessages) Enum() *ECstrike15UserMessages {
@@ -328,7 +331,7 @@ func (m *CCSUsrMsg_VGUIMenu) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_VGUIMenu.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -387,7 +390,7 @@ func (m *CCSUsrMsg_VGUIMenu_Subkey) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CCSUsrMsg_VGUIMenu_Subkey.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -438,7 +441,7 @@ func (m *CCSUsrMsg_Geiger) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CCSUsrMsg_Geiger.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -482,7 +485,7 @@ func (m *CCSUsrMsg_Train) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_CCSUsrMsg_Train.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -526,7 +529,7 @@ func (m *CCSUsrMsg_HudText) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CCSUsrMsg_HudText.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -573,7 +576,7 @@ func (m *CCSUsrMsg_SayText) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CCSUsrMsg_SayText.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -642,7 +645,7 @@ func (m *CCSUsrMsg_SayText2) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_SayText2.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -715,7 +718,7 @@ func (m *CCSUsrMsg_TextMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CCSUsrMsg_TextMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -775,7 +778,7 @@ func (m *CCSUsrMsg_HudMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CCSUsrMsg_HudMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -885,7 +888,7 @@ func (m *CCSUsrMsg_Shake) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_CCSUsrMsg_Shake.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -953,7 +956,7 @@ func (m *CCSUsrMsg_Fade) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_CCSUsrMsg_Fade.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1020,7 +1023,7 @@ func (m *CCSUsrMsg_Rumble) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CCSUsrMsg_Rumble.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1080,7 +1083,7 @@ func (m *CCSUsrMsg_CloseCaption) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CCSUsrMsg_CloseCaption.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1140,7 +1143,7 @@ func (m *CCSUsrMsg_CloseCaptionDirect) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CCSUsrMsg_CloseCaptionDirect.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1198,7 +1201,7 @@ func (m *CCSUsrMsg_SendAudio) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_SendAudio.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1245,7 +1248,7 @@ func (m *CCSUsrMsg_RawAudio) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_RawAudio.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1311,7 +1314,7 @@ func (m *CCSUsrMsg_VoiceMask) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_VoiceMask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1363,7 +1366,7 @@ func (m *CCSUsrMsg_VoiceMask_PlayerMask) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CCSUsrMsg_VoiceMask_PlayerMask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1416,7 +1419,7 @@ func (m *CCSUsrMsg_Damage) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CCSUsrMsg_Damage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1477,7 +1480,7 @@ func (m *CCSUsrMsg_RadioText) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_RadioText.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1542,7 +1545,7 @@ func (m *CCSUsrMsg_HintText) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_HintText.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1586,7 +1589,7 @@ func (m *CCSUsrMsg_KeyHintText) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CCSUsrMsg_KeyHintText.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1631,7 +1634,7 @@ func (m *CCSUsrMsg_ProcessSpottedEntityUpdate) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CCSUsrMsg_ProcessSpottedEntityUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1694,7 +1697,7 @@ func (m *CCSUsrMsg_ProcessSpottedEntityUpdate_SpottedEntityUpdate) XXX_Marshal(b
 		return xxx_messageInfo_CCSUsrMsg_ProcessSpottedEntityUpdate_SpottedEntityUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1794,7 +1797,7 @@ func (m *CCSUsrMsg_SendPlayerItemDrops) XXX_Marshal(b []byte, deterministic bool
 		return xxx_messageInfo_CCSUsrMsg_SendPlayerItemDrops.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1839,7 +1842,7 @@ func (m *CCSUsrMsg_SendPlayerItemFound) XXX_Marshal(b []byte, deterministic bool
 		return xxx_messageInfo_CCSUsrMsg_SendPlayerItemFound.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1894,7 +1897,7 @@ func (m *CCSUsrMsg_ReloadEffect) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CCSUsrMsg_ReloadEffect.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1971,7 +1974,7 @@ func (m *CCSUsrMsg_WeaponSound) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CCSUsrMsg_WeaponSound.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2053,7 +2056,7 @@ func (m *CCSUsrMsg_UpdateScreenHealthBar) XXX_Marshal(b []byte, deterministic bo
 		return xxx_messageInfo_CCSUsrMsg_UpdateScreenHealthBar.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2118,7 +2121,7 @@ func (m *CCSUsrMsg_EntityOutlineHighlight) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CCSUsrMsg_EntityOutlineHighlight.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2162,7 +2165,7 @@ func (m *CCSUsrMsg_AdjustMoney) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CCSUsrMsg_AdjustMoney.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2209,7 +2212,7 @@ func (m *CCSUsrMsg_ReportHit) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_ReportHit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2276,7 +2279,7 @@ func (m *CCSUsrMsg_KillCam) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CCSUsrMsg_KillCam.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2337,7 +2340,7 @@ func (m *CCSUsrMsg_DesiredTimescale) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CCSUsrMsg_DesiredTimescale.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2402,7 +2405,7 @@ func (m *CCSUsrMsg_CurrentTimescale) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CCSUsrMsg_CurrentTimescale.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2448,7 +2451,7 @@ func (m *CCSUsrMsg_AchievementEvent) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CCSUsrMsg_AchievementEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2509,7 +2512,7 @@ func (m *CCSUsrMsg_MatchEndConditions) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CCSUsrMsg_MatchEndConditions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2577,7 +2580,7 @@ func (m *CCSUsrMsg_PlayerStatsUpdate) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CCSUsrMsg_PlayerStatsUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2643,7 +2646,7 @@ func (m *CCSUsrMsg_PlayerStatsUpdate_Stat) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CCSUsrMsg_PlayerStatsUpdate_Stat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2695,7 +2698,7 @@ func (m *CCSUsrMsg_DisplayInventory) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CCSUsrMsg_DisplayInventory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2749,7 +2752,7 @@ func (m *CCSUsrMsg_QuestProgress) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_CCSUsrMsg_QuestProgress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2814,7 +2817,7 @@ func (m *CCSUsrMsg_ScoreLeaderboardData) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CCSUsrMsg_ScoreLeaderboardData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2858,7 +2861,7 @@ func (m *CCSUsrMsg_PlayerDecalDigitalSignature) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CCSUsrMsg_PlayerDecalDigitalSignature.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2903,7 +2906,7 @@ func (m *CCSUsrMsg_XRankGet) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_XRankGet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2956,7 +2959,7 @@ func (m *CCSUsrMsg_XRankUpd) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_XRankUpd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3015,7 +3018,7 @@ func (m *CCSUsrMsg_CallVoteFailed) XXX_Marshal(b []byte, deterministic bool) ([]
 		return xxx_messageInfo_CCSUsrMsg_CallVoteFailed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3073,7 +3076,7 @@ func (m *CCSUsrMsg_VoteStart) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_VoteStart.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3169,7 +3172,7 @@ func (m *CCSUsrMsg_VotePass) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_VotePass.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3235,7 +3238,7 @@ func (m *CCSUsrMsg_VoteFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CCSUsrMsg_VoteFailed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3286,7 +3289,7 @@ func (m *CCSUsrMsg_VoteSetup) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_VoteSetup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3335,7 +3338,7 @@ func (m *CCSUsrMsg_SendLastKillerDamageToClient) XXX_Marshal(b []byte, determini
 		return xxx_messageInfo_CCSUsrMsg_SendLastKillerDamageToClient.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3400,7 +3403,7 @@ func (m *CCSUsrMsg_ServerRankUpdate) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CCSUsrMsg_ServerRankUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3449,7 +3452,7 @@ func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CCSUsrMsg_ServerRankUpdate_RankUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3528,7 +3531,7 @@ func (m *CCSUsrMsg_XpUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_XpUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3572,7 +3575,7 @@ func (m *CCSUsrMsg_ItemPickup) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CCSUsrMsg_ItemPickup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3618,7 +3621,7 @@ func (m *CCSUsrMsg_ShowMenu) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_ShowMenu.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3676,7 +3679,7 @@ func (m *CCSUsrMsg_BarTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CCSUsrMsg_BarTime.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3720,7 +3723,7 @@ func (m *CCSUsrMsg_AmmoDenied) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CCSUsrMsg_AmmoDenied.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3764,7 +3767,7 @@ func (m *CCSUsrMsg_MarkAchievement) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CCSUsrMsg_MarkAchievement.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3808,7 +3811,7 @@ func (m *CCSUsrMsg_MatchStatsUpdate) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CCSUsrMsg_MatchStatsUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3853,7 +3856,7 @@ func (m *CCSUsrMsg_ItemDrop) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_ItemDrop.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3904,7 +3907,7 @@ func (m *CCSUsrMsg_GlowPropTurnOff) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CCSUsrMsg_GlowPropTurnOff.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3951,7 +3954,7 @@ func (m *CCSUsrMsg_RoundBackupFilenames) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CCSUsrMsg_RoundBackupFilenames.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4018,7 +4021,7 @@ func (m *CCSUsrMsg_SSUI) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_CCSUsrMsg_SSUI.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4080,7 +4083,7 @@ func (m *CCSUsrMsg_SurvivalStats) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_CCSUsrMsg_SurvivalStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4155,7 +4158,7 @@ func (m *CCSUsrMsg_SurvivalStats_Fact) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CCSUsrMsg_SurvivalStats_Fact.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4222,7 +4225,7 @@ func (m *CCSUsrMsg_SurvivalStats_Placement) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CCSUsrMsg_SurvivalStats_Placement.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4284,7 +4287,7 @@ func (m *CCSUsrMsg_SurvivalStats_Damage) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CCSUsrMsg_SurvivalStats_Damage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4356,7 +4359,7 @@ func (m *CCSUsrMsg_ResetHud) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_ResetHud.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4400,7 +4403,7 @@ func (m *CCSUsrMsg_GameTitle) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCSUsrMsg_GameTitle.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4444,7 +4447,7 @@ func (m *CCSUsrMsg_RequestState) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CCSUsrMsg_RequestState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4488,7 +4491,7 @@ func (m *CCSUsrMsg_StopSpectatorMode) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CCSUsrMsg_StopSpectatorMode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4532,7 +4535,7 @@ func (m *CCSUsrMsg_DisconnectToLobby) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CCSUsrMsg_DisconnectToLobby.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4576,7 +4579,7 @@ func (m *CCSUsrMsg_WarmupHasEnded) XXX_Marshal(b []byte, deterministic bool) ([]
 		return xxx_messageInfo_CCSUsrMsg_WarmupHasEnded.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4620,7 +4623,7 @@ func (m *CCSUsrMsg_ClientInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CCSUsrMsg_ClientInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4664,7 +4667,7 @@ func (m *CCSUsrMsg_ServerRankRevealAll) XXX_Marshal(b []byte, deterministic bool
 		return xxx_messageInfo_CCSUsrMsg_ServerRankRevealAll.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4690,6 +4693,226 @@ func (m *CCSUsrMsg_ServerRankRevealAll) GetSecondsTillShutdown() int32 {
 	return 0
 }
 
+type CCSUsrMsg_EndOfMatchAllPlayersData struct {
+	Allplayerdata []*CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData `protobuf:"bytes,1,rep,name=allplayerdata" json:"allplayerdata,omitempty"`
+	Scene         int32                                            `protobuf:"varint,2,opt,name=scene" json:"scene"`
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) Reset()         { *m = CCSUsrMsg_EndOfMatchAllPlayersData{} }
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) String() string { return proto.CompactTextString(m) }
+func (*CCSUsrMsg_EndOfMatchAllPlayersData) ProtoMessage()    {}
+func (*CCSUsrMsg_EndOfMatchAllPlayersData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0083c6368998e800, []int{68}
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData.Merge(m, src)
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) XXX_Size() int {
+	return m.Size()
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData proto.InternalMessageInfo
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) GetAllplayerdata() []*CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData {
+	if m != nil {
+		return m.Allplayerdata
+	}
+	return nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) GetScene() int32 {
+	if m != nil {
+		return m.Scene
+	}
+	return 0
+}
+
+type CCSUsrMsg_EndOfMatchAllPlayersData_Accolade struct {
+	Eaccolade int32   `protobuf:"varint,1,opt,name=eaccolade" json:"eaccolade"`
+	Value     float32 `protobuf:"fixed32,2,opt,name=value" json:"value"`
+	Position  int32   `protobuf:"varint,3,opt,name=position" json:"position"`
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) Reset() {
+	*m = CCSUsrMsg_EndOfMatchAllPlayersData_Accolade{}
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) ProtoMessage() {}
+func (*CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0083c6368998e800, []int{68, 0}
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData_Accolade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData_Accolade.Merge(m, src)
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) XXX_Size() int {
+	return m.Size()
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) XXX_DiscardUnknown() {
+	xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData_Accolade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData_Accolade proto.InternalMessageInfo
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) GetEaccolade() int32 {
+	if m != nil {
+		return m.Eaccolade
+	}
+	return 0
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) GetValue() float32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+type CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData struct {
+	Entindex    int32                                        `protobuf:"varint,1,opt,name=entindex" json:"entindex"`
+	Xuid        uint64                                       `protobuf:"varint,2,opt,name=xuid" json:"xuid"`
+	Name        string                                       `protobuf:"bytes,3,opt,name=name" json:"name"`
+	Teamnumber  int32                                        `protobuf:"varint,4,opt,name=teamnumber" json:"teamnumber"`
+	Nomination  *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade `protobuf:"bytes,5,opt,name=nomination" json:"nomination,omitempty"`
+	Items       []*CEconItemPreviewDataBlock                 `protobuf:"bytes,6,rep,name=items" json:"items,omitempty"`
+	Playercolor int32                                        `protobuf:"varint,7,opt,name=playercolor" json:"playercolor"`
+	Isbot       bool                                         `protobuf:"varint,8,opt,name=isbot" json:"isbot"`
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) Reset() {
+	*m = CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData{}
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) ProtoMessage() {}
+func (*CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0083c6368998e800, []int{68, 1}
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData.Merge(m, src)
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) XXX_Size() int {
+	return m.Size()
+}
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData proto.InternalMessageInfo
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) GetEntindex() int32 {
+	if m != nil {
+		return m.Entindex
+	}
+	return 0
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) GetXuid() uint64 {
+	if m != nil {
+		return m.Xuid
+	}
+	return 0
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) GetTeamnumber() int32 {
+	if m != nil {
+		return m.Teamnumber
+	}
+	return 0
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) GetNomination() *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade {
+	if m != nil {
+		return m.Nomination
+	}
+	return nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) GetItems() []*CEconItemPreviewDataBlock {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) GetPlayercolor() int32 {
+	if m != nil {
+		return m.Playercolor
+	}
+	return 0
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) GetIsbot() bool {
+	if m != nil {
+		return m.Isbot
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("ECstrike15UserMessages", ECstrike15UserMessages_name, ECstrike15UserMessages_value)
 	proto.RegisterEnum("ECSUsrMsg_DisconnectToLobby_Action", ECSUsrMsg_DisconnectToLobby_Action_name, ECSUsrMsg_DisconnectToLobby_Action_value)
@@ -4769,267 +4992,282 @@ func init() {
 	proto.RegisterType((*CCSUsrMsg_WarmupHasEnded)(nil), "CCSUsrMsg_WarmupHasEnded")
 	proto.RegisterType((*CCSUsrMsg_ClientInfo)(nil), "CCSUsrMsg_ClientInfo")
 	proto.RegisterType((*CCSUsrMsg_ServerRankRevealAll)(nil), "CCSUsrMsg_ServerRankRevealAll")
+	proto.RegisterType((*CCSUsrMsg_EndOfMatchAllPlayersData)(nil), "CCSUsrMsg_EndOfMatchAllPlayersData")
+	proto.RegisterType((*CCSUsrMsg_EndOfMatchAllPlayersData_Accolade)(nil), "CCSUsrMsg_EndOfMatchAllPlayersData.Accolade")
+	proto.RegisterType((*CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData)(nil), "CCSUsrMsg_EndOfMatchAllPlayersData.PlayerData")
 }
 
 func init() { proto.RegisterFile("cstrike15_usermessages.proto", fileDescriptor_0083c6368998e800) }
 
 var fileDescriptor_0083c6368998e800 = []byte{
-	// 3984 bytes of a gzipped FileDescriptorProto
+	// 4162 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcb, 0x73, 0x1c, 0xb9,
-	0x79, 0xd7, 0x0c, 0xdf, 0xe0, 0x0b, 0x6a, 0x3d, 0x96, 0x1a, 0x49, 0x24, 0xd5, 0xbb, 0x2b, 0x4b,
-	0x8a, 0x35, 0x2b, 0xd1, 0xda, 0xf5, 0x6a, 0xed, 0x24, 0xcb, 0x97, 0x48, 0x7a, 0x97, 0x5a, 0x7a,
-	0x86, 0x92, 0x56, 0x72, 0xa5, 0x3a, 0x60, 0x37, 0x66, 0x06, 0x61, 0x77, 0x63, 0x0c, 0xa0, 0x49,
-	0x8e, 0x7d, 0x48, 0x72, 0x73, 0xc5, 0xa9, 0x54, 0x0e, 0xbe, 0x24, 0xe5, 0x5c, 0x73, 0xcc, 0x3d,
-	0xeb, 0x7f, 0xc0, 0x55, 0xb9, 0xb8, 0x72, 0xda, 0xaa, 0x54, 0x52, 0xa9, 0xdd, 0x53, 0x52, 0xf9,
-	0x23, 0x52, 0x1f, 0xd0, 0x3d, 0x0d, 0xcc, 0xf0, 0xa1, 0x4a, 0xa5, 0x7c, 0x9b, 0xf9, 0x7d, 0x3f,
-	0x00, 0x1f, 0x80, 0x0f, 0xf8, 0x1e, 0x68, 0x74, 0x2b, 0x94, 0x4a, 0xb0, 0x43, 0xfa, 0xf8, 0xc3,
-	0x20, 0x93, 0x54, 0x24, 0x54, 0x4a, 0xd2, 0xa6, 0xb2, 0xde, 0x15, 0x5c, 0xf1, 0xda, 0x72, 0x9b,
-	0xf3, 0x76, 0x4c, 0x3f, 0xd0, 0xff, 0x0e, 0xb2, 0xd6, 0x07, 0x11, 0x95, 0xa1, 0x60, 0x5d, 0xc5,
-	0x45, 0xce, 0xb8, 0x9c, 0x52, 0x35, 0xd0, 0xa8, 0x56, 0x76, 0xd9, 0x0e, 0x5d, 0x99, 0xff, 0x55,
-	0x05, 0x79, 0xeb, 0xeb, 0xcd, 0x17, 0x52, 0xec, 0xca, 0x76, 0xf0, 0x72, 0xeb, 0xc5, 0xce, 0x2e,
-	0x4d, 0x33, 0x6f, 0x01, 0x8d, 0xa6, 0x24, 0xa1, 0x0b, 0x95, 0xe5, 0xca, 0xbd, 0xa9, 0xb5, 0xd1,
-	0xdf, 0xfe, 0xc7, 0xd2, 0xa5, 0x86, 0x46, 0x40, 0x22, 0x3b, 0xfc, 0x78, 0xa1, 0xba, 0x5c, 0xb9,
-	0x37, 0x59, 0x48, 0x00, 0xf1, 0x9e, 0xa0, 0x09, 0x99, 0x1d, 0x1c, 0xd2, 0x9e, 0x5c, 0x18, 0x59,
-	0x1e, 0xb9, 0x37, 0xbd, 0x52, 0xab, 0x0f, 0xf7, 0x5c, 0x6f, 0x6a, 0x4a, 0xa3, 0xa0, 0xd6, 0x3e,
-	0x41, 0xe3, 0x06, 0x3a, 0x67, 0xcc, 0xeb, 0x68, 0x44, 0x2a, 0xa1, 0x87, 0x2c, 0x04, 0x00, 0xf8,
-	0x75, 0x84, 0xcb, 0x11, 0xb6, 0x28, 0x6b, 0x53, 0xe1, 0xd5, 0xd0, 0x98, 0x20, 0x69, 0xdb, 0x74,
-	0x33, 0x96, 0xb3, 0x0d, 0xe4, 0x3f, 0x44, 0xf3, 0x25, 0x7f, 0x5f, 0x10, 0x96, 0x02, 0x5d, 0xc1,
-	0x0f, 0x97, 0xae, 0x21, 0xff, 0x21, 0xba, 0x5c, 0xd2, 0xb7, 0xb3, 0x68, 0x9f, 0x9e, 0x28, 0xd0,
-	0x52, 0xd1, 0x13, 0xe5, 0x6a, 0x09, 0x88, 0xff, 0x37, 0x15, 0x9b, 0xdf, 0x24, 0x3d, 0xcd, 0xbf,
-	0x8d, 0x26, 0x68, 0xaa, 0x02, 0x16, 0x9d, 0x38, 0x43, 0x8c, 0xd3, 0x54, 0xed, 0x44, 0x27, 0xfd,
-	0xee, 0xaa, 0x83, 0xdd, 0x81, 0x24, 0xec, 0x10, 0xb5, 0x30, 0x62, 0x2f, 0x34, 0x20, 0xde, 0x5d,
-	0x34, 0x0d, 0x0c, 0x12, 0xc7, 0x9a, 0x30, 0x6a, 0x11, 0x6c, 0x81, 0xff, 0x4f, 0xce, 0xde, 0xe6,
-	0x0a, 0xad, 0xbc, 0x85, 0x46, 0xba, 0xdb, 0xea, 0xd0, 0xb8, 0x4b, 0x68, 0x32, 0x91, 0xed, 0x40,
-	0x6f, 0xd2, 0x88, 0xa5, 0xef, 0x44, 0x22, 0xdb, 0xcf, 0xcd, 0x3e, 0x8d, 0x77, 0x89, 0x20, 0x89,
-	0x5c, 0x18, 0x5d, 0x1e, 0xb9, 0x37, 0xd5, 0xc8, 0xff, 0x0d, 0x2a, 0x3c, 0x76, 0x96, 0xc2, 0x3f,
-	0xb2, 0x17, 0x10, 0x94, 0xdd, 0x95, 0x6d, 0x50, 0x17, 0x46, 0x8d, 0xa4, 0x72, 0xd5, 0x4d, 0x64,
-	0x7b, 0x43, 0x2a, 0x6b, 0xcc, 0x11, 0x7b, 0x4c, 0xff, 0xdf, 0xab, 0xb6, 0x71, 0x6c, 0x67, 0x11,
-	0xf4, 0xb5, 0x88, 0x26, 0xc2, 0x0e, 0x49, 0x53, 0x1a, 0x3b, 0x7d, 0x15, 0xa0, 0xb7, 0x84, 0x46,
-	0xba, 0x5c, 0xea, 0xa9, 0x4f, 0xaf, 0xcc, 0xd6, 0xd7, 0x77, 0x65, 0xfb, 0x25, 0x0d, 0x15, 0x17,
-	0x2b, 0x1b, 0x0d, 0x90, 0x78, 0xb7, 0xd1, 0x68, 0x18, 0x8b, 0xc7, 0x7a, 0xfa, 0xd3, 0x2b, 0x53,
-	0x9a, 0xd1, 0xd8, 0x5a, 0x5b, 0x6d, 0x68, 0x38, 0x17, 0xaf, 0xe8, 0x2d, 0x19, 0x12, 0xaf, 0x78,
-	0xb7, 0xd0, 0x38, 0x6d, 0xb5, 0x68, 0x68, 0x96, 0xa0, 0x5c, 0x78, 0x8d, 0x79, 0x77, 0xd1, 0x4c,
-	0x8b, 0x44, 0x34, 0x60, 0x69, 0xa0, 0x58, 0x42, 0x17, 0xc6, 0x97, 0x2b, 0xf7, 0xaa, 0x39, 0x07,
-	0x81, 0x64, 0x27, 0xdd, 0x67, 0x09, 0xf5, 0xee, 0xa1, 0x59, 0xcd, 0xe3, 0x99, 0x32, 0xc4, 0x09,
-	0x8b, 0x38, 0x0d, 0xa2, 0x2f, 0x32, 0xa5, 0x99, 0x77, 0xd0, 0x54, 0x87, 0xc7, 0x91, 0x61, 0x4d,
-	0x59, 0xac, 0x49, 0x80, 0x35, 0xe5, 0x36, 0x9a, 0x68, 0x9d, 0x18, 0x02, 0xb2, 0x08, 0xe3, 0xad,
-	0x13, 0x2d, 0x2e, 0xcc, 0x73, 0x7a, 0xc8, 0xda, 0xff, 0xb1, 0x62, 0x1f, 0xa6, 0x66, 0x87, 0x1c,
-	0x52, 0xbd, 0xbc, 0x3c, 0x49, 0x48, 0x1a, 0x0d, 0x2c, 0xaf, 0x01, 0xbd, 0x87, 0x68, 0x3e, 0xe6,
-	0x21, 0x89, 0x03, 0x92, 0x74, 0x63, 0xa6, 0xb2, 0x88, 0xea, 0xa5, 0x2e, 0x06, 0x9d, 0xd3, 0xc2,
-	0xd5, 0x42, 0xe6, 0xf9, 0x68, 0xaa, 0x25, 0xe8, 0x4f, 0x33, 0x9a, 0x86, 0x3d, 0xbd, 0xe2, 0x05,
-	0xb1, 0x84, 0xbd, 0x65, 0x34, 0x19, 0x65, 0x82, 0x28, 0xc6, 0x53, 0xbd, 0xea, 0xfd, 0x19, 0x16,
-	0x28, 0x1c, 0xcb, 0xb9, 0x52, 0xd1, 0x67, 0x24, 0xa2, 0x4e, 0x23, 0x5b, 0xd1, 0x3e, 0xea, 0xae,
-	0x5c, 0xd5, 0xa6, 0xf4, 0x57, 0xae, 0x86, 0xc6, 0x5a, 0x31, 0x69, 0x4b, 0xad, 0x59, 0xff, 0xe6,
-	0xd0, 0x90, 0x77, 0x13, 0x8d, 0x84, 0xb1, 0x18, 0x36, 0x03, 0x40, 0xfd, 0xc8, 0x36, 0xcc, 0x46,
-	0x96, 0x1c, 0xc4, 0xba, 0x33, 0x96, 0x46, 0xd4, 0x3d, 0x91, 0x06, 0x82, 0x3d, 0x88, 0x88, 0x22,
-	0x8e, 0x1a, 0x1a, 0x39, 0x4f, 0x05, 0xff, 0xe7, 0xe8, 0x7a, 0x39, 0xca, 0x7a, 0xcc, 0x25, 0x5d,
-	0x27, 0x5d, 0x3d, 0xb7, 0x05, 0x34, 0xda, 0x21, 0xb2, 0xa3, 0x87, 0x9a, 0x2d, 0xfa, 0x03, 0xc4,
-	0x59, 0x97, 0xea, 0xa9, 0xeb, 0xf2, 0x3e, 0x9a, 0x6e, 0x09, 0x9e, 0x04, 0xdd, 0x98, 0xf4, 0xa8,
-	0x70, 0xee, 0x26, 0x04, 0x82, 0x3d, 0x8d, 0xfb, 0x7f, 0x59, 0x41, 0xb7, 0x4e, 0x1f, 0x7d, 0x83,
-	0x09, 0xb0, 0xf5, 0xdf, 0x83, 0x0e, 0x3f, 0x44, 0x57, 0x2c, 0xfb, 0xa4, 0x69, 0xb4, 0x9a, 0x45,
-	0x8c, 0x43, 0x6b, 0x41, 0x22, 0xc6, 0x03, 0xc9, 0xb3, 0xdc, 0x4e, 0x0b, 0xc3, 0x46, 0x5a, 0xd0,
-	0x04, 0xdc, 0xff, 0x07, 0xe7, 0xee, 0x6c, 0x90, 0x63, 0xd3, 0xba, 0x86, 0xc6, 0xba, 0x4c, 0x85,
-	0x1d, 0x77, 0x9f, 0x34, 0xa4, 0x4f, 0x77, 0xaa, 0xe0, 0x5a, 0xad, 0x0e, 0x5c, 0xab, 0x2c, 0x3a,
-	0x71, 0xe6, 0x35, 0x72, 0x9a, 0xa1, 0x7a, 0x7f, 0x80, 0xe6, 0x8e, 0x38, 0x0b, 0x69, 0xd0, 0x62,
-	0x31, 0xd5, 0x97, 0xec, 0xa8, 0xa5, 0xdc, 0xac, 0x96, 0x3d, 0xcb, 0x45, 0xfe, 0x7f, 0x57, 0xec,
-	0xe9, 0xbd, 0x04, 0xd9, 0x2e, 0x91, 0x87, 0xde, 0x1a, 0x9a, 0x31, 0xeb, 0x12, 0x24, 0x44, 0x1e,
-	0xca, 0x85, 0x8a, 0xf6, 0xc4, 0x4b, 0xf5, 0x53, 0xb8, 0x75, 0xb3, 0x50, 0xf0, 0xb3, 0x31, 0xdd,
-	0xed, 0xff, 0x96, 0xde, 0x23, 0x74, 0xb9, 0xe8, 0x83, 0x47, 0x01, 0x4d, 0xc9, 0x41, 0x4c, 0x1d,
-	0x77, 0x30, 0x9f, 0xb3, 0x79, 0xb4, 0xa9, 0x85, 0xb5, 0x3f, 0x41, 0xa8, 0xec, 0xcc, 0xfb, 0x2e,
-	0x9a, 0x6f, 0x93, 0x84, 0x06, 0x22, 0x8b, 0xa9, 0xd4, 0x7a, 0x38, 0xcb, 0x35, 0x0b, 0xc2, 0x06,
-	0xc8, 0x34, 0xfb, 0x0e, 0x9a, 0x3a, 0x20, 0x69, 0xae, 0xae, 0xb3, 0xe3, 0x07, 0x24, 0xd5, 0x0a,
-	0xf9, 0xbf, 0xae, 0xd8, 0x47, 0x66, 0x83, 0x24, 0xa4, 0x4d, 0x61, 0xb9, 0x49, 0xc2, 0xb3, 0x74,
-	0xc0, 0x2d, 0x18, 0xcc, 0xfb, 0x01, 0xba, 0xc2, 0xd2, 0x56, 0xcc, 0xe0, 0xf2, 0x0e, 0x8e, 0xb9,
-	0x88, 0xa3, 0xa0, 0xbc, 0xd9, 0xa7, 0xad, 0x9b, 0xbd, 0x71, 0xb9, 0xcf, 0x7b, 0x05, 0xb4, 0x3d,
-	0x2e, 0xe1, 0x9e, 0x3a, 0x62, 0xa1, 0x62, 0x49, 0x00, 0x9b, 0xa7, 0xcf, 0xa5, 0x7d, 0xc2, 0xe6,
-	0x8c, 0x70, 0x33, 0x97, 0xf9, 0xbf, 0x74, 0xf6, 0xa2, 0x01, 0x46, 0x54, 0xb8, 0xfe, 0xf3, 0x3c,
-	0xd7, 0x2d, 0x34, 0x1e, 0xc6, 0x8c, 0xa6, 0xca, 0xb5, 0x17, 0x83, 0xfd, 0x9f, 0x9d, 0xad, 0x5f,
-	0xb7, 0x0d, 0x77, 0x9b, 0xa5, 0xea, 0x82, 0xb0, 0xe5, 0x21, 0xba, 0x56, 0xf2, 0x3f, 0xa3, 0xbd,
-	0x7e, 0x93, 0xab, 0x68, 0xac, 0xc3, 0x52, 0x65, 0x6c, 0x68, 0xaa, 0x61, 0xfe, 0xf8, 0xbf, 0x1c,
-	0x45, 0xef, 0x95, 0xfc, 0x3d, 0xc1, 0x43, 0x2a, 0x65, 0xb3, 0xcb, 0x95, 0xa2, 0x11, 0xac, 0x88,
-	0xea, 0xbd, 0xe8, 0x46, 0x44, 0x51, 0xef, 0x5d, 0x84, 0x52, 0x7a, 0x1c, 0x64, 0xfa, 0x9f, 0x1e,
-	0xb7, 0x30, 0x9f, 0xa9, 0x94, 0x1e, 0xe7, 0xa4, 0x3f, 0x45, 0x73, 0x54, 0x37, 0xca, 0x79, 0xb0,
-	0x43, 0x60, 0xb0, 0x4f, 0xeb, 0x6f, 0x33, 0x46, 0xfd, 0x14, 0xac, 0x31, 0x4b, 0xad, 0x7f, 0xb2,
-	0xf6, 0x6f, 0x55, 0x74, 0xe5, 0x0c, 0xf5, 0xf2, 0x91, 0x07, 0x03, 0xa1, 0x29, 0x83, 0x43, 0x2c,
-	0xb4, 0x84, 0x26, 0xc3, 0x98, 0x48, 0x19, 0xb0, 0xc8, 0xd9, 0xa4, 0x09, 0x8d, 0xee, 0x44, 0x40,
-	0xe0, 0x82, 0xb5, 0x59, 0x1a, 0xb8, 0x26, 0x32, 0x61, 0xd0, 0x2f, 0x2d, 0x42, 0x4f, 0x1f, 0xe7,
-	0x01, 0xc2, 0x6b, 0x8b, 0xf0, 0x33, 0x27, 0x2a, 0xc8, 0x09, 0x6f, 0xc0, 0x8a, 0x48, 0xda, 0x8e,
-	0x69, 0xd0, 0xd3, 0x11, 0x41, 0x69, 0xe8, 0x00, 0xbe, 0x06, 0x9f, 0x1b, 0xd1, 0x16, 0xa4, 0x0a,
-	0x3a, 0x0e, 0x28, 0xd6, 0xb8, 0x00, 0xbd, 0x15, 0xe4, 0xe5, 0x87, 0xb9, 0x43, 0x64, 0x50, 0x50,
-	0x27, 0x2d, 0x2a, 0x36, 0xf2, 0x6d, 0x22, 0x37, 0xf2, 0x36, 0xf7, 0xd0, 0xac, 0xd5, 0x26, 0x7c,
-	0xa2, 0x63, 0x87, 0x7e, 0xc4, 0xd6, 0xa7, 0xaf, 0x3f, 0xf1, 0x0f, 0xd0, 0x6d, 0xf7, 0x92, 0x35,
-	0xd7, 0xc0, 0x8e, 0xa2, 0xc9, 0x86, 0xe0, 0x5d, 0xe9, 0xad, 0x0e, 0x6d, 0x70, 0xa5, 0xc8, 0x0d,
-	0x36, 0x43, 0x9e, 0x02, 0x71, 0x4f, 0xd0, 0x23, 0x46, 0x8f, 0x37, 0x88, 0x22, 0x6b, 0x31, 0x0f,
-	0x0f, 0x07, 0x76, 0xd0, 0xef, 0x9d, 0x3d, 0xc6, 0x33, 0xb8, 0xab, 0xbd, 0x8f, 0xd0, 0x24, 0x53,
-	0x34, 0x61, 0x69, 0x8b, 0xeb, 0x8d, 0x3c, 0xbf, 0xf7, 0x3e, 0x17, 0xae, 0xe4, 0xfe, 0xf9, 0x76,
-	0x2e, 0x9e, 0x02, 0xf5, 0xff, 0xb9, 0x62, 0x7b, 0xd1, 0x06, 0x8d, 0x39, 0x89, 0x36, 0x4d, 0xb4,
-	0x56, 0xde, 0xf6, 0x95, 0x53, 0x6e, 0xfb, 0x45, 0x34, 0x41, 0x42, 0x45, 0x52, 0x96, 0xb8, 0x76,
-	0x93, 0x83, 0x43, 0x76, 0x53, 0xbd, 0xc8, 0x6e, 0xaa, 0x17, 0xd9, 0xcd, 0x00, 0xe1, 0x8d, 0xff,
-	0x75, 0xc5, 0x3e, 0xd8, 0xaf, 0x28, 0xe9, 0xf2, 0x54, 0xfb, 0xb6, 0x0b, 0x54, 0xb7, 0x55, 0xab,
-	0x5e, 0xa4, 0xda, 0xc8, 0x45, 0xaa, 0x9d, 0xa2, 0xfb, 0x1b, 0xf0, 0xa2, 0xc6, 0xfb, 0x8e, 0x59,
-	0xb7, 0x91, 0x81, 0x20, 0xe8, 0x83, 0xa0, 0x4b, 0x2a, 0x92, 0x74, 0x9d, 0x10, 0xb8, 0x84, 0x61,
-	0x5b, 0x96, 0xca, 0xa9, 0x19, 0x3b, 0x69, 0x86, 0x82, 0xd2, 0x74, 0x9b, 0x92, 0x58, 0x75, 0xd6,
-	0x88, 0xb8, 0x60, 0x92, 0x0f, 0xd1, 0x7c, 0x47, 0x53, 0xb5, 0xef, 0x0d, 0x78, 0x1c, 0xb9, 0x91,
-	0xa8, 0x25, 0xfc, 0x22, 0x8e, 0x06, 0xe9, 0x29, 0x3d, 0x76, 0x66, 0x6e, 0xd3, 0x9f, 0xd3, 0x63,
-	0x3d, 0x3f, 0xd5, 0x8b, 0xa9, 0x73, 0xe2, 0x0d, 0xe4, 0x7f, 0x8a, 0x96, 0x4b, 0xd5, 0xcd, 0x8d,
-	0xf4, 0x45, 0xa6, 0x62, 0x96, 0xd2, 0x6d, 0xd6, 0xee, 0xc4, 0xac, 0xdd, 0xb9, 0xc0, 0xb6, 0xfc,
-	0x0f, 0xed, 0x7d, 0x5d, 0x8d, 0xfe, 0x2c, 0x93, 0x6a, 0x97, 0xa7, 0xb4, 0x77, 0xbe, 0x47, 0xf4,
-	0xff, 0xca, 0xf5, 0x52, 0xb4, 0xcb, 0x85, 0xda, 0x66, 0xca, 0xbb, 0x81, 0xc6, 0xba, 0x5c, 0x06,
-	0x66, 0xac, 0x62, 0x46, 0xa3, 0x5d, 0x2e, 0xbf, 0x2c, 0x44, 0x3d, 0x67, 0x6d, 0x40, 0xf4, 0xda,
-	0xdd, 0xa6, 0xd1, 0x53, 0xb7, 0xa9, 0x68, 0xfe, 0x33, 0x67, 0xad, 0xa0, 0xf9, 0x1b, 0xff, 0x17,
-	0x4e, 0xae, 0xfc, 0x19, 0x8b, 0xe3, 0x75, 0x62, 0x4e, 0xc5, 0x81, 0x84, 0xa8, 0xc3, 0x4d, 0xdf,
-	0x27, 0xf8, 0x81, 0xdc, 0xe5, 0x11, 0xf5, 0xbe, 0x83, 0x66, 0x5a, 0x4c, 0x48, 0x15, 0x28, 0x22,
-	0xda, 0xd4, 0x75, 0x9c, 0xd3, 0x5a, 0xb2, 0xaf, 0x05, 0xde, 0x7d, 0x34, 0x2b, 0x69, 0xc8, 0xd3,
-	0xa8, 0x60, 0xda, 0x97, 0xf3, 0x8c, 0x11, 0x19, 0xaa, 0xff, 0x3f, 0x15, 0x54, 0xb3, 0x82, 0x0b,
-	0x2a, 0x99, 0xa0, 0x3a, 0xc2, 0x97, 0x21, 0x89, 0xa9, 0xf7, 0x18, 0x5d, 0x8e, 0x0c, 0xa6, 0x93,
-	0x01, 0x0d, 0x3a, 0x4b, 0x85, 0xa3, 0xc1, 0x26, 0x1f, 0xa3, 0x6b, 0x45, 0x50, 0x17, 0x08, 0xb0,
-	0x0c, 0x96, 0xd0, 0x40, 0xd2, 0xd0, 0x59, 0xc6, 0x2b, 0x05, 0xa5, 0x91, 0x33, 0x9a, 0x34, 0x84,
-	0xc1, 0x58, 0xaa, 0xa8, 0xe8, 0xf2, 0x98, 0x40, 0xe0, 0xa2, 0x7a, 0x5d, 0xea, 0xa8, 0x8e, 0x6d,
-	0xf1, 0x7e, 0xaf, 0x4b, 0xbd, 0x3a, 0xc2, 0x52, 0x11, 0xa1, 0x82, 0x83, 0x98, 0xa6, 0x79, 0xc2,
-	0x62, 0xef, 0xc7, 0x9c, 0x96, 0xae, 0x81, 0x10, 0x34, 0xf4, 0xb7, 0xec, 0xd9, 0xae, 0x67, 0x42,
-	0xd0, 0x54, 0x95, 0xaa, 0xdf, 0x47, 0xb3, 0x61, 0x26, 0xce, 0x98, 0xe9, 0x4c, 0x98, 0x89, 0x3e,
-	0xd5, 0xff, 0x73, 0xbb, 0xa3, 0xd5, 0xb0, 0xc3, 0xe8, 0x11, 0x4d, 0x68, 0xaa, 0x36, 0x8f, 0x20,
-	0x7c, 0xb9, 0x8b, 0xa6, 0x49, 0x89, 0x39, 0xbb, 0x69, 0x0b, 0xe0, 0xa8, 0x84, 0xda, 0x64, 0xed,
-	0xad, 0x34, 0x10, 0x78, 0x3e, 0x70, 0x47, 0xe0, 0x7c, 0xed, 0x35, 0x18, 0x07, 0x70, 0x27, 0xf2,
-	0x7f, 0xe3, 0x24, 0x19, 0xbb, 0x44, 0x85, 0x9d, 0xcd, 0x34, 0x5a, 0xe7, 0x69, 0xc4, 0x60, 0x5d,
-	0xa5, 0xc9, 0x1f, 0x49, 0x3b, 0x66, 0x09, 0x73, 0x35, 0x28, 0x61, 0xb0, 0xa8, 0xa4, 0x1b, 0x24,
-	0xe4, 0x44, 0xc0, 0xed, 0xe3, 0x06, 0xa0, 0xd3, 0x49, 0x77, 0xb7, 0x10, 0x40, 0xde, 0x90, 0x74,
-	0x83, 0x63, 0x96, 0x9a, 0xee, 0x6c, 0x85, 0x50, 0xd2, 0x7d, 0x95, 0xe3, 0x79, 0x7f, 0xb0, 0x7e,
-	0x86, 0x37, 0xea, 0xf6, 0xb7, 0x5f, 0x08, 0xfc, 0xff, 0xaa, 0xa0, 0x9b, 0x56, 0x8c, 0xa3, 0x5d,
-	0x5a, 0x53, 0x11, 0x25, 0xf3, 0xf8, 0x64, 0x11, 0x4d, 0x1c, 0x51, 0x21, 0x07, 0x53, 0xd4, 0x02,
-	0xf4, 0xbe, 0x0f, 0x77, 0x0c, 0x51, 0x26, 0xfa, 0x9b, 0x5e, 0xb9, 0x53, 0x3f, 0xa7, 0xb3, 0x3a,
-	0xfc, 0x6e, 0x18, 0xbe, 0xbd, 0xaa, 0x63, 0xc3, 0xab, 0xea, 0x5d, 0x47, 0x23, 0xa1, 0x08, 0x9d,
-	0x50, 0x03, 0x80, 0xda, 0x27, 0x68, 0x14, 0x7a, 0x01, 0xf9, 0xe0, 0xc5, 0x04, 0x00, 0x6c, 0x64,
-	0x44, 0xe3, 0x81, 0x34, 0xd5, 0x40, 0xfe, 0x4f, 0x9c, 0x13, 0xc6, 0x24, 0x44, 0x10, 0x3b, 0x29,
-	0xd8, 0x09, 0x17, 0x3d, 0x1d, 0xc1, 0x18, 0xcc, 0x89, 0x12, 0x0b, 0xd0, 0x56, 0xb8, 0x7a, 0x8a,
-	0x19, 0x7c, 0x55, 0x41, 0xef, 0x94, 0xbd, 0xff, 0x38, 0xa3, 0x52, 0xed, 0x09, 0xde, 0x16, 0x54,
-	0x4a, 0xb8, 0x50, 0x7e, 0x0a, 0x00, 0xb4, 0xb5, 0x53, 0xcd, 0x09, 0x8d, 0xee, 0x44, 0x60, 0xef,
-	0x29, 0x17, 0x09, 0x89, 0x83, 0x2e, 0xd7, 0xb1, 0x6e, 0xd5, 0x62, 0xcd, 0x18, 0xd1, 0x9e, 0x96,
-	0xc0, 0xce, 0x1e, 0xf0, 0x34, 0x93, 0x05, 0x73, 0xc4, 0x62, 0x4e, 0x6b, 0x49, 0x4e, 0x7c, 0x80,
-	0xe6, 0x98, 0x0c, 0x28, 0x4c, 0x2f, 0xd0, 0xe3, 0x38, 0x15, 0xba, 0x19, 0x26, 0xf5, 0x09, 0xd1,
-	0x8a, 0xfa, 0x9f, 0xa1, 0x45, 0x2b, 0xb6, 0x09, 0xb9, 0xa0, 0x9f, 0x53, 0x12, 0x51, 0x71, 0xc0,
-	0x89, 0x88, 0x20, 0x66, 0xf1, 0xee, 0xe7, 0xd9, 0xbf, 0x09, 0x6c, 0xae, 0xd5, 0x4f, 0x23, 0x99,
-	0x72, 0x80, 0xff, 0x1a, 0xbd, 0x3f, 0x68, 0x04, 0x1b, 0x34, 0x24, 0xf1, 0x06, 0x6b, 0x33, 0x45,
-	0xe2, 0x26, 0x6b, 0xa7, 0x44, 0x65, 0x82, 0x7a, 0x8f, 0x9c, 0x3e, 0x6f, 0xd5, 0xcf, 0xe1, 0xe6,
-	0x5d, 0xff, 0xc4, 0x4e, 0x2a, 0xbe, 0x6c, 0x90, 0xf4, 0x70, 0x8b, 0x9a, 0x1c, 0x85, 0x47, 0x74,
-	0x28, 0x82, 0x9e, 0x00, 0x14, 0xe2, 0xe7, 0xf7, 0x10, 0x0a, 0x79, 0xaa, 0x04, 0x8f, 0x63, 0x2a,
-	0x9c, 0xdd, 0xb3, 0x70, 0xff, 0xe7, 0x43, 0x9d, 0xbf, 0xe8, 0x46, 0xff, 0x4f, 0x9d, 0x83, 0x75,
-	0x09, 0x92, 0x1e, 0xb2, 0xb4, 0xed, 0x06, 0xe8, 0x39, 0xe8, 0x37, 0xd0, 0x82, 0x75, 0x1f, 0x92,
-	0x38, 0x7e, 0xc9, 0x15, 0x7d, 0x46, 0x58, 0x4c, 0x75, 0xa0, 0x24, 0x28, 0x91, 0x03, 0x47, 0x30,
-	0xc7, 0x74, 0x4a, 0x35, 0x58, 0x1e, 0xd2, 0x88, 0xff, 0x9b, 0xaa, 0x9b, 0x9c, 0x2b, 0xda, 0x84,
-	0x4b, 0xd8, 0x24, 0x61, 0x24, 0x71, 0x7a, 0xd3, 0x88, 0x5d, 0x93, 0xad, 0x9e, 0x52, 0x93, 0xbd,
-	0x83, 0xa6, 0x8e, 0xb8, 0xa2, 0xc3, 0xfe, 0x60, 0x12, 0x60, 0xed, 0x07, 0x96, 0xd0, 0x24, 0x1c,
-	0x98, 0x40, 0x2a, 0xe1, 0xd4, 0x0d, 0xf4, 0x31, 0x6a, 0x2a, 0x01, 0x17, 0x58, 0x44, 0x15, 0x61,
-	0xb1, 0xd4, 0x1c, 0x3b, 0xf4, 0x42, 0xb9, 0x00, 0x68, 0x0f, 0xd0, 0x1c, 0x57, 0x1d, 0x2a, 0x02,
-	0xd0, 0x4b, 0x33, 0xc7, 0x2d, 0xe6, 0x8c, 0x96, 0xed, 0x53, 0x92, 0x00, 0xf7, 0xbe, 0xb6, 0xf4,
-	0x1e, 0x95, 0x41, 0xca, 0x03, 0xd0, 0xc4, 0x49, 0x41, 0xa6, 0x99, 0x7c, 0x4d, 0xe5, 0x73, 0x0e,
-	0xf3, 0x87, 0x83, 0x66, 0xc2, 0x97, 0xc2, 0x21, 0x4f, 0xda, 0x0e, 0xd9, 0x88, 0x72, 0x87, 0xfc,
-	0x77, 0xee, 0x93, 0x04, 0x57, 0x74, 0x8f, 0x48, 0x79, 0xce, 0xe2, 0x39, 0xab, 0x53, 0xbd, 0x70,
-	0x75, 0x46, 0xde, 0x62, 0x75, 0x46, 0x4f, 0x5f, 0x1d, 0xff, 0x39, 0xba, 0xea, 0xaa, 0x96, 0x5b,
-	0xca, 0xd9, 0xca, 0x95, 0x36, 0x54, 0x1d, 0xb6, 0x21, 0xff, 0xd3, 0x21, 0x43, 0xa1, 0x2a, 0xeb,
-	0x7a, 0xf7, 0x11, 0xee, 0x72, 0x05, 0xab, 0x42, 0xe2, 0x80, 0x49, 0x99, 0xd1, 0x22, 0x0b, 0x9f,
-	0xef, 0xe3, 0x3b, 0x1a, 0xf6, 0xff, 0xb5, 0x82, 0xee, 0xba, 0xe9, 0xd1, 0xe7, 0x44, 0x2a, 0x88,
-	0xa8, 0xa8, 0x30, 0x95, 0x92, 0x7d, 0xbe, 0x6e, 0x4a, 0x0a, 0x0f, 0xd0, 0x5c, 0x9a, 0x25, 0x41,
-	0x87, 0x29, 0x19, 0xb4, 0xd9, 0x11, 0x75, 0xcd, 0x7a, 0x26, 0xcd, 0x92, 0x6d, 0xa6, 0xe4, 0x16,
-	0x48, 0xe0, 0xb6, 0x8b, 0x74, 0xeb, 0x9c, 0xe9, 0xf8, 0x45, 0x23, 0x31, 0x44, 0xbb, 0x53, 0x45,
-	0x0e, 0x69, 0xea, 0x86, 0x5a, 0x79, 0xa7, 0xfb, 0x20, 0xb1, 0x3a, 0x35, 0xcc, 0xd1, 0xe1, 0x4e,
-	0x35, 0xd1, 0xff, 0x97, 0xaa, 0xed, 0x31, 0x9a, 0x54, 0x1c, 0x51, 0x91, 0xdf, 0x0b, 0xe0, 0x1b,
-	0xb7, 0xd0, 0x34, 0x1c, 0xdf, 0xb2, 0xb6, 0x00, 0x1e, 0xf0, 0x6e, 0xfd, 0xec, 0x16, 0xf5, 0xf2,
-	0x67, 0x03, 0x89, 0xfe, 0xef, 0xda, 0xb7, 0x15, 0x84, 0xac, 0x7e, 0xdf, 0x45, 0x88, 0x84, 0x3a,
-	0xf6, 0x28, 0x1c, 0x46, 0x3f, 0x62, 0xc8, 0x71, 0x93, 0xf2, 0xeb, 0xc1, 0x8b, 0x9c, 0xc1, 0xb9,
-	0x51, 0x20, 0x59, 0x28, 0x08, 0x45, 0x96, 0xe0, 0x10, 0x20, 0x3d, 0x58, 0x42, 0x93, 0xb0, 0x64,
-	0xc7, 0x2c, 0x95, 0x6e, 0x4d, 0x20, 0xcd, 0x92, 0x57, 0x2c, 0x95, 0xa6, 0x46, 0x99, 0x1e, 0x06,
-	0x61, 0x47, 0xbf, 0x64, 0xd9, 0xe9, 0x9d, 0xd6, 0x7e, 0x5d, 0xe3, 0xde, 0x5d, 0x34, 0xa3, 0x69,
-	0x60, 0xf7, 0xa0, 0xb0, 0xed, 0xb3, 0x35, 0x0f, 0x4c, 0x7f, 0x27, 0xf2, 0x5f, 0x39, 0xf7, 0x6b,
-	0x37, 0x9f, 0xec, 0xaa, 0xe3, 0x04, 0x1e, 0xea, 0x92, 0xd8, 0xd6, 0xfa, 0x7a, 0xff, 0xa9, 0xf0,
-	0x68, 0x25, 0xd8, 0x5a, 0x5f, 0x31, 0x2b, 0xf9, 0x9c, 0x2b, 0xd6, 0xea, 0x7d, 0xb9, 0xd7, 0xa0,
-	0xc7, 0x44, 0x44, 0x34, 0xca, 0xbd, 0xc2, 0x23, 0xfb, 0x34, 0xe8, 0x64, 0x9b, 0x85, 0x87, 0x59,
-	0x17, 0x4e, 0x03, 0x24, 0xd9, 0x6e, 0xb1, 0x09, 0x10, 0xff, 0x57, 0xee, 0x93, 0x54, 0x87, 0x1f,
-	0xeb, 0xe7, 0xc6, 0x3a, 0xc2, 0x07, 0x60, 0x40, 0x47, 0x24, 0x66, 0x51, 0x20, 0x63, 0xae, 0xab,
-	0x4e, 0x56, 0xc5, 0x0d, 0xa4, 0x2f, 0x41, 0xd8, 0x04, 0x99, 0x36, 0x24, 0x13, 0x1d, 0x0c, 0x57,
-	0xe8, 0xa7, 0x73, 0x89, 0x2e, 0xd2, 0x43, 0xd4, 0x46, 0xd3, 0x0c, 0xce, 0x74, 0xe1, 0x01, 0xfa,
-	0xc7, 0x1a, 0x04, 0x4d, 0x8d, 0xbb, 0x2f, 0x7d, 0x6b, 0x44, 0xf4, 0xdf, 0x3e, 0xd8, 0xe0, 0x7b,
-	0xa4, 0xbe, 0xdf, 0x3f, 0xb2, 0xe7, 0xbd, 0x9a, 0x24, 0x7c, 0x83, 0xa6, 0x8c, 0x46, 0x3a, 0xeb,
-	0x4f, 0x12, 0xbe, 0x33, 0xe8, 0xb1, 0x72, 0xd0, 0x5f, 0x47, 0x37, 0xec, 0x80, 0x55, 0x1c, 0x5a,
-	0x61, 0xf3, 0x69, 0x11, 0xf3, 0xd4, 0x29, 0x11, 0xb3, 0xff, 0x89, 0x7d, 0x34, 0x74, 0xd4, 0x6b,
-	0x87, 0x8d, 0xb7, 0xd0, 0xb8, 0x55, 0x71, 0x9b, 0xea, 0xc7, 0x4a, 0x1a, 0xf3, 0x9f, 0xdb, 0xab,
-	0x5f, 0x14, 0x69, 0xa0, 0x8d, 0xae, 0x89, 0x18, 0x93, 0x1f, 0x29, 0xda, 0x18, 0xcc, 0x04, 0x76,
-	0x44, 0x75, 0x9c, 0x0a, 0xb0, 0x81, 0xfc, 0xa7, 0xf6, 0x84, 0xb6, 0x62, 0x7e, 0xbc, 0x27, 0x78,
-	0x77, 0x3f, 0x13, 0xe9, 0x17, 0xad, 0xd6, 0x05, 0x59, 0xec, 0xaf, 0x2b, 0x76, 0xe8, 0xd3, 0x80,
-	0x20, 0x7b, 0x8d, 0x80, 0xf5, 0x14, 0x25, 0x6e, 0x59, 0xe6, 0x06, 0x95, 0xe1, 0xdc, 0xa0, 0xff,
-	0x60, 0x52, 0x1d, 0x7e, 0x30, 0x59, 0x46, 0x93, 0xfd, 0x12, 0xba, 0xbd, 0xe3, 0x7d, 0x14, 0x18,
-	0x29, 0x0b, 0x87, 0x8b, 0xec, 0x7d, 0xd4, 0xef, 0xda, 0x8f, 0x46, 0xcd, 0xe6, 0x8b, 0x9d, 0xfe,
-	0xc3, 0x77, 0x65, 0xe8, 0xe1, 0xfb, 0x5d, 0x84, 0x4c, 0x0a, 0xd6, 0xb7, 0xc5, 0x7e, 0x32, 0xac,
-	0x71, 0x6d, 0x4d, 0x4b, 0x68, 0xb2, 0x9f, 0x9f, 0x39, 0x55, 0x93, 0x22, 0x31, 0xfb, 0xfb, 0x31,
-	0x3b, 0x8e, 0x6d, 0x66, 0xe2, 0x88, 0x1d, 0x91, 0x58, 0xef, 0x2d, 0x8c, 0x7d, 0x92, 0xe5, 0xfb,
-	0x33, 0x5a, 0x8c, 0x0d, 0x88, 0xf7, 0x3d, 0x34, 0xd6, 0x22, 0xa1, 0x2a, 0xea, 0xa6, 0xb7, 0xeb,
-	0x67, 0x74, 0x51, 0x7f, 0x46, 0x42, 0xd5, 0x30, 0x5c, 0xef, 0x63, 0x34, 0x06, 0xc1, 0x73, 0xf1,
-	0x4e, 0xef, 0x9f, 0xd9, 0x68, 0x2f, 0x26, 0xa1, 0xb6, 0xba, 0x86, 0x69, 0xe0, 0x3d, 0x45, 0x13,
-	0xe6, 0x9e, 0x96, 0x0b, 0x63, 0x43, 0x2f, 0x0b, 0x6e, 0x5b, 0xe3, 0x7c, 0x1a, 0x05, 0x1f, 0xc2,
-	0x35, 0xc5, 0xc2, 0xc3, 0x34, 0x4b, 0x0e, 0xa8, 0x70, 0xee, 0x3d, 0x0b, 0xaf, 0xfd, 0x75, 0x05,
-	0x8d, 0x82, 0xaa, 0xfa, 0xf4, 0x81, 0x3f, 0x77, 0x3d, 0x2a, 0xf8, 0x72, 0x2b, 0x5f, 0x70, 0xee,
-	0xdf, 0x22, 0x5f, 0xa8, 0xa1, 0xb1, 0x23, 0x12, 0x67, 0x6e, 0xa0, 0x64, 0x20, 0xaf, 0x8e, 0xe6,
-	0x75, 0x06, 0x4d, 0xa5, 0x62, 0x69, 0x3b, 0xa5, 0x52, 0x3a, 0xc9, 0xf2, 0xa0, 0xb0, 0xc6, 0xd1,
-	0x54, 0x7f, 0x0d, 0xce, 0xd9, 0x05, 0x98, 0x1b, 0x25, 0x49, 0x3e, 0x37, 0x27, 0x14, 0x2d, 0x71,
-	0xc8, 0x47, 0xbb, 0x45, 0x67, 0x8e, 0x72, 0x25, 0x5c, 0xfb, 0x55, 0x05, 0x8d, 0xe7, 0x0f, 0x1c,
-	0x67, 0x0f, 0x77, 0x15, 0x55, 0x15, 0x77, 0x86, 0xa9, 0x2a, 0x0e, 0x31, 0xa4, 0xe2, 0xda, 0x11,
-	0xbb, 0xe9, 0xb2, 0xe2, 0xe0, 0x81, 0xa1, 0xbb, 0x96, 0xe0, 0x89, 0xb3, 0xf2, 0x1a, 0x81, 0xf8,
-	0x49, 0x3f, 0xa8, 0xe9, 0xa6, 0x76, 0x4e, 0x38, 0x09, 0x30, 0x34, 0xf6, 0x1f, 0x39, 0xaf, 0x61,
-	0x54, 0x52, 0xb5, 0x9d, 0xe9, 0xab, 0x41, 0xc0, 0x6f, 0xe7, 0x4c, 0x18, 0xc8, 0x7f, 0x6c, 0x47,
-	0x36, 0x5b, 0x24, 0xa1, 0xfb, 0x4c, 0x99, 0x87, 0xce, 0x28, 0x4b, 0x92, 0x9e, 0x7b, 0xa6, 0x35,
-	0xe4, 0x3f, 0x71, 0x8b, 0xad, 0x3a, 0x77, 0x02, 0x5b, 0x3a, 0xbf, 0xd5, 0x53, 0x3b, 0x8f, 0x6e,
-	0x2a, 0xde, 0x6d, 0x76, 0x69, 0xa8, 0x88, 0xe2, 0x42, 0x97, 0x8c, 0xde, 0xba, 0xe9, 0x06, 0x93,
-	0x21, 0x4f, 0x53, 0x1a, 0xaa, 0x7d, 0xfe, 0x39, 0x3f, 0x38, 0xe8, 0x9d, 0xdb, 0xf4, 0x23, 0x3b,
-	0x6d, 0x78, 0x45, 0x44, 0x92, 0x75, 0xb7, 0x89, 0xdc, 0x4c, 0x23, 0x1a, 0x9d, 0xdb, 0x6e, 0xc5,
-	0x76, 0x1d, 0x26, 0x2e, 0xdb, 0x49, 0x5b, 0xfc, 0xdc, 0x36, 0xaf, 0xdd, 0x02, 0x78, 0x11, 0xda,
-	0x34, 0xe8, 0x11, 0x25, 0xf1, 0x6a, 0x1c, 0x7b, 0x1f, 0xa3, 0x6b, 0xa6, 0xa4, 0x25, 0x03, 0xc5,
-	0xe2, 0x38, 0x90, 0x9d, 0x4c, 0x45, 0xfc, 0xd8, 0x8d, 0xef, 0xae, 0xe4, 0x94, 0x7d, 0x16, 0xc7,
-	0xcd, 0x9c, 0xf0, 0xe0, 0xab, 0x59, 0x74, 0x7d, 0xb3, 0xef, 0xf3, 0x5f, 0x48, 0x2a, 0x76, 0xf3,
-	0xef, 0x83, 0x3c, 0x0f, 0xcd, 0xad, 0x37, 0x83, 0x17, 0xbb, 0xfd, 0x4f, 0x77, 0x70, 0xc5, 0xc3,
-	0x68, 0xc6, 0x60, 0xe6, 0x63, 0x1b, 0x5c, 0xf5, 0xe6, 0xd1, 0xb4, 0x41, 0xf4, 0xe7, 0x34, 0x78,
-	0xc4, 0xbb, 0x8c, 0x66, 0x0d, 0x90, 0x7f, 0x30, 0x83, 0x47, 0x4b, 0x28, 0xff, 0x04, 0x05, 0x8f,
-	0x95, 0x9d, 0x17, 0x5f, 0xa5, 0xe0, 0xf1, 0x92, 0x96, 0x7f, 0xf9, 0x81, 0x27, 0xca, 0xf1, 0xcc,
-	0xf7, 0x1b, 0x78, 0xb2, 0x6c, 0x58, 0x18, 0x21, 0x9e, 0xf2, 0xae, 0xa0, 0xf9, 0x5c, 0xab, 0xc2,
-	0xcc, 0x30, 0x2a, 0x15, 0xd3, 0x9f, 0x26, 0xe0, 0x19, 0x6f, 0x0e, 0x21, 0x03, 0x3c, 0x23, 0x11,
-	0xc5, 0xb3, 0x65, 0xdf, 0xe6, 0x09, 0x1e, 0xcf, 0x79, 0xd7, 0x91, 0x67, 0x10, 0xfb, 0xc1, 0x1a,
-	0xcf, 0x7b, 0xb7, 0xd0, 0xc2, 0x30, 0x6e, 0x1e, 0xb2, 0x31, 0x2e, 0x47, 0xef, 0xbf, 0x31, 0xe3,
-	0xcb, 0x96, 0x9a, 0xf9, 0xcb, 0x31, 0xf6, 0x4a, 0x62, 0xff, 0x05, 0x16, 0x5f, 0x29, 0xc7, 0xb4,
-	0xed, 0x1d, 0x5f, 0x2d, 0xb5, 0x33, 0x97, 0x01, 0xbe, 0x56, 0x36, 0xef, 0x3f, 0x30, 0xe2, 0xeb,
-	0xe5, 0x38, 0xc5, 0xab, 0x1d, 0x7e, 0xc7, 0xbb, 0x86, 0x2e, 0x1b, 0xcc, 0x7a, 0xcc, 0xc3, 0x0b,
-	0xde, 0x7b, 0x68, 0xd9, 0xc0, 0x67, 0xbf, 0xa7, 0xe1, 0x1b, 0xb6, 0x3e, 0xe5, 0x63, 0x07, 0xae,
-	0x95, 0x9d, 0x5a, 0x05, 0x67, 0x7c, 0xd3, 0xbb, 0x81, 0xae, 0x19, 0xd8, 0x74, 0x00, 0x89, 0xa1,
-	0x11, 0xdd, 0xf2, 0x6e, 0xa2, 0x77, 0xf2, 0x75, 0x19, 0x3c, 0x93, 0xf8, 0x76, 0xb9, 0xd7, 0x79,
-	0xe9, 0x17, 0x2f, 0x7a, 0x35, 0x74, 0x3d, 0x9f, 0xf1, 0x40, 0x3d, 0x15, 0x2f, 0x95, 0xb2, 0xc1,
-	0x82, 0x25, 0x5e, 0x2e, 0x65, 0x83, 0x35, 0x48, 0x7c, 0xa7, 0xdc, 0xb9, 0xe1, 0xea, 0x20, 0xf6,
-	0x4b, 0x0d, 0x87, 0x8e, 0x3e, 0x7e, 0xb7, 0x14, 0x0e, 0x55, 0xd3, 0xf0, 0x7b, 0x96, 0xae, 0x03,
-	0xc5, 0x2c, 0xfc, 0xbe, 0xb7, 0x80, 0xae, 0x1a, 0x99, 0x7b, 0x2b, 0xe0, 0xbb, 0xde, 0x55, 0x84,
-	0x0b, 0x3b, 0x2a, 0xce, 0x3d, 0xfe, 0x4e, 0xb9, 0x85, 0x45, 0x59, 0x05, 0xdf, 0x1b, 0xc0, 0x5e,
-	0x74, 0x23, 0x7c, 0xbf, 0xec, 0xd7, 0x2d, 0x52, 0xe0, 0x87, 0xb6, 0x61, 0xe5, 0x95, 0x06, 0x5c,
-	0xb7, 0x8e, 0x6f, 0x9e, 0x40, 0xe3, 0x0f, 0x4a, 0x05, 0xac, 0xe6, 0x8f, 0x06, 0x9a, 0x43, 0xfe,
-	0x89, 0x1f, 0x7b, 0xb7, 0xd1, 0x8d, 0xc2, 0xaa, 0x87, 0xee, 0x1b, 0xbc, 0xe2, 0xdd, 0x45, 0x7e,
-	0x69, 0xf4, 0x67, 0x25, 0x9c, 0xf8, 0x7b, 0xe5, 0x42, 0x0d, 0x66, 0x64, 0xf8, 0x49, 0xa9, 0x4d,
-	0x99, 0x39, 0xe0, 0x0f, 0xad, 0x9b, 0x21, 0x4f, 0x0e, 0xf0, 0x47, 0xa5, 0xb5, 0xe4, 0xa1, 0x39,
-	0xfe, 0x7e, 0xd9, 0xb8, 0x0c, 0xbf, 0xf1, 0xc7, 0xa5, 0x39, 0x0e, 0x04, 0xd7, 0xf8, 0x69, 0xa9,
-	0xc9, 0x60, 0xc8, 0x8c, 0x3f, 0x29, 0xc7, 0x2c, 0x42, 0x62, 0xfc, 0x83, 0xb2, 0xab, 0x81, 0xb0,
-	0x16, 0xff, 0xd0, 0x5e, 0x9b, 0xa1, 0x07, 0x4f, 0xfc, 0x87, 0xde, 0x22, 0xaa, 0xe5, 0x47, 0xe8,
-	0x94, 0xa0, 0x16, 0xff, 0xd1, 0x59, 0xcd, 0xf5, 0x5b, 0x26, 0xfe, 0x63, 0xeb, 0x9c, 0x17, 0x4f,
-	0x34, 0xf8, 0x53, 0xcb, 0x20, 0xf2, 0xf4, 0x0d, 0xaf, 0x7a, 0xef, 0xa0, 0x2b, 0x06, 0x73, 0x6a,
-	0x9e, 0x78, 0xad, 0x54, 0xe0, 0xb4, 0x5a, 0x21, 0x5e, 0xf7, 0xde, 0x47, 0x77, 0x6c, 0xd3, 0x3e,
-	0xb5, 0xee, 0x87, 0x37, 0xca, 0x23, 0x6f, 0xbd, 0x1d, 0xe2, 0x4d, 0x6f, 0x09, 0xdd, 0xb4, 0x8f,
-	0xfc, 0xc0, 0xbb, 0x1b, 0x7e, 0xe6, 0x2d, 0xa3, 0x5b, 0x86, 0x70, 0xfa, 0xeb, 0x16, 0xde, 0x2a,
-	0xaf, 0x62, 0x08, 0xac, 0xf1, 0x76, 0x39, 0x13, 0x27, 0x82, 0xc4, 0x3b, 0xe5, 0xf9, 0x1d, 0x3a,
-	0xa1, 0x2b, 0xf8, 0x47, 0x0f, 0x7e, 0x51, 0x41, 0xfe, 0xe6, 0xd9, 0xfe, 0x3b, 0x58, 0x0d, 0xf5,
-	0x77, 0x35, 0x8f, 0xd0, 0x77, 0x0f, 0x83, 0x8b, 0x79, 0xc1, 0x06, 0x6d, 0x91, 0x2c, 0x56, 0xf8,
-	0xd2, 0x5b, 0xb7, 0xd8, 0xe2, 0x3f, 0xce, 0x68, 0x46, 0x71, 0x65, 0xcd, 0xff, 0xed, 0x37, 0x8b,
-	0x95, 0xdf, 0x7d, 0xb3, 0x58, 0xf9, 0xcf, 0x6f, 0x16, 0x2b, 0x7f, 0xfb, 0xed, 0xe2, 0xa5, 0xdf,
-	0x7d, 0xbb, 0x78, 0xe9, 0xeb, 0x6f, 0x17, 0x2f, 0x6d, 0x57, 0xde, 0x8c, 0x24, 0xb2, 0xfd, 0x17,
-	0x95, 0x4b, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x54, 0x20, 0xc5, 0xb4, 0xda, 0x2b, 0x00, 0x00,
+	0x79, 0xd7, 0x3c, 0xf8, 0x02, 0x5f, 0x50, 0xeb, 0xb1, 0xb3, 0xb3, 0x12, 0x49, 0xf5, 0xee, 0xca,
+	0x92, 0xb2, 0x9a, 0xd5, 0xd2, 0xda, 0xf5, 0x6a, 0xed, 0x24, 0xcb, 0x97, 0x48, 0x7a, 0x97, 0x12,
+	0x3d, 0x43, 0x49, 0x2b, 0xb9, 0x52, 0x1d, 0xb0, 0x1b, 0x33, 0x83, 0xb0, 0xbb, 0xd1, 0x6e, 0xa0,
+	0x49, 0xd1, 0x3e, 0x24, 0xb9, 0xb9, 0xe2, 0x54, 0x2a, 0x07, 0x5f, 0x92, 0x72, 0xae, 0x39, 0xe6,
+	0x1e, 0xfb, 0x1f, 0x70, 0x55, 0x72, 0x70, 0x25, 0x17, 0x57, 0xa5, 0xe2, 0x4a, 0xed, 0x9e, 0x92,
+	0xca, 0x1f, 0x91, 0xfa, 0x80, 0xee, 0x69, 0x60, 0x66, 0x48, 0xaa, 0x52, 0xa9, 0x9c, 0x38, 0xfc,
+	0x7d, 0x3f, 0x00, 0x1f, 0x80, 0x0f, 0xf8, 0x1e, 0x68, 0x74, 0xc3, 0x17, 0x32, 0x65, 0x47, 0xf4,
+	0xa3, 0x8f, 0xbd, 0x4c, 0xd0, 0x34, 0xa2, 0x42, 0x90, 0x1e, 0x15, 0xad, 0x24, 0xe5, 0x92, 0x37,
+	0x57, 0x7a, 0x9c, 0xf7, 0x42, 0xfa, 0xa1, 0xfa, 0xef, 0x30, 0xeb, 0x7e, 0x18, 0x50, 0xe1, 0xa7,
+	0x2c, 0x91, 0x3c, 0xcd, 0x19, 0x97, 0x63, 0x2a, 0x87, 0x1a, 0x35, 0xcb, 0x2e, 0x7b, 0xbe, 0x2d,
+	0x73, 0x7f, 0x59, 0x41, 0xce, 0xc6, 0x46, 0xe7, 0x99, 0x48, 0xf7, 0x44, 0xcf, 0x7b, 0xbe, 0xfd,
+	0x6c, 0x77, 0x8f, 0xc6, 0x99, 0xd3, 0x40, 0xf5, 0x98, 0x44, 0xb4, 0x51, 0x59, 0xa9, 0xdc, 0x99,
+	0x59, 0xaf, 0xff, 0xfa, 0x77, 0xcb, 0x97, 0xda, 0x0a, 0x01, 0x89, 0xe8, 0xf3, 0x93, 0x46, 0x75,
+	0xa5, 0x72, 0x67, 0xba, 0x90, 0x00, 0xe2, 0x3c, 0x44, 0x53, 0x22, 0x3b, 0x3c, 0xa2, 0xa7, 0xa2,
+	0x51, 0x5b, 0xa9, 0xdd, 0x99, 0x5d, 0x6d, 0xb6, 0x46, 0x7b, 0x6e, 0x75, 0x14, 0xa5, 0x5d, 0x50,
+	0x9b, 0x9f, 0xa1, 0x49, 0x0d, 0x9d, 0x33, 0xe6, 0x75, 0x54, 0x13, 0x32, 0x6d, 0x54, 0x0d, 0x01,
+	0x00, 0x6e, 0x0b, 0xe1, 0x72, 0x84, 0x6d, 0xca, 0x7a, 0x34, 0x75, 0x9a, 0x68, 0x22, 0x25, 0x71,
+	0x4f, 0x77, 0x33, 0x91, 0xb3, 0x35, 0xe4, 0xde, 0x47, 0x8b, 0x25, 0xff, 0x20, 0x25, 0x2c, 0x06,
+	0xba, 0x84, 0x1f, 0x36, 0x5d, 0x41, 0xee, 0x7d, 0x74, 0xb9, 0xa4, 0xef, 0x64, 0xc1, 0x01, 0x7d,
+	0x2d, 0x41, 0x4b, 0x49, 0x5f, 0x4b, 0x5b, 0x4b, 0x40, 0xdc, 0xbf, 0xaa, 0x98, 0xfc, 0x0e, 0x39,
+	0x55, 0xfc, 0x9b, 0x68, 0x8a, 0xc6, 0xd2, 0x63, 0xc1, 0x6b, 0x6b, 0x88, 0x49, 0x1a, 0xcb, 0xdd,
+	0xe0, 0xf5, 0xa0, 0xbb, 0xea, 0x70, 0x77, 0x20, 0xf1, 0xfb, 0x44, 0x36, 0x6a, 0xe6, 0x42, 0x03,
+	0xe2, 0xdc, 0x46, 0xb3, 0xc0, 0x20, 0x61, 0xa8, 0x08, 0x75, 0x83, 0x60, 0x0a, 0xdc, 0x7f, 0xb0,
+	0xf6, 0x36, 0x57, 0x68, 0xf5, 0x0d, 0x34, 0x52, 0xdd, 0x56, 0x47, 0xc6, 0x5d, 0x46, 0xd3, 0x91,
+	0xe8, 0x79, 0x6a, 0x93, 0x6a, 0x86, 0xbe, 0x53, 0x91, 0xe8, 0x3d, 0xd1, 0xfb, 0x34, 0x99, 0x90,
+	0x94, 0x44, 0xa2, 0x51, 0x5f, 0xa9, 0xdd, 0x99, 0x69, 0xe7, 0xff, 0x0d, 0x2b, 0x3c, 0x71, 0x96,
+	0xc2, 0xdf, 0x37, 0x17, 0x10, 0x94, 0xdd, 0x13, 0x3d, 0x50, 0x17, 0x46, 0x0d, 0x84, 0xb4, 0xd5,
+	0x8d, 0x44, 0x6f, 0x53, 0x48, 0x63, 0xcc, 0x9a, 0x39, 0xa6, 0xfb, 0xef, 0x55, 0xd3, 0x38, 0x76,
+	0xb2, 0x00, 0xfa, 0x5a, 0x42, 0x53, 0x7e, 0x9f, 0xc4, 0x31, 0x0d, 0xad, 0xbe, 0x0a, 0xd0, 0x59,
+	0x46, 0xb5, 0x84, 0x0b, 0x35, 0xf5, 0xd9, 0xd5, 0xf9, 0xd6, 0xc6, 0x9e, 0xe8, 0x3d, 0xa7, 0xbe,
+	0xe4, 0xe9, 0xea, 0x66, 0x1b, 0x24, 0xce, 0x4d, 0x54, 0xf7, 0xc3, 0xf4, 0x23, 0x35, 0xfd, 0xd9,
+	0xd5, 0x19, 0xc5, 0x68, 0x6f, 0xaf, 0xaf, 0xb5, 0x15, 0x9c, 0x8b, 0x57, 0x1b, 0xf5, 0x71, 0xe2,
+	0x55, 0xe7, 0x06, 0x9a, 0xa4, 0xdd, 0x2e, 0xf5, 0xf5, 0x12, 0x94, 0x0b, 0xaf, 0x30, 0xe7, 0x36,
+	0x9a, 0xeb, 0x92, 0x80, 0x7a, 0x2c, 0xf6, 0x24, 0x8b, 0x68, 0x63, 0x72, 0xa5, 0x72, 0xa7, 0x9a,
+	0x73, 0x10, 0x48, 0x76, 0xe3, 0x03, 0x16, 0x51, 0xe7, 0x0e, 0x9a, 0x57, 0x3c, 0x9e, 0x49, 0x4d,
+	0x9c, 0x32, 0x88, 0xb3, 0x20, 0x7a, 0x9a, 0x49, 0xc5, 0xbc, 0x85, 0x66, 0xfa, 0x3c, 0x0c, 0x34,
+	0x6b, 0xc6, 0x60, 0x4d, 0x03, 0xac, 0x28, 0x37, 0xd1, 0x54, 0xf7, 0xb5, 0x26, 0x20, 0x83, 0x30,
+	0xd9, 0x7d, 0xad, 0xc4, 0x85, 0x79, 0xce, 0x8e, 0x58, 0xfb, 0xdf, 0x57, 0xcc, 0xc3, 0xd4, 0xe9,
+	0x93, 0x23, 0xaa, 0x96, 0x97, 0x47, 0x11, 0x89, 0x83, 0xa1, 0xe5, 0xd5, 0xa0, 0x73, 0x1f, 0x2d,
+	0x86, 0xdc, 0x27, 0xa1, 0x47, 0xa2, 0x24, 0x64, 0x32, 0x0b, 0x68, 0xa3, 0x6a, 0x0c, 0xba, 0xa0,
+	0x84, 0x6b, 0x85, 0xcc, 0x71, 0xd1, 0x4c, 0x37, 0xa5, 0x3f, 0xca, 0x68, 0xec, 0x9f, 0x36, 0x6a,
+	0x06, 0xb1, 0x84, 0x9d, 0x15, 0x34, 0x1d, 0x64, 0x29, 0x91, 0x8c, 0xc7, 0x8d, 0xba, 0x41, 0x19,
+	0xa0, 0x70, 0x2c, 0x17, 0x4a, 0x45, 0x1f, 0x93, 0x80, 0x5a, 0x8d, 0x4c, 0x45, 0x07, 0xa8, 0xbd,
+	0x72, 0x55, 0x93, 0x32, 0x58, 0xb9, 0x26, 0x9a, 0xe8, 0x86, 0xa4, 0x27, 0x1a, 0x35, 0x43, 0xac,
+	0x21, 0xe7, 0x1d, 0x54, 0xf3, 0xc3, 0x74, 0xd4, 0x0c, 0x00, 0x75, 0x03, 0xd3, 0x30, 0xdb, 0x59,
+	0x74, 0x18, 0xaa, 0xce, 0x58, 0x1c, 0x50, 0xfb, 0x44, 0x6a, 0x08, 0xf6, 0x20, 0x20, 0x92, 0x58,
+	0x6a, 0x28, 0xe4, 0x3c, 0x15, 0xdc, 0x9f, 0xa0, 0xeb, 0xe5, 0x28, 0x1b, 0x21, 0x17, 0x74, 0x83,
+	0x24, 0x6a, 0x6e, 0x0d, 0x54, 0xef, 0x13, 0xd1, 0x57, 0x43, 0xcd, 0x17, 0xfd, 0x01, 0x62, 0xad,
+	0x4b, 0x75, 0xec, 0xba, 0xbc, 0x8f, 0x66, 0xbb, 0x29, 0x8f, 0xbc, 0x24, 0x24, 0xa7, 0x34, 0xb5,
+	0xee, 0x26, 0x04, 0x82, 0x7d, 0x85, 0xbb, 0x7f, 0x5e, 0x41, 0x37, 0xc6, 0x8f, 0xbe, 0xc9, 0x52,
+	0xb0, 0xf5, 0xff, 0x07, 0x1d, 0xbe, 0x87, 0xae, 0x18, 0xf6, 0x49, 0xe3, 0x60, 0x2d, 0x0b, 0x18,
+	0x87, 0xd6, 0x29, 0x09, 0x18, 0xf7, 0x04, 0xcf, 0x72, 0x3b, 0x2d, 0x0c, 0x1b, 0x29, 0x41, 0x07,
+	0x70, 0xf7, 0xef, 0xac, 0xbb, 0xb3, 0x4d, 0x4e, 0x74, 0xeb, 0x26, 0x9a, 0x48, 0x98, 0xf4, 0xfb,
+	0xf6, 0x3e, 0x29, 0x48, 0x9d, 0xee, 0x58, 0xc2, 0xb5, 0x5a, 0x1d, 0xba, 0x56, 0x59, 0xf0, 0xda,
+	0x9a, 0x57, 0x6d, 0x9c, 0xa1, 0x3a, 0xbf, 0x87, 0x16, 0x8e, 0x39, 0xf3, 0xa9, 0xd7, 0x65, 0x21,
+	0x55, 0x97, 0x6c, 0xdd, 0x50, 0x6e, 0x5e, 0xc9, 0x1e, 0xe7, 0x22, 0xf7, 0xbf, 0x2a, 0xe6, 0xf4,
+	0x9e, 0x83, 0x6c, 0x8f, 0x88, 0x23, 0x67, 0x1d, 0xcd, 0xe9, 0x75, 0xf1, 0x22, 0x22, 0x8e, 0x44,
+	0xa3, 0xa2, 0x3c, 0xf1, 0x72, 0x6b, 0x0c, 0xb7, 0xa5, 0x17, 0x0a, 0x7e, 0xb6, 0x67, 0x93, 0xc1,
+	0x6f, 0xe1, 0x3c, 0x40, 0x97, 0x8b, 0x3e, 0x78, 0xe0, 0xd1, 0x98, 0x1c, 0x86, 0xd4, 0x72, 0x07,
+	0x8b, 0x39, 0x9b, 0x07, 0x5b, 0x4a, 0xd8, 0xfc, 0x23, 0x84, 0xca, 0xce, 0x9c, 0x0f, 0xd0, 0x62,
+	0x8f, 0x44, 0xd4, 0x4b, 0xb3, 0x90, 0x0a, 0xa5, 0x87, 0xb5, 0x5c, 0xf3, 0x20, 0x6c, 0x83, 0x4c,
+	0xb1, 0x6f, 0xa1, 0x99, 0x43, 0x12, 0xe7, 0xea, 0x5a, 0x3b, 0x7e, 0x48, 0x62, 0xa5, 0x90, 0xfb,
+	0x8b, 0x8a, 0x79, 0x64, 0x36, 0x49, 0x44, 0x7a, 0x14, 0x96, 0x9b, 0x44, 0x3c, 0x8b, 0x87, 0xdc,
+	0x82, 0xc6, 0x9c, 0xef, 0xa2, 0x2b, 0x2c, 0xee, 0x86, 0x0c, 0x2e, 0x6f, 0xef, 0x84, 0xa7, 0x61,
+	0xe0, 0x95, 0x37, 0xfb, 0xac, 0x71, 0xb3, 0xb7, 0x2f, 0x0f, 0x78, 0x2f, 0x80, 0xb6, 0xcf, 0x05,
+	0xdc, 0x53, 0xc7, 0xcc, 0x97, 0x2c, 0xf2, 0x60, 0xf3, 0xd4, 0xb9, 0x34, 0x4f, 0xd8, 0x82, 0x16,
+	0x6e, 0xe5, 0x32, 0xf7, 0x67, 0xd6, 0x5e, 0xb4, 0xc1, 0x88, 0x0a, 0xd7, 0x7f, 0x9e, 0xe7, 0xba,
+	0x81, 0x26, 0xfd, 0x90, 0xd1, 0x58, 0xda, 0xf6, 0xa2, 0xb1, 0xff, 0xb5, 0xb3, 0x75, 0x5b, 0xa6,
+	0xe1, 0xee, 0xb0, 0x58, 0x5e, 0x10, 0xb6, 0xdc, 0x47, 0xd7, 0x4a, 0xfe, 0x17, 0xf4, 0x74, 0xd0,
+	0xe4, 0x2a, 0x9a, 0xe8, 0xb3, 0x58, 0x6a, 0x1b, 0x9a, 0x69, 0xeb, 0x7f, 0xdc, 0x9f, 0xd5, 0xd1,
+	0x7b, 0x25, 0x7f, 0x3f, 0xe5, 0x3e, 0x15, 0xa2, 0x93, 0x70, 0x29, 0x69, 0x00, 0x2b, 0x22, 0x4f,
+	0x9f, 0x25, 0x01, 0x91, 0xd4, 0x79, 0x17, 0xa1, 0x98, 0x9e, 0x78, 0x99, 0xfa, 0xaf, 0x51, 0x31,
+	0xcc, 0x67, 0x26, 0xa6, 0x27, 0x39, 0xe9, 0x8f, 0xd1, 0x02, 0x55, 0x8d, 0x72, 0x1e, 0xec, 0x10,
+	0x18, 0xec, 0xa3, 0xd6, 0x9b, 0x8c, 0xd1, 0x1a, 0x83, 0xb5, 0xe7, 0xa9, 0xf1, 0x9f, 0x68, 0xfe,
+	0x5b, 0x15, 0x5d, 0x39, 0x43, 0xbd, 0x7c, 0xe4, 0xe1, 0x40, 0x68, 0x46, 0xe3, 0x10, 0x0b, 0x2d,
+	0xa3, 0x69, 0x3f, 0x24, 0x42, 0x78, 0x2c, 0xb0, 0x36, 0x69, 0x4a, 0xa1, 0xbb, 0x01, 0x10, 0x78,
+	0xca, 0x7a, 0x2c, 0xf6, 0x6c, 0x13, 0x99, 0xd2, 0xe8, 0x57, 0x06, 0xe1, 0xb4, 0x51, 0x1f, 0x25,
+	0xbc, 0x34, 0x08, 0x3f, 0x6e, 0x4c, 0x8c, 0x12, 0x5e, 0x81, 0x15, 0x91, 0xb8, 0x17, 0x52, 0xef,
+	0xb4, 0x31, 0x69, 0xc8, 0x27, 0x15, 0xf8, 0x12, 0x7c, 0x6e, 0x40, 0xbb, 0x90, 0x2a, 0x34, 0xa6,
+	0x8c, 0x35, 0x2e, 0x40, 0x67, 0x15, 0x39, 0xf9, 0x61, 0xee, 0x13, 0xe1, 0x15, 0xd4, 0x69, 0x83,
+	0x8a, 0xb5, 0x7c, 0x87, 0x88, 0xcd, 0xbc, 0xcd, 0x1d, 0x34, 0x6f, 0xb4, 0xf1, 0x1f, 0x36, 0x66,
+	0x0c, 0xfa, 0xec, 0x80, 0xbe, 0xf1, 0xd0, 0x3d, 0x44, 0x37, 0xed, 0x4b, 0x56, 0x5f, 0x03, 0xbb,
+	0x92, 0x46, 0x9b, 0x29, 0x4f, 0x84, 0xb3, 0x36, 0xb2, 0xc1, 0x95, 0x22, 0x37, 0xd8, 0xf2, 0x79,
+	0x0c, 0xc4, 0xfd, 0x94, 0x1e, 0x33, 0x7a, 0xb2, 0x49, 0x24, 0x59, 0x0f, 0xb9, 0x7f, 0x34, 0xb4,
+	0x83, 0xee, 0xe9, 0xd9, 0x63, 0x3c, 0x86, 0xbb, 0xda, 0xf9, 0x04, 0x4d, 0x33, 0x49, 0x23, 0x16,
+	0x77, 0xb9, 0xda, 0xc8, 0xf3, 0x7b, 0x1f, 0x70, 0xe1, 0x4a, 0x1e, 0x9c, 0x6f, 0xeb, 0xe2, 0x29,
+	0x50, 0xf7, 0x1f, 0x2b, 0xa6, 0x17, 0x6d, 0xd3, 0x90, 0x93, 0x60, 0x4b, 0x47, 0x6b, 0xe5, 0x6d,
+	0x5f, 0x19, 0x73, 0xdb, 0x2f, 0xa1, 0x29, 0xe2, 0x4b, 0x12, 0xb3, 0xc8, 0xb6, 0x9b, 0x1c, 0x1c,
+	0xb1, 0x9b, 0xea, 0x45, 0x76, 0x53, 0xbd, 0xc8, 0x6e, 0x86, 0x08, 0xaf, 0xdc, 0xdf, 0x56, 0xcc,
+	0x83, 0xfd, 0x82, 0x92, 0x84, 0xc7, 0xca, 0xb7, 0x5d, 0xa0, 0xba, 0xa9, 0x5a, 0xf5, 0x22, 0xd5,
+	0x6a, 0x17, 0xa9, 0x36, 0x46, 0xf7, 0x57, 0xe0, 0x45, 0xb5, 0xf7, 0x9d, 0x30, 0x6e, 0x23, 0x0d,
+	0x41, 0xd0, 0x07, 0x41, 0x97, 0x90, 0x24, 0x4a, 0xac, 0x10, 0xb8, 0x84, 0x61, 0x5b, 0x96, 0xcb,
+	0xa9, 0x69, 0x3b, 0xe9, 0xf8, 0x29, 0xa5, 0xf1, 0x0e, 0x25, 0xa1, 0xec, 0xaf, 0x93, 0xf4, 0x82,
+	0x49, 0xde, 0x47, 0x8b, 0x7d, 0x45, 0x55, 0xbe, 0xd7, 0xe3, 0x61, 0x60, 0x47, 0xa2, 0x86, 0xf0,
+	0x69, 0x18, 0x0c, 0xd3, 0x63, 0x7a, 0xd2, 0xa8, 0x9d, 0x41, 0x7f, 0x42, 0x4f, 0xd4, 0xfc, 0xe4,
+	0x69, 0x48, 0xad, 0x13, 0xaf, 0x21, 0xf7, 0x73, 0xb4, 0x52, 0xaa, 0xae, 0x6f, 0xa4, 0xa7, 0x99,
+	0x0c, 0x59, 0x4c, 0x77, 0x58, 0xaf, 0x1f, 0xb2, 0x5e, 0xff, 0x02, 0xdb, 0x72, 0x3f, 0x36, 0xf7,
+	0x75, 0x2d, 0xf8, 0x93, 0x4c, 0xc8, 0x3d, 0x1e, 0xd3, 0xd3, 0xf3, 0x3d, 0xa2, 0xfb, 0x17, 0xb6,
+	0x97, 0xa2, 0x09, 0x4f, 0xe5, 0x0e, 0x93, 0xce, 0xdb, 0x68, 0x22, 0xe1, 0xc2, 0xd3, 0x63, 0x15,
+	0x33, 0xaa, 0x27, 0x5c, 0x7c, 0x55, 0x88, 0x4e, 0x1b, 0xd5, 0x21, 0xd1, 0x4b, 0x7b, 0x9b, 0xea,
+	0x63, 0xb7, 0xa9, 0x68, 0xfe, 0xe3, 0x46, 0x6d, 0xa8, 0xf9, 0x2b, 0xf7, 0xa7, 0x56, 0xae, 0xfc,
+	0x05, 0x0b, 0xc3, 0x0d, 0xa2, 0x4f, 0xc5, 0xa1, 0x80, 0xa8, 0xc3, 0x4e, 0xdf, 0xa7, 0xf8, 0xa1,
+	0xd8, 0xe3, 0x01, 0x75, 0xbe, 0x85, 0xe6, 0xba, 0x2c, 0x15, 0xd2, 0x93, 0x24, 0xed, 0x51, 0xdb,
+	0x71, 0xce, 0x2a, 0xc9, 0x81, 0x12, 0x38, 0x77, 0xd1, 0xbc, 0xa0, 0x3e, 0x8f, 0x83, 0x82, 0x69,
+	0x5e, 0xce, 0x73, 0x5a, 0xa4, 0xa9, 0xee, 0x7f, 0x57, 0x50, 0xd3, 0x08, 0x2e, 0xa8, 0x60, 0x29,
+	0x55, 0x11, 0xbe, 0xf0, 0x49, 0x48, 0x9d, 0x8f, 0xd0, 0xe5, 0x40, 0x63, 0x9e, 0x2c, 0x40, 0x6b,
+	0xa9, 0x70, 0x30, 0xdc, 0xe4, 0x53, 0x74, 0xad, 0x08, 0xea, 0xbc, 0x14, 0x2c, 0x83, 0x45, 0xd4,
+	0x13, 0xd4, 0xb7, 0x96, 0xf1, 0x4a, 0x41, 0x69, 0xe7, 0x8c, 0x0e, 0xf5, 0x61, 0x30, 0x16, 0x4b,
+	0x9a, 0x26, 0x3c, 0x24, 0x10, 0xb8, 0xc8, 0xd3, 0x84, 0x5a, 0xaa, 0x63, 0x53, 0x7c, 0x70, 0x9a,
+	0x50, 0xa7, 0x85, 0xb0, 0x90, 0x24, 0x95, 0xde, 0x61, 0x48, 0xe3, 0x3c, 0x61, 0x31, 0xf7, 0x63,
+	0x41, 0x49, 0xd7, 0x41, 0x08, 0x1a, 0xba, 0xdb, 0xe6, 0x6c, 0x37, 0xb2, 0x34, 0xa5, 0xb1, 0x2c,
+	0x55, 0xbf, 0x8b, 0xe6, 0xfd, 0x2c, 0x3d, 0x63, 0xa6, 0x73, 0x7e, 0x96, 0x0e, 0xa8, 0xee, 0x9f,
+	0x9a, 0x1d, 0xad, 0xf9, 0x7d, 0x46, 0x8f, 0x69, 0x44, 0x63, 0xb9, 0x75, 0x0c, 0xe1, 0xcb, 0x6d,
+	0x34, 0x4b, 0x4a, 0xcc, 0xda, 0x4d, 0x53, 0x00, 0x47, 0xc5, 0x57, 0x26, 0x6b, 0x6e, 0xa5, 0x86,
+	0xc0, 0xf3, 0x81, 0x3b, 0x02, 0xe7, 0x6b, 0xae, 0xc1, 0x24, 0x80, 0xbb, 0x81, 0xfb, 0x2b, 0x2b,
+	0xc9, 0xd8, 0x23, 0xd2, 0xef, 0x6f, 0xc5, 0xc1, 0x06, 0x8f, 0x03, 0x06, 0xeb, 0x2a, 0x74, 0xfe,
+	0x48, 0x7a, 0x21, 0x8b, 0x98, 0xad, 0x41, 0x09, 0x83, 0x45, 0x45, 0x89, 0x17, 0x91, 0xd7, 0x29,
+	0xdc, 0x3e, 0x76, 0x00, 0x3a, 0x1b, 0x25, 0x7b, 0x85, 0x00, 0xf2, 0x86, 0x28, 0xf1, 0x4e, 0x58,
+	0xac, 0xbb, 0x33, 0x15, 0x42, 0x51, 0xf2, 0x82, 0xc5, 0x66, 0x7f, 0xb0, 0x7e, 0x9a, 0x57, 0xb7,
+	0xfb, 0x3b, 0x28, 0x04, 0xee, 0x7f, 0x56, 0xd0, 0x3b, 0x46, 0x8c, 0xa3, 0x5c, 0x5a, 0x47, 0x12,
+	0x29, 0xf2, 0xf8, 0x64, 0x09, 0x4d, 0x1d, 0xd3, 0x54, 0x0c, 0xa7, 0xa8, 0x05, 0xe8, 0x7c, 0x07,
+	0xee, 0x18, 0x22, 0x75, 0xf4, 0x37, 0xbb, 0x7a, 0xab, 0x75, 0x4e, 0x67, 0x2d, 0xf8, 0xdd, 0xd6,
+	0x7c, 0x73, 0x55, 0x27, 0x46, 0x57, 0x15, 0x6a, 0x6d, 0x7e, 0xea, 0x5b, 0xa1, 0x06, 0x00, 0xcd,
+	0xcf, 0x50, 0x1d, 0x7a, 0x01, 0xf9, 0xf0, 0xc5, 0x04, 0x00, 0x6c, 0x64, 0x40, 0xc3, 0xa1, 0x34,
+	0x55, 0x43, 0xee, 0x0f, 0xad, 0x13, 0xc6, 0x04, 0x44, 0x10, 0xbb, 0x31, 0xd8, 0x09, 0x4f, 0x4f,
+	0x55, 0x04, 0xa3, 0x31, 0x2b, 0x4a, 0x2c, 0x40, 0x53, 0xe1, 0xea, 0x18, 0x33, 0xf8, 0x65, 0x05,
+	0xbd, 0x55, 0xf6, 0xfe, 0x83, 0x8c, 0x0a, 0xb9, 0x9f, 0xf2, 0x5e, 0x4a, 0x85, 0x80, 0x0b, 0xe5,
+	0x47, 0x00, 0x40, 0x5b, 0x33, 0xd5, 0x9c, 0x52, 0xe8, 0x6e, 0x00, 0xf6, 0x1e, 0xf3, 0x34, 0x22,
+	0xa1, 0x97, 0x70, 0x15, 0xeb, 0x56, 0x0d, 0xd6, 0x9c, 0x16, 0xed, 0x2b, 0x09, 0xec, 0xec, 0x21,
+	0x8f, 0x33, 0x51, 0x30, 0x6b, 0x06, 0x73, 0x56, 0x49, 0x72, 0xe2, 0x3d, 0xb4, 0xc0, 0x84, 0x47,
+	0x61, 0x7a, 0x9e, 0x1a, 0xc7, 0xaa, 0xd0, 0xcd, 0x31, 0xa1, 0x4e, 0x88, 0x52, 0xd4, 0xfd, 0x02,
+	0x2d, 0x19, 0xb1, 0x8d, 0xcf, 0x53, 0xfa, 0x25, 0x25, 0x01, 0x4d, 0x0f, 0x39, 0x49, 0x03, 0x88,
+	0x59, 0x9c, 0xbb, 0x79, 0xf6, 0xaf, 0x03, 0x9b, 0x6b, 0xad, 0x71, 0x24, 0x5d, 0x0e, 0x70, 0x5f,
+	0xa2, 0xf7, 0x87, 0x8d, 0x60, 0x93, 0xfa, 0x24, 0xdc, 0x64, 0x3d, 0x26, 0x49, 0xd8, 0x61, 0xbd,
+	0x98, 0xc8, 0x2c, 0xa5, 0xce, 0x03, 0xab, 0xcf, 0x1b, 0xad, 0x73, 0xb8, 0x79, 0xd7, 0x3f, 0x34,
+	0x93, 0x8a, 0xaf, 0xda, 0x24, 0x3e, 0xda, 0xa6, 0x3a, 0x47, 0xe1, 0x50, 0xb1, 0x1a, 0x32, 0x88,
+	0x29, 0x40, 0x21, 0x7e, 0x7e, 0x0f, 0x21, 0x9f, 0xc7, 0x32, 0xe5, 0x61, 0x48, 0x53, 0x6b, 0xf7,
+	0x0c, 0xdc, 0xfd, 0xc9, 0x48, 0xe7, 0xcf, 0x92, 0xe0, 0xff, 0xa8, 0x73, 0xb0, 0xae, 0x94, 0xc4,
+	0x47, 0x2c, 0xee, 0xd9, 0x01, 0x7a, 0x0e, 0xba, 0x6d, 0xd4, 0x28, 0x07, 0xdf, 0x20, 0x61, 0xf8,
+	0x9c, 0x4b, 0xfa, 0x98, 0xb0, 0x90, 0xaa, 0x40, 0x29, 0xa5, 0x44, 0x0c, 0x1d, 0xc1, 0x1c, 0x53,
+	0x29, 0xd5, 0x70, 0x79, 0x48, 0x21, 0xee, 0xaf, 0xaa, 0x76, 0x72, 0x2e, 0x69, 0x07, 0x2e, 0x61,
+	0xd5, 0x82, 0x92, 0xc8, 0xea, 0x4d, 0x21, 0x66, 0x4d, 0xb6, 0x3a, 0xa6, 0x26, 0x7b, 0x0b, 0xcd,
+	0x1c, 0x73, 0x49, 0x47, 0xfd, 0xc1, 0x34, 0xc0, 0xca, 0x0f, 0x2c, 0xa3, 0x69, 0x38, 0x30, 0x1e,
+	0x14, 0xca, 0xcd, 0xba, 0x81, 0x3a, 0x46, 0x1d, 0x99, 0xc2, 0x05, 0x16, 0x50, 0x49, 0x58, 0x28,
+	0x14, 0xc7, 0x0c, 0xbd, 0x50, 0x2e, 0x00, 0xda, 0x3d, 0xb4, 0xc0, 0x65, 0x9f, 0xa6, 0x1e, 0xe8,
+	0xa5, 0x98, 0x93, 0x06, 0x73, 0x4e, 0xc9, 0x0e, 0x28, 0x89, 0x80, 0x7b, 0x57, 0x59, 0xfa, 0x29,
+	0x15, 0x5e, 0xcc, 0x3d, 0xd0, 0xc4, 0x4a, 0x41, 0x66, 0x99, 0x78, 0x49, 0xc5, 0x13, 0x0e, 0xf3,
+	0x87, 0x83, 0xa6, 0xc3, 0x97, 0xc2, 0x21, 0x4f, 0x9b, 0x0e, 0x59, 0x8b, 0x72, 0x87, 0xfc, 0x37,
+	0xf6, 0x93, 0x04, 0x97, 0x74, 0x9f, 0x08, 0x71, 0xce, 0xe2, 0x59, 0xab, 0x53, 0xbd, 0x70, 0x75,
+	0x6a, 0x6f, 0xb0, 0x3a, 0xf5, 0xf1, 0xab, 0xe3, 0x3e, 0x41, 0x57, 0x6d, 0xd5, 0x72, 0x4b, 0x39,
+	0x5b, 0xb9, 0xd2, 0x86, 0xaa, 0xa3, 0x36, 0xe4, 0x7e, 0x3e, 0x62, 0x28, 0x54, 0x66, 0x89, 0x73,
+	0x17, 0xe1, 0x84, 0x4b, 0x58, 0x15, 0x12, 0x7a, 0x4c, 0x88, 0x8c, 0x16, 0x59, 0xf8, 0xe2, 0x00,
+	0xdf, 0x55, 0xb0, 0xfb, 0x2f, 0x15, 0x74, 0xdb, 0x4e, 0x8f, 0xbe, 0x24, 0x42, 0x42, 0x44, 0x45,
+	0x53, 0x5d, 0x29, 0x39, 0xe0, 0x1b, 0xba, 0xa4, 0x70, 0x0f, 0x2d, 0xc4, 0x59, 0xe4, 0xf5, 0x99,
+	0x14, 0x5e, 0x8f, 0x1d, 0x53, 0xdb, 0xac, 0xe7, 0xe2, 0x2c, 0xda, 0x61, 0x52, 0x6c, 0x83, 0x04,
+	0x6e, 0xbb, 0x40, 0xb5, 0xce, 0x99, 0x96, 0x5f, 0xd4, 0x12, 0x4d, 0x34, 0x3b, 0x95, 0xe4, 0x88,
+	0xc6, 0x8d, 0xda, 0x98, 0x4e, 0x0f, 0xc8, 0x91, 0xd5, 0xa9, 0x66, 0xd6, 0x47, 0x3b, 0x55, 0x44,
+	0xf7, 0x9f, 0xaa, 0xa6, 0xc7, 0xe8, 0xd0, 0xf4, 0x98, 0xa6, 0xf9, 0xbd, 0x00, 0xbe, 0x71, 0x1b,
+	0x6a, 0x78, 0xf1, 0x51, 0x59, 0x5b, 0x00, 0x0f, 0x78, 0xbb, 0x75, 0x76, 0x8b, 0x56, 0xf9, 0x13,
+	0xaa, 0x7c, 0xc5, 0xef, 0xe6, 0x37, 0x15, 0x84, 0x8c, 0x7e, 0xdf, 0x45, 0x88, 0xf8, 0x2a, 0xf6,
+	0x28, 0x1c, 0xc6, 0x20, 0x62, 0xc8, 0x71, 0x9d, 0xf2, 0xab, 0xc1, 0x8b, 0x9c, 0xc1, 0xba, 0x51,
+	0x9e, 0x86, 0x25, 0xa1, 0xc8, 0x12, 0x2c, 0x02, 0xa4, 0x07, 0xcb, 0x68, 0x1a, 0x96, 0xec, 0x84,
+	0xc5, 0xc2, 0xae, 0x09, 0xc4, 0x59, 0xf4, 0x82, 0xc5, 0x42, 0xd7, 0x28, 0xe3, 0x23, 0x0f, 0x9e,
+	0x25, 0x7a, 0xd4, 0x4a, 0xef, 0x94, 0xf6, 0x1b, 0x0a, 0x87, 0x07, 0x03, 0x45, 0x03, 0xbb, 0x07,
+	0x85, 0x4d, 0x9f, 0xad, 0x78, 0x60, 0xfa, 0xbb, 0x81, 0xfb, 0xc2, 0xba, 0x5f, 0x93, 0x7c, 0xb2,
+	0x6b, 0x96, 0x13, 0xb8, 0xaf, 0x4a, 0x62, 0xdb, 0x1b, 0x1b, 0x83, 0xa7, 0xc2, 0xe3, 0x55, 0x6f,
+	0x7b, 0x63, 0x55, 0xaf, 0xe4, 0x13, 0x2e, 0x59, 0xf7, 0xf4, 0xab, 0xfd, 0x36, 0x3d, 0x21, 0x69,
+	0x40, 0x83, 0xdc, 0x2b, 0x3c, 0x30, 0x4f, 0x83, 0x4a, 0xb6, 0x99, 0x7f, 0x94, 0x25, 0x70, 0x1a,
+	0x20, 0xc9, 0xb6, 0x8b, 0x4d, 0x80, 0xb8, 0x3f, 0xb7, 0x9f, 0xa4, 0xfa, 0xfc, 0x44, 0x3d, 0x37,
+	0xb6, 0x10, 0x3e, 0x04, 0x03, 0x3a, 0x26, 0x21, 0x0b, 0x3c, 0x11, 0x72, 0x55, 0x75, 0x32, 0x2a,
+	0x6e, 0x20, 0x7d, 0x0e, 0xc2, 0x0e, 0xc8, 0x94, 0x21, 0xe9, 0xe8, 0x60, 0xb4, 0x42, 0x3f, 0x9b,
+	0x4b, 0x54, 0x91, 0x1e, 0xa2, 0x36, 0x1a, 0x67, 0x70, 0xa6, 0x0b, 0x0f, 0x30, 0x38, 0xd6, 0x20,
+	0xe8, 0x28, 0xdc, 0x7e, 0xe9, 0x5b, 0x27, 0xe9, 0xe0, 0xed, 0x83, 0x0d, 0xbf, 0x47, 0xaa, 0xfb,
+	0xfd, 0x13, 0x73, 0xde, 0x6b, 0x51, 0xc4, 0x37, 0x69, 0xcc, 0x68, 0xa0, 0xb2, 0xfe, 0x28, 0xe2,
+	0xbb, 0xc3, 0x1e, 0x2b, 0x07, 0xdd, 0x0d, 0xf4, 0xb6, 0x19, 0xb0, 0xa6, 0x47, 0x46, 0xd8, 0x3c,
+	0x2e, 0x62, 0x9e, 0x19, 0x13, 0x31, 0xbb, 0x9f, 0xa1, 0xe6, 0x50, 0xd4, 0x6b, 0x86, 0x8d, 0x37,
+	0xd0, 0xa4, 0x51, 0x71, 0x9b, 0x19, 0xc4, 0x4a, 0x0a, 0x73, 0x9f, 0x20, 0xc7, 0xde, 0x30, 0x28,
+	0xd2, 0x40, 0x1b, 0x55, 0x13, 0xd1, 0x26, 0x5f, 0x2b, 0xda, 0x68, 0x4c, 0x07, 0x76, 0x44, 0xf6,
+	0xad, 0x0a, 0xb0, 0x86, 0xdc, 0x47, 0xe6, 0x84, 0xb6, 0x43, 0x7e, 0xb2, 0x9f, 0xf2, 0xe4, 0x20,
+	0x4b, 0xe3, 0xa7, 0xdd, 0xee, 0x05, 0x59, 0xec, 0x2f, 0x2a, 0x66, 0xe8, 0xd3, 0x86, 0x20, 0x7b,
+	0x9d, 0x80, 0xf5, 0x14, 0x25, 0x6e, 0x51, 0xe6, 0x06, 0x95, 0xd1, 0xdc, 0x60, 0xf0, 0x60, 0x52,
+	0x1d, 0x7d, 0x30, 0x59, 0x41, 0xd3, 0x83, 0x12, 0xba, 0xb9, 0xe3, 0x03, 0x14, 0x18, 0x31, 0xf3,
+	0x47, 0x8b, 0xec, 0x03, 0xd4, 0x4d, 0xcc, 0x47, 0xa3, 0x4e, 0xe7, 0xd9, 0xee, 0xe0, 0xe1, 0xbb,
+	0x32, 0xf2, 0xf0, 0xfd, 0x2e, 0x42, 0x3a, 0x05, 0x1b, 0xd8, 0xe2, 0x20, 0x19, 0x56, 0xb8, 0xb2,
+	0xa6, 0x65, 0x28, 0x36, 0xe5, 0xf9, 0x99, 0x55, 0x35, 0x29, 0x12, 0xb3, 0xbf, 0x9d, 0x30, 0xe3,
+	0xd8, 0x4e, 0x96, 0x1e, 0xb3, 0x63, 0x12, 0xaa, 0xbd, 0x85, 0xb1, 0x5f, 0x67, 0xf9, 0xfe, 0xd4,
+	0x8b, 0xb1, 0x01, 0x71, 0xbe, 0x8d, 0x26, 0xba, 0xc4, 0x97, 0x45, 0xdd, 0xf4, 0x66, 0xeb, 0x8c,
+	0x2e, 0x5a, 0x8f, 0x89, 0x2f, 0xdb, 0x9a, 0xeb, 0x7c, 0x8a, 0x26, 0x20, 0x78, 0x2e, 0xde, 0xe9,
+	0xdd, 0x33, 0x1b, 0xed, 0x87, 0xc4, 0x57, 0x56, 0xd7, 0xd6, 0x0d, 0x9c, 0x47, 0x68, 0x4a, 0xdf,
+	0xd3, 0xa2, 0x31, 0x31, 0xf2, 0xb2, 0x60, 0xb7, 0xd5, 0xce, 0xa7, 0x5d, 0xf0, 0x21, 0x5c, 0x93,
+	0xcc, 0x3f, 0x8a, 0xb3, 0xe8, 0x90, 0xa6, 0xd6, 0xbd, 0x67, 0xe0, 0xcd, 0xbf, 0xac, 0xa0, 0x3a,
+	0xa8, 0xaa, 0x4e, 0x1f, 0xf8, 0x73, 0xdb, 0xa3, 0x82, 0x2f, 0x37, 0xf2, 0x05, 0xeb, 0xfe, 0xcd,
+	0x41, 0x30, 0x8d, 0x63, 0x12, 0x66, 0x76, 0xa0, 0xa4, 0x21, 0xa7, 0x85, 0x16, 0x55, 0x06, 0x4d,
+	0x85, 0x64, 0x71, 0x2f, 0xa6, 0x42, 0x58, 0xc9, 0xf2, 0xb0, 0xb0, 0xc9, 0xd1, 0xcc, 0x60, 0x0d,
+	0xce, 0xd9, 0x05, 0x98, 0x1b, 0x25, 0x51, 0x3e, 0x37, 0x2b, 0x14, 0x2d, 0x71, 0xc8, 0x47, 0x93,
+	0xa2, 0x33, 0x4b, 0xb9, 0x12, 0x6e, 0xfe, 0xbc, 0x82, 0x26, 0xf3, 0x07, 0x8e, 0xb3, 0x87, 0xbb,
+	0x8a, 0xaa, 0x92, 0x5b, 0xc3, 0x54, 0x25, 0x87, 0x18, 0x52, 0x72, 0xe5, 0x88, 0xed, 0x74, 0x59,
+	0x72, 0xf0, 0xc0, 0xd0, 0x1d, 0xbc, 0x8e, 0x59, 0x2b, 0xaf, 0x10, 0x88, 0x9f, 0xe0, 0xaf, 0x6e,
+	0x6a, 0xe6, 0x84, 0xd3, 0x00, 0x43, 0x63, 0xf7, 0x81, 0xf5, 0x1a, 0x46, 0x05, 0x95, 0x3b, 0x99,
+	0xba, 0x1a, 0x52, 0xf8, 0x6d, 0x9d, 0x09, 0x0d, 0xb9, 0x1f, 0x99, 0x91, 0xcd, 0x36, 0x89, 0xe8,
+	0x01, 0x93, 0xfa, 0xa1, 0x33, 0xc8, 0xa2, 0xe8, 0xd4, 0x3e, 0xd3, 0x0a, 0x72, 0x1f, 0xda, 0xc5,
+	0x56, 0x95, 0x3b, 0x81, 0x2d, 0x9d, 0xdf, 0xea, 0x91, 0x99, 0x47, 0x77, 0x24, 0x4f, 0x3a, 0x09,
+	0xf5, 0x25, 0xd4, 0x47, 0x54, 0xc9, 0xe8, 0x8d, 0x9b, 0x6e, 0x32, 0xe1, 0xf3, 0x38, 0xa6, 0xbe,
+	0x3c, 0xe0, 0x5f, 0xf2, 0xc3, 0xc3, 0xd3, 0x73, 0x9b, 0x7e, 0x62, 0xa6, 0x0d, 0x2f, 0x48, 0x1a,
+	0x65, 0xc9, 0x0e, 0x11, 0x5b, 0x71, 0x40, 0x83, 0x73, 0xdb, 0xad, 0x9a, 0xae, 0x43, 0xc7, 0x65,
+	0xbb, 0x50, 0x8b, 0x3e, 0xaf, 0xcd, 0x4b, 0xbb, 0x00, 0x5e, 0x84, 0x36, 0x6d, 0x7a, 0x4c, 0x49,
+	0xb8, 0x16, 0x86, 0x50, 0x70, 0xd2, 0x25, 0x2d, 0xe1, 0x49, 0x16, 0x86, 0x9e, 0xe8, 0x67, 0x32,
+	0xe0, 0x27, 0x76, 0x7c, 0x77, 0x25, 0xa7, 0x1c, 0xb0, 0x30, 0xec, 0xe4, 0x04, 0xf7, 0x5f, 0xeb,
+	0xc8, 0x35, 0xcb, 0x91, 0xc1, 0xd3, 0xae, 0x72, 0x29, 0x6b, 0x61, 0xa8, 0x93, 0x42, 0xa1, 0x92,
+	0xd0, 0x03, 0x34, 0x4f, 0xc2, 0x50, 0x17, 0xfe, 0xf3, 0xa0, 0x01, 0x0e, 0x7f, 0xab, 0x75, 0x71,
+	0xdb, 0x22, 0xb9, 0x84, 0x34, 0xd5, 0xee, 0x04, 0xe6, 0x2c, 0x7c, 0x1a, 0xdb, 0xee, 0x5b, 0x43,
+	0xcd, 0x10, 0x4d, 0xaf, 0xf9, 0x3e, 0x0f, 0x89, 0xfe, 0x0e, 0x80, 0x92, 0xfc, 0x9f, 0xa1, 0x97,
+	0x9a, 0x02, 0x2e, 0x0f, 0xbd, 0x79, 0xfd, 0x6a, 0x08, 0x6e, 0xfb, 0x84, 0x0b, 0x36, 0x78, 0x7a,
+	0x1d, 0x98, 0x77, 0x81, 0x36, 0xff, 0xb9, 0x8a, 0x50, 0xa9, 0xa7, 0xf5, 0x30, 0x50, 0x19, 0xf7,
+	0x30, 0x30, 0x38, 0x9b, 0xd5, 0x91, 0xb3, 0x59, 0x7c, 0xc5, 0x54, 0x1b, 0xf9, 0x8a, 0xc9, 0xbe,
+	0x24, 0xea, 0x67, 0x5c, 0x12, 0x5f, 0x22, 0x14, 0xf3, 0x88, 0xc5, 0xfa, 0xa5, 0x78, 0x42, 0x05,
+	0x67, 0x1f, 0xbc, 0xc9, 0x3a, 0x17, 0xcb, 0xd5, 0x36, 0xda, 0x3b, 0x0f, 0xd0, 0x04, 0xb8, 0x71,
+	0xd1, 0x98, 0xbc, 0xf0, 0xd5, 0x45, 0x13, 0x21, 0x0c, 0xd1, 0x5b, 0xe4, 0xf3, 0x90, 0xeb, 0x37,
+	0xa5, 0x09, 0xfb, 0xe5, 0x47, 0x09, 0x94, 0x03, 0x16, 0x87, 0x5c, 0x5a, 0x4f, 0x49, 0x1a, 0xba,
+	0xf7, 0xbb, 0x79, 0x74, 0x7d, 0x6b, 0x10, 0x49, 0x3e, 0x13, 0x34, 0xdd, 0xcb, 0xbf, 0x3a, 0x73,
+	0x1c, 0xb4, 0xb0, 0xd1, 0xf1, 0x9e, 0xed, 0x0d, 0x3e, 0x08, 0xc3, 0x15, 0x07, 0xa3, 0x39, 0x8d,
+	0xe9, 0x4f, 0xb8, 0x70, 0xd5, 0x59, 0x44, 0xb3, 0x1a, 0x51, 0x1f, 0x69, 0xe1, 0x9a, 0x73, 0x19,
+	0xcd, 0x6b, 0x20, 0xff, 0x0c, 0x0b, 0xd7, 0x4b, 0x28, 0xff, 0xb0, 0x09, 0x4f, 0x94, 0x9d, 0xe7,
+	0xd0, 0x2a, 0x9e, 0x2c, 0x69, 0xf9, 0xf7, 0x44, 0x78, 0xaa, 0x1c, 0x4f, 0x7f, 0x15, 0x84, 0xa7,
+	0xcb, 0x86, 0xc5, 0xd5, 0x86, 0x67, 0x9c, 0x2b, 0x68, 0x31, 0xd7, 0xaa, 0xb8, 0xbc, 0x30, 0x2a,
+	0x15, 0x53, 0x1f, 0xbc, 0xe0, 0x39, 0x67, 0x01, 0x21, 0x0d, 0xc0, 0x87, 0x25, 0x78, 0xbe, 0xec,
+	0x5b, 0x7f, 0xd8, 0x81, 0x17, 0x9c, 0xeb, 0xc8, 0xd1, 0x88, 0xf9, 0x19, 0x04, 0x5e, 0x74, 0x6e,
+	0xa0, 0xc6, 0x28, 0xae, 0x3f, 0x8f, 0xc0, 0xb8, 0x1c, 0x7d, 0xf0, 0xe5, 0x02, 0xbe, 0x6c, 0xa8,
+	0x99, 0x7f, 0x8f, 0x80, 0x9d, 0x92, 0x38, 0x78, 0xd7, 0xc7, 0x57, 0xca, 0x31, 0xcd, 0x5b, 0x14,
+	0x5f, 0x2d, 0xb5, 0xd3, 0x2e, 0x06, 0x5f, 0x2b, 0x9b, 0x0f, 0x9e, 0xad, 0xf1, 0xf5, 0x72, 0x9c,
+	0xe2, 0x2d, 0x18, 0xbf, 0xe5, 0x5c, 0x43, 0x97, 0x35, 0x66, 0x3c, 0x11, 0xe3, 0x86, 0xf3, 0x1e,
+	0x5a, 0xd1, 0xf0, 0xd9, 0xaf, 0xb4, 0xf8, 0x6d, 0x53, 0x9f, 0xf2, 0x09, 0x0d, 0x37, 0xcb, 0x4e,
+	0x8d, 0x67, 0x0c, 0xfc, 0x8e, 0xf3, 0x36, 0xba, 0xa6, 0x61, 0xdd, 0x01, 0x94, 0x1b, 0xb4, 0xe8,
+	0x86, 0xf3, 0x0e, 0x7a, 0x2b, 0x5f, 0x97, 0xe1, 0x9b, 0x1e, 0xdf, 0x2c, 0xf7, 0x3a, 0x7f, 0x50,
+	0xc0, 0x4b, 0x4e, 0x13, 0x5d, 0xcf, 0x67, 0x3c, 0x54, 0xa5, 0xc7, 0xcb, 0xa5, 0x6c, 0xb8, 0x0c,
+	0x8e, 0x57, 0x4a, 0xd9, 0x70, 0x65, 0x1b, 0xdf, 0x2a, 0x77, 0x6e, 0xb4, 0xe6, 0x8c, 0xdd, 0x52,
+	0xc3, 0x11, 0x87, 0x82, 0xdf, 0x2d, 0x85, 0x23, 0x35, 0x5a, 0xfc, 0x9e, 0xa1, 0xeb, 0x50, 0x89,
+	0x14, 0xbf, 0xef, 0x34, 0xd0, 0x55, 0x2d, 0xb3, 0x7d, 0x0d, 0xbe, 0xed, 0x5c, 0x45, 0xb8, 0xb0,
+	0xa3, 0xc2, 0x9b, 0xe0, 0x6f, 0x95, 0x5b, 0x58, 0x14, 0xeb, 0xf0, 0x9d, 0x21, 0xec, 0x59, 0x12,
+	0xe0, 0xbb, 0x65, 0xbf, 0x76, 0xe9, 0x0b, 0xdf, 0x37, 0x0d, 0x2b, 0xaf, 0x5f, 0xe1, 0x96, 0x71,
+	0x7c, 0xf3, 0xb2, 0x0c, 0xfe, 0xb0, 0x54, 0xc0, 0x68, 0xfe, 0x60, 0xa8, 0x39, 0x54, 0x35, 0x30,
+	0x7c, 0x1f, 0xf7, 0x76, 0x61, 0xd5, 0x23, 0x5e, 0x0c, 0xaf, 0x3a, 0xb7, 0x91, 0x5b, 0x1a, 0xfd,
+	0x59, 0x65, 0x0c, 0xfc, 0xed, 0x72, 0xa1, 0x86, 0xf3, 0x7c, 0xfc, 0xb0, 0xd4, 0xa6, 0xcc, 0x47,
+	0xf1, 0xc7, 0xc6, 0xcd, 0x90, 0xa7, 0x9c, 0xf8, 0x93, 0xd2, 0x5a, 0xf2, 0x84, 0x0f, 0x7f, 0xa7,
+	0x6c, 0x5c, 0x26, 0x75, 0xf8, 0xd3, 0xd2, 0x1c, 0x87, 0x52, 0x36, 0xfc, 0xa8, 0xd4, 0x64, 0x38,
+	0x11, 0xc3, 0x9f, 0x95, 0x63, 0x16, 0x89, 0x16, 0xfe, 0x6e, 0xd9, 0xd5, 0x50, 0xb2, 0x84, 0xbf,
+	0x67, 0xae, 0xcd, 0xc8, 0x33, 0x3a, 0xfe, 0x7d, 0x67, 0x09, 0x35, 0xf3, 0x23, 0x34, 0x26, 0x55,
+	0xc2, 0x7f, 0x70, 0x56, 0x73, 0xf5, 0x42, 0x8e, 0xff, 0xd0, 0x38, 0xe7, 0xc5, 0xc3, 0x1f, 0xfe,
+	0xdc, 0x30, 0x88, 0xbc, 0x28, 0x80, 0xd7, 0x9c, 0xb7, 0xd0, 0x15, 0x8d, 0x59, 0x95, 0x74, 0xbc,
+	0x5e, 0x2a, 0x30, 0xae, 0x02, 0x8d, 0x37, 0x9c, 0xf7, 0xd1, 0x2d, 0xd3, 0xb4, 0xc7, 0x56, 0x93,
+	0xf1, 0x66, 0x79, 0xe4, 0x8d, 0x17, 0x69, 0xbc, 0xe5, 0x2c, 0xa3, 0x77, 0xcc, 0x23, 0x3f, 0xf4,
+	0x9a, 0x8b, 0x1f, 0x3b, 0x2b, 0xe8, 0x86, 0x26, 0x8c, 0x7f, 0x33, 0xc5, 0xdb, 0xe5, 0x55, 0x0c,
+	0xe9, 0x1a, 0xde, 0x29, 0x67, 0x62, 0xe5, 0x25, 0x78, 0xb7, 0x3c, 0xbf, 0x23, 0x27, 0x74, 0x15,
+	0x7f, 0xdf, 0x71, 0xd1, 0x52, 0x31, 0xd0, 0x78, 0x4f, 0x8b, 0xbf, 0xb8, 0xf7, 0xd3, 0x0a, 0x72,
+	0xb7, 0xce, 0x8e, 0x1c, 0xbd, 0x35, 0x3f, 0xf7, 0xbe, 0x1f, 0x1c, 0x79, 0x17, 0xf3, 0xbc, 0x4d,
+	0xda, 0x25, 0x59, 0x28, 0xf1, 0xa5, 0x37, 0x6e, 0xb1, 0xcd, 0x7f, 0x90, 0xd1, 0x8c, 0xe2, 0xca,
+	0xba, 0xfb, 0xeb, 0xaf, 0x97, 0x2a, 0xbf, 0xf9, 0x7a, 0xa9, 0xf2, 0x1f, 0x5f, 0x2f, 0x55, 0xfe,
+	0xfa, 0x9b, 0xa5, 0x4b, 0xbf, 0xf9, 0x66, 0xe9, 0xd2, 0x6f, 0xbf, 0x59, 0xba, 0xb4, 0x53, 0x79,
+	0x55, 0x8b, 0x44, 0xef, 0xcf, 0x2a, 0x97, 0xfe, 0x67, 0x00, 0xb8, 0x8f, 0xe5, 0xf6, 0x54, 0x2e,
+	0x00, 0x00,
 }
 
 func (m *CCSUsrMsg_VGUIMenu) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5037,41 +5275,49 @@ func (m *CCSUsrMsg_VGUIMenu) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_VGUIMenu) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_VGUIMenu) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x10
-	i++
+	if len(m.Subkeys) > 0 {
+		for iNdEx := len(m.Subkeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subkeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i--
 	if m.Show {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if len(m.Subkeys) > 0 {
-		for _, msg := range m.Subkeys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_VGUIMenu_Subkey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5079,25 +5325,32 @@ func (m *CCSUsrMsg_VGUIMenu_Subkey) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_VGUIMenu_Subkey) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_VGUIMenu_Subkey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Str)
+	copy(dAtA[i:], m.Str)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Str)))
-	i += copy(dAtA[i:], m.Str)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_Geiger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5105,20 +5358,25 @@ func (m *CCSUsrMsg_Geiger) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_Geiger) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_Geiger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Range))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_Train) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5126,20 +5384,25 @@ func (m *CCSUsrMsg_Train) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_Train) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_Train) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Train))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_HudText) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5147,21 +5410,27 @@ func (m *CCSUsrMsg_HudText) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_HudText) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_HudText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SayText) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5169,40 +5438,46 @@ func (m *CCSUsrMsg_SayText) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SayText) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SayText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntIdx))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	dAtA[i] = 0x18
-	i++
-	if m.Chat {
+	i--
+	if m.Textallchat {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
+	i--
 	dAtA[i] = 0x20
-	i++
-	if m.Textallchat {
+	i--
+	if m.Chat {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Text)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntIdx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SayText2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5210,55 +5485,55 @@ func (m *CCSUsrMsg_SayText2) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SayText2) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SayText2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntIdx))
-	dAtA[i] = 0x10
-	i++
-	if m.Chat {
+	i--
+	if m.Textallchat {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.MsgName)))
-	i += copy(dAtA[i:], m.MsgName)
+	i--
+	dAtA[i] = 0x28
 	if len(m.Params) > 0 {
-		for _, s := range m.Params {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Params[iNdEx])
+			copy(dAtA[i:], m.Params[iNdEx])
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Params[iNdEx])))
+			i--
 			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	dAtA[i] = 0x28
-	i++
-	if m.Textallchat {
+	i -= len(m.MsgName)
+	copy(dAtA[i:], m.MsgName)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.MsgName)))
+	i--
+	dAtA[i] = 0x1a
+	i--
+	if m.Chat {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntIdx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_TextMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5266,35 +5541,34 @@ func (m *CCSUsrMsg_TextMsg) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_TextMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_TextMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MsgDst))
 	if len(m.Params) > 0 {
-		for _, s := range m.Params {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Params[iNdEx])
+			copy(dAtA[i:], m.Params[iNdEx])
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Params[iNdEx])))
+			i--
 			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MsgDst))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_HudMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5302,73 +5576,85 @@ func (m *CCSUsrMsg_HudMsg) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_HudMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_HudMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Channel))
-	if m.Pos != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Pos.Size()))
-		n1, err1 := m.Pos.MarshalTo(dAtA[i:])
-		if err1 != nil {
-			return 0, err1
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Text)))
+	i--
+	dAtA[i] = 0x5a
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FxTime))))
+	i--
+	dAtA[i] = 0x55
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.HoldTime))))
+	i--
+	dAtA[i] = 0x4d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FadeOutTime))))
+	i--
+	dAtA[i] = 0x3d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FadeInTime))))
+	i--
+	dAtA[i] = 0x35
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Effect))
+	i--
+	dAtA[i] = 0x28
+	if m.Clr2 != nil {
+		{
+			size, err := m.Clr2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n1
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Clr1 != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Clr1.Size()))
-		n2, err2 := m.Clr1.MarshalTo(dAtA[i:])
-		if err2 != nil {
-			return 0, err2
+		{
+			size, err := m.Clr1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n2
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Clr2 != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Clr2.Size()))
-		n3, err3 := m.Clr2.MarshalTo(dAtA[i:])
-		if err3 != nil {
-			return 0, err3
+	if m.Pos != nil {
+		{
+			size, err := m.Pos.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n3
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Effect))
-	dAtA[i] = 0x35
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FadeInTime))))
-	i += 4
-	dAtA[i] = 0x3d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FadeOutTime))))
-	i += 4
-	dAtA[i] = 0x4d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.HoldTime))))
-	i += 4
-	dAtA[i] = 0x55
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FxTime))))
-	i += 4
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	return i, nil
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Channel))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_Shake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5376,32 +5662,37 @@ func (m *CCSUsrMsg_Shake) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_Shake) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_Shake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Command))
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.LocalAmplitude))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Frequency))))
-	i += 4
-	dAtA[i] = 0x25
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Duration))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x25
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Frequency))))
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.LocalAmplitude))))
+	i--
+	dAtA[i] = 0x15
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Command))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_Fade) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5409,36 +5700,43 @@ func (m *CCSUsrMsg_Fade) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_Fade) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_Fade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Duration))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.HoldTime))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Flags))
 	if m.Clr != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Clr.Size()))
-		n4, err4 := m.Clr.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
+		{
+			size, err := m.Clr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n4
+		i--
+		dAtA[i] = 0x22
 	}
-	return i, nil
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Flags))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.HoldTime))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Duration))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_Rumble) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5446,26 +5744,31 @@ func (m *CCSUsrMsg_Rumble) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_Rumble) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_Rumble) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Index))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Data))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Flags))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Data))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_CloseCaption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5473,31 +5776,36 @@ func (m *CCSUsrMsg_CloseCaption) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_CloseCaption) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_CloseCaption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Hash))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Duration))
-	dAtA[i] = 0x18
-	i++
+	i--
 	if m.FromPlayer {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Duration))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Hash))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_CloseCaptionDirect) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5505,31 +5813,36 @@ func (m *CCSUsrMsg_CloseCaptionDirect) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_CloseCaptionDirect) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_CloseCaptionDirect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Hash))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Duration))
-	dAtA[i] = 0x18
-	i++
+	i--
 	if m.FromPlayer {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Duration))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Hash))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SendAudio) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5537,21 +5850,27 @@ func (m *CCSUsrMsg_SendAudio) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SendAudio) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SendAudio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.RadioSound)
+	copy(dAtA[i:], m.RadioSound)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.RadioSound)))
-	i += copy(dAtA[i:], m.RadioSound)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_RawAudio) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5559,31 +5878,37 @@ func (m *CCSUsrMsg_RawAudio) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_RawAudio) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_RawAudio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Pitch))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Duration))))
-	i += 4
-	dAtA[i] = 0x22
-	i++
+	i -= len(m.VoiceFilename)
+	copy(dAtA[i:], m.VoiceFilename)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.VoiceFilename)))
-	i += copy(dAtA[i:], m.VoiceFilename)
-	return i, nil
+	i--
+	dAtA[i] = 0x22
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Duration))))
+	i--
+	dAtA[i] = 0x1d
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Pitch))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_VoiceMask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5591,37 +5916,44 @@ func (m *CCSUsrMsg_VoiceMask) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_VoiceMask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_VoiceMask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PlayerMasks) > 0 {
-		for _, msg := range m.PlayerMasks {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	dAtA[i] = 0x10
-	i++
+	i--
 	if m.PlayerModEnable {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	if len(m.PlayerMasks) > 0 {
+		for iNdEx := len(m.PlayerMasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerMasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_VoiceMask_PlayerMask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5629,23 +5961,28 @@ func (m *CCSUsrMsg_VoiceMask_PlayerMask) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_VoiceMask_PlayerMask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_VoiceMask_PlayerMask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.GameRulesMask))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.BanMasks))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.GameRulesMask))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_Damage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5653,33 +5990,40 @@ func (m *CCSUsrMsg_Damage) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_Damage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_Damage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Amount))
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.VictimEntindex))
+	i--
+	dAtA[i] = 0x18
 	if m.InflictorWorldPos != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.InflictorWorldPos.Size()))
-		n5, err5 := m.InflictorWorldPos.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
+		{
+			size, err := m.InflictorWorldPos.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n5
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.VictimEntindex))
-	return i, nil
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Amount))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_RadioText) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5687,42 +6031,42 @@ func (m *CCSUsrMsg_RadioText) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_RadioText) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_RadioText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MsgDst))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Client))
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.MsgName)))
-	i += copy(dAtA[i:], m.MsgName)
 	if len(m.Params) > 0 {
-		for _, s := range m.Params {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Params[iNdEx])
+			copy(dAtA[i:], m.Params[iNdEx])
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Params[iNdEx])))
+			i--
 			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	i -= len(m.MsgName)
+	copy(dAtA[i:], m.MsgName)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.MsgName)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Client))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MsgDst))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_HintText) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5730,21 +6074,27 @@ func (m *CCSUsrMsg_HintText) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_HintText) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_HintText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_KeyHintText) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5752,32 +6102,31 @@ func (m *CCSUsrMsg_KeyHintText) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_KeyHintText) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_KeyHintText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Hints) > 0 {
-		for _, s := range m.Hints {
+		for iNdEx := len(m.Hints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hints[iNdEx])
+			copy(dAtA[i:], m.Hints[iNdEx])
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Hints[iNdEx])))
+			i--
 			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ProcessSpottedEntityUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5785,37 +6134,44 @@ func (m *CCSUsrMsg_ProcessSpottedEntityUpdate) Marshal() (dAtA []byte, err error
 }
 
 func (m *CCSUsrMsg_ProcessSpottedEntityUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ProcessSpottedEntityUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	if len(m.EntityUpdates) > 0 {
+		for iNdEx := len(m.EntityUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntityUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i--
 	if m.NewUpdate {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if len(m.EntityUpdates) > 0 {
-		for _, msg := range m.EntityUpdates {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ProcessSpottedEntityUpdate_SpottedEntityUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5823,59 +6179,64 @@ func (m *CCSUsrMsg_ProcessSpottedEntityUpdate_SpottedEntityUpdate) Marshal() (dA
 }
 
 func (m *CCSUsrMsg_ProcessSpottedEntityUpdate_SpottedEntityUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ProcessSpottedEntityUpdate_SpottedEntityUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntityIdx))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ClassId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.OriginX))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.OriginY))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.OriginZ))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.AngleY))
-	dAtA[i] = 0x38
-	i++
-	if m.Defuser {
+	i--
+	if m.PlayerHasC4 {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x40
-	i++
+	i--
+	dAtA[i] = 0x48
+	i--
 	if m.PlayerHasDefuser {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x48
-	i++
-	if m.PlayerHasC4 {
+	i--
+	dAtA[i] = 0x40
+	i--
+	if m.Defuser {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.AngleY))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.OriginZ))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.OriginY))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.OriginX))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ClassId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntityIdx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SendPlayerItemDrops) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5883,29 +6244,36 @@ func (m *CCSUsrMsg_SendPlayerItemDrops) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SendPlayerItemDrops) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SendPlayerItemDrops) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.EntityUpdates) > 0 {
-		for _, msg := range m.EntityUpdates {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.EntityUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntityUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SendPlayerItemFound) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5913,30 +6281,37 @@ func (m *CCSUsrMsg_SendPlayerItemFound) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SendPlayerItemFound) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SendPlayerItemFound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entindex))
+	i--
+	dAtA[i] = 0x10
 	if m.Iteminfo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Iteminfo.Size()))
-		n6, err6 := m.Iteminfo.MarshalTo(dAtA[i:])
-		if err6 != nil {
-			return 0, err6
+		{
+			size, err := m.Iteminfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n6
+		i--
+		dAtA[i] = 0xa
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entindex))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ReloadEffect) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5944,35 +6319,40 @@ func (m *CCSUsrMsg_ReloadEffect) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ReloadEffect) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ReloadEffect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Actanim))
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginX))))
-	i += 4
-	dAtA[i] = 0x25
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginY))))
-	i += 4
-	dAtA[i] = 0x2d
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginZ))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x2d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginY))))
+	i--
+	dAtA[i] = 0x25
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginX))))
+	i--
+	dAtA[i] = 0x1d
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Actanim))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_WeaponSound) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5980,40 +6360,46 @@ func (m *CCSUsrMsg_WeaponSound) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_WeaponSound) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_WeaponSound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginX))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginY))))
-	i += 4
-	dAtA[i] = 0x25
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginZ))))
-	i += 4
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Sound)))
-	i += copy(dAtA[i:], m.Sound)
-	dAtA[i] = 0x35
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Timestamp))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x35
+	i -= len(m.Sound)
+	copy(dAtA[i:], m.Sound)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Sound)))
+	i--
+	dAtA[i] = 0x2a
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginZ))))
+	i--
+	dAtA[i] = 0x25
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginY))))
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OriginX))))
+	i--
+	dAtA[i] = 0x15
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_UpdateScreenHealthBar) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6021,31 +6407,36 @@ func (m *CCSUsrMsg_UpdateScreenHealthBar) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_UpdateScreenHealthBar) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_UpdateScreenHealthBar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.HealthratioOld))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.HealthratioNew))))
-	i += 4
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Style))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.HealthratioNew))))
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.HealthratioOld))))
+	i--
+	dAtA[i] = 0x15
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_EntityOutlineHighlight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6053,20 +6444,25 @@ func (m *CCSUsrMsg_EntityOutlineHighlight) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_EntityOutlineHighlight) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_EntityOutlineHighlight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_AdjustMoney) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6074,20 +6470,25 @@ func (m *CCSUsrMsg_AdjustMoney) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_AdjustMoney) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_AdjustMoney) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Amount))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ReportHit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6095,33 +6496,38 @@ func (m *CCSUsrMsg_ReportHit) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ReportHit) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ReportHit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xd
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PosX))))
-	i += 4
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PosY))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PosZ))))
-	i += 4
-	dAtA[i] = 0x25
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Timestamp))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x25
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PosZ))))
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PosY))))
+	i--
+	dAtA[i] = 0x15
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PosX))))
+	i--
+	dAtA[i] = 0xd
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_KillCam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6129,26 +6535,31 @@ func (m *CCSUsrMsg_KillCam) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_KillCam) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_KillCam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ObsMode))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.FirstTarget))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.SecondTarget))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.FirstTarget))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ObsMode))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_DesiredTimescale) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6156,32 +6567,37 @@ func (m *CCSUsrMsg_DesiredTimescale) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_DesiredTimescale) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_DesiredTimescale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xd
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DesiredTimescale))))
-	i += 4
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DurationRealtimeSec))))
-	i += 4
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.InterpolatorType))
-	dAtA[i] = 0x25
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.StartBlendTime))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x25
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.InterpolatorType))
+	i--
+	dAtA[i] = 0x18
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DurationRealtimeSec))))
+	i--
+	dAtA[i] = 0x15
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DesiredTimescale))))
+	i--
+	dAtA[i] = 0xd
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_CurrentTimescale) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6189,21 +6605,26 @@ func (m *CCSUsrMsg_CurrentTimescale) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_CurrentTimescale) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_CurrentTimescale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xd
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.CurTimescale))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0xd
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_AchievementEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6211,26 +6632,31 @@ func (m *CCSUsrMsg_AchievementEvent) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_AchievementEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_AchievementEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Achievement))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Count))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.UserId))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Achievement))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_MatchEndConditions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6238,29 +6664,34 @@ func (m *CCSUsrMsg_MatchEndConditions) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_MatchEndConditions) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_MatchEndConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Fraglimit))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MpMaxrounds))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MpWinlimit))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MpTimelimit))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MpWinlimit))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.MpMaxrounds))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Fraglimit))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_PlayerStatsUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6268,38 +6699,45 @@ func (m *CCSUsrMsg_PlayerStatsUpdate) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_PlayerStatsUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_PlayerStatsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Version))
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Crc))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.UserId))
+	i--
+	dAtA[i] = 0x28
 	if len(m.Stats) > 0 {
-		for _, msg := range m.Stats {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.UserId))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Crc))
-	return i, nil
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_PlayerStatsUpdate_Stat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6307,23 +6745,28 @@ func (m *CCSUsrMsg_PlayerStatsUpdate_Stat) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_PlayerStatsUpdate_Stat) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_PlayerStatsUpdate_Stat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Idx))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Delta))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Idx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_DisplayInventory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6331,28 +6774,33 @@ func (m *CCSUsrMsg_DisplayInventory) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_DisplayInventory) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_DisplayInventory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.UserId))
+	i--
+	dAtA[i] = 0x10
+	i--
 	if m.Display {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.UserId))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_QuestProgress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6360,34 +6808,39 @@ func (m *CCSUsrMsg_QuestProgress) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_QuestProgress) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_QuestProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.QuestId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.NormalPoints))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.BonusPoints))
-	dAtA[i] = 0x20
-	i++
+	i--
 	if m.IsEventQuest {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.BonusPoints))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.NormalPoints))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.QuestId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ScoreLeaderboardData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6395,27 +6848,34 @@ func (m *CCSUsrMsg_ScoreLeaderboardData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ScoreLeaderboardData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ScoreLeaderboardData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Data != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Data.Size()))
-		n7, err7 := m.Data.MarshalTo(dAtA[i:])
-		if err7 != nil {
-			return 0, err7
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n7
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_PlayerDecalDigitalSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6423,27 +6883,34 @@ func (m *CCSUsrMsg_PlayerDecalDigitalSignature) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CCSUsrMsg_PlayerDecalDigitalSignature) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_PlayerDecalDigitalSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Data != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Data.Size()))
-		n8, err8 := m.Data.MarshalTo(dAtA[i:])
-		if err8 != nil {
-			return 0, err8
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n8
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_XRankGet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6451,23 +6918,28 @@ func (m *CCSUsrMsg_XRankGet) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_XRankGet) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_XRankGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ModeIdx))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Controller))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ModeIdx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_XRankUpd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6475,26 +6947,31 @@ func (m *CCSUsrMsg_XRankUpd) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_XRankUpd) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_XRankUpd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ModeIdx))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Controller))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Ranking))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Controller))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ModeIdx))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_CallVoteFailed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6502,23 +6979,28 @@ func (m *CCSUsrMsg_CallVoteFailed) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_CallVoteFailed) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_CallVoteFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Reason))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Time))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Reason))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_VoteStart) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6526,49 +7008,57 @@ func (m *CCSUsrMsg_VoteStart) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_VoteStart) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_VoteStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Team))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntIdx))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.VoteType))
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.DispStr)))
-	i += copy(dAtA[i:], m.DispStr)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.DetailsStr)))
-	i += copy(dAtA[i:], m.DetailsStr)
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.OtherTeamStr)))
-	i += copy(dAtA[i:], m.OtherTeamStr)
-	dAtA[i] = 0x38
-	i++
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntidxTarget))
+	i--
+	dAtA[i] = 0x40
+	i--
 	if m.IsYesNoVote {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntidxTarget))
-	return i, nil
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.OtherTeamStr)
+	copy(dAtA[i:], m.OtherTeamStr)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.OtherTeamStr)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.DetailsStr)
+	copy(dAtA[i:], m.DetailsStr)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.DetailsStr)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.DispStr)
+	copy(dAtA[i:], m.DispStr)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.DispStr)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.VoteType))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.EntIdx))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Team))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_VotePass) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6576,31 +7066,38 @@ func (m *CCSUsrMsg_VotePass) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_VotePass) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_VotePass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Team))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.VoteType))
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.DispStr)))
-	i += copy(dAtA[i:], m.DispStr)
-	dAtA[i] = 0x22
-	i++
+	i -= len(m.DetailsStr)
+	copy(dAtA[i:], m.DetailsStr)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.DetailsStr)))
-	i += copy(dAtA[i:], m.DetailsStr)
-	return i, nil
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.DispStr)
+	copy(dAtA[i:], m.DispStr)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.DispStr)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.VoteType))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Team))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_VoteFailed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6608,23 +7105,28 @@ func (m *CCSUsrMsg_VoteFailed) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_VoteFailed) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_VoteFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Team))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Reason))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Team))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_VoteSetup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6632,32 +7134,31 @@ func (m *CCSUsrMsg_VoteSetup) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_VoteSetup) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_VoteSetup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.PotentialIssues) > 0 {
-		for _, s := range m.PotentialIssues {
+		for iNdEx := len(m.PotentialIssues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PotentialIssues[iNdEx])
+			copy(dAtA[i:], m.PotentialIssues[iNdEx])
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.PotentialIssues[iNdEx])))
+			i--
 			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SendLastKillerDamageToClient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6665,29 +7166,34 @@ func (m *CCSUsrMsg_SendLastKillerDamageToClient) Marshal() (dAtA []byte, err err
 }
 
 func (m *CCSUsrMsg_SendLastKillerDamageToClient) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SendLastKillerDamageToClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.NumHitsGiven))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.DamageGiven))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.NumHitsTaken))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.DamageTaken))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.NumHitsTaken))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.DamageGiven))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.NumHitsGiven))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ServerRankUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6695,29 +7201,36 @@ func (m *CCSUsrMsg_ServerRankUpdate) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ServerRankUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ServerRankUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.RankUpdate) > 0 {
-		for _, msg := range m.RankUpdate {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.RankUpdate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RankUpdate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6725,36 +7238,41 @@ func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.RankOld))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.RankNew))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.NumWins))
-	dAtA[i] = 0x2d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RankChange))))
-	i += 4
-	dAtA[i] = 0x30
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.RankTypeId))
-	return i, nil
+	i--
+	dAtA[i] = 0x30
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RankChange))))
+	i--
+	dAtA[i] = 0x2d
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.NumWins))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.RankNew))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.RankOld))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_XpUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6762,27 +7280,34 @@ func (m *CCSUsrMsg_XpUpdate) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_XpUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_XpUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Data != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Data.Size()))
-		n9, err9 := m.Data.MarshalTo(dAtA[i:])
-		if err9 != nil {
-			return 0, err9
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 		}
-		i += n9
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ItemPickup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6790,21 +7315,27 @@ func (m *CCSUsrMsg_ItemPickup) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ItemPickup) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ItemPickup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Item)
+	copy(dAtA[i:], m.Item)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Item)))
-	i += copy(dAtA[i:], m.Item)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ShowMenu) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6812,27 +7343,33 @@ func (m *CCSUsrMsg_ShowMenu) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ShowMenu) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ShowMenu) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.BitsValidSlots))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.DisplayTime))
-	dAtA[i] = 0x1a
-	i++
+	i -= len(m.MenuString)
+	copy(dAtA[i:], m.MenuString)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.MenuString)))
-	i += copy(dAtA[i:], m.MenuString)
-	return i, nil
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.DisplayTime))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.BitsValidSlots))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_BarTime) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6840,21 +7377,27 @@ func (m *CCSUsrMsg_BarTime) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_BarTime) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_BarTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Time)
+	copy(dAtA[i:], m.Time)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Time)))
-	i += copy(dAtA[i:], m.Time)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_AmmoDenied) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6862,20 +7405,25 @@ func (m *CCSUsrMsg_AmmoDenied) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_AmmoDenied) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_AmmoDenied) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.AmmoIdx))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_MarkAchievement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6883,21 +7431,27 @@ func (m *CCSUsrMsg_MarkAchievement) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_MarkAchievement) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_MarkAchievement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Achievement)
+	copy(dAtA[i:], m.Achievement)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Achievement)))
-	i += copy(dAtA[i:], m.Achievement)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_MatchStatsUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6905,21 +7459,27 @@ func (m *CCSUsrMsg_MatchStatsUpdate) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_MatchStatsUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_MatchStatsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Update)
+	copy(dAtA[i:], m.Update)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Update)))
-	i += copy(dAtA[i:], m.Update)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ItemDrop) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6927,28 +7487,33 @@ func (m *CCSUsrMsg_ItemDrop) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ItemDrop) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ItemDrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Itemid))
-	dAtA[i] = 0x10
-	i++
+	i--
 	if m.Death {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Itemid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_GlowPropTurnOff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6956,20 +7521,25 @@ func (m *CCSUsrMsg_GlowPropTurnOff) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_GlowPropTurnOff) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_GlowPropTurnOff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entidx))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_RoundBackupFilenames) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6977,31 +7547,38 @@ func (m *CCSUsrMsg_RoundBackupFilenames) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_RoundBackupFilenames) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_RoundBackupFilenames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Count))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Index))
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Filename)))
-	i += copy(dAtA[i:], m.Filename)
-	dAtA[i] = 0x22
-	i++
+	i -= len(m.Nicename)
+	copy(dAtA[i:], m.Nicename)
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Nicename)))
-	i += copy(dAtA[i:], m.Nicename)
-	return i, nil
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Filename)
+	copy(dAtA[i:], m.Filename)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Filename)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SSUI) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7009,33 +7586,38 @@ func (m *CCSUsrMsg_SSUI) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SSUI) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SSUI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EndTime))))
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.StartTime))))
+	i--
+	dAtA[i] = 0x15
+	i--
 	if m.Show {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.StartTime))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EndTime))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SurvivalStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7043,59 +7625,70 @@ func (m *CCSUsrMsg_SurvivalStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SurvivalStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SurvivalStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Xuid))
-	if len(m.Facts) > 0 {
-		for _, msg := range m.Facts {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Damages) > 0 {
+		for iNdEx := len(m.Damages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Damages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Ticknumber))
+	i--
+	dAtA[i] = 0x20
 	if len(m.Users) > 0 {
-		for _, msg := range m.Users {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Ticknumber))
-	if len(m.Damages) > 0 {
-		for _, msg := range m.Damages {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Facts) > 0 {
+		for iNdEx := len(m.Facts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Facts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return i, nil
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Xuid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SurvivalStats_Fact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7103,30 +7696,35 @@ func (m *CCSUsrMsg_SurvivalStats_Fact) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SurvivalStats_Fact) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SurvivalStats_Fact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Type))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Display))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Value))
-	dAtA[i] = 0x25
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Interestingness))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x25
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Value))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Display))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SurvivalStats_Placement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7134,26 +7732,31 @@ func (m *CCSUsrMsg_SurvivalStats_Placement) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SurvivalStats_Placement) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SurvivalStats_Placement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Xuid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Teamnumber))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Placement))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Teamnumber))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Xuid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_SurvivalStats_Damage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7161,32 +7764,37 @@ func (m *CCSUsrMsg_SurvivalStats_Damage) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_SurvivalStats_Damage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_SurvivalStats_Damage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Xuid))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.To))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ToHits))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.From))
-	dAtA[i] = 0x28
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.FromHits))
-	return i, nil
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.From))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.ToHits))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.To))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Xuid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ResetHud) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7194,25 +7802,30 @@ func (m *CCSUsrMsg_ResetHud) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ResetHud) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ResetHud) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	i--
 	if m.Reset_ {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_GameTitle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7220,20 +7833,25 @@ func (m *CCSUsrMsg_GameTitle) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_GameTitle) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_GameTitle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Dummy))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_RequestState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7241,20 +7859,25 @@ func (m *CCSUsrMsg_RequestState) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_RequestState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_RequestState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Dummy))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_StopSpectatorMode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7262,20 +7885,25 @@ func (m *CCSUsrMsg_StopSpectatorMode) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_StopSpectatorMode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_StopSpectatorMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Dummy))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_DisconnectToLobby) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7283,20 +7911,25 @@ func (m *CCSUsrMsg_DisconnectToLobby) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_DisconnectToLobby) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_DisconnectToLobby) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Dummy))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_WarmupHasEnded) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7304,20 +7937,25 @@ func (m *CCSUsrMsg_WarmupHasEnded) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_WarmupHasEnded) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_WarmupHasEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Dummy))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ClientInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7325,20 +7963,25 @@ func (m *CCSUsrMsg_ClientInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ClientInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ClientInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Dummy))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCSUsrMsg_ServerRankRevealAll) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7346,24 +7989,178 @@ func (m *CCSUsrMsg_ServerRankRevealAll) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCSUsrMsg_ServerRankRevealAll) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_ServerRankRevealAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.SecondsTillShutdown))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Scene))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Allplayerdata) > 0 {
+		for iNdEx := len(m.Allplayerdata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allplayerdata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Position))
+	i--
+	dAtA[i] = 0x18
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Value))))
+	i--
+	dAtA[i] = 0x15
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Eaccolade))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Isbot {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Playercolor))
+	i--
+	dAtA[i] = 0x38
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Nomination != nil {
+		{
+			size, err := m.Nomination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Teamnumber))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Xuid))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintCstrike15Usermessages(dAtA, i, uint64(m.Entindex))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintCstrike15Usermessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCstrike15Usermessages(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *CCSUsrMsg_VGUIMenu) Size() (n int) {
 	if m == nil {
@@ -8375,6 +9172,60 @@ func (m *CCSUsrMsg_ServerRankRevealAll) Size() (n int) {
 	return n
 }
 
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allplayerdata) > 0 {
+		for _, e := range m.Allplayerdata {
+			l = e.Size()
+			n += 1 + l + sovCstrike15Usermessages(uint64(l))
+		}
+	}
+	n += 1 + sovCstrike15Usermessages(uint64(m.Scene))
+	return n
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovCstrike15Usermessages(uint64(m.Eaccolade))
+	n += 5
+	n += 1 + sovCstrike15Usermessages(uint64(m.Position))
+	return n
+}
+
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovCstrike15Usermessages(uint64(m.Entindex))
+	n += 1 + sovCstrike15Usermessages(uint64(m.Xuid))
+	l = len(m.Name)
+	n += 1 + l + sovCstrike15Usermessages(uint64(l))
+	n += 1 + sovCstrike15Usermessages(uint64(m.Teamnumber))
+	if m.Nomination != nil {
+		l = m.Nomination.Size()
+		n += 1 + l + sovCstrike15Usermessages(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCstrike15Usermessages(uint64(l))
+		}
+	}
+	n += 1 + sovCstrike15Usermessages(uint64(m.Playercolor))
+	n += 2
+	return n
+}
+
 func sovCstrike15Usermessages(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -13578,7 +14429,570 @@ func (m *CCSUsrMsg_CallVoteFailed) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.Time = 0
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCstrike15Usermessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CCSUsrMsg_VoteStart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CCSUsrMsg_VoteStart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			}
+			m.Team = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Team |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntIdx", wireType)
+			}
+			m.EntIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DispStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetailsStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DetailsStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherTeamStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherTeamStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsYesNoVote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsYesNoVote = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntidxTarget", wireType)
+			}
+			m.EntidxTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntidxTarget |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CCSUsrMsg_VotePass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCstrike15Usermessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CCSUsrMsg_VotePass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CCSUsrMsg_VotePass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			}
+			m.Team = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Team |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DispStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetailsStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DetailsStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CCSUsrMsg_VoteFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCstrike15Usermessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CCSUsrMsg_VoteFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CCSUsrMsg_VoteFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			}
+			m.Team = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Team |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CCSUsrMsg_VoteSetup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCstrike15Usermessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CCSUsrMsg_VoteSetup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CCSUsrMsg_VoteSetup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PotentialIssues", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13588,11 +15002,24 @@ func (m *CCSUsrMsg_CallVoteFailed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PotentialIssues = append(m.PotentialIssues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -13617,7 +15044,7 @@ func (m *CCSUsrMsg_CallVoteFailed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_SendLastKillerDamageToClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13640,17 +15067,17 @@ func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_VoteStart: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_SendLastKillerDamageToClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_VoteStart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_SendLastKillerDamageToClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumHitsGiven", wireType)
 			}
-			m.Team = 0
+			m.NumHitsGiven = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13660,16 +15087,16 @@ func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Team |= int32(b&0x7F) << shift
+				m.NumHitsGiven |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntIdx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DamageGiven", wireType)
 			}
-			m.EntIdx = 0
+			m.DamageGiven = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13679,16 +15106,16 @@ func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EntIdx |= int32(b&0x7F) << shift
+				m.DamageGiven |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumHitsTaken", wireType)
 			}
-			m.VoteType = 0
+			m.NumHitsTaken = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13698,16 +15125,16 @@ func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VoteType |= int32(b&0x7F) << shift
+				m.NumHitsTaken |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DispStr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DamageTaken", wireType)
 			}
-			var stringLen uint64
+			m.DamageTaken = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13717,61 +15144,69 @@ func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DamageTaken |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DispStr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DetailsStr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CCSUsrMsg_ServerRankUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCstrike15Usermessages
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DetailsStr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CCSUsrMsg_ServerRankUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CCSUsrMsg_ServerRankUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherTeamStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RankUpdate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13781,63 +15216,26 @@ func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OtherTeamStr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsYesNoVote", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsYesNoVote = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntidxTarget", wireType)
-			}
-			m.EntidxTarget = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EntidxTarget |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.RankUpdate = append(m.RankUpdate, &CCSUsrMsg_ServerRankUpdate_RankUpdate{})
+			if err := m.RankUpdate[len(m.RankUpdate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -13862,7 +15260,7 @@ func (m *CCSUsrMsg_VoteStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_VotePass) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13885,17 +15283,17 @@ func (m *CCSUsrMsg_VotePass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_VotePass: wiretype end group for non-group")
+			return fmt.Errorf("proto: RankUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_VotePass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RankUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
 			}
-			m.Team = 0
+			m.AccountId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13905,16 +15303,16 @@ func (m *CCSUsrMsg_VotePass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Team |= int32(b&0x7F) << shift
+				m.AccountId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RankOld", wireType)
 			}
-			m.VoteType = 0
+			m.RankOld = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13924,16 +15322,16 @@ func (m *CCSUsrMsg_VotePass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VoteType |= int32(b&0x7F) << shift
+				m.RankOld |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DispStr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RankNew", wireType)
 			}
-			var stringLen uint64
+			m.RankNew = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13943,29 +15341,46 @@ func (m *CCSUsrMsg_VotePass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RankNew |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWins", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
+			m.NumWins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWins |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RankChange", wireType)
 			}
-			m.DispStr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DetailsStr", wireType)
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RankChange = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RankTypeId", wireType)
+			}
+			m.RankTypeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -13975,24 +15390,11 @@ func (m *CCSUsrMsg_VotePass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RankTypeId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DetailsStr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -14017,7 +15419,7 @@ func (m *CCSUsrMsg_VotePass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_VoteFailed) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_XpUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14040,17 +15442,17 @@ func (m *CCSUsrMsg_VoteFailed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_VoteFailed: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_XpUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_VoteFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_XpUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Team = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14060,30 +15462,28 @@ func (m *CCSUsrMsg_VoteFailed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Team |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
 			}
-			m.Reason = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Reason |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.Data == nil {
+				m.Data = &CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -14108,7 +15508,7 @@ func (m *CCSUsrMsg_VoteFailed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_VoteSetup) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_ItemPickup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14131,15 +15531,15 @@ func (m *CCSUsrMsg_VoteSetup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_VoteSetup: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_ItemPickup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_VoteSetup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_ItemPickup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PotentialIssues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14167,7 +15567,7 @@ func (m *CCSUsrMsg_VoteSetup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PotentialIssues = append(m.PotentialIssues, string(dAtA[iNdEx:postIndex]))
+			m.Item = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14193,7 +15593,7 @@ func (m *CCSUsrMsg_VoteSetup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_SendLastKillerDamageToClient) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_ShowMenu) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14216,17 +15616,17 @@ func (m *CCSUsrMsg_SendLastKillerDamageToClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_SendLastKillerDamageToClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_ShowMenu: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_SendLastKillerDamageToClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_ShowMenu: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumHitsGiven", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BitsValidSlots", wireType)
 			}
-			m.NumHitsGiven = 0
+			m.BitsValidSlots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14236,16 +15636,16 @@ func (m *CCSUsrMsg_SendLastKillerDamageToClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumHitsGiven |= int32(b&0x7F) << shift
+				m.BitsValidSlots |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DamageGiven", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayTime", wireType)
 			}
-			m.DamageGiven = 0
+			m.DisplayTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14255,107 +15655,16 @@ func (m *CCSUsrMsg_SendLastKillerDamageToClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DamageGiven |= int32(b&0x7F) << shift
+				m.DisplayTime |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumHitsTaken", wireType)
-			}
-			m.NumHitsTaken = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumHitsTaken |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DamageTaken", wireType)
-			}
-			m.DamageTaken = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DamageTaken |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CCSUsrMsg_ServerRankUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCstrike15Usermessages
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_ServerRankUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_ServerRankUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RankUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MenuString", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14365,25 +15674,23 @@ func (m *CCSUsrMsg_ServerRankUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RankUpdate = append(m.RankUpdate, &CCSUsrMsg_ServerRankUpdate_RankUpdate{})
-			if err := m.RankUpdate[len(m.RankUpdate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MenuString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14409,7 +15716,7 @@ func (m *CCSUsrMsg_ServerRankUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_BarTime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14432,55 +15739,17 @@ func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RankUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_BarTime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RankUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_BarTime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
-			}
-			m.AccountId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccountId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RankOld", wireType)
-			}
-			m.RankOld = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RankOld |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RankNew", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.RankNew = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14490,46 +15759,82 @@ func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RankNew |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumWins", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
 			}
-			m.NumWins = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumWins |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
 			}
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RankChange", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.RankChange = float32(math.Float32frombits(v))
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CCSUsrMsg_AmmoDenied) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCstrike15Usermessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CCSUsrMsg_AmmoDenied: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CCSUsrMsg_AmmoDenied: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RankTypeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmoIdx", wireType)
 			}
-			m.RankTypeId = 0
+			m.AmmoIdx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14539,7 +15844,7 @@ func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RankTypeId |= int32(b&0x7F) << shift
+				m.AmmoIdx |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14568,7 +15873,7 @@ func (m *CCSUsrMsg_ServerRankUpdate_RankUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_XpUpdate) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_MarkAchievement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14591,17 +15896,17 @@ func (m *CCSUsrMsg_XpUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_XpUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_MarkAchievement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_XpUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_MarkAchievement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Achievement", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14611,27 +15916,23 @@ func (m *CCSUsrMsg_XpUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Achievement = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14657,7 +15958,7 @@ func (m *CCSUsrMsg_XpUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_ItemPickup) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_MatchStatsUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14680,15 +15981,15 @@ func (m *CCSUsrMsg_ItemPickup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_ItemPickup: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_MatchStatsUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_ItemPickup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_MatchStatsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14716,7 +16017,7 @@ func (m *CCSUsrMsg_ItemPickup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Item = string(dAtA[iNdEx:postIndex])
+			m.Update = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14742,7 +16043,7 @@ func (m *CCSUsrMsg_ItemPickup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_ShowMenu) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_ItemDrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14765,17 +16066,17 @@ func (m *CCSUsrMsg_ShowMenu) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_ShowMenu: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_ItemDrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_ShowMenu: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_ItemDrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitsValidSlots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Itemid", wireType)
 			}
-			m.BitsValidSlots = 0
+			m.Itemid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14785,35 +16086,16 @@ func (m *CCSUsrMsg_ShowMenu) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BitsValidSlots |= int32(b&0x7F) << shift
+				m.Itemid |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayTime", wireType)
-			}
-			m.DisplayTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DisplayTime |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MenuString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Death", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14823,24 +16105,12 @@ func (m *CCSUsrMsg_ShowMenu) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MenuString = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Death = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -14865,7 +16135,7 @@ func (m *CCSUsrMsg_ShowMenu) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_BarTime) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_GlowPropTurnOff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14888,17 +16158,17 @@ func (m *CCSUsrMsg_BarTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_BarTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_GlowPropTurnOff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_BarTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_GlowPropTurnOff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entidx", wireType)
 			}
-			var stringLen uint64
+			m.Entidx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14908,24 +16178,11 @@ func (m *CCSUsrMsg_BarTime) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Entidx |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Time = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -14950,7 +16207,7 @@ func (m *CCSUsrMsg_BarTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_AmmoDenied) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_RoundBackupFilenames) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14973,17 +16230,55 @@ func (m *CCSUsrMsg_AmmoDenied) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_AmmoDenied: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_RoundBackupFilenames: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_AmmoDenied: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_RoundBackupFilenames: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmoIdx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.AmmoIdx = 0
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -14993,67 +16288,27 @@ func (m *CCSUsrMsg_AmmoDenied) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AmmoIdx |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CCSUsrMsg_MarkAchievement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCstrike15Usermessages
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_MarkAchievement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_MarkAchievement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Achievement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nicename", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15081,7 +16336,7 @@ func (m *CCSUsrMsg_MarkAchievement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Achievement = string(dAtA[iNdEx:postIndex])
+			m.Nicename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15107,7 +16362,7 @@ func (m *CCSUsrMsg_MarkAchievement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_MatchStatsUpdate) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_SSUI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15130,17 +16385,17 @@ func (m *CCSUsrMsg_MatchStatsUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_MatchStatsUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_SSUI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_MatchStatsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_SSUI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Show", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15150,24 +16405,34 @@ func (m *CCSUsrMsg_MatchStatsUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
+			m.Show = bool(v != 0)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.StartTime = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Update = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EndTime = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -15192,7 +16457,7 @@ func (m *CCSUsrMsg_MatchStatsUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_ItemDrop) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15215,17 +16480,17 @@ func (m *CCSUsrMsg_ItemDrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_ItemDrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_SurvivalStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_ItemDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_SurvivalStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Itemid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xuid", wireType)
 			}
-			m.Itemid = 0
+			m.Xuid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15235,16 +16500,16 @@ func (m *CCSUsrMsg_ItemDrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Itemid |= int64(b&0x7F) << shift
+				m.Xuid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Death", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facts", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15254,70 +16519,65 @@ func (m *CCSUsrMsg_ItemDrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Death = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CCSUsrMsg_GlowPropTurnOff) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCstrike15Usermessages
+			m.Facts = append(m.Facts, &CCSUsrMsg_SurvivalStats_Fact{})
+			if err := m.Facts[len(m.Facts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Users = append(m.Users, &CCSUsrMsg_SurvivalStats_Placement{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_GlowPropTurnOff: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_GlowPropTurnOff: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entidx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticknumber", wireType)
 			}
-			m.Entidx = 0
+			m.Ticknumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15327,11 +16587,45 @@ func (m *CCSUsrMsg_GlowPropTurnOff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Entidx |= int32(b&0x7F) << shift
+				m.Ticknumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Damages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Damages = append(m.Damages, &CCSUsrMsg_SurvivalStats_Damage{})
+			if err := m.Damages[len(m.Damages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -15356,7 +16650,7 @@ func (m *CCSUsrMsg_GlowPropTurnOff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_RoundBackupFilenames) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_SurvivalStats_Fact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15379,17 +16673,17 @@ func (m *CCSUsrMsg_RoundBackupFilenames) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_RoundBackupFilenames: wiretype end group for non-group")
+			return fmt.Errorf("proto: Fact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_RoundBackupFilenames: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Fact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Count = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15399,16 +16693,16 @@ func (m *CCSUsrMsg_RoundBackupFilenames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				m.Type |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
 			}
-			m.Index = 0
+			m.Display = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15418,16 +16712,16 @@ func (m *CCSUsrMsg_RoundBackupFilenames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				m.Display |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15437,56 +16731,22 @@ func (m *CCSUsrMsg_RoundBackupFilenames) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Value |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nicename", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interestingness", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nicename = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Interestingness = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -15511,7 +16771,7 @@ func (m *CCSUsrMsg_RoundBackupFilenames) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_SSUI) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_SurvivalStats_Placement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15534,17 +16794,17 @@ func (m *CCSUsrMsg_SSUI) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_SSUI: wiretype end group for non-group")
+			return fmt.Errorf("proto: Placement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_SSUI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Placement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Show", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xuid", wireType)
 			}
-			var v int
+			m.Xuid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15554,34 +16814,49 @@ func (m *CCSUsrMsg_SSUI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Xuid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Show = bool(v != 0)
 		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teamnumber", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Teamnumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Teamnumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.StartTime = float32(math.Float32frombits(v))
 		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Placement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Placement |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EndTime = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -15606,7 +16881,7 @@ func (m *CCSUsrMsg_SSUI) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_SurvivalStats_Damage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15629,10 +16904,10 @@ func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_SurvivalStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Damage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_SurvivalStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Damage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15655,10 +16930,10 @@ func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			m.To = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15668,31 +16943,16 @@ func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.To |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Facts = append(m.Facts, &CCSUsrMsg_SurvivalStats_Fact{})
-			if err := m.Facts[len(m.Facts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHits", wireType)
 			}
-			var msglen int
+			m.ToHits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15702,31 +16962,16 @@ func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ToHits |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Users = append(m.Users, &CCSUsrMsg_SurvivalStats_Placement{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticknumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			m.Ticknumber = 0
+			m.From = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15736,16 +16981,16 @@ func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ticknumber |= int32(b&0x7F) << shift
+				m.From |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Damages", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHits", wireType)
 			}
-			var msglen int
+			m.FromHits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15755,26 +17000,11 @@ func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FromHits |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCstrike15Usermessages
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Damages = append(m.Damages, &CCSUsrMsg_SurvivalStats_Damage{})
-			if err := m.Damages[len(m.Damages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -15799,7 +17029,7 @@ func (m *CCSUsrMsg_SurvivalStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_SurvivalStats_Fact) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_ResetHud) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15822,55 +17052,17 @@ func (m *CCSUsrMsg_SurvivalStats_Fact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_ResetHud: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
-			}
-			m.Display = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Display |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CCSUsrMsg_ResetHud: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reset_", wireType)
 			}
-			m.Value = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -15880,22 +17072,12 @@ func (m *CCSUsrMsg_SurvivalStats_Fact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interestingness", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Interestingness = float32(math.Float32frombits(v))
+			m.Reset_ = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -15920,7 +17102,7 @@ func (m *CCSUsrMsg_SurvivalStats_Fact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_SurvivalStats_Placement) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_GameTitle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15943,55 +17125,17 @@ func (m *CCSUsrMsg_SurvivalStats_Placement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Placement: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_GameTitle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Placement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_GameTitle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xuid", wireType)
-			}
-			m.Xuid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Xuid |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Teamnumber", wireType)
-			}
-			m.Teamnumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Teamnumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dummy", wireType)
 			}
-			m.Placement = 0
+			m.Dummy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16001,7 +17145,7 @@ func (m *CCSUsrMsg_SurvivalStats_Placement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Placement |= int32(b&0x7F) << shift
+				m.Dummy |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16030,7 +17174,7 @@ func (m *CCSUsrMsg_SurvivalStats_Placement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_SurvivalStats_Damage) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_RequestState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16053,17 +17197,17 @@ func (m *CCSUsrMsg_SurvivalStats_Damage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Damage: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_RequestState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Damage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_RequestState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dummy", wireType)
 			}
-			m.Xuid = 0
+			m.Dummy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16073,73 +17217,69 @@ func (m *CCSUsrMsg_SurvivalStats_Damage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Xuid |= uint64(b&0x7F) << shift
+				m.Dummy |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.To = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.To |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToHits", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
 			}
-			m.ToHits = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToHits |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CCSUsrMsg_StopSpectatorMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCstrike15Usermessages
 			}
-			m.From = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCstrike15Usermessages
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.From |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CCSUsrMsg_StopSpectatorMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CCSUsrMsg_StopSpectatorMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromHits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dummy", wireType)
 			}
-			m.FromHits = 0
+			m.Dummy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16149,7 +17289,7 @@ func (m *CCSUsrMsg_SurvivalStats_Damage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromHits |= int32(b&0x7F) << shift
+				m.Dummy |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16178,7 +17318,7 @@ func (m *CCSUsrMsg_SurvivalStats_Damage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_ResetHud) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_DisconnectToLobby) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16201,17 +17341,17 @@ func (m *CCSUsrMsg_ResetHud) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_ResetHud: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_DisconnectToLobby: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_ResetHud: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_DisconnectToLobby: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reset_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dummy", wireType)
 			}
-			var v int
+			m.Dummy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16221,12 +17361,11 @@ func (m *CCSUsrMsg_ResetHud) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Dummy |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reset_ = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -16251,7 +17390,7 @@ func (m *CCSUsrMsg_ResetHud) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_GameTitle) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_WarmupHasEnded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16274,10 +17413,10 @@ func (m *CCSUsrMsg_GameTitle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_GameTitle: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_WarmupHasEnded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_GameTitle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_WarmupHasEnded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16323,7 +17462,7 @@ func (m *CCSUsrMsg_GameTitle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_RequestState) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_ClientInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16346,10 +17485,10 @@ func (m *CCSUsrMsg_RequestState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_RequestState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_ClientInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_RequestState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16395,7 +17534,7 @@ func (m *CCSUsrMsg_RequestState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_StopSpectatorMode) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_ServerRankRevealAll) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16418,17 +17557,17 @@ func (m *CCSUsrMsg_StopSpectatorMode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_StopSpectatorMode: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_ServerRankRevealAll: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_StopSpectatorMode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_ServerRankRevealAll: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dummy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsTillShutdown", wireType)
 			}
-			m.Dummy = 0
+			m.SecondsTillShutdown = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16438,7 +17577,7 @@ func (m *CCSUsrMsg_StopSpectatorMode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Dummy |= int32(b&0x7F) << shift
+				m.SecondsTillShutdown |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16467,7 +17606,7 @@ func (m *CCSUsrMsg_StopSpectatorMode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_DisconnectToLobby) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16490,17 +17629,51 @@ func (m *CCSUsrMsg_DisconnectToLobby) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_DisconnectToLobby: wiretype end group for non-group")
+			return fmt.Errorf("proto: CCSUsrMsg_EndOfMatchAllPlayersData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_DisconnectToLobby: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CCSUsrMsg_EndOfMatchAllPlayersData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allplayerdata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allplayerdata = append(m.Allplayerdata, &CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData{})
+			if err := m.Allplayerdata[len(m.Allplayerdata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dummy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scene", wireType)
 			}
-			m.Dummy = 0
+			m.Scene = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16510,7 +17683,7 @@ func (m *CCSUsrMsg_DisconnectToLobby) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Dummy |= int32(b&0x7F) << shift
+				m.Scene |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16539,7 +17712,7 @@ func (m *CCSUsrMsg_DisconnectToLobby) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_WarmupHasEnded) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_Accolade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16562,17 +17735,17 @@ func (m *CCSUsrMsg_WarmupHasEnded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_WarmupHasEnded: wiretype end group for non-group")
+			return fmt.Errorf("proto: Accolade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_WarmupHasEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Accolade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dummy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eaccolade", wireType)
 			}
-			m.Dummy = 0
+			m.Eaccolade = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16582,7 +17755,37 @@ func (m *CCSUsrMsg_WarmupHasEnded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Dummy |= int32(b&0x7F) << shift
+				m.Eaccolade |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Value = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16611,7 +17814,7 @@ func (m *CCSUsrMsg_WarmupHasEnded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CCSUsrMsg_ClientInfo) Unmarshal(dAtA []byte) error {
+func (m *CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16634,17 +17837,17 @@ func (m *CCSUsrMsg_ClientInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_ClientInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlayerData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlayerData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dummy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entindex", wireType)
 			}
-			m.Dummy = 0
+			m.Entindex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16654,69 +17857,156 @@ func (m *CCSUsrMsg_ClientInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Dummy |= int32(b&0x7F) << shift
+				m.Entindex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xuid", wireType)
 			}
-			if skippy < 0 {
+			m.Xuid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Xuid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthCstrike15Usermessages
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CCSUsrMsg_ServerRankRevealAll) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCstrike15Usermessages
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teamnumber", wireType)
 			}
-			if iNdEx >= l {
+			m.Teamnumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Teamnumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nomination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Nomination == nil {
+				m.Nomination = &CCSUsrMsg_EndOfMatchAllPlayersData_Accolade{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CCSUsrMsg_ServerRankRevealAll: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CCSUsrMsg_ServerRankRevealAll: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Nomination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCstrike15Usermessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &CEconItemPreviewDataBlock{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsTillShutdown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Playercolor", wireType)
 			}
-			m.SecondsTillShutdown = 0
+			m.Playercolor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCstrike15Usermessages
@@ -16726,11 +18016,31 @@ func (m *CCSUsrMsg_ServerRankRevealAll) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SecondsTillShutdown |= int32(b&0x7F) << shift
+				m.Playercolor |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isbot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCstrike15Usermessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Isbot = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCstrike15Usermessages(dAtA[iNdEx:])
@@ -16758,6 +18068,7 @@ func (m *CCSUsrMsg_ServerRankRevealAll) Unmarshal(dAtA []byte) error {
 func skipCstrike15Usermessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -16789,10 +18100,8 @@ func skipCstrike15Usermessages(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -16813,55 +18122,30 @@ func skipCstrike15Usermessages(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthCstrike15Usermessages
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthCstrike15Usermessages
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowCstrike15Usermessages
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipCstrike15Usermessages(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthCstrike15Usermessages
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCstrike15Usermessages
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCstrike15Usermessages
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthCstrike15Usermessages = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowCstrike15Usermessages   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthCstrike15Usermessages        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCstrike15Usermessages          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCstrike15Usermessages = fmt.Errorf("proto: unexpected end of group")
 )
@@ -22,7 +22,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type CEngineGotvSyncPacket struct {
 	MatchId          uint64  `protobuf:"varint,1,opt,name=match_id,json=matchId" json:"match_id"`
@@ -50,7 +50,7 @@ func (m *CEngineGotvSyncPacket) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CEngineGotvSyncPacket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -139,7 +139,7 @@ func init() {
 func init() { proto.RegisterFile("engine_gcmessages.proto", fileDescriptor_32a42573523bc825) }
 
 var fileDescriptor_32a42573523bc825 = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
+	// 309 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8c, 0xcf, 0x4a, 0x33, 0x31,
 	0x14, 0x47, 0x27, 0xed, 0x7c, 0x6d, 0xbf, 0x2b, 0xfe, 0x0b, 0x88, 0x41, 0x24, 0x1d, 0x04, 0xa1,
 	0x0b, 0x69, 0xf1, 0x15, 0x2a, 0x22, 0xdd, 0x89, 0xee, 0xdc, 0x94, 0x98, 0xb9, 0x8d, 0xa1, 0x9d,
@@ -158,14 +158,14 @@ var fileDescriptor_32a42573523bc825 = []byte{
 	0x1a, 0x56, 0xce, 0x85, 0x42, 0xf6, 0x3f, 0xd0, 0x25, 0xa3, 0xb7, 0x70, 0x3a, 0xc6, 0xc5, 0xc8,
 	0x8a, 0x0c, 0x87, 0xda, 0x78, 0xb4, 0x73, 0x31, 0x61, 0x10, 0x84, 0x27, 0x95, 0x1e, 0x94, 0xb6,
 	0x9f, 0x2c, 0xd7, 0x9c, 0xac, 0xd6, 0x9c, 0x7c, 0xaf, 0x39, 0xf9, 0xd8, 0xf0, 0x68, 0xb5, 0xe1,
-	0xd1, 0xe7, 0x86, 0x47, 0x2f, 0xf5, 0xcc, 0xa9, 0x77, 0x12, 0xfd, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0xf2, 0xef, 0x47, 0x2d, 0xca, 0x01, 0x00, 0x00,
+	0xd1, 0xe7, 0x86, 0x47, 0x2f, 0xf5, 0xcc, 0xa9, 0x77, 0x12, 0xfd, 0x0e, 0x00, 0xf2, 0xef, 0x47,
+	0x2d, 0xca, 0x01, 0x00, 0x00,
 }
 
 func (m *CEngineGotvSyncPacket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -173,52 +173,59 @@ func (m *CEngineGotvSyncPacket) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CEngineGotvSyncPacket) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CEngineGotvSyncPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.MatchId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.InstanceId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.Signupfragment))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.Currentfragment))
-	dAtA[i] = 0x2d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Tickrate))))
-	i += 4
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.Tick))
-	dAtA[i] = 0x45
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rtdelay))))
-	i += 4
-	dAtA[i] = 0x4d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rcvage))))
-	i += 4
-	dAtA[i] = 0x55
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.KeyframeInterval))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x55
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rcvage))))
+	i--
+	dAtA[i] = 0x4d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rtdelay))))
+	i--
+	dAtA[i] = 0x45
+	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.Tick))
+	i--
+	dAtA[i] = 0x30
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Tickrate))))
+	i--
+	dAtA[i] = 0x2d
+	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.Currentfragment))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.Signupfragment))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.InstanceId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintEngineGcmessages(dAtA, i, uint64(m.MatchId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintEngineGcmessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEngineGcmessages(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *CEngineGotvSyncPacket) Size() (n int) {
 	if m == nil {
@@ -439,6 +446,7 @@ func (m *CEngineGotvSyncPacket) Unmarshal(dAtA []byte) error {
 func skipEngineGcmessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -470,10 +478,8 @@ func skipEngineGcmessages(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -494,55 +500,30 @@ func skipEngineGcmessages(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthEngineGcmessages
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthEngineGcmessages
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowEngineGcmessages
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipEngineGcmessages(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthEngineGcmessages
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEngineGcmessages
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEngineGcmessages
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthEngineGcmessages = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowEngineGcmessages   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthEngineGcmessages        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEngineGcmessages          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEngineGcmessages = fmt.Errorf("proto: unexpected end of group")
 )
@@ -22,7 +22,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type NET_Messages int32
 
@@ -425,7 +425,7 @@ func (m *CMsgVector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_CMsgVector.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -484,7 +484,7 @@ func (m *CMsgVector2D) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CMsgVector2D.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -537,7 +537,7 @@ func (m *CMsgQAngle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_CMsgQAngle.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -598,7 +598,7 @@ func (m *CMsgRGBA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_CMsgRGBA.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -667,7 +667,7 @@ func (m *CNETMsg_Tick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CNETMsg_Tick.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -739,7 +739,7 @@ func (m *CNETMsg_StringCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CNETMsg_StringCmd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -787,7 +787,7 @@ func (m *CNETMsg_SignonState) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CNETMsg_SignonState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -859,7 +859,7 @@ func (m *CMsg_CVars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_CMsg_CVars.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -905,7 +905,7 @@ func (m *CMsg_CVars_CVar) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_CMsg_CVars_CVar.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -963,7 +963,7 @@ func (m *CNETMsg_SetConVar) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CNETMsg_SetConVar.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1006,7 +1006,7 @@ func (m *CNETMsg_NOP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CNETMsg_NOP.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1043,7 +1043,7 @@ func (m *CNETMsg_Disconnect) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CNETMsg_Disconnect.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1090,7 +1090,7 @@ func (m *CNETMsg_File) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CNETMsg_File.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1155,7 +1155,7 @@ func (m *CNETMsg_SplitScreenUser) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_CNETMsg_SplitScreenUser.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1200,7 +1200,7 @@ func (m *CNETMsg_PlayerAvatarData) XXX_Marshal(b []byte, deterministic bool) ([]
 		return xxx_messageInfo_CNETMsg_PlayerAvatarData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1257,7 +1257,7 @@ func (m *CCLCMsg_ClientInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCLCMsg_ClientInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1345,7 +1345,7 @@ func (m *CCLCMsg_Move) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CCLCMsg_Move.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1408,7 +1408,7 @@ func (m *CCLCMsg_VoiceData) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CCLCMsg_VoiceData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1490,7 +1490,7 @@ func (m *CCLCMsg_BaselineAck) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCLCMsg_BaselineAck.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1541,7 +1541,7 @@ func (m *CCLCMsg_ListenEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CCLCMsg_ListenEvents.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1588,7 +1588,7 @@ func (m *CCLCMsg_RespondCvarValue) XXX_Marshal(b []byte, deterministic bool) ([]
 		return xxx_messageInfo_CCLCMsg_RespondCvarValue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1663,7 +1663,7 @@ func (m *CCLCMsg_FileCRCCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CCLCMsg_FileCRCCheck.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1777,7 +1777,7 @@ func (m *CCLCMsg_LoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_CCLCMsg_LoadingProgress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1821,7 +1821,7 @@ func (m *CCLCMsg_SplitPlayerConnect) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CCLCMsg_SplitPlayerConnect.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1865,7 +1865,7 @@ func (m *CCLCMsg_CmdKeyValues) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CCLCMsg_CmdKeyValues.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1930,7 +1930,7 @@ func (m *CSVCMsg_ServerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CSVCMsg_ServerInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2122,7 +2122,7 @@ func (m *CSVCMsg_ClassInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CSVCMsg_ClassInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2175,7 +2175,7 @@ func (m *CSVCMsg_ClassInfoClassT) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_CSVCMsg_ClassInfoClassT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2236,7 +2236,7 @@ func (m *CSVCMsg_SendTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CSVCMsg_SendTable.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2309,7 +2309,7 @@ func (m *CSVCMsg_SendTableSendpropT) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CSVCMsg_SendTableSendpropT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2409,7 +2409,7 @@ func (m *CSVCMsg_Print) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_CSVCMsg_Print.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2453,7 +2453,7 @@ func (m *CSVCMsg_SetPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CSVCMsg_SetPause.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2497,7 +2497,7 @@ func (m *CSVCMsg_SetView) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_CSVCMsg_SetView.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2548,7 +2548,7 @@ func (m *CSVCMsg_CreateStringTable) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CSVCMsg_CreateStringTable.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2643,7 +2643,7 @@ func (m *CSVCMsg_UpdateStringTable) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CSVCMsg_UpdateStringTable.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2703,7 +2703,7 @@ func (m *CSVCMsg_VoiceInit) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CSVCMsg_VoiceInit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2772,7 +2772,7 @@ func (m *CSVCMsg_VoiceData) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CSVCMsg_VoiceData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2882,7 +2882,7 @@ func (m *CSVCMsg_FixAngle) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CSVCMsg_FixAngle.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2933,7 +2933,7 @@ func (m *CSVCMsg_CrosshairAngle) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CSVCMsg_CrosshairAngle.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2977,7 +2977,7 @@ func (m *CSVCMsg_Prefetch) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CSVCMsg_Prefetch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3025,7 +3025,7 @@ func (m *CSVCMsg_BSPDecal) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CSVCMsg_BSPDecal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3099,7 +3099,7 @@ func (m *CSVCMsg_SplitScreen) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CSVCMsg_SplitScreen.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3160,7 +3160,7 @@ func (m *CSVCMsg_GetCvarValue) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CSVCMsg_GetCvarValue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3212,7 +3212,7 @@ func (m *CSVCMsg_Menu) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CSVCMsg_Menu.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3265,7 +3265,7 @@ func (m *CSVCMsg_UserMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CSVCMsg_UserMessage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3323,7 +3323,7 @@ func (m *CSVCMsg_PaintmapData) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CSVCMsg_PaintmapData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3370,7 +3370,7 @@ func (m *CSVCMsg_GameEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CSVCMsg_GameEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3443,7 +3443,7 @@ func (m *CSVCMsg_GameEventKeyT) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CSVCMsg_GameEventKeyT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3543,7 +3543,7 @@ func (m *CSVCMsg_GameEventList) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CSVCMsg_GameEventList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3588,7 +3588,7 @@ func (m *CSVCMsg_GameEventListKeyT) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CSVCMsg_GameEventListKeyT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3641,7 +3641,7 @@ func (m *CSVCMsg_GameEventListDescriptorT) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CSVCMsg_GameEventListDescriptorT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3701,7 +3701,7 @@ func (m *CSVCMsg_TempEntities) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CSVCMsg_TempEntities.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3765,7 +3765,7 @@ func (m *CSVCMsg_PacketEntities) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CSVCMsg_PacketEntities.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3852,7 +3852,7 @@ func (m *CSVCMsg_Sounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_CSVCMsg_Sounds.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3919,7 +3919,7 @@ func (m *CSVCMsg_SoundsSounddataT) XXX_Marshal(b []byte, deterministic bool) ([]
 		return xxx_messageInfo_CSVCMsg_SoundsSounddataT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4077,7 +4077,7 @@ func (m *CSVCMsg_EntityMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CSVCMsg_EntityMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4135,7 +4135,7 @@ func (m *CSVCMsg_CmdKeyValues) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CSVCMsg_CmdKeyValues.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4180,7 +4180,7 @@ func (m *CSVCMsg_EncryptedData) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CSVCMsg_EncryptedData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4237,7 +4237,7 @@ func (m *CSVCMsg_HltvReplay) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CSVCMsg_HltvReplay.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4327,7 +4327,7 @@ func (m *CCLCMsg_HltvReplay) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CCLCMsg_HltvReplay.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4399,7 +4399,7 @@ func (m *CSVCMsg_Broadcast_Command) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CSVCMsg_Broadcast_Command.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4498,287 +4498,285 @@ func init() {
 func init() { proto.RegisterFile("netmessages.proto", fileDescriptor_52cbc162d33bb4fa) }
 
 var fileDescriptor_52cbc162d33bb4fa = []byte{
-	// 4373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7a, 0x4d, 0x6c, 0x24, 0x49,
-	0x56, 0x7f, 0x57, 0xf9, 0xa3, 0xca, 0x51, 0x65, 0x3b, 0x1d, 0xee, 0x8f, 0x1a, 0xcf, 0xb6, 0xbb,
-	0x3b, 0xfb, 0x3f, 0x33, 0x3d, 0xfd, 0x9f, 0xf1, 0x8e, 0xba, 0x67, 0x86, 0xa5, 0x47, 0x7b, 0xb0,
-	0xcb, 0xe5, 0x6e, 0x6b, 0x6d, 0xb7, 0xb7, 0xaa, 0xda, 0xc3, 0x8c, 0x90, 0x42, 0xe1, 0xcc, 0x70,
-	0x39, 0xe5, 0xfc, 0xa8, 0xcd, 0x88, 0x2a, 0xbb, 0x46, 0x42, 0x70, 0x82, 0x1b, 0xe2, 0x00, 0x42,
-	0x48, 0x48, 0xc0, 0x09, 0x09, 0x71, 0x06, 0x21, 0x24, 0xce, 0x7b, 0xdc, 0x23, 0xa7, 0x65, 0x35,
-	0x73, 0x42, 0xe2, 0x84, 0x40, 0x70, 0x03, 0xbd, 0x17, 0x19, 0x99, 0x91, 0x55, 0xf6, 0xec, 0x2c,
-	0x70, 0xb1, 0x5c, 0xbf, 0xf7, 0x8b, 0xc8, 0x88, 0x17, 0x2f, 0xde, 0x57, 0x26, 0x59, 0x8b, 0x85,
-	0x8a, 0x84, 0x94, 0x7c, 0x20, 0xe4, 0xd6, 0x30, 0x4d, 0x54, 0xb2, 0xf1, 0x70, 0x90, 0x24, 0x83,
-	0x50, 0x7c, 0x1f, 0x7f, 0x9d, 0x8e, 0xce, 0xbe, 0xef, 0x0b, 0xe9, 0xa5, 0xc1, 0x50, 0x25, 0xa9,
-	0x66, 0xb8, 0xaf, 0x08, 0x69, 0x1f, 0xca, 0xc1, 0x89, 0xf0, 0x54, 0x92, 0x52, 0x4a, 0x2a, 0x57,
-	0xad, 0xca, 0xc3, 0xca, 0x93, 0xea, 0xce, 0xfc, 0x4f, 0x7f, 0xfe, 0xe0, 0x56, 0xb7, 0x72, 0x05,
-	0xd8, 0xa4, 0x55, 0xb5, 0xb1, 0x09, 0x60, 0x5f, 0xb5, 0xe6, 0x6c, 0xec, 0x2b, 0xf7, 0x53, 0xd2,
-	0x2c, 0x66, 0x7a, 0xb6, 0xfb, 0x5d, 0xe7, 0x32, 0x2b, 0xf8, 0xf1, 0x76, 0x3c, 0x08, 0xc5, 0xff,
-	0x6a, 0x05, 0x5f, 0x92, 0x3a, 0xcc, 0xd4, 0x7d, 0xb9, 0xb3, 0x0d, 0xf2, 0x14, 0xe7, 0x59, 0x30,
-	0x72, 0xdc, 0xdd, 0x00, 0xe7, 0xc9, 0xb1, 0x01, 0x60, 0xa7, 0x38, 0x4f, 0x8e, 0x9d, 0x02, 0xc6,
-	0x5b, 0xf3, 0x36, 0xc6, 0xdd, 0xbf, 0xad, 0x92, 0x66, 0xfb, 0xa8, 0xd3, 0x3f, 0x94, 0x03, 0xd6,
-	0x0f, 0xbc, 0x0b, 0xda, 0x22, 0xf3, 0x2a, 0xf0, 0x2e, 0xf0, 0x19, 0xcb, 0x19, 0x0f, 0x11, 0xfa,
-	0x6b, 0xe4, 0xf6, 0x79, 0x22, 0x15, 0xf3, 0x92, 0x68, 0x38, 0x52, 0x5c, 0x05, 0x49, 0xac, 0x82,
-	0x48, 0xe0, 0x8c, 0x86, 0xb9, 0x0e, 0x8c, 0x76, 0x99, 0x40, 0x7f, 0x4c, 0xdc, 0xeb, 0x06, 0x32,
-	0xa9, 0x7c, 0xe6, 0x8b, 0x71, 0x80, 0x48, 0x6b, 0xc1, 0x9a, 0xe6, 0xc1, 0x35, 0xd3, 0xf4, 0x94,
-	0xbf, 0x6b, 0xc8, 0xf4, 0x35, 0x79, 0x84, 0x53, 0x9e, 0xa5, 0x3c, 0x12, 0x52, 0xf1, 0x54, 0x5d,
-	0x33, 0xe3, 0xa2, 0x35, 0xe3, 0x26, 0xd0, 0xf7, 0x4a, 0xec, 0xd2, 0x84, 0x1f, 0x91, 0xb5, 0xf3,
-	0x50, 0x8d, 0x59, 0x2a, 0x86, 0x21, 0x9f, 0xb0, 0xb3, 0x90, 0x0f, 0x64, 0xab, 0x66, 0x4d, 0xb0,
-	0x0a, 0xe2, 0x2e, 0x4a, 0xf7, 0x40, 0xe8, 0x3e, 0x27, 0x6b, 0x46, 0x71, 0x3d, 0x95, 0x06, 0xf1,
-	0xa0, 0x1d, 0xf9, 0x74, 0x93, 0xd4, 0xbc, 0x24, 0x8a, 0x78, 0xec, 0xa3, 0x02, 0x97, 0xb2, 0xc1,
-	0x06, 0x74, 0xff, 0xb9, 0x42, 0xd6, 0xf3, 0x51, 0xc1, 0x20, 0x4e, 0xe2, 0x9e, 0xe2, 0x4a, 0xd0,
-	0xf7, 0x48, 0x53, 0xe2, 0x4f, 0x26, 0xe1, 0x77, 0x49, 0xfb, 0x0d, 0x69, 0x11, 0xdf, 0x21, 0x0d,
-	0x39, 0xe4, 0x97, 0x31, 0xf3, 0x92, 0x51, 0xac, 0xf0, 0xd4, 0x0d, 0x8f, 0xa0, 0xa0, 0x0d, 0x38,
-	0x7d, 0x46, 0x68, 0x3c, 0x8a, 0x98, 0x14, 0xe9, 0x58, 0xa4, 0x0c, 0x16, 0x2d, 0x52, 0x89, 0xf6,
-	0x60, 0xd8, 0x4e, 0x3c, 0x8a, 0x7a, 0x28, 0x3e, 0xd6, 0x52, 0xfa, 0x21, 0xa1, 0x19, 0x91, 0xc5,
-	0x42, 0x5d, 0x26, 0xe9, 0x45, 0xe0, 0xcb, 0xd6, 0xfc, 0xc3, 0xb9, 0x27, 0x4b, 0xdd, 0xb5, 0x4c,
-	0x72, 0x94, 0x0b, 0xe8, 0x03, 0x52, 0x8f, 0xf8, 0x90, 0xc5, 0x3c, 0x12, 0x78, 0x76, 0xf9, 0x5e,
-	0x23, 0x3e, 0x3c, 0xe2, 0x91, 0x70, 0xff, 0xa2, 0xa2, 0x6f, 0x00, 0x6b, 0x9f, 0xf0, 0x54, 0xd2,
-	0x77, 0xc9, 0x82, 0x37, 0xe6, 0xa9, 0x6c, 0x55, 0x1e, 0xce, 0x3d, 0x69, 0x3c, 0x73, 0xb6, 0x0a,
-	0xd9, 0x16, 0xfc, 0xed, 0x6a, 0xf1, 0xc6, 0x05, 0x99, 0x87, 0x9f, 0x60, 0x88, 0x38, 0xb7, 0xad,
-	0x47, 0x44, 0xe8, 0x06, 0x59, 0x18, 0xf3, 0x70, 0x24, 0x70, 0xf7, 0x46, 0xa4, 0x21, 0xfa, 0x21,
-	0x59, 0xf5, 0x03, 0x0f, 0x8e, 0x94, 0xa7, 0x13, 0xbd, 0x38, 0x7b, 0xd7, 0x2b, 0x85, 0x10, 0xd7,
-	0xf8, 0xc2, 0x3a, 0x44, 0xa1, 0xda, 0x49, 0x0c, 0x4f, 0x7e, 0x07, 0x0e, 0x31, 0xce, 0xd6, 0x5a,
-	0x79, 0xd2, 0x78, 0xd6, 0xb0, 0xd6, 0xda, 0x35, 0x32, 0x77, 0x99, 0x34, 0xcc, 0xd8, 0xa3, 0xd7,
-	0xc7, 0xee, 0x16, 0xa1, 0xe6, 0xe7, 0x6e, 0x20, 0xbd, 0x24, 0x8e, 0x85, 0xa7, 0xf0, 0x3a, 0x89,
-	0x2b, 0x55, 0xde, 0x05, 0x20, 0xee, 0x5f, 0x56, 0x8a, 0x9b, 0xb7, 0x17, 0x84, 0x78, 0xb4, 0x2a,
-	0xe5, 0xb1, 0x3c, 0x13, 0x29, 0x0b, 0xfc, 0xd2, 0x25, 0x27, 0x46, 0xb0, 0xef, 0xd3, 0x47, 0x64,
-	0xe9, 0x2c, 0x08, 0x85, 0xde, 0x9b, 0xad, 0x81, 0x3a, 0xc0, 0xb0, 0x2b, 0xfa, 0x9c, 0xac, 0x07,
-	0xd2, 0x98, 0xb2, 0x2f, 0xa2, 0x84, 0x81, 0x08, 0x15, 0x51, 0x37, 0xc7, 0x1f, 0x48, 0x6d, 0xcc,
-	0xbb, 0x22, 0x4a, 0xf0, 0xf1, 0x2d, 0x32, 0xef, 0x8b, 0x78, 0x82, 0xd7, 0xd9, 0xb0, 0x10, 0x71,
-	0x9f, 0x93, 0x7b, 0xb9, 0x92, 0x86, 0x61, 0xa0, 0x7a, 0x5e, 0x2a, 0x44, 0xfc, 0x46, 0x0a, 0x3c,
-	0x24, 0x19, 0x26, 0xaa, 0xb4, 0x58, 0x44, 0xdc, 0x13, 0xd2, 0x32, 0x83, 0xb4, 0x81, 0x6d, 0x8f,
-	0xb9, 0xe2, 0xe9, 0x2e, 0x57, 0x9c, 0xba, 0x64, 0x89, 0x7b, 0x68, 0xc0, 0xd9, 0x3e, 0xcd, 0xf1,
-	0x14, 0x30, 0xbd, 0x4b, 0xe6, 0xd2, 0xc1, 0x29, 0x6e, 0xb0, 0x99, 0x49, 0x01, 0x70, 0xff, 0xb4,
-	0x4a, 0x68, 0xbb, 0x7d, 0xa0, 0x0f, 0x24, 0x0c, 0x44, 0xac, 0xf6, 0xe3, 0xb3, 0x84, 0x3e, 0x25,
-	0x2b, 0x52, 0xc4, 0x3e, 0x53, 0xfc, 0x34, 0x14, 0xcc, 0x4b, 0x3d, 0x9c, 0xb7, 0x96, 0x8d, 0x6c,
-	0x82, 0xac, 0x0f, 0xa2, 0x76, 0xea, 0xe1, 0x65, 0xd3, 0x17, 0x63, 0xf6, 0x12, 0x35, 0xb4, 0x44,
-	0xdf, 0xa2, 0xfb, 0xa4, 0x16, 0x48, 0x06, 0x17, 0xbf, 0xa4, 0xbb, 0xc5, 0x40, 0xbe, 0x0a, 0xd5,
-	0x18, 0x4e, 0x22, 0x57, 0x73, 0x49, 0x6d, 0x75, 0xa3, 0x5c, 0xfa, 0x98, 0x90, 0xb3, 0x34, 0x10,
-	0xb1, 0x2f, 0xe1, 0x48, 0x6d, 0x17, 0xb7, 0x94, 0xe1, 0xfb, 0x3e, 0xac, 0xc7, 0x90, 0xf0, 0x50,
-	0x17, 0xad, 0x43, 0x6d, 0x64, 0x12, 0x3c, 0xd7, 0x47, 0xa4, 0xe9, 0x8d, 0xa4, 0x4a, 0x22, 0x3c,
-	0x4f, 0xf0, 0x4f, 0x73, 0x4f, 0x6a, 0xdd, 0x86, 0xc6, 0xe0, 0x10, 0xa5, 0xfb, 0xfb, 0x60, 0x55,
-	0x99, 0x7a, 0x0e, 0x93, 0xb1, 0xa0, 0x1f, 0x93, 0x75, 0xf0, 0x04, 0xa7, 0xdc, 0xbb, 0x18, 0x0d,
-	0x59, 0xe6, 0x87, 0x64, 0x49, 0xeb, 0x6b, 0xf1, 0x28, 0xda, 0x41, 0x79, 0x3b, 0x13, 0xd3, 0x2d,
-	0x02, 0xfe, 0x81, 0xc5, 0xe2, 0xb2, 0x18, 0x62, 0xab, 0x69, 0x25, 0x1e, 0x45, 0x47, 0xe2, 0x32,
-	0xe7, 0x83, 0xf1, 0x70, 0xc5, 0x51, 0x4d, 0xcd, 0xdc, 0x78, 0xb8, 0xe2, 0xee, 0x5f, 0x55, 0xc9,
-	0x9a, 0x59, 0xd0, 0x49, 0x12, 0x78, 0x02, 0x2d, 0xc0, 0xf0, 0x2b, 0xd3, 0x7c, 0x90, 0x5c, 0x8d,
-	0x02, 0x1f, 0x9f, 0xb6, 0x68, 0x24, 0x80, 0xd0, 0x36, 0x59, 0x3c, 0x4b, 0xd2, 0x88, 0x2b, 0x7c,
-	0xca, 0xca, 0x33, 0xba, 0x95, 0xcf, 0xb7, 0x87, 0x38, 0x53, 0x2f, 0xee, 0x9d, 0xbc, 0xde, 0x6f,
-	0x77, 0x76, 0xb7, 0xfb, 0xdb, 0x6c, 0xef, 0x75, 0xf7, 0x70, 0xbb, 0xcf, 0x3a, 0x47, 0x2f, 0xf7,
-	0x8f, 0x3a, 0xdd, 0x6c, 0x28, 0xfd, 0xff, 0x60, 0x27, 0x3f, 0x19, 0x89, 0xd8, 0x13, 0xec, 0x74,
-	0xa2, 0x84, 0x2c, 0x05, 0xc4, 0x65, 0x23, 0xdb, 0x01, 0x91, 0x26, 0xa3, 0xbf, 0x60, 0xf1, 0x28,
-	0x3a, 0x15, 0x69, 0xe9, 0x04, 0x97, 0x33, 0xd9, 0x11, 0x8a, 0xe8, 0x0e, 0xd9, 0x18, 0xc5, 0x10,
-	0xe2, 0x52, 0x21, 0xa5, 0xf0, 0x99, 0xe4, 0xd1, 0x30, 0x14, 0x2c, 0x39, 0x3b, 0x93, 0x42, 0x95,
-	0x62, 0x51, 0xcb, 0xe6, 0xf5, 0x90, 0xf6, 0x1a, 0x59, 0xee, 0x80, 0xac, 0x1b, 0x5d, 0xed, 0x70,
-	0x29, 0xc2, 0x20, 0x16, 0xdb, 0xde, 0x05, 0x7d, 0x9f, 0x2c, 0x9f, 0x66, 0x3f, 0x59, 0x1e, 0x9c,
-	0xcd, 0x9a, 0x9b, 0x46, 0x84, 0xe1, 0xfb, 0x1d, 0xd2, 0xc8, 0xa9, 0x71, 0x5a, 0xca, 0x0a, 0x88,
-	0x11, 0x1c, 0xa5, 0xee, 0x27, 0xe4, 0xb6, 0x79, 0xd0, 0x41, 0x20, 0x95, 0x88, 0x3b, 0x63, 0x11,
-	0x2b, 0x49, 0xef, 0x13, 0x22, 0xe0, 0x3f, 0x16, 0x71, 0x79, 0x81, 0x9e, 0xba, 0xd6, 0x5d, 0x42,
-	0xe4, 0x90, 0xcb, 0x0b, 0xf7, 0x8f, 0x2a, 0xa4, 0x65, 0xc6, 0x75, 0x85, 0x1c, 0x26, 0xb1, 0xdf,
-	0x1e, 0xf3, 0xf4, 0x04, 0x5d, 0xef, 0xf7, 0xc8, 0xa2, 0x97, 0x24, 0x17, 0x81, 0x28, 0x2d, 0x2f,
-	0xc3, 0x30, 0x70, 0x29, 0xae, 0x46, 0x92, 0x79, 0x89, 0x2f, 0xca, 0x0b, 0xd3, 0x82, 0x76, 0xe2,
-	0x8b, 0xdc, 0xeb, 0xcf, 0xdd, 0xec, 0xf5, 0xe7, 0x67, 0xbc, 0xbe, 0xfb, 0xe7, 0x73, 0xc5, 0x7e,
-	0xe0, 0x1e, 0xb4, 0xbb, 0xed, 0xf6, 0xb9, 0xf0, 0x2e, 0xe0, 0x8a, 0xc2, 0xe3, 0xd8, 0x90, 0xab,
-	0xf3, 0xd2, 0xb2, 0xea, 0x00, 0x1f, 0x73, 0x75, 0x0e, 0x4f, 0x44, 0xa9, 0xed, 0x4a, 0x11, 0x01,
-	0xb5, 0xe3, 0x60, 0xb8, 0x6c, 0xf9, 0xa2, 0x72, 0xb5, 0x83, 0x68, 0x2f, 0x93, 0xd0, 0x87, 0xa4,
-	0x9e, 0xb3, 0xe6, 0xa7, 0x7d, 0x32, 0x32, 0xde, 0x27, 0xcb, 0xe8, 0xb6, 0xcf, 0x52, 0xee, 0xe5,
-	0xf9, 0x4e, 0x3e, 0x19, 0x88, 0xf6, 0x32, 0x09, 0xb8, 0xbe, 0xc8, 0xff, 0x04, 0x4d, 0x26, 0x77,
-	0x7d, 0x91, 0xff, 0x09, 0xe0, 0xe0, 0xd8, 0xec, 0xac, 0x04, 0x00, 0xf0, 0x7d, 0x38, 0xf5, 0x39,
-	0x97, 0xe7, 0x4c, 0x4d, 0x86, 0xa2, 0x55, 0x9f, 0x9e, 0xfb, 0x15, 0x97, 0xe7, 0xfd, 0xc9, 0x50,
-	0x40, 0xd4, 0x46, 0x6e, 0x28, 0xe2, 0xd6, 0x92, 0xc5, 0xaa, 0x01, 0x7a, 0x20, 0x62, 0xfa, 0x2e,
-	0x69, 0x0e, 0xb9, 0x77, 0x81, 0x9b, 0x06, 0x9f, 0x45, 0xec, 0x83, 0x02, 0x09, 0xec, 0x79, 0xdf,
-	0x07, 0x0f, 0x51, 0xf0, 0xb2, 0xdb, 0xd1, 0xb0, 0xb8, 0x2b, 0x86, 0xab, 0xaf, 0x87, 0xfb, 0x19,
-	0xb9, 0x97, 0x5b, 0x5c, 0xc2, 0xfd, 0x20, 0x1e, 0x1c, 0xa7, 0xc9, 0x00, 0xee, 0x00, 0x28, 0x6f,
-	0x98, 0xfd, 0x5f, 0x3e, 0x23, 0x83, 0xba, 0x6d, 0xb2, 0x61, 0x06, 0x63, 0x04, 0xd2, 0x11, 0xa5,
-	0x9d, 0xc5, 0xd8, 0xef, 0x18, 0xaf, 0x5f, 0x14, 0x36, 0xd2, 0x8e, 0xfc, 0x1f, 0x89, 0x09, 0x9a,
-	0xad, 0x84, 0x68, 0x74, 0x21, 0x26, 0x68, 0x48, 0xb2, 0xe4, 0x90, 0x0a, 0xd8, 0xfd, 0x8f, 0x45,
-	0x42, 0xdb, 0xbd, 0x13, 0xbd, 0x02, 0x8c, 0x10, 0x18, 0x75, 0xf4, 0xca, 0x55, 0xe2, 0x25, 0xe1,
-	0xcc, 0xca, 0x11, 0xbd, 0x36, 0xd6, 0x2c, 0x5c, 0x17, 0x6b, 0xde, 0x23, 0xcd, 0x40, 0x32, 0x5f,
-	0xf8, 0x81, 0xc7, 0x95, 0xf0, 0x4b, 0x01, 0xa7, 0x11, 0xc8, 0x5d, 0x23, 0xa0, 0x3f, 0x24, 0xad,
-	0x40, 0x82, 0x5b, 0x09, 0xbc, 0x80, 0x87, 0x6c, 0xcc, 0xc3, 0xb1, 0xc8, 0x12, 0xbd, 0x52, 0x10,
-	0xba, 0x13, 0xc8, 0xd7, 0x19, 0xe9, 0x04, 0x38, 0x7a, 0xd9, 0x76, 0x4c, 0x5b, 0xf8, 0x65, 0x31,
-	0x6d, 0xf1, 0xda, 0x98, 0xb6, 0x47, 0xee, 0x23, 0xc5, 0x0f, 0x52, 0x70, 0x80, 0xf1, 0x80, 0xa9,
-	0x84, 0x0d, 0xd3, 0xe4, 0x6a, 0xc2, 0x52, 0x01, 0xc3, 0xee, 0x58, 0xc3, 0xde, 0x82, 0x61, 0x39,
-	0xb3, 0x9f, 0x1c, 0x03, 0xaf, 0x0b, 0x34, 0x7a, 0x8f, 0xcc, 0x7b, 0x2c, 0xd1, 0x59, 0xf6, 0x42,
-	0x6e, 0xcf, 0xaf, 0xc1, 0x09, 0x41, 0x0e, 0x89, 0x41, 0xbc, 0x6e, 0x05, 0xf1, 0xc5, 0x88, 0x0f,
-	0x21, 0x7c, 0x3f, 0x26, 0xc4, 0xc3, 0xc0, 0x8f, 0x8c, 0x25, 0x8b, 0xb1, 0xa4, 0x71, 0x20, 0x6d,
-	0x11, 0x47, 0x62, 0x56, 0x6e, 0x65, 0x04, 0xc4, 0xa2, 0xae, 0x68, 0x69, 0x9e, 0x13, 0xbc, 0x43,
-	0x1a, 0x11, 0xbf, 0x62, 0x7a, 0x02, 0x59, 0xb2, 0x64, 0x12, 0xf1, 0x2b, 0x9d, 0x69, 0xc8, 0x82,
-	0xc6, 0xa5, 0x14, 0xb2, 0xd5, 0x9c, 0xa1, 0x21, 0x0e, 0x34, 0x9d, 0x30, 0x33, 0xcc, 0x8e, 0x96,
-	0x4b, 0x77, 0x08, 0x05, 0xbd, 0x30, 0x51, 0xe0, 0x13, 0xc0, 0x9d, 0xb3, 0x20, 0x56, 0x22, 0x1d,
-	0xf3, 0xb0, 0xb5, 0x62, 0x15, 0x7e, 0x4d, 0x10, 0xed, 0x67, 0x12, 0xb8, 0xb7, 0x03, 0x1e, 0x09,
-	0xe6, 0x07, 0x69, 0x6b, 0xd5, 0xce, 0xb6, 0x01, 0xdd, 0x0d, 0xd2, 0x52, 0x3a, 0xee, 0x5c, 0x93,
-	0x8e, 0x83, 0x97, 0x00, 0xc2, 0x20, 0x4d, 0x46, 0x19, 0x6d, 0xcd, 0xa2, 0x35, 0x23, 0x3e, 0x7c,
-	0x09, 0x22, 0xe4, 0x3e, 0x20, 0x75, 0x79, 0x91, 0xa5, 0xcf, 0xd4, 0x9e, 0x4c, 0x5e, 0x4c, 0xb2,
-	0x4c, 0x64, 0x09, 0xeb, 0x2f, 0x64, 0xac, 0xdb, 0x0e, 0x0f, 0x60, 0x43, 0x19, 0x8e, 0x4e, 0xc3,
-	0xc0, 0x63, 0xc1, 0xb0, 0x75, 0xdb, 0xf2, 0x59, 0x75, 0x0d, 0xef, 0x0f, 0xa9, 0x4b, 0xc8, 0x68,
-	0xe0, 0x31, 0x58, 0x56, 0xe0, 0xb7, 0xee, 0x3e, 0xac, 0x3c, 0x99, 0x37, 0x9c, 0xd1, 0xc0, 0x3b,
-	0xe4, 0xc3, 0x7d, 0xdf, 0xfd, 0xcf, 0x0a, 0x59, 0x33, 0x37, 0x0f, 0xd5, 0x8b, 0x17, 0x6f, 0x8b,
-	0x38, 0x5e, 0x2a, 0xb8, 0x12, 0x2c, 0x89, 0xb3, 0x43, 0xc3, 0x0b, 0x68, 0xcc, 0x6e, 0x45, 0x4b,
-	0x5f, 0xc7, 0xfa, 0xe0, 0xe8, 0xc7, 0xa4, 0x66, 0xce, 0xac, 0x8a, 0xe5, 0xc7, 0xc6, 0xd6, 0xcc,
-	0xa4, 0x5b, 0xc8, 0x60, 0xaa, 0x6b, 0xa8, 0x1b, 0xbf, 0x95, 0x8d, 0x62, 0x0a, 0x34, 0xa2, 0xff,
-	0x9d, 0xca, 0xcc, 0x35, 0x61, 0xdf, 0xa7, 0x1f, 0x90, 0x55, 0xc8, 0x5f, 0x32, 0x73, 0x9b, 0x49,
-	0xce, 0x97, 0x41, 0x88, 0xd6, 0x86, 0xca, 0x41, 0x1b, 0x86, 0xe9, 0x66, 0x82, 0xdd, 0x12, 0xe2,
-	0x58, 0x9c, 0xfc, 0xfb, 0x5c, 0xb1, 0xf5, 0x9e, 0x49, 0x60, 0xe9, 0xdb, 0x64, 0x31, 0x90, 0x4c,
-	0x64, 0x15, 0xa6, 0xd9, 0xf0, 0x42, 0x20, 0x3b, 0xb1, 0x0f, 0x87, 0x1c, 0x0b, 0x75, 0xd3, 0x22,
-	0x9a, 0xb1, 0x50, 0xc5, 0x1a, 0xde, 0x27, 0xcb, 0xb1, 0x10, 0x3e, 0x38, 0x1d, 0x88, 0x65, 0x69,
-	0xc9, 0xe5, 0x34, 0x51, 0xb4, 0xab, 0x25, 0xf4, 0x39, 0x59, 0x18, 0xa6, 0xc9, 0x50, 0x57, 0x83,
-	0x8d, 0x67, 0xf7, 0xb7, 0x66, 0x96, 0xb5, 0x05, 0x19, 0x36, 0x70, 0x98, 0xea, 0x6a, 0xee, 0xc6,
-	0xdf, 0x54, 0x09, 0x29, 0x50, 0xac, 0x84, 0x20, 0x36, 0x95, 0x4a, 0x05, 0x95, 0xc5, 0xa4, 0x31,
-	0x4f, 0x67, 0x97, 0x5b, 0x1b, 0xf3, 0xf4, 0x28, 0x0b, 0xfd, 0xba, 0x20, 0xb7, 0x03, 0xb0, 0x86,
-	0xb4, 0x0b, 0x0e, 0x92, 0x34, 0x50, 0x93, 0x52, 0x2a, 0x97, 0xa3, 0xe0, 0x4e, 0x7c, 0x35, 0x5b,
-	0xa7, 0x2e, 0xfa, 0xda, 0x4e, 0xdf, 0x23, 0x4d, 0x48, 0x75, 0x45, 0x28, 0x22, 0xbc, 0xfa, 0x8b,
-	0xb6, 0x87, 0x8e, 0x47, 0x51, 0x27, 0x13, 0x80, 0x41, 0x87, 0xc9, 0x25, 0xd3, 0x49, 0x48, 0xcd,
-	0xba, 0xa9, 0xf5, 0x30, 0xb9, 0xd4, 0x29, 0xd0, 0x63, 0x42, 0xce, 0x83, 0xc1, 0x79, 0xc6, 0xa9,
-	0x5b, 0x9c, 0x25, 0xc0, 0x35, 0xe9, 0x01, 0xa9, 0x63, 0x46, 0x1e, 0x28, 0x59, 0x0e, 0xc1, 0x90,
-	0x86, 0x07, 0x4a, 0xba, 0xef, 0x93, 0x65, 0xa3, 0xdf, 0xe3, 0x34, 0x88, 0xbf, 0xad, 0x88, 0xfc,
-	0x88, 0x38, 0xc5, 0x51, 0xa8, 0x63, 0x3e, 0x92, 0x98, 0x87, 0x0d, 0xe1, 0x9f, 0xb2, 0x81, 0x64,
-	0x98, 0xfb, 0x82, 0xac, 0x5a, 0x23, 0x4e, 0x02, 0x71, 0x09, 0x1a, 0x10, 0xb1, 0x0a, 0xd4, 0x84,
-	0x05, 0xb1, 0x2f, 0xae, 0x4a, 0x27, 0xd4, 0xd0, 0x92, 0x7d, 0x10, 0xb8, 0x3f, 0xaf, 0x92, 0xb7,
-	0xf2, 0x6b, 0x83, 0x17, 0xac, 0x57, 0x78, 0xd1, 0x6f, 0x29, 0xd8, 0x33, 0xaf, 0x29, 0x62, 0x95,
-	0x06, 0x42, 0x96, 0x73, 0xbf, 0x88, 0x5f, 0x75, 0x34, 0x0e, 0x34, 0x3c, 0x89, 0x8c, 0x66, 0x1f,
-	0x36, 0x81, 0x83, 0xc8, 0x68, 0x9f, 0x90, 0xdb, 0x23, 0x29, 0x52, 0x86, 0xd7, 0xed, 0x2c, 0xb8,
-	0x82, 0x5c, 0x3b, 0xf8, 0x4a, 0x94, 0x82, 0xdf, 0x1a, 0x30, 0xb0, 0x32, 0x00, 0x79, 0x2f, 0xf8,
-	0x0a, 0xfd, 0x5f, 0x31, 0x0c, 0x07, 0x94, 0x32, 0x30, 0x33, 0x00, 0xb9, 0xcf, 0xc9, 0x7a, 0x99,
-	0xab, 0x4f, 0xcb, 0x36, 0x0d, 0xc7, 0x1e, 0x00, 0xc7, 0x56, 0x58, 0x69, 0x6d, 0xd6, 0x4a, 0x31,
-	0xfb, 0xc5, 0x70, 0x84, 0x65, 0x4f, 0xdd, 0xca, 0x32, 0x88, 0x16, 0xc0, 0x54, 0xee, 0x9f, 0x54,
-	0x0a, 0x05, 0xbf, 0x19, 0xfa, 0x53, 0x0a, 0x7e, 0x40, 0xea, 0xfa, 0x62, 0x4f, 0xfb, 0x20, 0x44,
-	0xf7, 0x7d, 0x53, 0xeb, 0x79, 0xe7, 0x3c, 0x1e, 0x08, 0xff, 0x5a, 0x7d, 0x43, 0xad, 0xd7, 0xd6,
-	0x72, 0x4b, 0xed, 0xf6, 0xda, 0xe6, 0x6e, 0x58, 0x5b, 0x58, 0x38, 0x23, 0xac, 0xbb, 0xf6, 0xe3,
-	0x40, 0xd1, 0x4d, 0x52, 0xfb, 0xc9, 0x88, 0x87, 0x70, 0xf9, 0x4a, 0x2b, 0xca, 0x40, 0xd0, 0x09,
-	0x78, 0x10, 0xaf, 0xdc, 0xaa, 0x41, 0x88, 0xbe, 0x4d, 0x6a, 0x63, 0x91, 0x4a, 0xc8, 0x85, 0xf1,
-	0xa8, 0x5f, 0x54, 0x3e, 0xea, 0x1a, 0xc4, 0xfd, 0x87, 0xb9, 0xa9, 0xc7, 0x61, 0xd9, 0x08, 0x25,
-	0x46, 0xe1, 0xec, 0x8b, 0x12, 0x43, 0x3b, 0x79, 0x97, 0x2c, 0x41, 0x1a, 0x12, 0x44, 0xb0, 0x9c,
-	0xaa, 0x65, 0x0d, 0x05, 0x9c, 0x97, 0x97, 0x73, 0x33, 0xe5, 0xe5, 0x7b, 0xa4, 0xc9, 0x47, 0x7e,
-	0x00, 0xfa, 0xc5, 0xe2, 0xc7, 0x76, 0x26, 0x8d, 0x4c, 0x02, 0x45, 0x10, 0x5c, 0xf2, 0x31, 0xac,
-	0x48, 0xab, 0x6b, 0xc1, 0x4e, 0x18, 0xc7, 0xa5, 0x95, 0x72, 0xa9, 0x44, 0x5a, 0x4a, 0xa2, 0x32,
-	0xcc, 0x2a, 0x65, 0x6b, 0xff, 0x97, 0xa5, 0x6c, 0xfd, 0x57, 0x29, 0x65, 0x97, 0xfe, 0xa7, 0xa5,
-	0x2c, 0xf9, 0x4e, 0xa5, 0xec, 0xe7, 0x85, 0x67, 0xda, 0x0b, 0xae, 0x74, 0x13, 0xfc, 0x21, 0xa9,
-	0x43, 0x86, 0xa8, 0x82, 0xb1, 0x28, 0xf9, 0xa6, 0x1c, 0xa5, 0x8f, 0xc8, 0x02, 0x07, 0x2a, 0x1e,
-	0x9f, 0x49, 0xe4, 0x75, 0x0b, 0xbd, 0xab, 0x25, 0xee, 0x67, 0xe4, 0x6e, 0xe1, 0x83, 0x12, 0x29,
-	0xcf, 0x79, 0x90, 0xea, 0xe9, 0xf3, 0xc1, 0x95, 0x1b, 0x07, 0xff, 0x7a, 0xb1, 0xaa, 0xe3, 0x54,
-	0x9c, 0x09, 0xe5, 0x9d, 0xa3, 0xfd, 0x27, 0xa3, 0xd8, 0xbf, 0xc6, 0xfb, 0x11, 0x14, 0x68, 0xe7,
-	0xf7, 0x8b, 0x4a, 0x31, 0x76, 0xa7, 0x77, 0xbc, 0x2b, 0x3c, 0x1e, 0xd2, 0xfb, 0x64, 0x6e, 0x98,
-	0x94, 0xcb, 0x0e, 0xfd, 0xa2, 0xa0, 0x0b, 0x38, 0x5c, 0x48, 0x1f, 0x78, 0x0c, 0x9c, 0xf5, 0x28,
-	0x15, 0xd9, 0x23, 0x4a, 0x17, 0x12, 0x09, 0x7d, 0x2d, 0xc7, 0x27, 0xcd, 0xf8, 0xe3, 0xb9, 0x1b,
-	0xfc, 0x31, 0xfa, 0xd5, 0xc4, 0x17, 0x61, 0xc6, 0x9b, 0x2f, 0xf9, 0x55, 0x10, 0xe4, 0xf3, 0x41,
-	0xe0, 0xca, 0xc3, 0xa4, 0x9d, 0xf7, 0x37, 0xc2, 0xe4, 0xf2, 0x38, 0x13, 0xb8, 0x7f, 0x56, 0x21,
-	0xeb, 0x79, 0x70, 0x28, 0x3a, 0x7d, 0xb4, 0x63, 0x85, 0xee, 0x95, 0x67, 0xad, 0xad, 0x8e, 0x25,
-	0x3c, 0xd4, 0x6f, 0x66, 0xa0, 0xb8, 0x7c, 0x71, 0xef, 0xb0, 0xf7, 0x92, 0xf5, 0x8e, 0x0f, 0xf6,
-	0xfb, 0xbd, 0x76, 0xb7, 0xd3, 0x39, 0x62, 0xdb, 0xbb, 0xbb, 0x6f, 0x7a, 0x9d, 0x6e, 0x16, 0xe7,
-	0x4d, 0xb3, 0xb0, 0x3a, 0xdd, 0x2c, 0x84, 0x15, 0x66, 0xf9, 0xf2, 0x35, 0x3b, 0xd6, 0x12, 0x7d,
-	0x08, 0x9f, 0x93, 0xdb, 0x66, 0x81, 0x2f, 0x85, 0xfa, 0xae, 0xbd, 0x87, 0x47, 0x64, 0xc9, 0xbb,
-	0x36, 0xc3, 0xa8, 0x7b, 0x59, 0x8a, 0xe1, 0x7a, 0xa4, 0x69, 0x26, 0x3e, 0x14, 0xf1, 0x08, 0x54,
-	0xeb, 0x07, 0x3c, 0x4c, 0x06, 0x6c, 0x26, 0x69, 0x21, 0x5a, 0x80, 0xe5, 0xf4, 0x07, 0x64, 0x35,
-	0x12, 0xf1, 0x88, 0x5d, 0x88, 0x09, 0xcb, 0x2a, 0x48, 0xbb, 0x63, 0xb9, 0x0c, 0xc2, 0xbc, 0xd2,
-	0x74, 0x7f, 0xbb, 0x50, 0xef, 0x1b, 0x29, 0xd2, 0x4c, 0x75, 0x98, 0xba, 0xcb, 0x6b, 0x1e, 0x54,
-	0x8b, 0xe4, 0xc0, 0x14, 0xed, 0x40, 0x40, 0x7f, 0x63, 0x4f, 0x0f, 0x04, 0xf4, 0x36, 0xef, 0x92,
-	0xc6, 0x90, 0x4b, 0xa9, 0xce, 0xd3, 0x64, 0x34, 0x38, 0x9f, 0x52, 0x5f, 0x21, 0x70, 0x7f, 0x50,
-	0xa8, 0xef, 0x98, 0x07, 0xb1, 0x8a, 0xf8, 0x10, 0xc7, 0x43, 0x12, 0x95, 0xfd, 0x2e, 0x55, 0xc0,
-	0x39, 0xea, 0xfe, 0x8b, 0xe5, 0x8f, 0x5f, 0xf2, 0x48, 0x60, 0xbf, 0x08, 0x5c, 0xa1, 0x6e, 0x17,
-	0xcd, 0x04, 0x7e, 0xdd, 0x34, 0xc2, 0x04, 0x6b, 0x93, 0xd4, 0xf0, 0x47, 0xd6, 0xd4, 0xcb, 0x77,
-	0x97, 0x81, 0xf4, 0x03, 0x32, 0x7f, 0x21, 0x26, 0x10, 0xef, 0x21, 0xb7, 0x6c, 0x6d, 0xcd, 0x3c,
-	0x66, 0x0b, 0xb4, 0xab, 0xba, 0xc8, 0x9a, 0xde, 0xea, 0xfc, 0x0d, 0x5b, 0xdd, 0xf8, 0xfb, 0x2a,
-	0x59, 0xc0, 0x71, 0xdf, 0x92, 0x78, 0x82, 0x27, 0xe7, 0x21, 0xd3, 0x41, 0xae, 0x64, 0x18, 0x4b,
-	0x63, 0x1e, 0xea, 0xd0, 0x0b, 0xc6, 0x03, 0xa4, 0xb3, 0x30, 0xc9, 0x3a, 0x8f, 0x79, 0xda, 0x37,
-	0xe6, 0xe1, 0x1e, 0xa0, 0x3a, 0x81, 0x0d, 0x59, 0x98, 0xc4, 0x83, 0xd2, 0x82, 0x6a, 0x63, 0x1e,
-	0x1e, 0x24, 0xc5, 0x1c, 0xf2, 0x3c, 0x49, 0x55, 0x29, 0xed, 0x80, 0x71, 0x3d, 0x40, 0xcd, 0x1c,
-	0xe0, 0xc8, 0x4b, 0x79, 0x06, 0xcc, 0x01, 0x2e, 0x3c, 0x27, 0x24, 0x49, 0x88, 0x51, 0xa3, 0x6e,
-	0x13, 0x92, 0x24, 0x34, 0xbb, 0x19, 0x05, 0xb1, 0xfa, 0xf4, 0x63, 0x8c, 0x05, 0xf3, 0xd6, 0x6e,
-	0xde, 0x20, 0x0c, 0x76, 0x0d, 0xa4, 0xcb, 0x6c, 0xcf, 0x4b, 0x76, 0xb0, 0x1f, 0xf3, 0xf0, 0x73,
-	0x8d, 0xbb, 0x7f, 0x5c, 0x25, 0x77, 0x66, 0xce, 0xe1, 0x20, 0x90, 0x8a, 0x76, 0x48, 0xa3, 0x78,
-	0xd9, 0x6a, 0x5e, 0xe6, 0x3c, 0xde, 0xba, 0x96, 0xbc, 0x55, 0x30, 0x99, 0xea, 0xda, 0xe3, 0x36,
-	0x3e, 0xfb, 0xe5, 0xa7, 0x63, 0xf2, 0xc9, 0xea, 0x74, 0x3e, 0xb9, 0xf1, 0x15, 0x69, 0xda, 0x33,
-	0xdb, 0x16, 0x56, 0xb9, 0xce, 0xc2, 0x6e, 0x9c, 0x89, 0x7e, 0x54, 0xb2, 0xbd, 0xef, 0xdd, 0xb0,
-	0x0d, 0xcb, 0xfe, 0xdc, 0xdf, 0xad, 0x14, 0x77, 0xa8, 0x2f, 0xa2, 0x61, 0x07, 0xfc, 0x31, 0xa4,
-	0x51, 0x3a, 0xb8, 0x05, 0x90, 0x8a, 0xcd, 0x04, 0x37, 0x44, 0xa7, 0xf3, 0xdb, 0xea, 0x0d, 0xf9,
-	0xed, 0x3b, 0x24, 0x73, 0xf2, 0xd7, 0xe4, 0x63, 0x5a, 0x80, 0xf9, 0xd8, 0x5f, 0x57, 0x8b, 0x40,
-	0x78, 0xcc, 0xbd, 0x0b, 0xa1, 0xf2, 0xa5, 0x4c, 0xe5, 0xdb, 0x95, 0x1b, 0xf2, 0xed, 0x0f, 0xc9,
-	0xea, 0x08, 0x93, 0xcc, 0xeb, 0x53, 0xc5, 0x95, 0x4c, 0x68, 0xe8, 0x0f, 0x48, 0x1d, 0x3b, 0x54,
-	0x61, 0xb6, 0xa8, 0xdc, 0x00, 0x03, 0xb9, 0x0b, 0x60, 0x31, 0x1f, 0x33, 0x9d, 0xe6, 0x52, 0x4e,
-	0x9e, 0xcd, 0x67, 0x1a, 0xdb, 0xa0, 0xb0, 0x9c, 0x57, 0xba, 0x13, 0x06, 0x05, 0x8b, 0xc6, 0xc7,
-	0xb1, 0xb3, 0x34, 0x89, 0x4a, 0xb7, 0x62, 0x09, 0xf1, 0xbd, 0x34, 0x89, 0xa6, 0xd5, 0x55, 0xbb,
-	0x41, 0x5d, 0xff, 0xb6, 0x40, 0x56, 0xf2, 0xd8, 0x06, 0x51, 0x1d, 0x73, 0x22, 0x73, 0x36, 0x0c,
-	0x03, 0x7d, 0xe9, 0xdc, 0x96, 0x8d, 0x0c, 0xd9, 0xf4, 0x39, 0x59, 0x44, 0x8e, 0x69, 0x20, 0xbc,
-	0xbd, 0x55, 0x9e, 0x6d, 0x0b, 0xa5, 0xba, 0x05, 0xd0, 0xcd, 0xa8, 0x1b, 0xff, 0x35, 0x9f, 0xe5,
-	0x16, 0x1a, 0x07, 0x15, 0x26, 0x69, 0x30, 0x08, 0x62, 0xa6, 0xf3, 0x8c, 0x35, 0xa3, 0x42, 0x8d,
-	0xfe, 0x86, 0x45, 0xd0, 0x19, 0xec, 0x14, 0xe1, 0x0b, 0x8b, 0xa0, 0x3f, 0x13, 0x98, 0x22, 0x7c,
-	0x09, 0x91, 0x70, 0x9c, 0x84, 0xa3, 0xa9, 0xf7, 0xf2, 0x19, 0x86, 0x61, 0x4d, 0x84, 0x3c, 0x0b,
-	0x56, 0xa8, 0xf6, 0x6a, 0x1e, 0xd6, 0x40, 0x70, 0x62, 0xde, 0xa2, 0xe6, 0xa9, 0x65, 0x96, 0x2e,
-	0xda, 0xda, 0xcf, 0xf3, 0xce, 0x2c, 0x5f, 0x9c, 0x4e, 0x58, 0x6a, 0x37, 0x25, 0x2c, 0x9b, 0xa4,
-	0x06, 0xc5, 0x49, 0x2c, 0xc2, 0x52, 0xae, 0x6a, 0x40, 0x28, 0x17, 0x86, 0x81, 0xf2, 0xce, 0x4b,
-	0x75, 0xb1, 0x86, 0x8a, 0xf2, 0x8a, 0xcc, 0x96, 0x57, 0x8f, 0xc8, 0x92, 0x4e, 0xe1, 0xe2, 0x51,
-	0x84, 0xbd, 0xbb, 0xbc, 0xd7, 0x84, 0xf0, 0xd1, 0x28, 0xc2, 0x86, 0xa0, 0xa1, 0xb0, 0x73, 0x1e,
-	0xfb, 0xa1, 0xc0, 0xf6, 0x5d, 0xd1, 0x10, 0xcc, 0x98, 0xaf, 0x50, 0x86, 0x09, 0xf3, 0x50, 0xf0,
-	0x0b, 0x91, 0x32, 0xbd, 0x83, 0x52, 0x17, 0x6f, 0x39, 0x93, 0xe1, 0x95, 0x9b, 0x80, 0x5a, 0x53,
-	0x1e, 0xfb, 0x49, 0xc4, 0xa4, 0x10, 0x3e, 0xb6, 0xf1, 0xf2, 0x0b, 0xa7, 0x05, 0x3d, 0x21, 0xfc,
-	0x22, 0xd3, 0x0c, 0xc5, 0x58, 0x84, 0xd8, 0xc7, 0x2b, 0x67, 0x9a, 0x07, 0x80, 0x03, 0x2d, 0x90,
-	0x4c, 0x8a, 0x58, 0x81, 0x8e, 0xb1, 0x9b, 0x67, 0x8c, 0x92, 0x04, 0xb2, 0x97, 0xe1, 0x70, 0x3b,
-	0x02, 0xc9, 0x78, 0x74, 0x8a, 0x05, 0xd1, 0x9a, 0x5d, 0xef, 0x04, 0x72, 0x5b, 0xc3, 0xee, 0x55,
-	0x11, 0xb6, 0xf5, 0x5a, 0x0f, 0x25, 0x86, 0x23, 0x08, 0xda, 0xb3, 0xf9, 0x6e, 0x1d, 0x5f, 0xa7,
-	0xc2, 0x49, 0xd9, 0xfd, 0xae, 0xea, 0x75, 0xfd, 0xae, 0x07, 0x04, 0xc8, 0xb3, 0x2e, 0xaa, 0x26,
-	0x62, 0x85, 0x17, 0xee, 0x45, 0xe1, 0x27, 0x7f, 0xe5, 0x76, 0xfb, 0x6f, 0x16, 0xd1, 0xa7, 0x13,
-	0x7b, 0xe9, 0x64, 0xa8, 0x84, 0x6f, 0xde, 0x1c, 0x0b, 0x03, 0x94, 0x07, 0xe7, 0x30, 0xac, 0x0c,
-	0x1d, 0x36, 0x44, 0x95, 0xd2, 0xd2, 0x2f, 0xc4, 0x04, 0xd2, 0x29, 0xf7, 0x9f, 0xaa, 0x45, 0x33,
-	0xff, 0x55, 0xfe, 0x55, 0x07, 0x18, 0x18, 0x5e, 0x81, 0x92, 0x46, 0x34, 0x04, 0xd6, 0x30, 0x4c,
-	0x83, 0x88, 0xa7, 0x13, 0xa6, 0x78, 0x3a, 0x10, 0xe5, 0x24, 0x76, 0x39, 0x93, 0xf5, 0x51, 0x44,
-	0x9f, 0x82, 0x5f, 0xc1, 0x77, 0xef, 0x52, 0x25, 0x43, 0x96, 0xa5, 0x0d, 0x79, 0xa7, 0x41, 0xcb,
-	0x7a, 0x2a, 0x19, 0x6e, 0x2b, 0x48, 0x20, 0x73, 0x2e, 0x4f, 0x15, 0x90, 0x4b, 0x2f, 0x24, 0x0d,
-	0x99, 0xa7, 0x6a, 0x5b, 0xd1, 0x1f, 0x90, 0x3b, 0x86, 0x1d, 0x26, 0x97, 0x7e, 0x72, 0x19, 0xb3,
-	0x53, 0x31, 0x08, 0xca, 0x2f, 0x93, 0xd6, 0xb3, 0x31, 0x19, 0x63, 0x07, 0x08, 0x50, 0x89, 0x4c,
-	0x8f, 0x14, 0xb1, 0x5f, 0xba, 0xd5, 0x6b, 0xe5, 0x71, 0x9d, 0xd8, 0xa7, 0x9f, 0x92, 0xdb, 0xd3,
-	0xa3, 0x52, 0xae, 0xca, 0xdd, 0x2f, 0x5a, 0x1e, 0xd6, 0xe5, 0x4a, 0xb8, 0xff, 0x5a, 0x29, 0x5e,
-	0xd2, 0x5b, 0x1a, 0xde, 0x24, 0xb5, 0x14, 0x3c, 0x87, 0x2c, 0xd7, 0xef, 0x06, 0x44, 0xb7, 0x63,
-	0x9e, 0x13, 0x8a, 0x78, 0x90, 0xbd, 0x95, 0xab, 0xe6, 0x57, 0x34, 0x13, 0x1e, 0xa0, 0x8c, 0xbe,
-	0x4f, 0x96, 0xcb, 0xcb, 0xb2, 0xb3, 0xb3, 0xa6, 0xb4, 0x16, 0x44, 0x7f, 0x48, 0x5a, 0xe5, 0xf3,
-	0x63, 0xc5, 0x05, 0xb0, 0xf5, 0x7d, 0xa7, 0x74, 0x92, 0x1d, 0x73, 0x1b, 0xf2, 0x3c, 0x17, 0x3f,
-	0x78, 0xb2, 0xbd, 0xa6, 0xce, 0x73, 0xfb, 0x41, 0x24, 0xdc, 0xe7, 0x45, 0xef, 0x66, 0x27, 0x4d,
-	0xb8, 0x0f, 0xb5, 0x3e, 0xcb, 0xde, 0x90, 0xe3, 0xbb, 0xbb, 0xa8, 0xfc, 0x51, 0x10, 0x00, 0x4f,
-	0xff, 0xae, 0x42, 0x9a, 0x47, 0x9d, 0x3e, 0xcb, 0x6a, 0x01, 0x49, 0x1b, 0xa4, 0x16, 0x0b, 0xc5,
-	0x8e, 0x5e, 0x1f, 0x3b, 0xb7, 0x28, 0xd5, 0xed, 0xdc, 0xe2, 0x7b, 0x12, 0xa7, 0x42, 0x9b, 0xa4,
-	0x0e, 0xd8, 0x5e, 0x10, 0x0a, 0xa7, 0x4a, 0xef, 0x91, 0x75, 0xf8, 0x35, 0xf5, 0x5d, 0x86, 0x33,
-	0x67, 0x68, 0xfd, 0xc0, 0xbb, 0x70, 0xe6, 0xe9, 0x1a, 0x59, 0x46, 0x9a, 0xf9, 0x50, 0xc9, 0x59,
-	0xc8, 0x21, 0xf3, 0xd9, 0x8b, 0xb3, 0x48, 0xd7, 0xc9, 0x2a, 0x42, 0xc5, 0xf7, 0x46, 0x4e, 0x8d,
-	0xb6, 0xc8, 0x6d, 0x00, 0xa7, 0x3f, 0xe2, 0x70, 0xfc, 0xa7, 0x7f, 0x58, 0x25, 0xcd, 0xf6, 0x41,
-	0xbb, 0x58, 0x3b, 0x25, 0x2b, 0x5e, 0xe8, 0x59, 0x9f, 0x65, 0x38, 0x75, 0x58, 0x07, 0x60, 0x87,
-	0xc9, 0x58, 0x38, 0x4b, 0xf0, 0x50, 0xf8, 0x95, 0x77, 0x3b, 0x1c, 0x02, 0x0f, 0x05, 0xc8, 0x7a,
-	0xdf, 0xed, 0x34, 0xe8, 0x6d, 0xe2, 0x00, 0x68, 0xbf, 0x9b, 0x76, 0x9a, 0xb0, 0x14, 0x40, 0xa7,
-	0xdf, 0x3c, 0x3b, 0xcb, 0x86, 0x6f, 0xbf, 0xfb, 0x75, 0x56, 0x40, 0x39, 0x38, 0x4b, 0xf9, 0x7d,
-	0xa3, 0xb3, 0x4a, 0x37, 0xc8, 0x5d, 0x10, 0xcc, 0xbe, 0x4b, 0x74, 0x1c, 0x7a, 0x87, 0xac, 0x15,
-	0x9b, 0xc8, 0xb6, 0xe6, 0xac, 0x99, 0x27, 0xd8, 0xae, 0xcc, 0xa1, 0x66, 0xc7, 0x85, 0x8d, 0x3b,
-	0xb7, 0x9f, 0x1e, 0x90, 0xb5, 0x99, 0x6e, 0x0e, 0x3c, 0x71, 0xa6, 0x9f, 0xd3, 0xeb, 0x77, 0xb6,
-	0x0f, 0x9d, 0x5b, 0xf4, 0x6d, 0x72, 0x53, 0xaf, 0xc7, 0xa9, 0x3c, 0x9d, 0x90, 0x7b, 0x37, 0x94,
-	0xdb, 0x30, 0xee, 0x86, 0x82, 0xdb, 0xb9, 0x45, 0x37, 0xc9, 0xc6, 0xb4, 0xb0, 0xdb, 0x39, 0x7c,
-	0x7d, 0xd2, 0x41, 0x79, 0x85, 0xde, 0x27, 0x6f, 0x4d, 0xcb, 0xfb, 0x5f, 0x1c, 0x77, 0xd8, 0xce,
-	0x7e, 0xbf, 0xe7, 0x54, 0x36, 0xaa, 0x4e, 0xe5, 0xe9, 0x37, 0xf3, 0xa4, 0xd9, 0x3b, 0x29, 0x9f,
-	0xaf, 0x1c, 0x7b, 0xd6, 0x0b, 0x50, 0xa7, 0x0e, 0x27, 0xaa, 0xb1, 0xec, 0x45, 0x80, 0x3e, 0x64,
-	0x80, 0xf2, 0x17, 0x2b, 0x0e, 0xa1, 0x0e, 0x69, 0x6a, 0x96, 0xee, 0x51, 0x3b, 0x0d, 0xfa, 0x16,
-	0xb9, 0x83, 0xa4, 0xe9, 0x36, 0xb2, 0xd3, 0x34, 0xa2, 0x99, 0x06, 0xa8, 0xb3, 0x6c, 0xa6, 0xce,
-	0x1b, 0x90, 0xce, 0x4a, 0x09, 0x42, 0x93, 0x5a, 0xa5, 0xcb, 0x64, 0x09, 0x20, 0x6c, 0x9e, 0x3b,
-	0x0e, 0x5d, 0x21, 0x04, 0x1f, 0x8e, 0x39, 0x99, 0xb3, 0x46, 0x57, 0x49, 0x23, 0x5b, 0xcc, 0x49,
-	0x20, 0x2e, 0x1d, 0x6a, 0x56, 0x67, 0xfa, 0x54, 0xce, 0x3a, 0xbd, 0x4b, 0xa8, 0x5e, 0x9d, 0xdd,
-	0x60, 0x72, 0x6e, 0x1b, 0xa6, 0xe9, 0xff, 0x38, 0x77, 0xc0, 0x7c, 0x71, 0xb2, 0xe2, 0x88, 0x9c,
-	0xbb, 0x06, 0xb4, 0x8a, 0x7c, 0xe7, 0x1e, 0x18, 0x16, 0x80, 0x59, 0x18, 0xce, 0xe0, 0x96, 0x59,
-	0x7f, 0x5e, 0x71, 0x38, 0x6f, 0x99, 0xa7, 0x97, 0xb3, 0x7a, 0x67, 0x03, 0x6c, 0x10, 0x70, 0xbb,
-	0xec, 0x70, 0xde, 0x36, 0x6b, 0x32, 0xfd, 0x2c, 0xe7, 0x7b, 0x70, 0xe7, 0x00, 0x39, 0x14, 0xf1,
-	0xc8, 0xb9, 0x6f, 0x9e, 0x5b, 0x2a, 0x69, 0x9c, 0x4d, 0x33, 0x99, 0xdd, 0x46, 0x71, 0x1e, 0x18,
-	0xd4, 0xee, 0x0e, 0x38, 0x8f, 0x0c, 0x5a, 0x32, 0x7e, 0xb7, 0xd8, 0x90, 0x15, 0xa1, 0x9d, 0xc7,
-	0xc6, 0x4a, 0xac, 0x3b, 0xf1, 0xff, 0xcc, 0x91, 0xce, 0xf8, 0x45, 0xe7, 0xdd, 0xa7, 0xbf, 0x57,
-	0x21, 0x6b, 0x9a, 0x87, 0xab, 0x03, 0xcb, 0x66, 0x0a, 0xae, 0x6e, 0xb7, 0x73, 0x7c, 0xb0, 0xfd,
-	0x05, 0xeb, 0x9c, 0x74, 0x8e, 0xfa, 0xac, 0xbd, 0x7d, 0xd4, 0xee, 0x1c, 0x38, 0xb7, 0x40, 0x37,
-	0x25, 0xc1, 0x6e, 0x67, 0xbb, 0xff, 0xca, 0xa9, 0x80, 0x6f, 0x28, 0xe1, 0x2f, 0x3b, 0x47, 0x9d,
-	0xee, 0x7e, 0xdb, 0xa9, 0xd2, 0xf7, 0xc8, 0xe3, 0x92, 0xa4, 0xd7, 0x7f, 0xd3, 0xfe, 0x11, 0x3b,
-	0xea, 0x74, 0x76, 0xd9, 0xde, 0x9b, 0x83, 0x03, 0xf6, 0xe6, 0x78, 0x77, 0xbb, 0xdf, 0x71, 0xe6,
-	0x76, 0x1e, 0xfe, 0xf4, 0xeb, 0xcd, 0xca, 0xcf, 0xbe, 0xde, 0xac, 0xfc, 0xe2, 0xeb, 0xcd, 0xca,
-	0x1f, 0x7c, 0xb3, 0x79, 0xeb, 0x67, 0xdf, 0x6c, 0xde, 0xfa, 0xc7, 0x6f, 0x36, 0x6f, 0x7d, 0x39,
-	0x17, 0xc9, 0xc1, 0xef, 0x54, 0x6e, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xa9, 0xb1,
-	0xb6, 0x8b, 0x2c, 0x00, 0x00,
+	// 4345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4b, 0x6c, 0x24, 0x49,
+	0x5a, 0xee, 0x2a, 0x3f, 0xaa, 0x2a, 0xaa, 0x6c, 0xa7, 0xd3, 0xee, 0xee, 0x1c, 0xcf, 0xb6, 0xbb,
+	0x3b, 0x9b, 0x99, 0xe9, 0x69, 0x66, 0xbc, 0xa3, 0xee, 0x99, 0x61, 0xe9, 0x11, 0x07, 0xbb, 0x5c,
+	0xee, 0xb6, 0xd6, 0xaf, 0xad, 0xaa, 0xf6, 0x30, 0x23, 0xa4, 0x50, 0x38, 0x33, 0x5c, 0x4e, 0x39,
+	0x1f, 0xb5, 0x19, 0x51, 0x65, 0xd7, 0x48, 0x08, 0x4e, 0x70, 0x43, 0x1c, 0x40, 0x08, 0x09, 0x09,
+	0x38, 0x21, 0x21, 0xce, 0x20, 0x84, 0xc4, 0x79, 0x8f, 0x7b, 0xe4, 0xb4, 0xac, 0x66, 0x4e, 0x48,
+	0x9c, 0x10, 0x08, 0x6e, 0xa0, 0x3f, 0x1e, 0x99, 0x11, 0x55, 0xf6, 0xec, 0x2c, 0x70, 0xb1, 0x5c,
+	0xdf, 0xff, 0x45, 0xe4, 0x9f, 0x7f, 0x44, 0xfc, 0xaf, 0x48, 0xb4, 0x9a, 0x52, 0x9e, 0x50, 0xc6,
+	0xc8, 0x80, 0xb2, 0xad, 0x61, 0x9e, 0xf1, 0x6c, 0xe3, 0xd1, 0x20, 0xcb, 0x06, 0x31, 0xfd, 0xbe,
+	0xf8, 0x75, 0x36, 0x3a, 0xff, 0x7e, 0x48, 0x59, 0x90, 0x47, 0x43, 0x9e, 0xe5, 0x92, 0xe1, 0xbf,
+	0x46, 0xa8, 0x7d, 0xc8, 0x06, 0xa7, 0x34, 0xe0, 0x59, 0xee, 0xba, 0xa8, 0x72, 0xed, 0x55, 0x1e,
+	0x55, 0x9e, 0x56, 0x77, 0xe6, 0x7f, 0xf2, 0xb3, 0x87, 0x77, 0xba, 0x95, 0x6b, 0xc0, 0x26, 0x5e,
+	0xd5, 0xc4, 0x26, 0x80, 0x7d, 0xe5, 0xcd, 0x99, 0xd8, 0x57, 0xfe, 0xa7, 0xa8, 0x55, 0xce, 0xf4,
+	0x7c, 0xf7, 0xbb, 0xce, 0xa5, 0x35, 0xf8, 0xd1, 0x76, 0x3a, 0x88, 0xe9, 0xff, 0x49, 0x83, 0x2f,
+	0x51, 0x1d, 0x66, 0xea, 0xbe, 0xda, 0xd9, 0x06, 0x79, 0x2e, 0xe6, 0x59, 0xd0, 0x72, 0xf1, 0x76,
+	0x03, 0xaf, 0x6a, 0x62, 0x03, 0xc0, 0xce, 0xbc, 0x39, 0x13, 0x3b, 0x03, 0x8c, 0x78, 0xf3, 0x26,
+	0x46, 0xfc, 0xbf, 0xab, 0xa2, 0x56, 0xfb, 0xa8, 0xd3, 0x3f, 0x64, 0x03, 0xdc, 0x8f, 0x82, 0x4b,
+	0xd7, 0x43, 0xf3, 0x3c, 0x0a, 0x2e, 0xc5, 0x33, 0x96, 0x14, 0x4f, 0x20, 0xee, 0xaf, 0xa1, 0xf5,
+	0x8b, 0x8c, 0x71, 0x1c, 0x64, 0xc9, 0x70, 0xc4, 0x09, 0x8f, 0xb2, 0x94, 0x47, 0x09, 0xf5, 0xe6,
+	0x0d, 0xe6, 0x1a, 0x30, 0xda, 0x36, 0xc1, 0xfd, 0x11, 0xf2, 0x6f, 0x1a, 0x88, 0x19, 0x0f, 0x71,
+	0x48, 0xc7, 0x91, 0x40, 0xbc, 0x05, 0x63, 0x9a, 0x87, 0x37, 0x4c, 0xd3, 0xe3, 0xe1, 0xae, 0x26,
+	0xbb, 0xc7, 0xe8, 0xb1, 0x98, 0xf2, 0x3c, 0x27, 0x09, 0x65, 0x9c, 0xe4, 0xfc, 0x86, 0x19, 0x17,
+	0x8d, 0x19, 0x37, 0x81, 0xbe, 0x67, 0xb1, 0xad, 0x09, 0x3f, 0x42, 0xab, 0x17, 0x31, 0x1f, 0xe3,
+	0x9c, 0x0e, 0x63, 0x32, 0xc1, 0xe7, 0x31, 0x19, 0x30, 0xaf, 0x66, 0x4c, 0xb0, 0x02, 0xe2, 0xae,
+	0x90, 0xee, 0x81, 0xd0, 0x7f, 0x81, 0x56, 0xb5, 0xe1, 0x7a, 0x3c, 0x8f, 0xd2, 0x41, 0x3b, 0x09,
+	0xdd, 0x4d, 0x54, 0x0b, 0xb2, 0x24, 0x21, 0x69, 0x28, 0x0c, 0xd8, 0x50, 0x83, 0x35, 0xe8, 0xff,
+	0x4b, 0x05, 0xad, 0x15, 0xa3, 0xa2, 0x41, 0x9a, 0xa5, 0x3d, 0x4e, 0x38, 0x75, 0xdf, 0x43, 0x2d,
+	0x26, 0x7e, 0x62, 0x06, 0xbf, 0x2d, 0xeb, 0x37, 0x99, 0x41, 0x7c, 0x07, 0x35, 0xd9, 0x90, 0x5c,
+	0xa5, 0x38, 0xc8, 0x46, 0x29, 0xf7, 0xaa, 0x06, 0x0f, 0x09, 0x41, 0x1b, 0x70, 0xf7, 0x39, 0x72,
+	0xd3, 0x51, 0x82, 0x19, 0xcd, 0xc7, 0x34, 0xc7, 0xa0, 0x34, 0xcd, 0x99, 0x37, 0x67, 0xb0, 0x9d,
+	0x74, 0x94, 0xf4, 0x84, 0xf8, 0x44, 0x4a, 0xdd, 0x0f, 0x91, 0xab, 0x88, 0x38, 0xa5, 0xfc, 0x2a,
+	0xcb, 0x2f, 0xa3, 0x90, 0x79, 0xf3, 0x8f, 0xe6, 0x9e, 0x36, 0xba, 0xab, 0x4a, 0x72, 0x54, 0x08,
+	0xdc, 0x87, 0xa8, 0x9e, 0x90, 0x21, 0x4e, 0x49, 0x42, 0xbd, 0x05, 0xf3, 0x5d, 0x13, 0x32, 0x3c,
+	0x22, 0x09, 0xf5, 0xff, 0xb2, 0x22, 0x4f, 0x00, 0x6e, 0x9f, 0x92, 0x9c, 0xb9, 0xef, 0xa2, 0x85,
+	0x60, 0x4c, 0x72, 0xe6, 0x55, 0x1e, 0xcd, 0x3d, 0x6d, 0x3e, 0x77, 0xb6, 0x4a, 0xd9, 0x16, 0xfc,
+	0xed, 0x4a, 0xf1, 0xc6, 0x25, 0x9a, 0x87, 0x9f, 0xb0, 0x11, 0xc5, 0xdc, 0xa6, 0x1d, 0x05, 0xe2,
+	0x6e, 0xa0, 0x85, 0x31, 0x89, 0x47, 0xd4, 0xab, 0x1a, 0x22, 0x09, 0xb9, 0x1f, 0xa2, 0x95, 0x30,
+	0x0a, 0x60, 0x49, 0x49, 0x3e, 0x91, 0xca, 0x99, 0x6f, 0xbd, 0x5c, 0x0a, 0x85, 0x8e, 0x2f, 0x8d,
+	0x45, 0xa4, 0xbc, 0x9d, 0xa5, 0xf0, 0xe4, 0x77, 0x60, 0x11, 0x53, 0xa5, 0x6b, 0xe5, 0x69, 0xf3,
+	0x79, 0xd3, 0xd0, 0xb5, 0xab, 0x65, 0xfe, 0x12, 0x6a, 0xea, 0xb1, 0x47, 0xc7, 0x27, 0xfe, 0x16,
+	0x72, 0xf5, 0xcf, 0xdd, 0x88, 0x05, 0x59, 0x9a, 0xd2, 0x80, 0x8b, 0xe3, 0x44, 0xaf, 0xb9, 0xfd,
+	0x16, 0x80, 0xf8, 0x7f, 0x55, 0x29, 0x4f, 0xde, 0x5e, 0x14, 0x8b, 0xa5, 0xe5, 0x39, 0x49, 0xd9,
+	0x39, 0xcd, 0x71, 0x14, 0x5a, 0x87, 0x1c, 0x69, 0xc1, 0x7e, 0xe8, 0x3e, 0x46, 0x8d, 0xf3, 0x28,
+	0xa6, 0xf2, 0xdd, 0x4c, 0x0b, 0xd4, 0x01, 0x86, 0xb7, 0x72, 0x5f, 0xa0, 0xb5, 0x88, 0xe9, 0xad,
+	0x1c, 0xd2, 0x24, 0xc3, 0x20, 0x12, 0x86, 0xa8, 0xeb, 0xe5, 0x8f, 0x98, 0xdc, 0xcc, 0xbb, 0x34,
+	0xc9, 0xc4, 0xe3, 0x3d, 0x34, 0x1f, 0xd2, 0x74, 0xe2, 0xcd, 0x1b, 0x2c, 0x81, 0xf8, 0x2f, 0xd0,
+	0xfd, 0xc2, 0x48, 0xc3, 0x38, 0xe2, 0xbd, 0x20, 0xa7, 0x34, 0x7d, 0xc3, 0xa8, 0x58, 0x24, 0x16,
+	0x67, 0xdc, 0x52, 0x56, 0x20, 0xfe, 0x29, 0xf2, 0xf4, 0x20, 0xb9, 0xc1, 0xb6, 0xc7, 0x84, 0x93,
+	0x7c, 0x97, 0x70, 0xe2, 0xfa, 0xa8, 0x41, 0x02, 0xb1, 0x81, 0xd5, 0x7b, 0xea, 0xe5, 0x29, 0x61,
+	0xf7, 0x1e, 0x9a, 0xcb, 0x07, 0x67, 0xe2, 0x05, 0x5b, 0x4a, 0x0a, 0x80, 0xff, 0x67, 0x55, 0xe4,
+	0xb6, 0xdb, 0x07, 0x72, 0x41, 0xe2, 0x88, 0xa6, 0x7c, 0x3f, 0x3d, 0xcf, 0xdc, 0x67, 0x68, 0x99,
+	0xd1, 0x34, 0xc4, 0x9c, 0x9c, 0xc5, 0x14, 0x07, 0x79, 0x20, 0xe6, 0xad, 0xa9, 0x91, 0x2d, 0x90,
+	0xf5, 0x41, 0xd4, 0xce, 0x03, 0x71, 0xd8, 0xe4, 0xc1, 0x98, 0x3d, 0x44, 0x4d, 0x29, 0x91, 0xa7,
+	0xe8, 0x01, 0xaa, 0x45, 0x0c, 0xc3, 0xc1, 0xb7, 0x6c, 0xb7, 0x18, 0xb1, 0xd7, 0x31, 0x1f, 0xc3,
+	0x4a, 0x14, 0x66, 0xb6, 0xcc, 0x56, 0xd7, 0xc6, 0x75, 0x9f, 0x20, 0x74, 0x9e, 0x47, 0x34, 0x0d,
+	0x19, 0x2c, 0xa9, 0xe9, 0xe2, 0x1a, 0x0a, 0xdf, 0x0f, 0x41, 0x1f, 0x4d, 0x12, 0x8b, 0xba, 0x68,
+	0x2c, 0x6a, 0x53, 0x49, 0xc4, 0xba, 0x3e, 0x46, 0xad, 0x60, 0xc4, 0x78, 0x96, 0x88, 0xf5, 0x04,
+	0xff, 0x34, 0xf7, 0xb4, 0xd6, 0x6d, 0x4a, 0x0c, 0x16, 0x91, 0xf9, 0x7f, 0x00, 0xbb, 0x4a, 0x99,
+	0xe7, 0x30, 0x1b, 0x53, 0xf7, 0x63, 0xb4, 0x06, 0x9e, 0xe0, 0x8c, 0x04, 0x97, 0xa3, 0x21, 0x56,
+	0x7e, 0x88, 0x59, 0x56, 0x5f, 0x4d, 0x47, 0xc9, 0x8e, 0x90, 0xb7, 0x95, 0xd8, 0xdd, 0x42, 0xe0,
+	0x1f, 0x70, 0x4a, 0xaf, 0xca, 0x21, 0xa6, 0x99, 0x96, 0xd3, 0x51, 0x72, 0x44, 0xaf, 0x0a, 0x3e,
+	0x6c, 0x1e, 0xc2, 0x89, 0x37, 0x67, 0x2c, 0x97, 0x40, 0xfc, 0xbf, 0xae, 0xa2, 0x55, 0xad, 0xd0,
+	0x69, 0x16, 0x05, 0x54, 0xec, 0x00, 0xcd, 0xaf, 0x4c, 0xf3, 0x41, 0x72, 0x3d, 0x8a, 0x42, 0xf1,
+	0xb4, 0x45, 0x2d, 0x01, 0xc4, 0x6d, 0xa3, 0xc5, 0xf3, 0x2c, 0x4f, 0x08, 0x17, 0x4f, 0x59, 0x7e,
+	0xee, 0x6e, 0x15, 0xf3, 0xed, 0x09, 0x1c, 0xf3, 0x97, 0xf7, 0x4f, 0x8f, 0xf7, 0xdb, 0x9d, 0xdd,
+	0xed, 0xfe, 0x36, 0xde, 0x3b, 0xee, 0x1e, 0x6e, 0xf7, 0x71, 0xe7, 0xe8, 0xd5, 0xfe, 0x51, 0xa7,
+	0xab, 0x86, 0xba, 0xbf, 0x0a, 0xfb, 0xe4, 0xc7, 0x23, 0x9a, 0x06, 0x14, 0x9f, 0x4d, 0x38, 0x65,
+	0x56, 0x40, 0x5c, 0xd2, 0xb2, 0x1d, 0x10, 0x49, 0xb2, 0xf0, 0x17, 0x38, 0x1d, 0x25, 0x67, 0x34,
+	0xb7, 0x56, 0x70, 0x49, 0xc9, 0x8e, 0x84, 0xc8, 0xdd, 0x41, 0x1b, 0xa3, 0x14, 0x42, 0x5c, 0x4e,
+	0x19, 0xa3, 0x21, 0x66, 0x24, 0x19, 0xc6, 0x14, 0x67, 0xe7, 0xe7, 0x8c, 0x72, 0x2b, 0x16, 0x79,
+	0x26, 0xaf, 0x27, 0x68, 0xc7, 0x82, 0xe5, 0x0f, 0xd0, 0x9a, 0xb6, 0xd5, 0x0e, 0x61, 0x34, 0x8e,
+	0x52, 0xba, 0x1d, 0x5c, 0xba, 0xef, 0xa3, 0xa5, 0x33, 0xf5, 0x13, 0x17, 0xc1, 0x59, 0xeb, 0xdc,
+	0xd2, 0x22, 0x11, 0xbe, 0xdf, 0x41, 0xcd, 0x82, 0x9a, 0xe6, 0x56, 0x56, 0x80, 0xb4, 0xe0, 0x28,
+	0xf7, 0x3f, 0x41, 0xeb, 0xfa, 0x41, 0x07, 0x11, 0xe3, 0x34, 0xed, 0x8c, 0x69, 0xca, 0x99, 0xfb,
+	0x00, 0x21, 0x0a, 0xff, 0xe1, 0x84, 0xb0, 0x4b, 0xe1, 0xa9, 0x6b, 0xdd, 0x86, 0x40, 0x0e, 0x09,
+	0xbb, 0xf4, 0xff, 0xb8, 0x82, 0x3c, 0x3d, 0xae, 0x4b, 0xd9, 0x30, 0x4b, 0xc3, 0xf6, 0x98, 0xe4,
+	0xa7, 0xc2, 0xf5, 0x7e, 0x0f, 0x2d, 0x06, 0x59, 0x76, 0x19, 0x51, 0x4b, 0x3d, 0x85, 0x89, 0xc0,
+	0xc5, 0x09, 0x1f, 0x31, 0x1c, 0x64, 0x21, 0xb5, 0x15, 0x93, 0x82, 0x76, 0x16, 0xd2, 0xc2, 0xeb,
+	0xcf, 0xdd, 0xee, 0xf5, 0xe7, 0x67, 0xbc, 0xbe, 0xff, 0x17, 0x73, 0xe5, 0xfb, 0xc0, 0x39, 0x68,
+	0x77, 0xdb, 0xed, 0x0b, 0x1a, 0x5c, 0xc2, 0x11, 0x85, 0xc7, 0xe1, 0x21, 0xe1, 0x17, 0x96, 0x5a,
+	0x75, 0x80, 0x4f, 0x08, 0xbf, 0x80, 0x27, 0x0a, 0xa9, 0xe9, 0x4a, 0x05, 0x02, 0x66, 0x17, 0x83,
+	0xe1, 0xb0, 0x15, 0x4a, 0x15, 0x66, 0x07, 0xd1, 0x9e, 0x92, 0xb8, 0x8f, 0x50, 0xbd, 0x60, 0xcd,
+	0x4f, 0xfb, 0x64, 0xc1, 0x78, 0x1f, 0x2d, 0xc1, 0xff, 0x90, 0xb1, 0x04, 0x45, 0xbe, 0x53, 0x4c,
+	0x06, 0xa2, 0x3d, 0x25, 0x01, 0xd7, 0x97, 0x84, 0x9f, 0x78, 0x8b, 0xc6, 0xd9, 0x00, 0x00, 0x70,
+	0x70, 0x6c, 0x66, 0x56, 0x02, 0x00, 0xf8, 0x3e, 0x31, 0xf5, 0x05, 0x61, 0x17, 0x98, 0x4f, 0x86,
+	0xd4, 0xab, 0x4f, 0xcf, 0xfd, 0x9a, 0xb0, 0x8b, 0xfe, 0x64, 0x48, 0x21, 0x6a, 0x0b, 0x6e, 0x4c,
+	0x53, 0xaf, 0x61, 0xb0, 0x6a, 0x80, 0x1e, 0xd0, 0xd4, 0x7d, 0x17, 0xb5, 0x86, 0x24, 0xb8, 0x14,
+	0x2f, 0x0d, 0x3e, 0x0b, 0x99, 0x0b, 0x05, 0x12, 0x78, 0xe7, 0xfd, 0x10, 0x3c, 0x44, 0xc9, 0x53,
+	0xa7, 0xa3, 0x69, 0x70, 0x97, 0x35, 0x57, 0x1e, 0x0f, 0xff, 0x33, 0x74, 0xbf, 0xd8, 0x71, 0x19,
+	0x09, 0xa3, 0x74, 0x70, 0x92, 0x67, 0x03, 0x38, 0x03, 0x60, 0xbc, 0xa1, 0xfa, 0xdf, 0x5e, 0x23,
+	0x8d, 0xfa, 0x6d, 0xb4, 0xa1, 0x07, 0x8b, 0x08, 0x24, 0x23, 0x4a, 0x5b, 0xc5, 0xd8, 0xef, 0x18,
+	0xaf, 0x5f, 0x96, 0x7b, 0xa4, 0x9d, 0x84, 0x3f, 0xa4, 0x13, 0xb1, 0x6d, 0x19, 0x44, 0xa3, 0x4b,
+	0x3a, 0x11, 0x1b, 0x89, 0x59, 0x0e, 0xa9, 0x84, 0xfd, 0xff, 0x5c, 0x44, 0x6e, 0xbb, 0x77, 0x2a,
+	0x35, 0x10, 0x11, 0x42, 0x44, 0x1d, 0xa9, 0x39, 0xcf, 0x82, 0x2c, 0x9e, 0xd1, 0x5c, 0xa0, 0x37,
+	0xc6, 0x9a, 0x85, 0x9b, 0x62, 0xcd, 0x7b, 0xa8, 0x15, 0x31, 0x1c, 0xd2, 0x30, 0x0a, 0x08, 0xa7,
+	0xa1, 0x15, 0x70, 0x9a, 0x11, 0xdb, 0xd5, 0x02, 0xf7, 0x37, 0x90, 0x17, 0x31, 0x70, 0x2b, 0x51,
+	0x10, 0x91, 0x18, 0x8f, 0x49, 0x3c, 0xa6, 0x2a, 0xd1, 0xb3, 0x82, 0xd0, 0xdd, 0x88, 0x1d, 0x2b,
+	0xd2, 0x29, 0x70, 0xa4, 0xda, 0x66, 0x4c, 0x5b, 0xf8, 0x45, 0x31, 0x6d, 0xf1, 0xc6, 0x98, 0xb6,
+	0x87, 0x1e, 0x08, 0x4a, 0x18, 0xe5, 0xe0, 0x00, 0xd3, 0x01, 0xe6, 0x19, 0x1e, 0xe6, 0xd9, 0xf5,
+	0x04, 0xe7, 0x14, 0x86, 0xdd, 0x35, 0x86, 0xbd, 0x05, 0xc3, 0x0a, 0x66, 0x3f, 0x3b, 0x01, 0x5e,
+	0x17, 0x68, 0xee, 0x7d, 0x34, 0x1f, 0xe0, 0x4c, 0x66, 0xd9, 0x0b, 0xc5, 0x7e, 0x3e, 0x06, 0x27,
+	0x04, 0x39, 0xa4, 0x08, 0xe2, 0x75, 0x23, 0x88, 0x2f, 0x26, 0x64, 0x08, 0xe1, 0xfb, 0x09, 0x42,
+	0x81, 0x08, 0xfc, 0x82, 0xd1, 0x30, 0x18, 0x0d, 0x89, 0x03, 0x69, 0x0b, 0x39, 0x4c, 0x64, 0xe5,
+	0x46, 0x46, 0x80, 0x0c, 0xea, 0xb2, 0x94, 0x16, 0x39, 0xc1, 0x3b, 0xa8, 0x99, 0x90, 0x6b, 0x2c,
+	0x27, 0x60, 0xd6, 0x4e, 0x46, 0x09, 0xb9, 0x96, 0x99, 0x06, 0x2b, 0x69, 0x84, 0x31, 0xca, 0xbc,
+	0xd6, 0x0c, 0x4d, 0xe0, 0x40, 0x93, 0x09, 0x33, 0x16, 0xd9, 0xd1, 0x92, 0x75, 0x86, 0x84, 0xa0,
+	0x17, 0x67, 0x1c, 0x7c, 0x02, 0xb8, 0x73, 0x1c, 0xa5, 0x9c, 0xe6, 0x63, 0x12, 0x7b, 0xcb, 0x46,
+	0xe1, 0xd7, 0x02, 0xd1, 0xbe, 0x92, 0xc0, 0xb9, 0x1d, 0x90, 0x84, 0xe2, 0x30, 0xca, 0xbd, 0x15,
+	0x33, 0xdb, 0x06, 0x74, 0x37, 0xca, 0xad, 0x74, 0xdc, 0xb9, 0x21, 0x1d, 0x07, 0x2f, 0x01, 0x84,
+	0x41, 0x9e, 0x8d, 0x14, 0x6d, 0xd5, 0xa0, 0xb5, 0x12, 0x32, 0x7c, 0x05, 0x22, 0xc1, 0x7d, 0x88,
+	0xea, 0xec, 0x52, 0xa5, 0xcf, 0xae, 0x39, 0x19, 0xbb, 0x9c, 0xa8, 0x4c, 0xa4, 0x21, 0xea, 0x2f,
+	0xc1, 0x58, 0x33, 0x1d, 0x1e, 0xc0, 0x9a, 0x32, 0x1c, 0x9d, 0xc5, 0x51, 0x80, 0xa3, 0xa1, 0xb7,
+	0x6e, 0xf8, 0xac, 0xba, 0x84, 0xf7, 0x87, 0xae, 0x8f, 0xd0, 0x68, 0x10, 0x60, 0x50, 0x2b, 0x0a,
+	0xbd, 0x7b, 0x8f, 0x2a, 0x4f, 0xe7, 0x35, 0x67, 0x34, 0x08, 0x0e, 0xc9, 0x70, 0x3f, 0xf4, 0xff,
+	0xab, 0x82, 0x56, 0xf5, 0xc9, 0x13, 0xe6, 0x15, 0x07, 0x6f, 0x0b, 0x39, 0x41, 0x4e, 0x09, 0xa7,
+	0x38, 0x4b, 0xd5, 0xa2, 0x79, 0x15, 0x63, 0xdb, 0x2d, 0x4b, 0xe9, 0x71, 0x2a, 0x17, 0xce, 0xfd,
+	0x18, 0xd5, 0xf4, 0x9a, 0x55, 0x45, 0xf9, 0xb1, 0xb1, 0x35, 0x33, 0xe9, 0x96, 0x60, 0x60, 0xde,
+	0xd5, 0xd4, 0x8d, 0xdf, 0x56, 0xa3, 0x30, 0x07, 0x8b, 0xc8, 0x7f, 0xa7, 0x32, 0x73, 0x49, 0xd8,
+	0x0f, 0xdd, 0x0f, 0xd0, 0x0a, 0xe4, 0x2f, 0x6a, 0xbb, 0xcd, 0x24, 0xe7, 0x4b, 0x20, 0x14, 0xbb,
+	0x4d, 0x18, 0x47, 0xec, 0x61, 0x98, 0x6e, 0x26, 0xd8, 0x35, 0x04, 0x2e, 0x8a, 0x93, 0xff, 0x98,
+	0x2b, 0x5f, 0xbd, 0xa7, 0x13, 0x58, 0xf7, 0x6d, 0xb4, 0x18, 0x31, 0x4c, 0xd3, 0xd0, 0x7a, 0xe1,
+	0x85, 0x88, 0x75, 0xd2, 0x10, 0x16, 0x39, 0xa5, 0xfc, 0x36, 0x25, 0x5a, 0x29, 0xe5, 0xa5, 0x0e,
+	0xef, 0xa3, 0xa5, 0x94, 0xd2, 0x10, 0x9c, 0x0e, 0xc4, 0xb2, 0xdc, 0x72, 0x39, 0x2d, 0x21, 0xda,
+	0x95, 0x12, 0xf7, 0x05, 0x5a, 0x18, 0xe6, 0xd9, 0x50, 0x56, 0x83, 0xcd, 0xe7, 0x0f, 0xb6, 0x66,
+	0xd4, 0xda, 0x82, 0x0c, 0x1b, 0x38, 0x98, 0x77, 0x25, 0x77, 0xe3, 0x6f, 0xab, 0x08, 0x95, 0xa8,
+	0xa8, 0x84, 0x20, 0x36, 0x59, 0xa5, 0x02, 0x57, 0x31, 0x69, 0x4c, 0xf2, 0x59, 0x75, 0x6b, 0x63,
+	0x92, 0x1f, 0xa9, 0xd0, 0x2f, 0x0b, 0x72, 0x33, 0x00, 0x4b, 0x48, 0xba, 0xe0, 0x28, 0xcb, 0x23,
+	0x3e, 0xb1, 0x52, 0xb9, 0x02, 0x05, 0x77, 0x12, 0xf2, 0xd9, 0x3a, 0x75, 0x31, 0x94, 0xfb, 0xf4,
+	0x3d, 0xd4, 0x82, 0x54, 0x97, 0xc6, 0x34, 0x11, 0x47, 0x7f, 0xd1, 0xf4, 0xd0, 0xe9, 0x28, 0xe9,
+	0x28, 0x01, 0x6c, 0xe8, 0x38, 0xbb, 0xc2, 0x32, 0x09, 0xa9, 0x19, 0x27, 0xb5, 0x1e, 0x67, 0x57,
+	0x32, 0x05, 0x7a, 0x82, 0xd0, 0x45, 0x34, 0xb8, 0x50, 0x9c, 0xba, 0xc1, 0x69, 0x00, 0x2e, 0x49,
+	0x0f, 0x51, 0x5d, 0x64, 0xe4, 0x11, 0x67, 0x76, 0x08, 0x86, 0x34, 0x3c, 0xe2, 0xcc, 0x7f, 0x1f,
+	0x2d, 0x69, 0xfb, 0x9e, 0xe4, 0x51, 0xfa, 0x6d, 0x45, 0xe4, 0x47, 0xc8, 0x29, 0x97, 0x82, 0x9f,
+	0x90, 0x11, 0x13, 0x79, 0xd8, 0x10, 0xfe, 0xb1, 0x37, 0x88, 0xc2, 0xfc, 0x97, 0x68, 0xc5, 0x18,
+	0x71, 0x1a, 0xd1, 0x2b, 0xb0, 0x00, 0x4d, 0x79, 0xc4, 0x27, 0x38, 0x4a, 0x43, 0x7a, 0x6d, 0xad,
+	0x50, 0x53, 0x4a, 0xf6, 0x41, 0xe0, 0xff, 0xac, 0x8a, 0xde, 0x2a, 0x8e, 0x8d, 0x38, 0x60, 0xbd,
+	0xd2, 0x8b, 0x7e, 0x4b, 0xc1, 0xae, 0xbc, 0x26, 0x4d, 0x79, 0x1e, 0x51, 0x66, 0xe7, 0x7e, 0x09,
+	0xb9, 0xee, 0x48, 0x1c, 0x68, 0x62, 0x25, 0x14, 0xcd, 0x5c, 0x6c, 0x04, 0x0b, 0xa1, 0x68, 0x9f,
+	0xa0, 0xf5, 0x11, 0xa3, 0x39, 0x16, 0xc7, 0xed, 0x3c, 0xba, 0x86, 0x5c, 0x3b, 0xfa, 0x8a, 0x5a,
+	0xc1, 0x6f, 0x15, 0x18, 0xa2, 0x32, 0x00, 0x79, 0x2f, 0xfa, 0x4a, 0xf8, 0xbf, 0x72, 0x98, 0x18,
+	0x60, 0x65, 0x60, 0x7a, 0x80, 0xe0, 0xbe, 0x40, 0x6b, 0x36, 0x57, 0xae, 0x96, 0xb9, 0x35, 0x1c,
+	0x73, 0x00, 0x2c, 0x5b, 0xb9, 0x4b, 0x6b, 0xb3, 0xbb, 0x54, 0x64, 0xbf, 0x22, 0x1c, 0xc1, 0x94,
+	0x5e, 0xdd, 0xc8, 0x32, 0x90, 0x14, 0xc0, 0x54, 0xfe, 0x9f, 0x56, 0x4a, 0x03, 0xbf, 0x19, 0x86,
+	0x53, 0x06, 0x7e, 0x88, 0xea, 0xf2, 0x60, 0x4f, 0xfb, 0x20, 0x81, 0xee, 0x87, 0xba, 0xd6, 0x0b,
+	0x2e, 0x48, 0x3a, 0xa0, 0xe1, 0x8d, 0xf6, 0x86, 0x5a, 0xaf, 0x2d, 0xe5, 0x86, 0xd9, 0x4d, 0xdd,
+	0xe6, 0x6e, 0xd1, 0x2d, 0x2e, 0x9d, 0x91, 0xa8, 0xbb, 0xf6, 0xd3, 0x88, 0x43, 0xbf, 0xeb, 0xc7,
+	0x23, 0x12, 0xc3, 0xe1, 0xb3, 0x34, 0x52, 0x20, 0xd8, 0x04, 0x3c, 0x48, 0x60, 0xb7, 0x6a, 0x04,
+	0xe4, 0xbe, 0x8d, 0x6a, 0x63, 0x9a, 0x33, 0xc8, 0x85, 0xc5, 0x52, 0xbf, 0xac, 0x7c, 0xd4, 0xd5,
+	0x88, 0xff, 0x8f, 0x73, 0x53, 0x8f, 0x13, 0x65, 0x23, 0x94, 0x18, 0xa5, 0xb3, 0x2f, 0x4b, 0x0c,
+	0x81, 0x41, 0x22, 0x07, 0x69, 0x48, 0x94, 0x80, 0x3a, 0x55, 0x63, 0x37, 0x94, 0x70, 0x51, 0x5e,
+	0xce, 0xcd, 0x94, 0x97, 0xef, 0xa1, 0x16, 0x19, 0x85, 0x11, 0xd8, 0x57, 0x14, 0x3f, 0xa6, 0x33,
+	0x69, 0x2a, 0x09, 0x14, 0x41, 0x70, 0xc8, 0xc7, 0xa0, 0x91, 0x34, 0xd7, 0x82, 0x99, 0x30, 0x8e,
+	0x2d, 0x4d, 0x09, 0xe3, 0x34, 0xb7, 0x92, 0x28, 0x85, 0x19, 0xa5, 0x6c, 0xed, 0xff, 0xb3, 0x94,
+	0xad, 0xff, 0x32, 0xa5, 0x6c, 0xe3, 0x7f, 0x5b, 0xca, 0xa2, 0xef, 0x54, 0xca, 0x7e, 0x5e, 0x7a,
+	0xa6, 0xbd, 0xe8, 0x5a, 0x36, 0xc1, 0x1f, 0xa1, 0x3a, 0x64, 0x88, 0x3c, 0x1a, 0x53, 0xcb, 0x37,
+	0x15, 0xa8, 0xfb, 0x18, 0x2d, 0x10, 0xa0, 0x7a, 0x55, 0x23, 0x91, 0x97, 0x2d, 0xf4, 0xae, 0x94,
+	0xf8, 0x9f, 0xa1, 0x7b, 0xa5, 0x0f, 0xca, 0x18, 0xbb, 0x20, 0x51, 0x2e, 0xa7, 0x2f, 0x06, 0x57,
+	0x6e, 0x1d, 0xfc, 0xeb, 0xa5, 0x56, 0x27, 0x39, 0x3d, 0xa7, 0x3c, 0xb8, 0x10, 0xfb, 0x3f, 0x1b,
+	0xa5, 0xe1, 0x0d, 0xde, 0x0f, 0x09, 0x81, 0x74, 0x7e, 0x3f, 0xaf, 0x94, 0x63, 0x77, 0x7a, 0x27,
+	0xbb, 0x34, 0x20, 0xb1, 0xfb, 0x00, 0xcd, 0x0d, 0x33, 0xbb, 0xec, 0x90, 0x17, 0x05, 0x5d, 0xc0,
+	0xe1, 0x40, 0x86, 0xc0, 0xc3, 0xe0, 0xac, 0x47, 0x39, 0x55, 0x8f, 0xb0, 0x0e, 0xa4, 0x20, 0xf4,
+	0xa5, 0x5c, 0x3c, 0x69, 0xc6, 0x1f, 0xcf, 0xdd, 0xe2, 0x8f, 0x85, 0x5f, 0xcd, 0x42, 0x1a, 0x2b,
+	0xde, 0xbc, 0xe5, 0x57, 0x41, 0x50, 0xcc, 0x07, 0x81, 0xab, 0x08, 0x93, 0x66, 0xde, 0xdf, 0x8c,
+	0xb3, 0xab, 0x13, 0x25, 0xf0, 0xff, 0x1c, 0xba, 0xd3, 0x3a, 0x38, 0x94, 0x9d, 0x3e, 0xb7, 0x63,
+	0x84, 0xee, 0xe5, 0xe7, 0xde, 0x56, 0xc7, 0x10, 0x1e, 0xca, 0x9b, 0x19, 0x28, 0x2e, 0x5f, 0xde,
+	0x3f, 0xec, 0xbd, 0xc2, 0xbd, 0x93, 0x83, 0xfd, 0x7e, 0xaf, 0xdd, 0xed, 0x74, 0x8e, 0xf0, 0xf6,
+	0xee, 0xee, 0x9b, 0x5e, 0xa7, 0xab, 0xe2, 0xbc, 0x6e, 0x16, 0x56, 0xa7, 0x9b, 0x85, 0xa0, 0xa1,
+	0xca, 0x97, 0x6f, 0x78, 0x63, 0x29, 0x91, 0x8b, 0xf0, 0x39, 0x5a, 0xd7, 0x0a, 0xbe, 0xa2, 0xfc,
+	0xbb, 0xf6, 0x1e, 0xa0, 0x0b, 0x70, 0x63, 0x86, 0x51, 0x0f, 0x54, 0x8a, 0xe1, 0x07, 0xa8, 0xa5,
+	0x27, 0x3e, 0xa4, 0xe9, 0x08, 0x4c, 0x1b, 0x46, 0x24, 0xce, 0x06, 0x78, 0x26, 0x69, 0x41, 0x52,
+	0x20, 0xca, 0xe9, 0x0f, 0xd0, 0x4a, 0x42, 0xd3, 0x11, 0xbe, 0xa4, 0x13, 0xac, 0x2a, 0x48, 0xb3,
+	0x63, 0xb9, 0x04, 0xc2, 0xa2, 0xd2, 0xf4, 0x7f, 0xa7, 0x34, 0x2f, 0x74, 0x4f, 0x95, 0xe9, 0x44,
+	0xea, 0xce, 0x6e, 0x78, 0x50, 0x2d, 0x61, 0x03, 0x5d, 0xb4, 0x03, 0x41, 0xf8, 0x1b, 0x73, 0x7a,
+	0x20, 0x08, 0x6f, 0xf3, 0x2e, 0x6a, 0x0e, 0x09, 0x63, 0xfc, 0x22, 0xcf, 0x46, 0x83, 0x8b, 0x29,
+	0xf3, 0x95, 0x02, 0xff, 0x07, 0xa5, 0xf9, 0x4e, 0x48, 0x94, 0xf2, 0x84, 0x0c, 0xc5, 0x78, 0x48,
+	0xa2, 0xd4, 0x6f, 0xab, 0x02, 0x2e, 0x50, 0xff, 0x5f, 0x0d, 0x7f, 0xfc, 0x8a, 0x24, 0x54, 0xf4,
+	0x8b, 0xc0, 0x15, 0xca, 0x76, 0xd1, 0x4c, 0xe0, 0x97, 0x4d, 0x23, 0x91, 0x60, 0x6d, 0xa2, 0x9a,
+	0xf8, 0xa1, 0x9a, 0x7a, 0xc5, 0xdb, 0x29, 0xd0, 0xfd, 0x00, 0xcd, 0x5f, 0xd2, 0x09, 0xc4, 0x7b,
+	0xc8, 0x2d, 0xbd, 0xad, 0x99, 0xc7, 0x6c, 0x81, 0x75, 0x79, 0x57, 0xb0, 0xa6, 0x5f, 0x75, 0xfe,
+	0x96, 0x57, 0xdd, 0xf8, 0x87, 0x2a, 0x5a, 0x10, 0xe3, 0xbe, 0x25, 0xf1, 0x04, 0x4f, 0x4e, 0x62,
+	0x2c, 0x83, 0x9c, 0xb5, 0x31, 0x1a, 0x63, 0x12, 0xcb, 0xd0, 0x0b, 0x9b, 0x07, 0x48, 0xe7, 0x71,
+	0xa6, 0x3a, 0x8f, 0x45, 0xda, 0x37, 0x26, 0xf1, 0x1e, 0xa0, 0x32, 0x81, 0x8d, 0x71, 0x9c, 0xa5,
+	0x03, 0x4b, 0xa1, 0xda, 0x98, 0xc4, 0x07, 0x59, 0x39, 0x07, 0xbb, 0xc8, 0x72, 0x6e, 0xa5, 0x1d,
+	0x30, 0xae, 0x07, 0xa8, 0x9e, 0x03, 0x1c, 0xb9, 0xb7, 0x38, 0x35, 0x07, 0xb8, 0xf0, 0x82, 0x90,
+	0x65, 0xb1, 0x57, 0x33, 0x4e, 0xb0, 0x20, 0x64, 0x59, 0xac, 0xdf, 0x66, 0x14, 0xa5, 0xfc, 0xd3,
+	0x8f, 0xbd, 0xba, 0x51, 0x4d, 0xc1, 0xc3, 0xdf, 0x08, 0x18, 0xf6, 0x35, 0x90, 0xae, 0xd4, 0x3b,
+	0x37, 0xcc, 0x60, 0x3f, 0x26, 0xf1, 0xe7, 0x12, 0xf7, 0xff, 0xa4, 0x8a, 0xee, 0xce, 0xac, 0x03,
+	0x74, 0x0a, 0xdd, 0x0e, 0x6a, 0x96, 0x97, 0xad, 0xfa, 0x32, 0xe7, 0xc9, 0xd6, 0x8d, 0xe4, 0xad,
+	0x92, 0x89, 0x79, 0xd7, 0x1c, 0xb7, 0xf1, 0xd9, 0x2f, 0x5e, 0x1d, 0x9d, 0x4f, 0x56, 0xa7, 0xf3,
+	0xc9, 0x8d, 0xaf, 0x50, 0xcb, 0x9c, 0xd9, 0xdc, 0x61, 0x95, 0x9b, 0x76, 0xd8, 0xad, 0x33, 0xb9,
+	0x1f, 0x59, 0x7b, 0xef, 0x7b, 0xb7, 0xbc, 0x86, 0xb1, 0xff, 0xfc, 0xdf, 0xab, 0x94, 0x67, 0xa8,
+	0x4f, 0x93, 0x61, 0x07, 0xfc, 0x31, 0xa4, 0x51, 0x32, 0xb8, 0x45, 0x90, 0x8a, 0xcd, 0x04, 0x37,
+	0x81, 0x4e, 0xe7, 0xb7, 0xd5, 0x5b, 0xf2, 0xdb, 0x77, 0x90, 0x72, 0xf2, 0x37, 0xe4, 0x63, 0x52,
+	0x20, 0xf2, 0xb1, 0xbf, 0xa9, 0x96, 0x81, 0xf0, 0x84, 0x04, 0x97, 0x94, 0x17, 0xaa, 0x4c, 0xe5,
+	0xdb, 0x95, 0x5b, 0xf2, 0xed, 0x0f, 0xd1, 0xca, 0x48, 0x24, 0x99, 0x37, 0xa7, 0x8a, 0xcb, 0x4a,
+	0xa8, 0xe9, 0x0f, 0x51, 0x5d, 0x74, 0xa8, 0x62, 0xa5, 0x54, 0xb1, 0x01, 0xa1, 0x3b, 0x15, 0x73,
+	0x52, 0xce, 0x87, 0x75, 0xa7, 0xd9, 0xca, 0xc9, 0xd5, 0x7c, 0xba, 0xb1, 0x0d, 0x06, 0x2b, 0x78,
+	0xd6, 0x99, 0xd0, 0x28, 0xec, 0x68, 0xf1, 0x38, 0x7c, 0x9e, 0x67, 0x89, 0x75, 0x2a, 0x1a, 0x02,
+	0xdf, 0xcb, 0xb3, 0x64, 0xda, 0x5c, 0xb5, 0x5b, 0xcc, 0xf5, 0xef, 0x0b, 0x68, 0xb9, 0x88, 0x6d,
+	0x10, 0xd5, 0x45, 0x4e, 0xa4, 0xd7, 0x06, 0x8b, 0x40, 0x6f, 0xad, 0xdb, 0x92, 0x96, 0x09, 0xb6,
+	0xfb, 0x02, 0x2d, 0x0a, 0x8e, 0x6e, 0x20, 0xbc, 0xbd, 0x65, 0xcf, 0xb6, 0x25, 0xa4, 0xb2, 0x05,
+	0xd0, 0x55, 0xd4, 0x8d, 0xff, 0x9e, 0x47, 0x4d, 0x03, 0x07, 0x13, 0x66, 0x79, 0x34, 0x88, 0x52,
+	0x2c, 0xf3, 0x8c, 0x55, 0x6d, 0x42, 0x89, 0xfe, 0xa6, 0x41, 0x90, 0x19, 0xec, 0x14, 0xe1, 0x0b,
+	0x83, 0x20, 0x3f, 0x13, 0x98, 0x22, 0x7c, 0x09, 0x91, 0x70, 0x9c, 0xc5, 0xa3, 0xa9, 0x7b, 0x79,
+	0x85, 0x89, 0xb0, 0x06, 0xcd, 0x37, 0x55, 0xa1, 0x2e, 0x18, 0xee, 0x0c, 0x09, 0xc1, 0xa9, 0xbe,
+	0x45, 0x2d, 0x52, 0x4b, 0x95, 0x2e, 0x9a, 0xd6, 0x2f, 0xf2, 0x4e, 0x95, 0x2f, 0x4e, 0x27, 0x2c,
+	0xb5, 0xdb, 0x12, 0x16, 0xb8, 0x1e, 0xbf, 0x20, 0x69, 0x4a, 0x63, 0x2b, 0x57, 0xd5, 0x20, 0x94,
+	0x0b, 0xc3, 0x88, 0x07, 0x17, 0x56, 0x5d, 0x2c, 0xa1, 0xb2, 0xbc, 0x42, 0xb3, 0xe5, 0xd5, 0x63,
+	0xd4, 0x90, 0x29, 0x5c, 0x3a, 0x4a, 0xbc, 0xa6, 0xf1, 0xde, 0x75, 0x01, 0x1f, 0x8d, 0x12, 0xd1,
+	0x10, 0xd4, 0x14, 0x7c, 0x41, 0xd2, 0x30, 0xa6, 0x5e, 0xcb, 0x6a, 0x08, 0x2a, 0xe6, 0x6b, 0x21,
+	0x13, 0x09, 0xf3, 0x90, 0x92, 0x4b, 0x9a, 0x63, 0xf9, 0x06, 0x56, 0x17, 0x6f, 0x49, 0xc9, 0xc4,
+	0x91, 0x9b, 0x80, 0x59, 0x73, 0x92, 0x86, 0x19, 0xdc, 0xb8, 0xd3, 0xd0, 0x5b, 0x36, 0x98, 0x48,
+	0x0a, 0x7a, 0x94, 0x86, 0x65, 0xa6, 0x19, 0xd3, 0x31, 0x8d, 0xbd, 0x15, 0x93, 0x26, 0x04, 0x07,
+	0x80, 0x03, 0x2d, 0x62, 0x98, 0xd1, 0x94, 0x83, 0x8d, 0x3d, 0xc7, 0xd8, 0x94, 0x28, 0x62, 0x3d,
+	0x85, 0xc3, 0xe9, 0x88, 0x18, 0x26, 0xc9, 0x99, 0x28, 0x88, 0x56, 0xcd, 0x7a, 0x27, 0x62, 0xdb,
+	0x12, 0xf6, 0xaf, 0xcb, 0xb0, 0x2d, 0x75, 0x3d, 0x64, 0x22, 0x1c, 0x41, 0xd0, 0x9e, 0xcd, 0x77,
+	0xeb, 0xe2, 0x3a, 0x15, 0x56, 0xca, 0xec, 0x77, 0x55, 0x6f, 0xea, 0x77, 0x3d, 0x44, 0x40, 0x9e,
+	0x75, 0x51, 0x35, 0x9a, 0x72, 0x71, 0xe0, 0x5e, 0x96, 0x7e, 0xf2, 0x97, 0x6e, 0xb7, 0xff, 0x56,
+	0x19, 0x7d, 0x3a, 0x69, 0x90, 0x4f, 0x86, 0x9c, 0x86, 0xfa, 0xe6, 0x98, 0x6a, 0xc0, 0x1e, 0x5c,
+	0xc0, 0xa0, 0x99, 0x70, 0xd8, 0x10, 0x55, 0x2c, 0xd5, 0x2f, 0xe9, 0x04, 0xd2, 0x29, 0xff, 0x9f,
+	0xab, 0x65, 0x33, 0xff, 0x75, 0xf1, 0x55, 0x07, 0x6c, 0x30, 0x71, 0x04, 0x2c, 0x8b, 0x48, 0x08,
+	0x76, 0xc3, 0x30, 0x8f, 0x12, 0xf8, 0xa6, 0x80, 0x93, 0x7c, 0x40, 0xed, 0x24, 0x76, 0x49, 0xc9,
+	0xfa, 0x42, 0x04, 0x9d, 0x06, 0x75, 0xf7, 0xce, 0x78, 0x36, 0xc4, 0x2a, 0x6d, 0x28, 0x3a, 0x0d,
+	0x52, 0xd6, 0xe3, 0xd9, 0x70, 0x9b, 0x43, 0x02, 0x59, 0x70, 0x49, 0xce, 0x81, 0x6c, 0x5d, 0x48,
+	0x6a, 0x32, 0xc9, 0xf9, 0x36, 0x77, 0x7f, 0x80, 0xee, 0x6a, 0x76, 0x9c, 0x5d, 0x85, 0xd9, 0x55,
+	0x8a, 0xcf, 0xe8, 0x20, 0xb2, 0x2f, 0x93, 0xd6, 0xd4, 0x18, 0xc5, 0xd8, 0x01, 0x02, 0x54, 0x22,
+	0xd3, 0x23, 0xa1, 0x85, 0x68, 0x9e, 0xea, 0x55, 0x7b, 0x1c, 0xb4, 0x13, 0x3f, 0x45, 0xeb, 0xd3,
+	0xa3, 0x72, 0xc2, 0xed, 0xee, 0x97, 0x6b, 0x0f, 0xeb, 0x12, 0x4e, 0xfd, 0x7f, 0xab, 0x94, 0x97,
+	0xf4, 0x86, 0x85, 0x37, 0x51, 0x2d, 0x07, 0xcf, 0xc1, 0xec, 0xfa, 0x5d, 0x83, 0xc2, 0xed, 0xe8,
+	0xe7, 0xc4, 0x34, 0x1d, 0xa8, 0x5b, 0xb9, 0x6a, 0x71, 0x44, 0x95, 0xf0, 0x40, 0xc8, 0xa0, 0x81,
+	0x69, 0xab, 0x65, 0x66, 0x67, 0x2d, 0x66, 0x28, 0x04, 0x97, 0x26, 0xf6, 0xfa, 0xe1, 0xf2, 0x00,
+	0x98, 0xf6, 0xbe, 0x6b, 0xad, 0x64, 0x47, 0x9f, 0x86, 0x22, 0xcf, 0x15, 0x1f, 0x3c, 0x99, 0x5e,
+	0x53, 0xe6, 0xb9, 0xfd, 0x28, 0xa1, 0xfe, 0x8b, 0xb2, 0x77, 0xb3, 0x93, 0x67, 0x24, 0x84, 0x5a,
+	0x1f, 0xab, 0x1b, 0x72, 0x71, 0x77, 0x97, 0xd8, 0x1f, 0x05, 0x01, 0xf0, 0xec, 0xef, 0x2b, 0xa8,
+	0x75, 0xd4, 0xe9, 0x63, 0x55, 0x0b, 0x30, 0xb7, 0x89, 0x6a, 0xd0, 0xc1, 0x3d, 0x3a, 0x3e, 0x71,
+	0xee, 0xb8, 0xae, 0x6c, 0xe7, 0x96, 0xdf, 0x93, 0x38, 0x15, 0xb7, 0x85, 0xea, 0x80, 0xc1, 0xd5,
+	0x9a, 0x53, 0x75, 0xef, 0xa3, 0x35, 0xf8, 0x35, 0xf5, 0x5d, 0x86, 0x33, 0xa7, 0x69, 0x70, 0x29,
+	0xec, 0xcc, 0xbb, 0xab, 0xd0, 0xeb, 0xe5, 0xe5, 0x87, 0x4a, 0xce, 0x42, 0x01, 0xe9, 0xcf, 0x5e,
+	0x9c, 0x45, 0x77, 0x0d, 0xad, 0x08, 0xa8, 0xfc, 0xde, 0xc8, 0xa9, 0xb9, 0x1e, 0x5a, 0x07, 0x70,
+	0xfa, 0x23, 0x0e, 0x27, 0x7c, 0xf6, 0x47, 0xf0, 0xed, 0xd8, 0x41, 0xbb, 0xd4, 0xdd, 0x45, 0xcb,
+	0x41, 0x1c, 0x18, 0x9f, 0x65, 0x38, 0x75, 0xd0, 0x03, 0x30, 0xf8, 0x16, 0xc1, 0x69, 0xc0, 0x43,
+	0xe1, 0x57, 0xd1, 0xed, 0x70, 0x10, 0x3c, 0x14, 0x20, 0xe3, 0xbe, 0xdb, 0x69, 0xba, 0xeb, 0xc8,
+	0x01, 0xd0, 0xbc, 0x9b, 0x76, 0x5a, 0xa0, 0x0a, 0xa0, 0xd3, 0x37, 0xcf, 0xce, 0x92, 0xe6, 0x9b,
+	0x77, 0xbf, 0xce, 0x32, 0x18, 0x47, 0xcc, 0x62, 0xdf, 0x37, 0x3a, 0x2b, 0xee, 0x06, 0xba, 0x07,
+	0x82, 0xd9, 0xbb, 0x44, 0xc7, 0x71, 0xef, 0xa2, 0xd5, 0xf2, 0x25, 0xd4, 0xab, 0x39, 0xab, 0xfa,
+	0x09, 0xa6, 0x2b, 0x73, 0x5c, 0xfd, 0xc6, 0xe5, 0x1e, 0x77, 0xd6, 0x9f, 0x1d, 0xa0, 0xd5, 0x99,
+	0x6e, 0x0e, 0x3c, 0x71, 0xa6, 0x9f, 0xd3, 0xeb, 0x77, 0xb6, 0x0f, 0x9d, 0x3b, 0xee, 0xdb, 0xe8,
+	0xb6, 0x5e, 0x8f, 0x53, 0x79, 0x36, 0x41, 0xf7, 0x6f, 0x29, 0xb7, 0x61, 0xdc, 0x2d, 0x05, 0xb7,
+	0x73, 0xc7, 0xdd, 0x44, 0x1b, 0xd3, 0xc2, 0x6e, 0xe7, 0xf0, 0xf8, 0xb4, 0x23, 0xe4, 0x15, 0xf7,
+	0x01, 0x7a, 0x6b, 0x5a, 0xde, 0xff, 0xe2, 0xa4, 0x83, 0x77, 0xf6, 0xfb, 0x3d, 0xa7, 0xb2, 0x51,
+	0x75, 0x2a, 0xcf, 0xbe, 0x99, 0x47, 0xad, 0xde, 0xa9, 0xbd, 0xbe, 0x6c, 0x1c, 0x18, 0x17, 0xa0,
+	0x4e, 0x1d, 0x56, 0x54, 0x62, 0xea, 0x22, 0xc0, 0x69, 0x68, 0xa8, 0xb8, 0x58, 0x71, 0x90, 0xeb,
+	0xa0, 0x96, 0x64, 0xc9, 0x1e, 0xb5, 0xd3, 0x74, 0xdf, 0x42, 0x77, 0x05, 0x69, 0xba, 0x8d, 0xec,
+	0xb4, 0xb4, 0x68, 0xa6, 0x01, 0xea, 0x2c, 0xe9, 0xa9, 0x8b, 0x06, 0xa4, 0xb3, 0x6c, 0x41, 0x62,
+	0x4b, 0xad, 0xb8, 0x4b, 0xa8, 0x01, 0x90, 0x68, 0x9e, 0x3b, 0x8e, 0xbb, 0x8c, 0x90, 0x78, 0xb8,
+	0xc8, 0xc9, 0x9c, 0x55, 0x77, 0x05, 0x35, 0x95, 0x32, 0xd0, 0xfe, 0x76, 0x5c, 0xad, 0x9d, 0xee,
+	0x53, 0x39, 0x6b, 0xee, 0x3d, 0xe4, 0x4a, 0xed, 0xcc, 0x06, 0x93, 0xb3, 0xae, 0x99, 0xba, 0xff,
+	0xe3, 0xdc, 0x85, 0xed, 0x2b, 0x26, 0x2b, 0x97, 0xc8, 0xb9, 0xa7, 0x41, 0xa3, 0xc8, 0x77, 0xee,
+	0xc3, 0xc6, 0x02, 0x50, 0x85, 0x61, 0x05, 0x7b, 0x5a, 0xff, 0xa2, 0xe2, 0x70, 0xde, 0xd2, 0x4f,
+	0xb7, 0xb3, 0x7a, 0x67, 0x03, 0xf6, 0x20, 0xe0, 0x66, 0xd9, 0xe1, 0xbc, 0xad, 0x75, 0xd2, 0xfd,
+	0x2c, 0xe7, 0x7b, 0x70, 0xe6, 0x00, 0x81, 0x46, 0x86, 0xf3, 0x40, 0x3f, 0xd7, 0x2a, 0x69, 0x9c,
+	0x4d, 0x3d, 0x99, 0xd9, 0x46, 0x71, 0x1e, 0x6a, 0xd4, 0xec, 0x0e, 0x38, 0x8f, 0x35, 0x6a, 0x6d,
+	0x7e, 0xbf, 0x7c, 0x21, 0x23, 0x42, 0x3b, 0x4f, 0xf4, 0x2e, 0x31, 0xce, 0xc4, 0xaf, 0xe8, 0x25,
+	0x9d, 0xf1, 0x8b, 0xce, 0xbb, 0xcf, 0x7e, 0xbf, 0x82, 0x56, 0x25, 0x4f, 0x68, 0x07, 0x3b, 0x1b,
+	0x73, 0x38, 0xba, 0xdd, 0xce, 0xc9, 0xc1, 0xf6, 0x17, 0xb8, 0x73, 0xda, 0x39, 0xea, 0xe3, 0xf6,
+	0xf6, 0x51, 0xbb, 0x73, 0xe0, 0xdc, 0x01, 0xdb, 0x58, 0x82, 0xdd, 0xce, 0x76, 0xff, 0xb5, 0x53,
+	0x01, 0xdf, 0x60, 0xe1, 0xaf, 0x3a, 0x47, 0x9d, 0xee, 0x7e, 0xdb, 0xa9, 0xba, 0xef, 0xa1, 0x27,
+	0x96, 0xa4, 0xd7, 0x7f, 0xd3, 0xfe, 0x21, 0x3e, 0xea, 0x74, 0x76, 0xf1, 0xde, 0x9b, 0x83, 0x03,
+	0xfc, 0xe6, 0x64, 0x77, 0xbb, 0xdf, 0x71, 0xe6, 0x76, 0x1e, 0xfd, 0xe4, 0xeb, 0xcd, 0xca, 0x4f,
+	0xbf, 0xde, 0xac, 0xfc, 0xfc, 0xeb, 0xcd, 0xca, 0x1f, 0x7e, 0xb3, 0x79, 0xe7, 0xa7, 0xdf, 0x6c,
+	0xde, 0xf9, 0xa7, 0x6f, 0x36, 0xef, 0x7c, 0x39, 0x97, 0xb0, 0xc1, 0xef, 0x56, 0xee, 0xfc, 0xcf,
+	0x00, 0xe9, 0xa9, 0xb1, 0xb6, 0x8b, 0x2c, 0x00, 0x00,
 }
 
 func (m *CMsgVector) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4786,29 +4784,34 @@ func (m *CMsgVector) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgVector) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgVector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xd
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.X))))
-	i += 4
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Y))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Z))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Y))))
+	i--
+	dAtA[i] = 0x15
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.X))))
+	i--
+	dAtA[i] = 0xd
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgVector2D) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4816,25 +4819,30 @@ func (m *CMsgVector2D) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgVector2D) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgVector2D) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xd
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.X))))
-	i += 4
-	dAtA[i] = 0x15
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Y))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x15
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.X))))
+	i--
+	dAtA[i] = 0xd
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgQAngle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4842,29 +4850,34 @@ func (m *CMsgQAngle) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgQAngle) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgQAngle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xd
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.X))))
-	i += 4
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Y))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Z))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Y))))
+	i--
+	dAtA[i] = 0x15
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.X))))
+	i--
+	dAtA[i] = 0xd
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgRGBA) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4872,29 +4885,34 @@ func (m *CMsgRGBA) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgRGBA) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgRGBA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.R))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.G))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.B))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.A))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.B))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.G))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.R))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_Tick) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4902,32 +4920,37 @@ func (m *CNETMsg_Tick) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_Tick) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Tick))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.HostComputationtime))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.HostComputationtimeStdDeviation))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.HostFramestarttimeStdDeviation))
-	dAtA[i] = 0x38
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.HltvReplayFlags))
-	return i, nil
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.HostFramestarttimeStdDeviation))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.HostComputationtimeStdDeviation))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.HostComputationtime))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Tick))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_StringCmd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4935,21 +4958,27 @@ func (m *CNETMsg_StringCmd) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_StringCmd) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_StringCmd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Command)
+	copy(dAtA[i:], m.Command)
 	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Command)))
-	i += copy(dAtA[i:], m.Command)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_SignonState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4957,45 +4986,45 @@ func (m *CNETMsg_SignonState) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_SignonState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_SignonState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SignonState))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SpawnCount))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumServerPlayers))
+	i -= len(m.MapName)
+	copy(dAtA[i:], m.MapName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.MapName)))
+	i--
+	dAtA[i] = 0x2a
 	if len(m.PlayersNetworkids) > 0 {
-		for _, s := range m.PlayersNetworkids {
+		for iNdEx := len(m.PlayersNetworkids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlayersNetworkids[iNdEx])
+			copy(dAtA[i:], m.PlayersNetworkids[iNdEx])
+			i = encodeVarintNetmessages(dAtA, i, uint64(len(m.PlayersNetworkids[iNdEx])))
+			i--
 			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.MapName)))
-	i += copy(dAtA[i:], m.MapName)
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumServerPlayers))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SpawnCount))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SignonState))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsg_CVars) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5003,29 +5032,36 @@ func (m *CMsg_CVars) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsg_CVars) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsg_CVars) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Cvars) > 0 {
-		for _, msg := range m.Cvars {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintNetmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Cvars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cvars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsg_CVars_CVar) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5033,28 +5069,35 @@ func (m *CMsg_CVars_CVar) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsg_CVars_CVar) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsg_CVars_CVar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.DictionaryName))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_SetConVar) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5062,27 +5105,34 @@ func (m *CNETMsg_SetConVar) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_SetConVar) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_SetConVar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Convars != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(m.Convars.Size()))
-		n1, err1 := m.Convars.MarshalTo(dAtA[i:])
-		if err1 != nil {
-			return 0, err1
+		{
+			size, err := m.Convars.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetmessages(dAtA, i, uint64(size))
 		}
-		i += n1
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_NOP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5090,17 +5140,22 @@ func (m *CNETMsg_NOP) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_NOP) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_NOP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_Disconnect) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5108,21 +5163,27 @@ func (m *CNETMsg_Disconnect) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_Disconnect) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_Disconnect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
 	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_File) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5130,40 +5191,46 @@ func (m *CNETMsg_File) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_File) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.TransferId))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.FileName)))
-	i += copy(dAtA[i:], m.FileName)
-	dAtA[i] = 0x18
-	i++
-	if m.IsReplayDemoFile {
+	i--
+	if m.Deny {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
+	i--
 	dAtA[i] = 0x20
-	i++
-	if m.Deny {
+	i--
+	if m.IsReplayDemoFile {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.FileName)
+	copy(dAtA[i:], m.FileName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.FileName)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.TransferId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_SplitScreenUser) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5171,20 +5238,25 @@ func (m *CNETMsg_SplitScreenUser) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_SplitScreenUser) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_SplitScreenUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.Slot))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CNETMsg_PlayerAvatarData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5192,26 +5264,32 @@ func (m *CNETMsg_PlayerAvatarData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CNETMsg_PlayerAvatarData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNETMsg_PlayerAvatarData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Accountid))
 	if m.Rgb != nil {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Rgb)
+		copy(dAtA[i:], m.Rgb)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Rgb)))
-		i += copy(dAtA[i:], m.Rgb)
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_ClientInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5219,55 +5297,61 @@ func (m *CCLCMsg_ClientInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_ClientInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_ClientInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xd
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.SendTableCrc))
-	i += 4
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ServerCount))
-	dAtA[i] = 0x18
-	i++
-	if m.IsHltv {
+	if len(m.CustomFiles) > 0 {
+		for iNdEx := len(m.CustomFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.CustomFiles[iNdEx]))
+			i--
+			dAtA[i] = 0x3d
+		}
+	}
+	i -= len(m.FriendsName)
+	copy(dAtA[i:], m.FriendsName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.FriendsName)))
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.FriendsId))
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.IsReplay {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
+	i--
 	dAtA[i] = 0x20
-	i++
-	if m.IsReplay {
+	i--
+	if m.IsHltv {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.FriendsId))
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.FriendsName)))
-	i += copy(dAtA[i:], m.FriendsName)
-	if len(m.CustomFiles) > 0 {
-		for _, num := range m.CustomFiles {
-			dAtA[i] = 0x3d
-			i++
-			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(num))
-			i += 4
-		}
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ServerCount))
+	i--
+	dAtA[i] = 0x10
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.SendTableCrc))
+	i--
+	dAtA[i] = 0xd
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_Move) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5275,29 +5359,35 @@ func (m *CCLCMsg_Move) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_Move) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumBackupCommands))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumNewCommands))
 	if m.Data != nil {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumNewCommands))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumBackupCommands))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_VoiceData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5305,41 +5395,47 @@ func (m *CCLCMsg_VoiceData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_VoiceData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_VoiceData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	dAtA[i] = 0x11
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Xuid))
-	i += 8
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.UncompressedSampleOffset))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SectionNumber))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SequenceBytes))
+	i--
+	dAtA[i] = 0x20
 	if m.Format != nil {
-		dAtA[i] = 0x18
-		i++
 		i = encodeVarintNetmessages(dAtA, i, uint64(*m.Format))
+		i--
+		dAtA[i] = 0x18
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SequenceBytes))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SectionNumber))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.UncompressedSampleOffset))
-	return i, nil
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Xuid))
+	i--
+	dAtA[i] = 0x11
+	if m.Data != nil {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_BaselineAck) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5347,23 +5443,28 @@ func (m *CCLCMsg_BaselineAck) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_BaselineAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_BaselineAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.BaselineTick))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.BaselineNr))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.BaselineTick))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_ListenEvents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5371,25 +5472,30 @@ func (m *CCLCMsg_ListenEvents) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_ListenEvents) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_ListenEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
 	if len(m.EventMask) > 0 {
-		for _, num := range m.EventMask {
+		for iNdEx := len(m.EventMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.EventMask[iNdEx]))
+			i--
 			dAtA[i] = 0xd
-			i++
-			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(num))
-			i += 4
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_RespondCvarValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5397,31 +5503,38 @@ func (m *CCLCMsg_RespondCvarValue) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_RespondCvarValue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_RespondCvarValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Cookie))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.StatusCode))
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x22
-	i++
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
 	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	return i, nil
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.StatusCode))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Cookie))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_FileCRCCheck) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5429,55 +5542,63 @@ func (m *CCLCMsg_FileCRCCheck) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_FileCRCCheck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_FileCRCCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.CodePath))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.CodeFilename))
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Filename)))
-	i += copy(dAtA[i:], m.Filename)
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.FileFraction))
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.PackFileNumber))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.PackFileId))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.FileLen))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.FileHashType))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Crc))
+	i--
+	dAtA[i] = 0x38
 	if m.Md5 != nil {
-		dAtA[i] = 0x32
-		i++
+		i -= len(m.Md5)
+		copy(dAtA[i:], m.Md5)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Md5)))
-		i += copy(dAtA[i:], m.Md5)
+		i--
+		dAtA[i] = 0x32
 	}
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Crc))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.FileHashType))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.FileLen))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.PackFileId))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.PackFileNumber))
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.FileFraction))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Filename)
+	copy(dAtA[i:], m.Filename)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Filename)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.CodeFilename))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.CodePath))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_LoadingProgress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5485,20 +5606,25 @@ func (m *CCLCMsg_LoadingProgress) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_LoadingProgress) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_LoadingProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.Progress))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_SplitPlayerConnect) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5506,27 +5632,34 @@ func (m *CCLCMsg_SplitPlayerConnect) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_SplitPlayerConnect) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_SplitPlayerConnect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Convars != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(m.Convars.Size()))
-		n2, err2 := m.Convars.MarshalTo(dAtA[i:])
-		if err2 != nil {
-			return 0, err2
+		{
+			size, err := m.Convars.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetmessages(dAtA, i, uint64(size))
 		}
-		i += n2
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_CmdKeyValues) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5534,23 +5667,29 @@ func (m *CCLCMsg_CmdKeyValues) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_CmdKeyValues) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_CmdKeyValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Keyvalues != nil {
-		dAtA[i] = 0xa
-		i++
+		i -= len(m.Keyvalues)
+		copy(dAtA[i:], m.Keyvalues)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Keyvalues)))
-		i += copy(dAtA[i:], m.Keyvalues)
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_ServerInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5558,131 +5697,141 @@ func (m *CSVCMsg_ServerInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_ServerInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_ServerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Protocol))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ServerCount))
-	dAtA[i] = 0x18
-	i++
-	if m.IsDedicated {
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.UgcMapId))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb0
+	i--
+	if m.IsRedirectingToProxyRelay {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x20
-	i++
-	if m.IsOfficialValveServer {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa8
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.PublicIp))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa0
+	i -= len(m.HostName)
+	copy(dAtA[i:], m.HostName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.HostName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	i -= len(m.SkyName)
+	copy(dAtA[i:], m.SkyName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.SkyName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	i -= len(m.MapGroupName)
+	copy(dAtA[i:], m.MapGroupName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.MapGroupName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	i -= len(m.MapName)
+	copy(dAtA[i:], m.MapName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.MapName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	i -= len(m.GameDir)
+	copy(dAtA[i:], m.GameDir)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.GameDir)))
+	i--
+	dAtA[i] = 0x7a
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.TickInterval))))
+	i--
+	dAtA[i] = 0x75
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.PlayerSlot))
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.MaxClasses))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.MaxClients))
+	i--
+	dAtA[i] = 0x58
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.StringTableCrc))
+	i--
+	dAtA[i] = 0x55
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.ClientCrc))
+	i--
+	dAtA[i] = 0x4d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.MapCrc))
+	i--
+	dAtA[i] = 0x45
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.COs))
+	i--
+	dAtA[i] = 0x38
+	i--
+	if m.IsReplay {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x28
-	i++
+	i--
+	dAtA[i] = 0x30
+	i--
 	if m.IsHltv {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x30
-	i++
-	if m.IsReplay {
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.IsOfficialValveServer {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.COs))
-	dAtA[i] = 0x45
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.MapCrc))
-	i += 4
-	dAtA[i] = 0x4d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.ClientCrc))
-	i += 4
-	dAtA[i] = 0x55
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.StringTableCrc))
-	i += 4
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.MaxClients))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.MaxClasses))
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.PlayerSlot))
-	dAtA[i] = 0x75
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.TickInterval))))
-	i += 4
-	dAtA[i] = 0x7a
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.GameDir)))
-	i += copy(dAtA[i:], m.GameDir)
-	dAtA[i] = 0x82
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.MapName)))
-	i += copy(dAtA[i:], m.MapName)
-	dAtA[i] = 0x8a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.MapGroupName)))
-	i += copy(dAtA[i:], m.MapGroupName)
-	dAtA[i] = 0x92
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.SkyName)))
-	i += copy(dAtA[i:], m.SkyName)
-	dAtA[i] = 0x9a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.HostName)))
-	i += copy(dAtA[i:], m.HostName)
-	dAtA[i] = 0xa0
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.PublicIp))
-	dAtA[i] = 0xa8
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.IsRedirectingToProxyRelay {
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.IsDedicated {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0xb0
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.UgcMapId))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ServerCount))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Protocol))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_ClassInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5690,37 +5839,44 @@ func (m *CSVCMsg_ClassInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_ClassInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_ClassInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	if len(m.Classes) > 0 {
+		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i--
 	if m.CreateOnClient {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if len(m.Classes) > 0 {
-		for _, msg := range m.Classes {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintNetmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_ClassInfoClassT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5728,28 +5884,35 @@ func (m *CSVCMsg_ClassInfoClassT) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_ClassInfoClassT) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_ClassInfoClassT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ClassId))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.DataTableName)))
-	i += copy(dAtA[i:], m.DataTableName)
-	dAtA[i] = 0x1a
-	i++
+	i -= len(m.ClassName)
+	copy(dAtA[i:], m.ClassName)
 	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.ClassName)))
-	i += copy(dAtA[i:], m.ClassName)
-	return i, nil
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.DataTableName)
+	copy(dAtA[i:], m.DataTableName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.DataTableName)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ClassId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_SendTable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5757,49 +5920,57 @@ func (m *CSVCMsg_SendTable) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_SendTable) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_SendTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	if m.IsEnd {
+	if len(m.Props) > 0 {
+		for iNdEx := len(m.Props) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Props[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i--
+	if m.NeedsDecoder {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.NetTableName)))
-	i += copy(dAtA[i:], m.NetTableName)
+	i--
 	dAtA[i] = 0x18
-	i++
-	if m.NeedsDecoder {
+	i -= len(m.NetTableName)
+	copy(dAtA[i:], m.NetTableName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.NetTableName)))
+	i--
+	dAtA[i] = 0x12
+	i--
+	if m.IsEnd {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if len(m.Props) > 0 {
-		for _, msg := range m.Props {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintNetmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_SendTableSendpropT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5807,48 +5978,55 @@ func (m *CSVCMsg_SendTableSendpropT) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_SendTableSendpropT) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_SendTableSendpropT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Type))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.VarName)))
-	i += copy(dAtA[i:], m.VarName)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Flags))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Priority))
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.DtName)))
-	i += copy(dAtA[i:], m.DtName)
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumElements))
-	dAtA[i] = 0x3d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.LowValue))))
-	i += 4
-	dAtA[i] = 0x45
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.HighValue))))
-	i += 4
-	dAtA[i] = 0x48
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumBits))
-	return i, nil
+	i--
+	dAtA[i] = 0x48
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.HighValue))))
+	i--
+	dAtA[i] = 0x45
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.LowValue))))
+	i--
+	dAtA[i] = 0x3d
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumElements))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.DtName)
+	copy(dAtA[i:], m.DtName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.DtName)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Priority))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Flags))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.VarName)
+	copy(dAtA[i:], m.VarName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.VarName)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_Print) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5856,21 +6034,27 @@ func (m *CSVCMsg_Print) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_Print) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_Print) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
 	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_SetPause) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5878,25 +6062,30 @@ func (m *CSVCMsg_SetPause) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_SetPause) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_SetPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	i--
 	if m.Paused {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_SetView) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5904,20 +6093,25 @@ func (m *CSVCMsg_SetView) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_SetView) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_SetView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.EntityIndex))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_CreateStringTable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5925,50 +6119,57 @@ func (m *CSVCMsg_CreateStringTable) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_CreateStringTable) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_CreateStringTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.MaxEntries))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumEntries))
-	dAtA[i] = 0x20
-	i++
+	if m.StringData != nil {
+		i -= len(m.StringData)
+		copy(dAtA[i:], m.StringData)
+		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.StringData)))
+		i--
+		dAtA[i] = 0x42
+	}
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Flags))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.UserDataSizeBits))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.UserDataSize))
+	i--
+	dAtA[i] = 0x28
+	i--
 	if m.UserDataFixedSize {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.UserDataSize))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.UserDataSizeBits))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Flags))
-	if m.StringData != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.StringData)))
-		i += copy(dAtA[i:], m.StringData)
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumEntries))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.MaxEntries))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_UpdateStringTable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5976,29 +6177,35 @@ func (m *CSVCMsg_UpdateStringTable) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_UpdateStringTable) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_UpdateStringTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.TableId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumChangedEntries))
 	if m.StringData != nil {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.StringData)
+		copy(dAtA[i:], m.StringData)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.StringData)))
-		i += copy(dAtA[i:], m.StringData)
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumChangedEntries))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.TableId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_VoiceInit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6006,29 +6213,35 @@ func (m *CSVCMsg_VoiceInit) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_VoiceInit) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_VoiceInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Quality))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Codec)))
-	i += copy(dAtA[i:], m.Codec)
 	if m.Version != nil {
-		dAtA[i] = 0x18
-		i++
 		i = encodeVarintNetmessages(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x18
 	}
-	return i, nil
+	i -= len(m.Codec)
+	copy(dAtA[i:], m.Codec)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Codec)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Quality))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_VoiceData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6036,63 +6249,69 @@ func (m *CSVCMsg_VoiceData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_VoiceData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_VoiceData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Client))
-	dAtA[i] = 0x10
-	i++
-	if m.Proximity {
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.UncompressedSampleOffset))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SectionNumber))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SequenceBytes))
+	i--
+	dAtA[i] = 0x40
+	if m.Format != nil {
+		i = encodeVarintNetmessages(dAtA, i, uint64(*m.Format))
+		i--
+		dAtA[i] = 0x38
+	}
+	i--
+	if m.Caster {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x19
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Xuid))
-	i += 8
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.AudibleMask))
+	i--
+	dAtA[i] = 0x30
 	if m.VoiceData != nil {
-		dAtA[i] = 0x2a
-		i++
+		i -= len(m.VoiceData)
+		copy(dAtA[i:], m.VoiceData)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.VoiceData)))
-		i += copy(dAtA[i:], m.VoiceData)
+		i--
+		dAtA[i] = 0x2a
 	}
-	dAtA[i] = 0x30
-	i++
-	if m.Caster {
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.AudibleMask))
+	i--
+	dAtA[i] = 0x20
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Xuid))
+	i--
+	dAtA[i] = 0x19
+	i--
+	if m.Proximity {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if m.Format != nil {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(*m.Format))
-	}
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SequenceBytes))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SectionNumber))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.UncompressedSampleOffset))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Client))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_FixAngle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6100,35 +6319,42 @@ func (m *CSVCMsg_FixAngle) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_FixAngle) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_FixAngle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	if m.Angle != nil {
+		{
+			size, err := m.Angle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetmessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i--
 	if m.Relative {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if m.Angle != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(m.Angle.Size()))
-		n3, err3 := m.Angle.MarshalTo(dAtA[i:])
-		if err3 != nil {
-			return 0, err3
-		}
-		i += n3
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_CrosshairAngle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6136,27 +6362,34 @@ func (m *CSVCMsg_CrosshairAngle) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_CrosshairAngle) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_CrosshairAngle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Angle != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(m.Angle.Size()))
-		n4, err4 := m.Angle.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
+		{
+			size, err := m.Angle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetmessages(dAtA, i, uint64(size))
 		}
-		i += n4
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_Prefetch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6164,20 +6397,25 @@ func (m *CSVCMsg_Prefetch) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_Prefetch) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_Prefetch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintNetmessages(dAtA, i, uint64(m.SoundIndex))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_BSPDecal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6185,44 +6423,51 @@ func (m *CSVCMsg_BSPDecal) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_BSPDecal) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_BSPDecal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pos != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(m.Pos.Size()))
-		n5, err5 := m.Pos.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
-		}
-		i += n5
-	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.DecalTextureIndex))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.EntityIndex))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ModelIndex))
-	dAtA[i] = 0x28
-	i++
+	i--
 	if m.LowPriority {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ModelIndex))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.EntityIndex))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.DecalTextureIndex))
+	i--
+	dAtA[i] = 0x10
+	if m.Pos != nil {
+		{
+			size, err := m.Pos.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetmessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_SplitScreen) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6230,28 +6475,33 @@ func (m *CSVCMsg_SplitScreen) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_SplitScreen) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_SplitScreen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.PlayerIndex))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x10
 	if m.Type != nil {
-		dAtA[i] = 0x8
-		i++
 		i = encodeVarintNetmessages(dAtA, i, uint64(*m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Slot))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.PlayerIndex))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_GetCvarValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6259,24 +6509,30 @@ func (m *CSVCMsg_GetCvarValue) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_GetCvarValue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_GetCvarValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Cookie))
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.CvarName)
+	copy(dAtA[i:], m.CvarName)
 	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.CvarName)))
-	i += copy(dAtA[i:], m.CvarName)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Cookie))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_Menu) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6284,26 +6540,32 @@ func (m *CSVCMsg_Menu) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_Menu) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_Menu) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.DialogType))
 	if m.MenuKeyValues != nil {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.MenuKeyValues)
+		copy(dAtA[i:], m.MenuKeyValues)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.MenuKeyValues)))
-		i += copy(dAtA[i:], m.MenuKeyValues)
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.DialogType))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_UserMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6311,29 +6573,35 @@ func (m *CSVCMsg_UserMessage) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_UserMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_UserMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.MsgType))
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Passthrough))
+	i--
+	dAtA[i] = 0x18
 	if m.MsgData != nil {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.MsgData)
+		copy(dAtA[i:], m.MsgData)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.MsgData)))
-		i += copy(dAtA[i:], m.MsgData)
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Passthrough))
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.MsgType))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_PaintmapData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6341,23 +6609,29 @@ func (m *CSVCMsg_PaintmapData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_PaintmapData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_PaintmapData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Paintmap != nil {
-		dAtA[i] = 0xa
-		i++
+		i -= len(m.Paintmap)
+		copy(dAtA[i:], m.Paintmap)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Paintmap)))
-		i += copy(dAtA[i:], m.Paintmap)
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_GameEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6365,39 +6639,47 @@ func (m *CSVCMsg_GameEvent) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_GameEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_GameEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.EventName)))
-	i += copy(dAtA[i:], m.EventName)
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Eventid))
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Passthrough))
+	i--
+	dAtA[i] = 0x20
 	if len(m.Keys) > 0 {
-		for _, msg := range m.Keys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintNetmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Passthrough))
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Eventid))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.EventName)
+	copy(dAtA[i:], m.EventName)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.EventName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_GameEventKeyT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6405,54 +6687,61 @@ func (m *CSVCMsg_GameEventKeyT) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_GameEventKeyT) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_GameEventKeyT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Type))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.ValString)))
-	i += copy(dAtA[i:], m.ValString)
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.ValFloat))))
-	i += 4
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ValLong))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ValShort))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ValByte))
-	dAtA[i] = 0x38
-	i++
+	if m.ValWstring != nil {
+		i -= len(m.ValWstring)
+		copy(dAtA[i:], m.ValWstring)
+		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.ValWstring)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ValUint64))
+	i--
+	dAtA[i] = 0x40
+	i--
 	if m.ValBool {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ValUint64))
-	if m.ValWstring != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.ValWstring)))
-		i += copy(dAtA[i:], m.ValWstring)
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ValByte))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ValShort))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ValLong))
+	i--
+	dAtA[i] = 0x20
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.ValFloat))))
+	i--
+	dAtA[i] = 0x1d
+	i -= len(m.ValString)
+	copy(dAtA[i:], m.ValString)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.ValString)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_GameEventList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6460,29 +6749,36 @@ func (m *CSVCMsg_GameEventList) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_GameEventList) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_GameEventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Descriptors) > 0 {
-		for _, msg := range m.Descriptors {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintNetmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Descriptors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Descriptors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_GameEventListKeyT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6490,24 +6786,30 @@ func (m *CSVCMsg_GameEventListKeyT) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_GameEventListKeyT) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_GameEventListKeyT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Type))
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
 	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_GameEventListDescriptorT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6515,36 +6817,44 @@ func (m *CSVCMsg_GameEventListDescriptorT) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_GameEventListDescriptorT) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_GameEventListDescriptorT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Eventid))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
 	if len(m.Keys) > 0 {
-		for _, msg := range m.Keys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintNetmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetmessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return i, nil
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Eventid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_TempEntities) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6552,34 +6862,40 @@ func (m *CSVCMsg_TempEntities) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_TempEntities) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_TempEntities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	if m.EntityData != nil {
+		i -= len(m.EntityData)
+		copy(dAtA[i:], m.EntityData)
+		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.EntityData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumEntries))
+	i--
+	dAtA[i] = 0x10
+	i--
 	if m.Reliable {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.NumEntries))
-	if m.EntityData != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.EntityData)))
-		i += copy(dAtA[i:], m.EntityData)
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_PacketEntities) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6587,51 +6903,57 @@ func (m *CSVCMsg_PacketEntities) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_PacketEntities) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_PacketEntities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.MaxEntries))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.UpdatedEntries))
-	dAtA[i] = 0x18
-	i++
-	if m.IsDelta {
+	if m.EntityData != nil {
+		i -= len(m.EntityData)
+		copy(dAtA[i:], m.EntityData)
+		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.EntityData)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.DeltaFrom))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Baseline))
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.UpdateBaseline {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
+	i--
 	dAtA[i] = 0x20
-	i++
-	if m.UpdateBaseline {
+	i--
+	if m.IsDelta {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Baseline))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.DeltaFrom))
-	if m.EntityData != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.EntityData)))
-		i += copy(dAtA[i:], m.EntityData)
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.UpdatedEntries))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.MaxEntries))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_Sounds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6639,37 +6961,44 @@ func (m *CSVCMsg_Sounds) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_Sounds) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_Sounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	if len(m.Sounds) > 0 {
+		for iNdEx := len(m.Sounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetmessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i--
 	if m.ReliableSound {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if len(m.Sounds) > 0 {
-		for _, msg := range m.Sounds {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintNetmessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_SoundsSounddataT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6677,84 +7006,89 @@ func (m *CSVCMsg_SoundsSounddataT) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_SoundsSounddataT) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_SoundsSounddataT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64((uint32(m.OriginX)<<1)^uint32((m.OriginX>>31))))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64((uint32(m.OriginY)<<1)^uint32((m.OriginY>>31))))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64((uint32(m.OriginZ)<<1)^uint32((m.OriginZ>>31))))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Volume))
-	dAtA[i] = 0x2d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DelayValue))))
-	i += 4
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SequenceNumber))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.EntityIndex))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Channel))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Pitch))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Flags))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SoundNum))
-	dAtA[i] = 0x65
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.SoundNumHandle))
-	i += 4
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SpeakerEntity))
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.RandomSeed))
-	dAtA[i] = 0x78
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.SoundLevel))
-	dAtA[i] = 0x80
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.IsSentence {
+	i--
+	if m.IsAmbient {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x88
-	i++
+	i--
 	dAtA[i] = 0x1
-	i++
-	if m.IsAmbient {
+	i--
+	dAtA[i] = 0x88
+	i--
+	if m.IsSentence {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SoundLevel))
+	i--
+	dAtA[i] = 0x78
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.RandomSeed))
+	i--
+	dAtA[i] = 0x70
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SpeakerEntity))
+	i--
+	dAtA[i] = 0x68
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.SoundNumHandle))
+	i--
+	dAtA[i] = 0x65
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SoundNum))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Flags))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Pitch))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Channel))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.EntityIndex))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.SequenceNumber))
+	i--
+	dAtA[i] = 0x30
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DelayValue))))
+	i--
+	dAtA[i] = 0x2d
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Volume))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintNetmessages(dAtA, i, uint64((uint32(m.OriginZ)<<1)^uint32((m.OriginZ>>31))))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64((uint32(m.OriginY)<<1)^uint32((m.OriginY>>31))))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64((uint32(m.OriginX)<<1)^uint32((m.OriginX>>31))))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_EntityMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6762,29 +7096,35 @@ func (m *CSVCMsg_EntityMsg) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_EntityMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_EntityMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.EntIndex))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ClassId))
 	if m.EntData != nil {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.EntData)
+		copy(dAtA[i:], m.EntData)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.EntData)))
-		i += copy(dAtA[i:], m.EntData)
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ClassId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.EntIndex))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_CmdKeyValues) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6792,23 +7132,29 @@ func (m *CSVCMsg_CmdKeyValues) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_CmdKeyValues) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_CmdKeyValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Keyvalues != nil {
-		dAtA[i] = 0xa
-		i++
+		i -= len(m.Keyvalues)
+		copy(dAtA[i:], m.Keyvalues)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Keyvalues)))
-		i += copy(dAtA[i:], m.Keyvalues)
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_EncryptedData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6816,26 +7162,32 @@ func (m *CSVCMsg_EncryptedData) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_EncryptedData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_EncryptedData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.KeyType))
+	i--
+	dAtA[i] = 0x10
 	if m.Encrypted != nil {
-		dAtA[i] = 0xa
-		i++
+		i -= len(m.Encrypted)
+		copy(dAtA[i:], m.Encrypted)
 		i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Encrypted)))
-		i += copy(dAtA[i:], m.Encrypted)
+		i--
+		dAtA[i] = 0xa
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.KeyType))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_HltvReplay) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6843,39 +7195,44 @@ func (m *CSVCMsg_HltvReplay) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_HltvReplay) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_HltvReplay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Delay))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.PrimaryTarget))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ReplayStopAt))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ReplayStartAt))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ReplaySlowdownBegin))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.ReplaySlowdownEnd))
-	dAtA[i] = 0x3d
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.ReplaySlowdownRate))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x3d
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ReplaySlowdownEnd))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ReplaySlowdownBegin))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ReplayStartAt))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.ReplayStopAt))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.PrimaryTarget))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Delay))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CCLCMsg_HltvReplay) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6883,35 +7240,40 @@ func (m *CCLCMsg_HltvReplay) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CCLCMsg_HltvReplay) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CCLCMsg_HltvReplay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.Request))
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SlowdownLength))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SlowdownRate))))
-	i += 4
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintNetmessages(dAtA, i, uint64(m.PrimaryTargetEntIndex))
-	dAtA[i] = 0x2d
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EventTime))))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x2d
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.PrimaryTargetEntIndex))
+	i--
+	dAtA[i] = 0x20
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SlowdownRate))))
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SlowdownLength))))
+	i--
+	dAtA[i] = 0x15
+	i = encodeVarintNetmessages(dAtA, i, uint64(m.Request))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CSVCMsg_Broadcast_Command) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6919,25 +7281,33 @@ func (m *CSVCMsg_Broadcast_Command) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CSVCMsg_Broadcast_Command) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CSVCMsg_Broadcast_Command) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
+	i -= len(m.Cmd)
+	copy(dAtA[i:], m.Cmd)
 	i = encodeVarintNetmessages(dAtA, i, uint64(len(m.Cmd)))
-	i += copy(dAtA[i:], m.Cmd)
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintNetmessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNetmessages(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *CMsgVector) Size() (n int) {
 	if m == nil {
@@ -16168,6 +16538,7 @@ func (m *CSVCMsg_Broadcast_Command) Unmarshal(dAtA []byte) error {
 func skipNetmessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -16199,10 +16570,8 @@ func skipNetmessages(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -16223,55 +16592,30 @@ func skipNetmessages(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthNetmessages
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthNetmessages
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowNetmessages
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipNetmessages(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthNetmessages
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNetmessages
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNetmessages
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthNetmessages = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowNetmessages   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthNetmessages        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNetmessages          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNetmessages = fmt.Errorf("proto: unexpected end of group")
 )
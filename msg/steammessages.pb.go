@@ -23,7 +23,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GCProtoBufMsgSrc int32
 
@@ -258,7 +258,7 @@ func (m *CMsgProtoBufHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CMsgProtoBufHeader.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -381,7 +381,7 @@ func (m *CMsgWebAPIKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_CMsgWebAPIKey.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -464,7 +464,7 @@ func (m *CMsgHttpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_CMsgHttpRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -558,7 +558,7 @@ func (m *CMsgHttpRequest_RequestHeader) XXX_Marshal(b []byte, deterministic bool
 		return xxx_messageInfo_CMsgHttpRequest_RequestHeader.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -610,7 +610,7 @@ func (m *CMsgHttpRequest_QueryParam) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CMsgHttpRequest_QueryParam.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -667,7 +667,7 @@ func (m *CMsgWebAPIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CMsgWebAPIRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -755,7 +755,7 @@ func (m *CMsgHttpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CMsgHttpResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -814,7 +814,7 @@ func (m *CMsgHttpResponse_ResponseHeader) XXX_Marshal(b []byte, deterministic bo
 		return xxx_messageInfo_CMsgHttpResponse_ResponseHeader.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -866,7 +866,7 @@ func (m *CMsgAMFindAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CMsgAMFindAccounts.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -917,7 +917,7 @@ func (m *CMsgAMFindAccountsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CMsgAMFindAccountsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -967,7 +967,7 @@ func (m *CMsgNotifyWatchdog) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CMsgNotifyWatchdog.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1053,7 +1053,7 @@ func (m *CMsgAMGetLicenses) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CMsgAMGetLicenses.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1099,7 +1099,7 @@ func (m *CMsgPackageLicense) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CMsgPackageLicense.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1158,7 +1158,7 @@ func (m *CMsgAMGetLicensesResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return xxx_messageInfo_CMsgAMGetLicensesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1211,7 +1211,7 @@ func (m *CMsgAMGetUserGameStats) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CMsgAMGetUserGameStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1273,7 +1273,7 @@ func (m *CMsgAMGetUserGameStatsResponse) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CMsgAMGetUserGameStatsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1348,7 +1348,7 @@ func (m *CMsgAMGetUserGameStatsResponse_Stats) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgAMGetUserGameStatsResponse_Stats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1405,7 +1405,7 @@ func (m *CMsgAMGetUserGameStatsResponse_Achievement_Blocks) XXX_Marshal(b []byte
 		return xxx_messageInfo_CMsgAMGetUserGameStatsResponse_Achievement_Blocks.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1464,7 +1464,7 @@ func (m *CMsgGCGetCommandList) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CMsgGCGetCommandList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1515,7 +1515,7 @@ func (m *CMsgGCGetCommandListResponse) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CMsgGCGetCommandListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1559,7 +1559,7 @@ func (m *CGCMsgMemCachedGet) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CGCMsgMemCachedGet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1603,7 +1603,7 @@ func (m *CGCMsgMemCachedGetResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CGCMsgMemCachedGetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1648,7 +1648,7 @@ func (m *CGCMsgMemCachedGetResponse_ValueTag) XXX_Marshal(b []byte, deterministi
 		return xxx_messageInfo_CGCMsgMemCachedGetResponse_ValueTag.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1699,7 +1699,7 @@ func (m *CGCMsgMemCachedSet) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CGCMsgMemCachedSet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1744,7 +1744,7 @@ func (m *CGCMsgMemCachedSet_KeyPair) XXX_Marshal(b []byte, deterministic bool) (
 		return xxx_messageInfo_CGCMsgMemCachedSet_KeyPair.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1795,7 +1795,7 @@ func (m *CGCMsgMemCachedDelete) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CGCMsgMemCachedDelete.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1838,7 +1838,7 @@ func (m *CGCMsgMemCachedStats) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CGCMsgMemCachedStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1888,7 +1888,7 @@ func (m *CGCMsgMemCachedStatsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CGCMsgMemCachedStatsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2023,7 +2023,7 @@ func (m *CGCMsgSQLStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_CGCMsgSQLStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2075,7 +2075,7 @@ func (m *CGCMsgSQLStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CGCMsgSQLStatsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2178,7 +2178,7 @@ func (m *CMsgAMAddFreeLicense) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CMsgAMAddFreeLicense.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2245,7 +2245,7 @@ func (m *CMsgAMAddFreeLicenseResponse) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CMsgAMAddFreeLicenseResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2305,7 +2305,7 @@ func (m *CGCMsgGetIPLocation) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CGCMsgGetIPLocation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2354,7 +2354,7 @@ func (m *CIPLocationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_CIPLocationInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2433,7 +2433,7 @@ func (m *CGCMsgGetIPLocationResponse) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CGCMsgGetIPLocationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2478,7 +2478,7 @@ func (m *CGCMsgSystemStatsSchema) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_CGCMsgSystemStatsSchema.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2528,7 +2528,7 @@ func (m *CGCMsgGetSystemStats) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CGCMsgGetSystemStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2577,7 +2577,7 @@ func (m *CGCMsgGetSystemStatsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CGCMsgGetSystemStatsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2710,7 +2710,7 @@ func (m *CMsgAMSendEmail) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_CMsgAMSendEmail.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2790,7 +2790,7 @@ func (m *CMsgAMSendEmail_ReplacementToken) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CMsgAMSendEmail_ReplacementToken.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2846,7 +2846,7 @@ func (m *CMsgAMSendEmail_PersonaNameReplacementToken) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgAMSendEmail_PersonaNameReplacementToken.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2897,7 +2897,7 @@ func (m *CMsgAMSendEmailResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_CMsgAMSendEmailResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2946,7 +2946,7 @@ func (m *CMsgGCGetEmailTemplate) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CMsgGCGetEmailTemplate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3013,7 +3013,7 @@ func (m *CMsgGCGetEmailTemplateResponse) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CMsgGCGetEmailTemplateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3077,7 +3077,7 @@ func (m *CMsgAMGrantGuestPasses2) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return xxx_messageInfo_CMsgAMGrantGuestPasses2.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3150,7 +3150,7 @@ func (m *CMsgAMGrantGuestPasses2Response) XXX_Marshal(b []byte, deterministic bo
 		return xxx_messageInfo_CMsgAMGrantGuestPasses2Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3205,7 +3205,7 @@ func (m *CGCSystemMsg_GetAccountDetails) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CGCSystemMsg_GetAccountDetails.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3291,7 +3291,7 @@ func (m *CGCSystemMsg_GetAccountDetails_Response) XXX_Marshal(b []byte, determin
 		return xxx_messageInfo_CGCSystemMsg_GetAccountDetails_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3568,7 +3568,7 @@ func (m *CMsgGCGetPersonaNames) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CMsgGCGetPersonaNames.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3613,7 +3613,7 @@ func (m *CMsgGCGetPersonaNames_Response) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CMsgGCGetPersonaNames_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3669,7 +3669,7 @@ func (m *CMsgGCGetPersonaNames_Response_PersonaName) XXX_Marshal(b []byte, deter
 		return xxx_messageInfo_CMsgGCGetPersonaNames_Response_PersonaName.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3721,7 +3721,7 @@ func (m *CMsgGCCheckFriendship) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CMsgGCCheckFriendship.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3773,7 +3773,7 @@ func (m *CMsgGCCheckFriendship_Response) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CMsgGCCheckFriendship_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3825,7 +3825,7 @@ func (m *CMsgGCMsgMasterSetDirectory) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CMsgGCMsgMasterSetDirectory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3880,7 +3880,7 @@ func (m *CMsgGCMsgMasterSetDirectory_SubGC) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCMsgMasterSetDirectory_SubGC.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -3952,7 +3952,7 @@ func (m *CMsgGCMsgMasterSetDirectory_Response) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgGCMsgMasterSetDirectory_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4000,7 +4000,7 @@ func (m *CMsgGCMsgWebAPIJobRequestForwardResponse) XXX_Marshal(b []byte, determi
 		return xxx_messageInfo_CMsgGCMsgWebAPIJobRequestForwardResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4044,7 +4044,7 @@ func (m *CGCSystemMsg_GetPurchaseTrust_Request) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CGCSystemMsg_GetPurchaseTrust_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4093,7 +4093,7 @@ func (m *CGCSystemMsg_GetPurchaseTrust_Response) XXX_Marshal(b []byte, determini
 		return xxx_messageInfo_CGCSystemMsg_GetPurchaseTrust_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4162,7 +4162,7 @@ func (m *CMsgGCHAccountVacStatusChange) XXX_Marshal(b []byte, deterministic bool
 		return xxx_messageInfo_CMsgGCHAccountVacStatusChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4234,7 +4234,7 @@ func (m *CMsgGCGetPartnerAccountLink) XXX_Marshal(b []byte, deterministic bool)
 		return xxx_messageInfo_CMsgGCGetPartnerAccountLink.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4279,7 +4279,7 @@ func (m *CMsgGCGetPartnerAccountLink_Response) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgGCGetPartnerAccountLink_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4334,7 +4334,7 @@ func (m *CMsgGCRoutingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CMsgGCRoutingInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4409,7 +4409,7 @@ func (m *CMsgGCMsgMasterSetWebAPIRouting) XXX_Marshal(b []byte, deterministic bo
 		return xxx_messageInfo_CMsgGCMsgMasterSetWebAPIRouting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4455,7 +4455,7 @@ func (m *CMsgGCMsgMasterSetWebAPIRouting_Entry) XXX_Marshal(b []byte, determinis
 		return xxx_messageInfo_CMsgGCMsgMasterSetWebAPIRouting_Entry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4513,7 +4513,7 @@ func (m *CMsgGCMsgMasterSetClientMsgRouting) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgGCMsgMasterSetClientMsgRouting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4560,7 +4560,7 @@ func (m *CMsgGCMsgMasterSetClientMsgRouting_Entry) XXX_Marshal(b []byte, determi
 		return xxx_messageInfo_CMsgGCMsgMasterSetClientMsgRouting_Entry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4613,7 +4613,7 @@ func (m *CMsgGCMsgMasterSetWebAPIRouting_Response) XXX_Marshal(b []byte, determi
 		return xxx_messageInfo_CMsgGCMsgMasterSetWebAPIRouting_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4663,7 +4663,7 @@ func (m *CMsgGCMsgMasterSetClientMsgRouting_Response) XXX_Marshal(b []byte, dete
 		return xxx_messageInfo_CMsgGCMsgMasterSetClientMsgRouting_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4710,7 +4710,7 @@ func (m *CMsgGCMsgSetOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CMsgGCMsgSetOptions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4762,7 +4762,7 @@ func (m *CMsgGCMsgSetOptions_MessageRange) XXX_Marshal(b []byte, deterministic b
 		return xxx_messageInfo_CMsgGCMsgSetOptions_MessageRange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4825,7 +4825,7 @@ func (m *CMsgGCHUpdateSession) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_CMsgGCHUpdateSession.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -4954,7 +4954,7 @@ func (m *CMsgGCHUpdateSession_ExtraField) XXX_Marshal(b []byte, deterministic bo
 		return xxx_messageInfo_CMsgGCHUpdateSession_ExtraField.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5007,7 +5007,7 @@ func (m *CMsgNotificationOfSuspiciousActivity) XXX_Marshal(b []byte, determinist
 		return xxx_messageInfo_CMsgNotificationOfSuspiciousActivity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5070,7 +5070,7 @@ func (m *CMsgNotificationOfSuspiciousActivity_MultipleGameInstances) XXX_Marshal
 		return xxx_messageInfo_CMsgNotificationOfSuspiciousActivity_MultipleGameInstances.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5124,7 +5124,7 @@ func (m *CMsgDPPartnerMicroTxns) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_CMsgDPPartnerMicroTxns.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5207,7 +5207,7 @@ func (m *CMsgDPPartnerMicroTxns_PartnerMicroTxn) XXX_Marshal(b []byte, determini
 		return xxx_messageInfo_CMsgDPPartnerMicroTxns_PartnerMicroTxn.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5366,7 +5366,7 @@ func (m *CMsgDPPartnerMicroTxns_PartnerInfo) XXX_Marshal(b []byte, deterministic
 		return xxx_messageInfo_CMsgDPPartnerMicroTxns_PartnerInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5432,7 +5432,7 @@ func (m *CMsgDPPartnerMicroTxnsResponse) XXX_Marshal(b []byte, deterministic boo
 		return xxx_messageInfo_CMsgDPPartnerMicroTxnsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -5587,370 +5587,368 @@ func init() {
 func init() { proto.RegisterFile("steammessages.proto", fileDescriptor_662a1850681ae3f8) }
 
 var fileDescriptor_662a1850681ae3f8 = []byte{
-	// 5700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x7b, 0x4d, 0x6c, 0x23, 0xc9,
-	0x75, 0xff, 0x90, 0xfa, 0x7e, 0x12, 0x29, 0xaa, 0x35, 0x1f, 0x5c, 0xcd, 0x97, 0xb6, 0xd7, 0xb3,
-	0xab, 0xdd, 0x59, 0x73, 0x66, 0x67, 0x67, 0x3f, 0x2c, 0xdb, 0xf8, 0xaf, 0x86, 0xfa, 0x18, 0xee,
-	0x4a, 0x1a, 0x2d, 0xc9, 0xd1, 0xfe, 0x13, 0x04, 0x6e, 0x17, 0xbb, 0x8b, 0x64, 0xad, 0xc8, 0xee,
-	0xde, 0xae, 0xa2, 0x24, 0xde, 0x36, 0x09, 0x60, 0xd8, 0x97, 0x20, 0x40, 0x0e, 0xb9, 0x04, 0xbe,
-	0xc5, 0x08, 0x72, 0x48, 0xe2, 0x8b, 0x0f, 0xb9, 0x25, 0xbe, 0x38, 0x87, 0x00, 0x3e, 0x04, 0x48,
-	0x4e, 0x89, 0x63, 0x23, 0xc7, 0x20, 0x40, 0xae, 0x09, 0x90, 0xa0, 0x5e, 0x55, 0x35, 0xab, 0x49,
-	0xed, 0x68, 0x62, 0x9f, 0xc4, 0x7e, 0xbf, 0xf7, 0x5e, 0x7d, 0xbd, 0x7a, 0xef, 0xd5, 0xab, 0x12,
-	0xac, 0x72, 0x41, 0x49, 0xbf, 0x4f, 0x39, 0x27, 0x1d, 0xca, 0x2b, 0x71, 0x12, 0x89, 0x68, 0x6d,
-	0xbd, 0x13, 0x45, 0x9d, 0x1e, 0x7d, 0x80, 0x5f, 0xad, 0x41, 0xfb, 0x41, 0x40, 0xb9, 0x9f, 0xb0,
-	0x58, 0x44, 0x89, 0xe2, 0x70, 0xff, 0x6a, 0x1a, 0x9c, 0xea, 0x01, 0xef, 0x1c, 0xc9, 0xaf, 0x27,
-	0x83, 0xf6, 0x53, 0x4a, 0x02, 0x9a, 0x38, 0x6f, 0xc3, 0xb2, 0xdf, 0x63, 0x34, 0x14, 0x1e, 0xaa,
-	0xf5, 0x58, 0x50, 0xce, 0xad, 0xe7, 0x36, 0x66, 0x9f, 0x4c, 0xff, 0xec, 0x9f, 0xef, 0x5e, 0xa9,
-	0x17, 0x14, 0xd8, 0x90, 0x58, 0x2d, 0x70, 0x1e, 0xc2, 0x8a, 0xe1, 0xa6, 0x9c, 0xb3, 0x28, 0x94,
-	0xfc, 0xf9, 0xf5, 0xdc, 0xc6, 0x8c, 0xe6, 0xd7, 0xca, 0x1a, 0x0a, 0xad, 0x05, 0xce, 0x06, 0x14,
-	0x78, 0x34, 0x48, 0x7c, 0xea, 0x91, 0x38, 0x96, 0xdc, 0x53, 0xeb, 0xb9, 0x8d, 0x82, 0xe6, 0x5e,
-	0x54, 0xd0, 0x56, 0x1c, 0xd7, 0x02, 0xe7, 0x43, 0x28, 0x7c, 0x1e, 0xb5, 0x3c, 0x16, 0x78, 0x8a,
-	0x5a, 0x06, 0xd9, 0x8f, 0xcd, 0xab, 0xef, 0x7c, 0xf8, 0xf8, 0xf1, 0xfb, 0x1f, 0x3c, 0x7e, 0xfc,
-	0xf0, 0x83, 0x77, 0x3f, 0x78, 0xf8, 0x8d, 0xf7, 0xde, 0x7b, 0xe7, 0xfd, 0x77, 0xde, 0xab, 0x2f,
-	0x7e, 0x1e, 0xb5, 0x6a, 0x41, 0x03, 0x19, 0x2d, 0x49, 0x41, 0x92, 0x0e, 0x15, 0xe5, 0xc5, 0x4b,
-	0x25, 0x9b, 0xc8, 0x28, 0x47, 0xaf, 0x44, 0x3c, 0xa9, 0x20, 0x24, 0x7d, 0x5a, 0x5e, 0x5a, 0xcf,
-	0x6d, 0x2c, 0x98, 0xd1, 0x2b, 0xf0, 0xe3, 0xa8, 0x75, 0x48, 0xfa, 0xd4, 0xb9, 0x09, 0x73, 0x34,
-	0xa1, 0x7c, 0xd0, 0x13, 0xe5, 0x82, 0x1c, 0xf3, 0x66, 0xee, 0x51, 0xdd, 0x50, 0x9c, 0x37, 0xa1,
-	0x40, 0x93, 0x24, 0x4a, 0x3c, 0xbd, 0x32, 0xe5, 0xa2, 0xa5, 0x68, 0x09, 0xa1, 0x03, 0x85, 0x38,
-	0x57, 0x21, 0xcf, 0xe2, 0xf2, 0xb2, 0x35, 0x11, 0x79, 0x16, 0x3b, 0x87, 0x00, 0x1d, 0xdf, 0xeb,
-	0xf3, 0x8e, 0xc7, 0x13, 0xbf, 0xfc, 0x33, 0xb9, 0x0a, 0xc5, 0x47, 0x2b, 0x95, 0xbd, 0xaa, 0x59,
-	0xb1, 0x03, 0xde, 0x69, 0x24, 0xfe, 0xe6, 0xad, 0x71, 0x8a, 0xf7, 0x3c, 0xe4, 0x31, 0xf5, 0x59,
-	0x9b, 0xd1, 0xa0, 0x3e, 0xdf, 0xf1, 0x15, 0xd5, 0x79, 0x0c, 0xab, 0x1d, 0xdf, 0x0b, 0x58, 0xe2,
-	0xb1, 0x30, 0xa0, 0xe7, 0x66, 0x56, 0xff, 0x2e, 0x67, 0xb5, 0x5b, 0xea, 0xf8, 0xdb, 0x2c, 0xa9,
-	0x49, 0x5c, 0xcd, 0xe5, 0x26, 0x7c, 0xf9, 0xa3, 0xdb, 0x5f, 0xe6, 0xbf, 0xff, 0xa3, 0xdb, 0x5f,
-	0xce, 0xbb, 0x7f, 0x9d, 0x83, 0x82, 0x34, 0x99, 0xcf, 0x68, 0x6b, 0xeb, 0xa8, 0xf6, 0x09, 0x1d,
-	0x3a, 0x37, 0x61, 0x96, 0x0b, 0x22, 0x06, 0x1c, 0x8d, 0xa4, 0xb0, 0x39, 0xf5, 0xe8, 0xbd, 0xf7,
-	0xea, 0x9a, 0xe4, 0xac, 0x03, 0x10, 0xdf, 0x8f, 0x06, 0xa1, 0x30, 0x56, 0x51, 0xd8, 0xcc, 0x3d,
-	0xac, 0x2f, 0x68, 0x62, 0x2d, 0x70, 0x1e, 0x80, 0x13, 0x0f, 0x5a, 0x3d, 0xc6, 0xbb, 0x34, 0xf1,
-	0x3a, 0x49, 0x34, 0x18, 0x59, 0x84, 0xe4, 0x2c, 0xa5, 0xe0, 0x9e, 0xc4, 0x6a, 0x81, 0x6c, 0xef,
-	0x84, 0x0e, 0x25, 0xd3, 0xb4, 0xd5, 0xeb, 0x99, 0x13, 0x3a, 0xac, 0x05, 0xce, 0x2d, 0x98, 0x0d,
-	0xa2, 0x3e, 0x61, 0x61, 0x79, 0xc6, 0x9a, 0x6a, 0x4d, 0x73, 0x7f, 0x6f, 0x1a, 0x96, 0x65, 0xe7,
-	0x9f, 0x0a, 0x11, 0xd7, 0xe9, 0x17, 0x03, 0xca, 0x85, 0x73, 0x1f, 0x8a, 0x89, 0xfa, 0xe9, 0xf5,
-	0xa9, 0xe8, 0x46, 0xca, 0xd6, 0x8d, 0xda, 0x82, 0xc6, 0x0e, 0x10, 0x72, 0xd6, 0x61, 0xbe, 0x1b,
-	0x71, 0x81, 0x46, 0x91, 0xb7, 0x1a, 0x48, 0xa9, 0xce, 0x75, 0x98, 0x1a, 0x24, 0x3d, 0xec, 0xbf,
-	0x01, 0x25, 0xc1, 0xf9, 0x10, 0xe6, 0xba, 0xb8, 0xbb, 0x78, 0x79, 0x7a, 0x7d, 0x6a, 0x63, 0xf1,
-	0xd1, 0x9d, 0xca, 0x58, 0x4f, 0x2a, 0xfa, 0xaf, 0xda, 0x84, 0x75, 0xc3, 0xee, 0x6c, 0x02, 0x48,
-	0x63, 0x8c, 0x49, 0x42, 0xfa, 0xbc, 0x3c, 0x83, 0xc2, 0x37, 0x27, 0x84, 0x3f, 0x1d, 0xd0, 0x64,
-	0x78, 0x24, 0x79, 0xea, 0x0b, 0x1d, 0x2a, 0xf0, 0x17, 0x77, 0xbe, 0x05, 0x8b, 0x71, 0xc4, 0x53,
-	0xe1, 0xd9, 0xcb, 0x85, 0x41, 0xf2, 0x6b, 0xe9, 0x32, 0x4c, 0xb7, 0xa2, 0x60, 0x58, 0x9e, 0x5b,
-	0xcf, 0x6d, 0x2c, 0xe9, 0xc1, 0x20, 0xc5, 0x79, 0x00, 0x25, 0xd2, 0xe2, 0x51, 0x6f, 0x20, 0xa8,
-	0x27, 0x58, 0x9f, 0x46, 0x03, 0x51, 0x9e, 0xb7, 0xa6, 0x6d, 0xd9, 0xa0, 0x4d, 0x05, 0xae, 0xed,
-	0x40, 0x21, 0x33, 0x3c, 0xa9, 0x1b, 0x67, 0x31, 0x67, 0x4d, 0x14, 0x52, 0x9c, 0x35, 0x98, 0x39,
-	0x25, 0xbd, 0x41, 0x76, 0x82, 0x15, 0x69, 0xed, 0x09, 0xc0, 0xa8, 0xaf, 0x2f, 0xab, 0x63, 0x29,
-	0xa3, 0xc3, 0xfd, 0x49, 0x1e, 0x56, 0x46, 0x16, 0x6c, 0xcc, 0xe0, 0x6d, 0x58, 0x7e, 0x7e, 0xf8,
-	0xbc, 0xb1, 0xb3, 0x3d, 0xda, 0xf5, 0xb6, 0xda, 0x82, 0x02, 0xcd, 0xae, 0xbf, 0x0f, 0x45, 0x16,
-	0x0a, 0x9a, 0xb4, 0x89, 0x4f, 0xbd, 0x09, 0x6b, 0x28, 0xa4, 0x18, 0x32, 0xdf, 0x83, 0x45, 0x65,
-	0x59, 0x8a, 0xd3, 0x36, 0x0d, 0x50, 0x00, 0xb2, 0xdd, 0x81, 0xb9, 0x53, 0x9a, 0x48, 0x17, 0x99,
-	0x31, 0x6c, 0x43, 0x74, 0xde, 0x80, 0x39, 0x12, 0x33, 0xef, 0x84, 0x0e, 0xd1, 0xb6, 0x17, 0x1f,
-	0x15, 0x2b, 0x99, 0x8d, 0x58, 0x9f, 0x25, 0x31, 0x93, 0x1b, 0xf2, 0x2d, 0x98, 0xd3, 0x56, 0x5b,
-	0x9e, 0x45, 0xc6, 0xd2, 0xf8, 0x82, 0xd7, 0x0d, 0x83, 0xf3, 0x16, 0x14, 0x93, 0x68, 0x20, 0x58,
-	0xd8, 0x31, 0xbe, 0x78, 0xce, 0x6a, 0x7b, 0x49, 0x63, 0xe8, 0x8c, 0xdd, 0x7f, 0xcc, 0x41, 0x69,
-	0xa4, 0x88, 0xc7, 0x51, 0xc8, 0x71, 0x70, 0x6a, 0xab, 0x7b, 0x7e, 0x14, 0xd0, 0xcc, 0xde, 0x01,
-	0x05, 0x54, 0xa3, 0x80, 0x3a, 0x9b, 0x23, 0xf3, 0xcf, 0xa3, 0x11, 0xae, 0x57, 0xc6, 0x55, 0x55,
-	0xcc, 0x8f, 0xf1, 0x0d, 0x60, 0xcc, 0x70, 0x6a, 0xdc, 0x0c, 0xd7, 0x76, 0xa1, 0x98, 0x15, 0xfa,
-	0xf5, 0xcc, 0xca, 0x6d, 0xab, 0x30, 0xb8, 0x75, 0xb0, 0xcb, 0xc2, 0x60, 0x4b, 0xb9, 0x26, 0x8e,
-	0x43, 0xa3, 0x24, 0xf1, 0xbb, 0x9e, 0x18, 0xc6, 0xe3, 0x43, 0x43, 0xa0, 0x39, 0x8c, 0xa9, 0x74,
-	0xf2, 0x9a, 0x8d, 0x8b, 0x84, 0x85, 0x9d, 0x4c, 0x03, 0x4b, 0x0a, 0x6a, 0x20, 0xe2, 0x7e, 0x00,
-	0x6b, 0x93, 0xed, 0xa4, 0x53, 0xf9, 0x0a, 0xcc, 0x5b, 0xf1, 0x76, 0x6a, 0x63, 0xb6, 0x3e, 0xc7,
-	0x55, 0x8c, 0x75, 0xff, 0x3b, 0xa7, 0x7a, 0x78, 0x18, 0x09, 0xd6, 0x1e, 0x7e, 0x46, 0x84, 0xdf,
-	0x0d, 0xa2, 0x8e, 0xf4, 0x76, 0xda, 0x83, 0xdb, 0x9d, 0xd3, 0x34, 0xe7, 0x35, 0x00, 0xd2, 0xa3,
-	0x89, 0x50, 0xdd, 0xcf, 0x5b, 0x1c, 0x0b, 0x48, 0xc7, 0xde, 0xbf, 0x03, 0x2b, 0x8a, 0x29, 0xa0,
-	0x5c, 0xb0, 0x90, 0x08, 0x69, 0x7f, 0x76, 0x3c, 0x2e, 0x21, 0xbc, 0x3d, 0x42, 0xa5, 0x13, 0xf4,
-	0x13, 0x26, 0x98, 0x4f, 0x7a, 0x68, 0xa9, 0xf3, 0xc6, 0x09, 0x1a, 0xaa, 0x5c, 0x05, 0xe9, 0x15,
-	0xd0, 0x4e, 0x8d, 0x1e, 0xa4, 0xc8, 0x55, 0x20, 0x71, 0xcc, 0x02, 0xb4, 0xcc, 0xd4, 0x77, 0x23,
-	0x09, 0xa5, 0xe8, 0xb9, 0x40, 0x0b, 0x4c, 0xd7, 0x4e, 0x52, 0xdc, 0x77, 0xd5, 0x8e, 0xdd, 0x3a,
-	0xd8, 0xa3, 0x62, 0x9f, 0xf9, 0x34, 0xe4, 0x94, 0xcb, 0xfd, 0x82, 0xd3, 0x33, 0x96, 0x9d, 0x18,
-	0xa2, 0xfb, 0xfb, 0x7a, 0xce, 0x8e, 0x88, 0x7f, 0x42, 0x3a, 0x54, 0xcb, 0xc9, 0x59, 0x89, 0x15,
-	0xc5, 0xe4, 0x35, 0xe9, 0xac, 0x68, 0x7a, 0x2d, 0x70, 0xde, 0x80, 0x25, 0xd9, 0x5d, 0xcf, 0x4f,
-	0x28, 0x11, 0x34, 0xc8, 0x4c, 0xde, 0xa2, 0x44, 0xaa, 0x0a, 0x70, 0xee, 0xc2, 0x7c, 0x74, 0x16,
-	0xd2, 0x64, 0x3c, 0x8b, 0x99, 0x43, 0x6a, 0x2d, 0x70, 0xbb, 0xf0, 0xca, 0x44, 0xd7, 0xd3, 0x15,
-	0xff, 0x3a, 0xcc, 0xf5, 0x14, 0x0d, 0x17, 0x7c, 0xf1, 0xd1, 0x6a, 0x65, 0xb2, 0xc7, 0x75, 0xc3,
-	0x23, 0x97, 0x5b, 0xa7, 0x1a, 0x76, 0x7f, 0x34, 0xcd, 0x0d, 0xe1, 0x7a, 0xda, 0xd2, 0x73, 0x4e,
-	0x93, 0x3d, 0xd2, 0xa7, 0x0d, 0x41, 0x04, 0x97, 0x9d, 0xbc, 0x30, 0x91, 0x33, 0xe6, 0xe5, 0xdc,
-	0x86, 0xb9, 0x0e, 0xe9, 0x53, 0x13, 0xa2, 0x0d, 0x3e, 0x2b, 0x89, 0xb5, 0xc0, 0xb9, 0x0a, 0x33,
-	0x72, 0x2b, 0xf3, 0xf2, 0xd4, 0xfa, 0xd4, 0x46, 0xa1, 0xae, 0x3e, 0xdc, 0x1f, 0x4c, 0xc3, 0x9d,
-	0x8b, 0x1b, 0x4c, 0xc7, 0xf7, 0x9b, 0x36, 0x6c, 0x25, 0x57, 0x53, 0x13, 0xc9, 0xd5, 0x37, 0x4d,
-	0xaf, 0x54, 0x3c, 0xbd, 0x57, 0x79, 0x71, 0x67, 0x2a, 0xea, 0x4b, 0xc9, 0x38, 0x04, 0x1c, 0xe2,
-	0x77, 0x19, 0x3d, 0xa5, 0x7d, 0x99, 0xb9, 0xb6, 0x7a, 0x91, 0x7f, 0x62, 0x82, 0xeb, 0xa3, 0xcb,
-	0x34, 0x6d, 0x59, 0x92, 0x4f, 0x50, 0xb2, 0xbe, 0x62, 0x69, 0x53, 0xa4, 0xb5, 0x4f, 0x60, 0x46,
-	0x4d, 0xff, 0x6d, 0x69, 0xa8, 0x44, 0x8c, 0x9b, 0x1b, 0xa6, 0x48, 0xb5, 0x40, 0x1a, 0x24, 0xc2,
-	0x23, 0xef, 0x94, 0x1a, 0xa4, 0xa4, 0x1f, 0x63, 0xe0, 0xfb, 0x93, 0x1c, 0x38, 0x93, 0xcd, 0xca,
-	0x38, 0x64, 0x0f, 0x63, 0xac, 0x85, 0x82, 0x85, 0xd5, 0x02, 0xe7, 0xd1, 0xd8, 0x98, 0xd9, 0x28,
-	0x27, 0x4b, 0xf7, 0xba, 0x35, 0x0a, 0x26, 0x65, 0xee, 0xc1, 0xe2, 0x20, 0x94, 0x6d, 0x61, 0x98,
-	0xc7, 0x55, 0x98, 0x33, 0x3e, 0x50, 0x01, 0x32, 0xc2, 0xbb, 0xdf, 0x85, 0xab, 0x72, 0xce, 0xf6,
-	0xaa, 0x7b, 0x54, 0x54, 0xa3, 0x7e, 0x9f, 0x84, 0xc1, 0x3e, 0xe3, 0x42, 0xe6, 0x6a, 0x3a, 0xac,
-	0xe4, 0xc6, 0xf6, 0x7b, 0x2d, 0x90, 0x9d, 0xf7, 0x15, 0xaf, 0x17, 0x27, 0xb4, 0xcd, 0xce, 0xb3,
-	0x41, 0x54, 0x63, 0x47, 0x08, 0xb9, 0x5b, 0x70, 0xeb, 0xa2, 0x16, 0x52, 0x53, 0x7b, 0x15, 0x96,
-	0x8c, 0x32, 0x1d, 0x00, 0xa6, 0x36, 0x16, 0xea, 0x8b, 0x9a, 0x26, 0x03, 0xac, 0xbb, 0x01, 0x4e,
-	0x75, 0x4f, 0x2a, 0x39, 0xa0, 0xfd, 0x2a, 0xf1, 0xbb, 0x34, 0xd8, 0xa3, 0xc2, 0x71, 0x60, 0xfa,
-	0x84, 0x0e, 0xb9, 0x16, 0xc0, 0xdf, 0xee, 0x0f, 0x73, 0xb0, 0x36, 0xc9, 0x9a, 0xb6, 0xf5, 0x2d,
-	0x98, 0xc5, 0xc5, 0xe2, 0x7a, 0xd7, 0x7e, 0xad, 0xf2, 0xd5, 0xcc, 0x15, 0x5c, 0xbf, 0x26, 0xe9,
-	0xd4, 0xb5, 0xcc, 0xda, 0x13, 0x98, 0x37, 0x34, 0xe9, 0x0e, 0xdb, 0xd1, 0x20, 0x54, 0xd3, 0x63,
-	0xfc, 0xa8, 0x22, 0xbd, 0x30, 0x87, 0xf9, 0x5e, 0x6e, 0x62, 0x2c, 0x0d, 0x2a, 0x9c, 0x07, 0xd6,
-	0x58, 0x30, 0xcf, 0x9b, 0x60, 0xa9, 0x7c, 0x42, 0x87, 0x47, 0x84, 0x25, 0x6a, 0xa0, 0x6b, 0xff,
-	0x0f, 0xe6, 0x34, 0xe1, 0xd7, 0x4c, 0xa6, 0xee, 0xc3, 0xb5, 0xb1, 0x46, 0xb6, 0x69, 0x8f, 0x0a,
-	0x7a, 0xe1, 0xb4, 0x5e, 0x87, 0xab, 0xe3, 0x3d, 0x42, 0x4f, 0xf2, 0xe7, 0xd3, 0x70, 0xeb, 0x22,
-	0x20, 0x9d, 0xf0, 0x07, 0x50, 0xf2, 0x07, 0x49, 0xe2, 0xf9, 0x51, 0x18, 0x52, 0x5f, 0x06, 0x21,
-	0x75, 0xd8, 0x98, 0x4e, 0x4f, 0x98, 0x83, 0x24, 0xa9, 0x8e, 0x40, 0xb9, 0xe5, 0xfc, 0x7e, 0xe0,
-	0xc9, 0x73, 0x5f, 0xde, 0xe2, 0x9b, 0xf5, 0xfb, 0xb8, 0xe6, 0x1a, 0xe6, 0x54, 0xf9, 0x15, 0x1b,
-	0x96, 0xd3, 0xf8, 0x2a, 0x2c, 0x48, 0xb8, 0xdd, 0x1b, 0xf0, 0x2e, 0x46, 0xb8, 0xe9, 0x34, 0xc2,
-	0xf5, 0x83, 0x5d, 0x49, 0x95, 0x9e, 0x4d, 0x26, 0xe5, 0x5d, 0x26, 0x38, 0x46, 0x39, 0xc3, 0x31,
-	0xd7, 0xa1, 0xe2, 0x29, 0x13, 0x5c, 0xee, 0x6a, 0xc9, 0xd0, 0x67, 0x9c, 0x53, 0x8e, 0xd1, 0xce,
-	0xb0, 0xc8, 0xf4, 0xfc, 0x00, 0xc9, 0x72, 0x77, 0x05, 0x38, 0x5d, 0x4a, 0xd1, 0x9c, 0xc5, 0x05,
-	0x0a, 0x40, 0x5d, 0x6f, 0x42, 0x41, 0xb3, 0x69, 0x75, 0xf3, 0x16, 0xe3, 0x92, 0x82, 0xb4, 0xc6,
-	0xd7, 0x00, 0x5a, 0x43, 0x41, 0xb9, 0x97, 0x50, 0x12, 0x94, 0x17, 0xec, 0x66, 0x91, 0x5e, 0xa7,
-	0x24, 0x90, 0xfa, 0x14, 0xd3, 0x59, 0xc2, 0x84, 0xa0, 0x21, 0x9e, 0xaa, 0x53, 0x7d, 0x08, 0x7d,
-	0xa6, 0x10, 0xb9, 0x47, 0x7b, 0xac, 0xcf, 0x84, 0xd7, 0x27, 0xe7, 0x08, 0xe0, 0x39, 0xda, 0xf0,
-	0x16, 0x10, 0x3b, 0xd0, 0x90, 0x6c, 0x1c, 0x97, 0x89, 0x09, 0xda, 0xe7, 0x78, 0x68, 0x4e, 0x1b,
-	0x97, 0xf4, 0x9a, 0x24, 0x3b, 0x2e, 0x2c, 0xd0, 0x53, 0xa6, 0x17, 0xb1, 0x60, 0xf3, 0xa4, 0x64,
-	0x69, 0x71, 0xaa, 0xb1, 0xa2, 0x85, 0x2b, 0x92, 0xfb, 0x6d, 0x28, 0x2a, 0x5b, 0x69, 0x7c, 0xba,
-	0xaf, 0xfc, 0xeb, 0x7d, 0x28, 0x72, 0xbf, 0x4b, 0xfb, 0xc4, 0xf3, 0x89, 0x20, 0xbd, 0xa8, 0x93,
-	0x75, 0x82, 0x0a, 0xab, 0x2a, 0xc8, 0xfd, 0x9f, 0x29, 0xb8, 0x9e, 0x95, 0x4f, 0xad, 0xec, 0x0e,
-	0xcc, 0x89, 0xae, 0x9c, 0x37, 0x9e, 0x51, 0x60, 0x88, 0x32, 0x55, 0xd2, 0x3f, 0x8d, 0x21, 0x8e,
-	0x65, 0x06, 0x25, 0x0d, 0x57, 0x0d, 0x2a, 0xbb, 0x66, 0x44, 0x88, 0x2f, 0xd8, 0x29, 0xcd, 0x24,
-	0x09, 0x05, 0x8d, 0x6d, 0x21, 0xe4, 0x7c, 0x00, 0x57, 0xa3, 0x98, 0x26, 0x98, 0x64, 0x71, 0x8f,
-	0x0f, 0x5a, 0x7d, 0xb9, 0x06, 0xd9, 0x63, 0xee, 0xea, 0x88, 0xa3, 0x61, 0x18, 0x9c, 0x5d, 0xb8,
-	0x15, 0x27, 0x34, 0x26, 0x09, 0x0d, 0x3c, 0x19, 0x32, 0xd0, 0x7f, 0x73, 0x8f, 0x9e, 0x53, 0x7f,
-	0x20, 0x15, 0xd8, 0x69, 0xd8, 0x9a, 0xe1, 0x6c, 0xa4, 0x8c, 0x3b, 0x9a, 0xcf, 0x39, 0x80, 0xf5,
-	0x30, 0x0a, 0xbd, 0x17, 0xea, 0xb2, 0xf3, 0xb6, 0xdb, 0x61, 0x14, 0x1e, 0x7d, 0xb5, 0xba, 0x07,
-	0x50, 0x0a, 0x28, 0x09, 0x30, 0x7a, 0x24, 0x54, 0x24, 0x8c, 0xf2, 0xcc, 0xe9, 0x62, 0xd9, 0xa0,
-	0x75, 0x05, 0x3a, 0xbb, 0x70, 0xdb, 0x9a, 0x00, 0x19, 0x70, 0x02, 0x2f, 0x1a, 0x08, 0x8f, 0x85,
-	0xde, 0x17, 0x03, 0x3a, 0xa0, 0x99, 0x23, 0xe6, 0x2b, 0x23, 0x56, 0x19, 0x82, 0x82, 0x67, 0x03,
-	0x51, 0x0b, 0x3f, 0x95, 0x6c, 0x32, 0x4f, 0xc2, 0xda, 0x0a, 0xc7, 0x0d, 0x90, 0xc6, 0x5b, 0x45,
-	0x73, 0x7f, 0x9c, 0x53, 0xc1, 0x6a, 0xeb, 0x60, 0x2b, 0x08, 0x76, 0x13, 0x9a, 0x66, 0x86, 0x97,
-	0x24, 0x94, 0xd2, 0x2d, 0xb0, 0xd8, 0xc3, 0x7a, 0x84, 0x9f, 0x59, 0xf7, 0x79, 0x16, 0x1f, 0x21,
-	0x55, 0x1a, 0xb7, 0x4e, 0x22, 0xc7, 0xf2, 0xc1, 0x11, 0x59, 0x86, 0x61, 0x2e, 0xa2, 0x84, 0x7a,
-	0x98, 0xfe, 0x27, 0x43, 0x75, 0x70, 0x9a, 0xb6, 0xdc, 0x6e, 0x09, 0xf1, 0xaa, 0x82, 0xe5, 0xf1,
-	0xc9, 0xfd, 0xe3, 0x9c, 0x0a, 0x7f, 0xe3, 0x7d, 0x4e, 0x6d, 0xd7, 0xca, 0x94, 0x72, 0x13, 0x99,
-	0xd2, 0x26, 0x5c, 0x8f, 0x07, 0x89, 0xdf, 0x25, 0x9c, 0x7a, 0x8a, 0xe4, 0x05, 0x54, 0x10, 0xd6,
-	0xcb, 0x94, 0xe9, 0xae, 0x1a, 0x9e, 0x3a, 0xb2, 0x6c, 0x23, 0x07, 0x6e, 0x8a, 0x84, 0x84, 0x5c,
-	0x8f, 0x27, 0x9d, 0x14, 0x4d, 0x74, 0xdf, 0x80, 0x55, 0xb5, 0x9d, 0xf6, 0xa8, 0xa8, 0x1d, 0xed,
-	0x47, 0xbe, 0x3a, 0x23, 0x94, 0x60, 0x8a, 0xc5, 0xca, 0xfb, 0xcf, 0xd5, 0xe5, 0x4f, 0xf7, 0xa7,
-	0x39, 0x58, 0xae, 0x8e, 0x38, 0x6a, 0x61, 0x3b, 0xd2, 0x45, 0xaf, 0xdc, 0x58, 0xd1, 0x6b, 0x1d,
-	0xe6, 0x7b, 0x44, 0x30, 0x31, 0x08, 0x54, 0xc8, 0xc9, 0x9b, 0x69, 0x36, 0x54, 0x39, 0xcd, 0xbd,
-	0x28, 0xec, 0x28, 0x96, 0x29, 0x8b, 0x65, 0x44, 0x96, 0x1d, 0xd7, 0x13, 0x9c, 0x99, 0x5b, 0x43,
-	0x94, 0x3e, 0x06, 0xed, 0x3b, 0x53, 0x28, 0x52, 0x24, 0x19, 0x0b, 0x7d, 0x26, 0x86, 0x68, 0xec,
-	0x69, 0x2c, 0x94, 0x14, 0x77, 0x07, 0x6e, 0x5e, 0x30, 0xdc, 0x74, 0x19, 0x5e, 0x87, 0x19, 0x16,
-	0xb6, 0x23, 0x13, 0x81, 0x4b, 0x95, 0xb1, 0x11, 0xd7, 0x15, 0xec, 0x7e, 0x07, 0x6e, 0x68, 0x27,
-	0x34, 0xe4, 0x82, 0xf6, 0xd1, 0x0f, 0x35, 0xd0, 0x4f, 0x39, 0xeb, 0xb0, 0xd0, 0xf1, 0xbd, 0x0b,
-	0xb2, 0xa6, 0xb9, 0x8e, 0xaf, 0x8a, 0xa2, 0xaf, 0xc2, 0x82, 0xf6, 0x77, 0x27, 0xa7, 0x99, 0x98,
-	0x3c, 0xaf, 0xc8, 0x9f, 0x9c, 0x8e, 0x22, 0xed, 0x1e, 0x15, 0x56, 0x13, 0xee, 0x4f, 0xd3, 0x48,
-	0x9b, 0x05, 0xd2, 0x01, 0x5c, 0xde, 0x3a, 0xe6, 0xf4, 0x44, 0xf0, 0xf1, 0xc6, 0x31, 0xc7, 0xe5,
-	0x9f, 0x9c, 0xca, 0xa0, 0xa6, 0x7c, 0x9d, 0xf7, 0x79, 0xd4, 0xe2, 0x99, 0x5d, 0x00, 0x0a, 0xf8,
-	0x38, 0x6a, 0x61, 0x50, 0x1b, 0x32, 0xda, 0x0b, 0x58, 0xd8, 0x51, 0x8c, 0xb6, 0x9b, 0x5b, 0x32,
-	0x90, 0x61, 0x1d, 0x70, 0x9a, 0x98, 0xfa, 0x32, 0xcf, 0x38, 0xb4, 0x25, 0x09, 0xe9, 0xda, 0x32,
-	0x77, 0xde, 0x87, 0xab, 0x78, 0xa0, 0xe0, 0x34, 0x39, 0xb5, 0x25, 0x6c, 0xb7, 0xe5, 0x48, 0x8e,
-	0x06, 0x32, 0xa4, 0x72, 0xeb, 0x30, 0xcf, 0x23, 0x5f, 0xa6, 0x1e, 0x59, 0x1f, 0x95, 0x52, 0x71,
-	0xdb, 0xea, 0xdf, 0x9e, 0x88, 0x3c, 0x99, 0xfc, 0x92, 0x20, 0xe3, 0x91, 0x4a, 0x06, 0x6f, 0x46,
-	0xcf, 0x11, 0x95, 0x1e, 0x30, 0x95, 0x91, 0x04, 0x16, 0x76, 0x32, 0x2e, 0x69, 0xd9, 0xa0, 0xfb,
-	0x0a, 0x74, 0xbe, 0x0e, 0xcb, 0x32, 0x26, 0xd3, 0x16, 0xf1, 0x4f, 0xb4, 0xcf, 0x03, 0x8b, 0xbf,
-	0x98, 0x82, 0xca, 0xd1, 0xbd, 0x09, 0x05, 0xed, 0x9c, 0x3c, 0x75, 0x80, 0x59, 0xb4, 0x27, 0x46,
-	0x43, 0xfb, 0x78, 0x52, 0xb8, 0x07, 0x8b, 0xbd, 0xa8, 0x13, 0x19, 0x4f, 0xba, 0x64, 0xaf, 0x0a,
-	0x02, 0x4a, 0xe3, 0x6b, 0xa0, 0xbe, 0xd4, 0x92, 0x14, 0x6c, 0x0f, 0x86, 0x74, 0xb9, 0x1e, 0xee,
-	0xf7, 0x74, 0x19, 0x75, 0xeb, 0xa0, 0x41, 0xc3, 0x60, 0xa7, 0xaf, 0xfd, 0xc4, 0x0b, 0x9d, 0xe7,
-	0x5b, 0x50, 0xa4, 0x92, 0x11, 0x8b, 0xd9, 0x13, 0x05, 0x89, 0x25, 0xc4, 0x0e, 0x78, 0x07, 0x6b,
-	0x12, 0x6f, 0x80, 0xfa, 0xf6, 0xda, 0x51, 0xd2, 0x27, 0x22, 0x7b, 0x3d, 0x80, 0xc8, 0x2e, 0x02,
-	0xce, 0xef, 0xc0, 0x6a, 0x4c, 0x13, 0x1e, 0x85, 0x04, 0x93, 0x7e, 0x4f, 0x44, 0x27, 0x34, 0x34,
-	0xc7, 0xb8, 0xb7, 0x2b, 0x63, 0x7d, 0xac, 0x1c, 0x29, 0x5e, 0x79, 0x18, 0xa8, 0xd3, 0xb8, 0x47,
-	0x7c, 0x0c, 0x58, 0x4d, 0x29, 0x54, 0x5f, 0x89, 0x47, 0x20, 0x52, 0x38, 0xee, 0x33, 0x75, 0x4d,
-	0xd1, 0xf1, 0x33, 0x06, 0x34, 0xaf, 0xc8, 0x7b, 0xbe, 0xf3, 0x0d, 0x98, 0xd5, 0x6d, 0xce, 0x61,
-	0x9b, 0xaf, 0x4e, 0xb4, 0x39, 0xd1, 0x90, 0x16, 0x58, 0xfb, 0x0e, 0x94, 0xc6, 0x31, 0x39, 0xfb,
-	0x88, 0x4e, 0xd6, 0x1f, 0x17, 0x90, 0x6e, 0xca, 0x89, 0x8a, 0x69, 0xb2, 0x9c, 0xa5, 0xa4, 0xd5,
-	0x89, 0xb1, 0x05, 0x37, 0x5f, 0x30, 0xde, 0x4b, 0xd7, 0x2b, 0xdb, 0x95, 0xfc, 0x85, 0x5d, 0x71,
-	0xdf, 0x87, 0x1b, 0x63, 0xe3, 0xfd, 0xaa, 0x80, 0x54, 0xb0, 0x03, 0x92, 0xfb, 0x97, 0x39, 0x55,
-	0xab, 0xc0, 0xd3, 0x1c, 0xca, 0x35, 0x69, 0x3f, 0xee, 0x49, 0xd7, 0xfb, 0xc2, 0x13, 0xe3, 0xff,
-	0xc5, 0x88, 0x5e, 0x03, 0x50, 0xbc, 0x3d, 0x12, 0x76, 0x54, 0xf9, 0x20, 0x4d, 0x34, 0x25, 0x7d,
-	0x9f, 0x84, 0x9d, 0x09, 0x4b, 0x9b, 0xb6, 0xd8, 0x6c, 0x4b, 0x73, 0xff, 0x20, 0xa7, 0x8a, 0x1d,
-	0x93, 0x3d, 0x7e, 0xa9, 0x11, 0xcb, 0x8d, 0x2d, 0xb4, 0x80, 0x47, 0xcf, 0x19, 0x17, 0x1c, 0xbb,
-	0x6e, 0x8e, 0x7c, 0x45, 0x03, 0xee, 0x20, 0x26, 0xdd, 0x91, 0xa1, 0x64, 0xea, 0xc5, 0x29, 0xd5,
-	0xfd, 0x97, 0x9c, 0x99, 0xfb, 0xbd, 0x84, 0x84, 0x62, 0x6f, 0x40, 0xb9, 0x38, 0x22, 0xf2, 0x0c,
-	0xf0, 0xe8, 0xf2, 0xb2, 0x4b, 0xb6, 0x06, 0x96, 0xbf, 0xb8, 0x06, 0xf6, 0x36, 0x2c, 0xc7, 0xa8,
-	0x50, 0xba, 0xbb, 0x8e, 0x6c, 0x24, 0x33, 0x8b, 0x05, 0x05, 0x36, 0x23, 0x6c, 0x5f, 0xba, 0xc7,
-	0x80, 0x0c, 0x91, 0x97, 0x9e, 0xc7, 0x4c, 0x65, 0x66, 0x99, 0xf9, 0x2c, 0x49, 0xbc, 0x19, 0xed,
-	0xa4, 0xa8, 0xcc, 0xd3, 0x08, 0x66, 0xfc, 0xe8, 0xd0, 0x0d, 0x9f, 0xa6, 0xb9, 0x5d, 0xb8, 0xfb,
-	0x15, 0x03, 0x7c, 0xb9, 0xac, 0x67, 0x03, 0x8a, 0xba, 0xff, 0xd8, 0x79, 0x9d, 0xab, 0xcf, 0x6c,
-	0xe6, 0x1e, 0x9a, 0xbe, 0xef, 0x29, 0xba, 0xdb, 0x85, 0x3b, 0xd5, 0xbd, 0xaa, 0x0a, 0x87, 0x07,
-	0xbc, 0xe3, 0xed, 0x51, 0xa1, 0x8b, 0xb3, 0x2a, 0x09, 0xba, 0xb4, 0xd6, 0x38, 0x2a, 0x6b, 0xe6,
-	0x27, 0xca, 0x9a, 0xea, 0xf6, 0x2c, 0xf7, 0xfd, 0x1f, 0xdd, 0xfe, 0x72, 0xda, 0xfd, 0xd3, 0x02,
-	0xbc, 0xf1, 0xe2, 0xa6, 0xbc, 0x74, 0x70, 0x0f, 0xc1, 0xa1, 0x69, 0xb6, 0x16, 0x27, 0xd4, 0xc7,
-	0x4a, 0x64, 0x6a, 0x5a, 0x2b, 0x1a, 0xdc, 0x4e, 0x31, 0x69, 0xcd, 0xe6, 0xb2, 0x6d, 0x62, 0xd7,
-	0x2e, 0x6a, 0x04, 0x5d, 0xc8, 0x1b, 0xb0, 0x64, 0xfb, 0xcd, 0x8c, 0x89, 0x2d, 0x5a, 0x8e, 0xd0,
-	0x79, 0x08, 0x2b, 0x8c, 0x7b, 0x71, 0x12, 0xb5, 0x59, 0x8f, 0x9a, 0xd4, 0xd7, 0xae, 0xf9, 0x2e,
-	0x33, 0x7e, 0xa4, 0x50, 0x9d, 0x01, 0x3f, 0x86, 0x55, 0xc6, 0x3d, 0x16, 0x9e, 0xd2, 0x50, 0x44,
-	0xc9, 0xd0, 0xc8, 0xcc, 0x58, 0x32, 0x2b, 0x8c, 0xd7, 0x0c, 0xae, 0xa5, 0x36, 0xa0, 0xc0, 0xb8,
-	0x77, 0x4a, 0x7c, 0xaf, 0x45, 0xc2, 0x90, 0xaa, 0x5b, 0x08, 0xc3, 0xbf, 0xc8, 0xf8, 0x31, 0xf1,
-	0x9f, 0x20, 0xa0, 0x39, 0xfd, 0x61, 0x8b, 0x26, 0x9e, 0x4f, 0xda, 0xea, 0x4c, 0x60, 0x71, 0x56,
-	0x25, 0x52, 0x25, 0x6d, 0xd3, 0x77, 0xee, 0x77, 0xa3, 0xa8, 0xe7, 0xe9, 0xd1, 0x63, 0xf4, 0xb5,
-	0xfa, 0xde, 0x40, 0x54, 0xaf, 0x80, 0xdc, 0x16, 0x8c, 0x7b, 0x78, 0xa6, 0xa5, 0x01, 0x06, 0x5e,
-	0xc3, 0xba, 0xc0, 0xf8, 0xbe, 0x22, 0xcb, 0x98, 0xcb, 0xf0, 0x74, 0xc6, 0xfd, 0x84, 0xb5, 0x68,
-	0x80, 0x31, 0xd7, 0xf0, 0x2d, 0x31, 0x79, 0x2c, 0xd3, 0x88, 0xb4, 0x1a, 0xbd, 0x9d, 0x32, 0xf1,
-	0xd6, 0x10, 0x9d, 0x0f, 0xe0, 0x1a, 0xe3, 0x5e, 0x3b, 0xa1, 0xd4, 0x13, 0x09, 0x23, 0xa3, 0x5e,
-	0x16, 0x2c, 0x95, 0x0e, 0xe3, 0x32, 0xe1, 0x6f, 0x4a, 0x06, 0xd3, 0xd1, 0x0f, 0xe1, 0x9a, 0x25,
-	0x65, 0xed, 0xb7, 0xa2, 0x7d, 0x54, 0x6c, 0x1b, 0x31, 0x6b, 0xcb, 0xbd, 0x0d, 0xcb, 0x72, 0x88,
-	0xd1, 0x99, 0x77, 0xca, 0xa2, 0x1e, 0x0d, 0x7d, 0x8a, 0x77, 0xce, 0xf3, 0xe9, 0xcd, 0x15, 0xdf,
-	0x8f, 0xce, 0x8e, 0x35, 0xe4, 0x7c, 0x03, 0xae, 0x33, 0x6e, 0x7a, 0x85, 0x29, 0x06, 0x0d, 0xbc,
-	0x20, 0x3a, 0x0b, 0xcb, 0x25, 0x4b, 0x68, 0x95, 0x71, 0xdd, 0xb1, 0x7d, 0xe4, 0xd8, 0x8e, 0xce,
-	0x42, 0x6d, 0x07, 0x7e, 0xd4, 0xef, 0x0f, 0x42, 0x26, 0x86, 0x66, 0x5d, 0x57, 0xb2, 0x76, 0x50,
-	0x35, 0xb8, 0x5e, 0x5d, 0xd5, 0x3d, 0x91, 0x90, 0x80, 0x1a, 0x09, 0x27, 0xdb, 0xbd, 0xa6, 0xc4,
-	0x34, 0xf7, 0xfb, 0x70, 0x35, 0x65, 0xb5, 0x67, 0x61, 0xd5, 0x4e, 0xf6, 0x84, 0x16, 0xb0, 0x26,
-	0xc1, 0x05, 0x73, 0xff, 0xcc, 0x82, 0xf2, 0xd5, 0xcc, 0xbd, 0x88, 0x21, 0xcb, 0xfe, 0xf3, 0x01,
-	0x8f, 0x69, 0x88, 0x2f, 0x1a, 0x68, 0x18, 0xa8, 0x02, 0xe8, 0x35, 0x8b, 0x7b, 0x65, 0xc4, 0xb0,
-	0x13, 0x06, 0xf2, 0x10, 0x8a, 0x57, 0x23, 0x83, 0x24, 0xa1, 0xa1, 0x3f, 0x2c, 0x5f, 0xb7, 0xfd,
-	0xb6, 0xa1, 0xaa, 0xfb, 0x32, 0xe9, 0x9b, 0x7b, 0xf4, 0x94, 0xf6, 0xca, 0x37, 0xb2, 0xf7, 0x65,
-	0x94, 0xf4, 0xf7, 0x25, 0x5d, 0xee, 0xd0, 0x76, 0xc2, 0x64, 0xb3, 0xca, 0x22, 0xca, 0x76, 0x0a,
-	0xa4, 0x90, 0xaa, 0x31, 0x05, 0xb3, 0x3e, 0x78, 0x59, 0x21, 0x7b, 0x8b, 0x3d, 0x7d, 0xc5, 0x36,
-	0x05, 0xcd, 0x52, 0xd5, 0x1c, 0xd8, 0xd7, 0x0f, 0xd1, 0xfa, 0xb0, 0xcd, 0xce, 0x80, 0x24, 0x81,
-	0x47, 0x43, 0xd2, 0xea, 0xd1, 0xa0, 0x7c, 0x33, 0xbb, 0xb6, 0x8d, 0x94, 0x63, 0x47, 0x31, 0x18,
-	0xaf, 0xd0, 0x8d, 0x42, 0xea, 0x9d, 0xd2, 0x04, 0x1f, 0x19, 0x94, 0x6f, 0x8d, 0x79, 0x05, 0x89,
-	0x1e, 0x6b, 0xd0, 0xf9, 0x08, 0xd6, 0xe4, 0xba, 0x9e, 0x45, 0x5e, 0x9b, 0xf8, 0x22, 0x4a, 0x3c,
-	0x32, 0x10, 0xdd, 0xb4, 0xc1, 0xdb, 0x96, 0xe8, 0x75, 0xc6, 0x9b, 0x67, 0xd1, 0x2e, 0x72, 0x6d,
-	0x0d, 0x44, 0xd7, 0xb4, 0xf9, 0x4d, 0xb8, 0x61, 0x89, 0x6b, 0x49, 0x35, 0xd2, 0x3b, 0xd6, 0x48,
-	0xaf, 0x0a, 0x23, 0xac, 0x05, 0x71, 0xa8, 0xdf, 0x82, 0x1b, 0x76, 0x6f, 0x7d, 0x6b, 0x9a, 0xee,
-	0x5a, 0xc2, 0xd7, 0xe2, 0x51, 0xa7, 0xfd, 0xd1, 0x44, 0xdd, 0x85, 0x79, 0x25, 0xcd, 0x82, 0xf2,
-	0xab, 0x76, 0xad, 0x0f, 0xa9, 0x35, 0xb4, 0xc3, 0x74, 0x3e, 0x58, 0x40, 0x43, 0xc1, 0xda, 0x43,
-	0x99, 0xea, 0xbb, 0xd9, 0x6d, 0x7c, 0xa4, 0xd8, 0x53, 0x5c, 0xc6, 0xcc, 0x44, 0x68, 0x09, 0x31,
-	0xf4, 0x7a, 0x2c, 0x3c, 0xa1, 0x41, 0xf9, 0x35, 0xfb, 0x48, 0x91, 0x88, 0x9a, 0x86, 0xf7, 0x11,
-	0x95, 0xfe, 0x2f, 0x11, 0x5e, 0x8b, 0x25, 0xa2, 0xeb, 0x05, 0x32, 0x3d, 0xf8, 0x9a, 0x6d, 0x19,
-	0x89, 0x78, 0x22, 0x91, 0x6d, 0x99, 0x49, 0x55, 0xa0, 0x24, 0xce, 0xc3, 0x6c, 0x95, 0xe1, 0x9e,
-	0x65, 0x93, 0x45, 0x71, 0x1e, 0x5a, 0x35, 0x86, 0x4c, 0x9c, 0x7a, 0x17, 0xae, 0xa5, 0xd9, 0x8e,
-	0x95, 0x45, 0x72, 0x67, 0x4d, 0xa7, 0x16, 0x2c, 0xe0, 0xfa, 0x8e, 0x32, 0xfd, 0x76, 0xbf, 0x9f,
-	0xb7, 0x72, 0x24, 0x5b, 0x6a, 0x14, 0xd3, 0xfe, 0x3f, 0xac, 0xf0, 0x81, 0xef, 0x53, 0x1a, 0x50,
-	0x79, 0x64, 0x89, 0x4e, 0x06, 0xb1, 0x39, 0x2b, 0xdf, 0xaf, 0xbc, 0x58, 0x36, 0x93, 0xbd, 0x97,
-	0x52, 0x2d, 0xfb, 0x4a, 0x89, 0xf3, 0x18, 0xae, 0xb7, 0x09, 0xeb, 0xa5, 0x6a, 0xbd, 0xb4, 0x9b,
-	0x79, 0xec, 0xe6, 0x55, 0x85, 0x2a, 0xf6, 0x86, 0xc6, 0xd6, 0x8e, 0x61, 0xd1, 0x52, 0x7b, 0x69,
-	0x98, 0x1f, 0x8f, 0x9b, 0xf9, 0xaf, 0x88, 0x9b, 0xee, 0x89, 0x99, 0xbf, 0x6a, 0x97, 0xfa, 0x27,
-	0xbb, 0xb8, 0x5f, 0x79, 0x97, 0xc5, 0x52, 0x43, 0x7a, 0x62, 0xa3, 0x6d, 0x91, 0x69, 0x66, 0xd1,
-	0x1c, 0xd8, 0x68, 0x5b, 0xd8, 0x47, 0xbb, 0x84, 0x75, 0xba, 0x22, 0x73, 0x3f, 0x66, 0x74, 0xd4,
-	0x25, 0xe2, 0x7e, 0x61, 0xa6, 0x7d, 0xac, 0x31, 0xcf, 0xae, 0x6c, 0xe2, 0x84, 0x71, 0x9e, 0xb9,
-	0x68, 0x30, 0x44, 0x79, 0x4e, 0xc5, 0x3b, 0x07, 0xaf, 0x9d, 0x0a, 0x67, 0xd2, 0xd3, 0x65, 0x44,
-	0x47, 0x9a, 0xdd, 0xbf, 0xc8, 0xc3, 0x4d, 0xd5, 0xe6, 0x01, 0xef, 0x1c, 0x10, 0x2e, 0xe4, 0x51,
-	0x5a, 0x6c, 0xb3, 0x84, 0xca, 0xad, 0x37, 0x94, 0xb6, 0xd7, 0x47, 0xea, 0xe8, 0xad, 0x51, 0x26,
-	0x9f, 0x2f, 0x2a, 0xd4, 0xbc, 0x33, 0x72, 0x1e, 0xc3, 0x54, 0xc0, 0x12, 0xfd, 0x34, 0xc0, 0xad,
-	0xbc, 0x40, 0x75, 0xa5, 0x31, 0x68, 0xed, 0x55, 0xeb, 0x92, 0x7d, 0xed, 0xcf, 0x72, 0x30, 0x83,
-	0x9f, 0xf2, 0xa8, 0x76, 0x71, 0x43, 0xf3, 0x81, 0x69, 0xc2, 0xdc, 0x6f, 0xe4, 0x27, 0xee, 0x37,
-	0xae, 0xc3, 0x54, 0x2b, 0x3a, 0xcf, 0x3e, 0xd9, 0x69, 0x45, 0xe7, 0x72, 0xad, 0xcc, 0x95, 0x52,
-	0x8f, 0x85, 0xd9, 0x12, 0x9d, 0xb9, 0x58, 0xda, 0x67, 0x21, 0x95, 0xad, 0x77, 0x7c, 0xaf, 0xc5,
-	0x42, 0x92, 0x0c, 0x33, 0xe5, 0xa4, 0xf9, 0x8e, 0xff, 0x04, 0xa9, 0x6e, 0x15, 0xbe, 0xf6, 0x82,
-	0x41, 0x79, 0x2f, 0x95, 0xd1, 0xba, 0x07, 0xb0, 0x91, 0x2a, 0x51, 0xcf, 0x3e, 0x3e, 0x8e, 0x5a,
-	0xfa, 0x49, 0xc7, 0x6e, 0x94, 0x9c, 0x91, 0x24, 0xb0, 0xee, 0xc3, 0x2e, 0x9b, 0x11, 0x77, 0x0f,
-	0xee, 0x8d, 0xe7, 0xa2, 0x47, 0xba, 0x04, 0xd8, 0x4c, 0x06, 0x5c, 0x78, 0xe6, 0x6d, 0xcc, 0x65,
-	0x37, 0xed, 0x3f, 0xcc, 0xc3, 0xeb, 0x97, 0x69, 0xd2, 0xdd, 0xda, 0x82, 0xb5, 0x2e, 0x91, 0x19,
-	0x25, 0x8b, 0x12, 0x2f, 0x2d, 0x4a, 0x76, 0x19, 0x97, 0xd3, 0x90, 0x31, 0xce, 0x1b, 0x5d, 0xc2,
-	0x8f, 0x24, 0x9b, 0xd1, 0xf4, 0x54, 0x31, 0x39, 0xbb, 0x70, 0x5b, 0xaa, 0x08, 0x23, 0x2f, 0xa1,
-	0x3e, 0x0d, 0x85, 0x27, 0x93, 0xf9, 0xb3, 0x28, 0x09, 0xbc, 0x84, 0x72, 0x3a, 0x76, 0xb0, 0x7a,
-	0xa5, 0x4b, 0xf8, 0x61, 0x54, 0x47, 0xc6, 0x23, 0xcd, 0x57, 0x47, 0x36, 0x9d, 0xdc, 0x9c, 0x91,
-	0x5e, 0x8f, 0x0a, 0xcf, 0x27, 0xbc, 0xeb, 0x09, 0xd9, 0x57, 0xaa, 0x0a, 0x9d, 0x56, 0x00, 0xfc,
-	0x0c, 0x59, 0xaa, 0x84, 0x77, 0x9b, 0x8a, 0x01, 0x5d, 0x2b, 0xeb, 0x53, 0x8f, 0xf4, 0x7a, 0xa9,
-	0x90, 0x5d, 0xbe, 0x2a, 0x4a, 0x74, 0xab, 0xd7, 0xd3, 0xfc, 0xee, 0xbf, 0xe7, 0xe0, 0xb6, 0x5a,
-	0xb9, 0xa7, 0x3a, 0x53, 0x3a, 0x26, 0x7e, 0x43, 0xbd, 0x8e, 0xe9, 0x92, 0xb0, 0xf3, 0x12, 0x37,
-	0xe5, 0xa3, 0x73, 0x71, 0x7e, 0xf2, 0x5c, 0xfc, 0x18, 0x56, 0x13, 0xec, 0xd0, 0x29, 0xf1, 0x65,
-	0x2e, 0xc4, 0x05, 0x49, 0x44, 0xb6, 0xf4, 0xb6, 0x82, 0x0c, 0xc7, 0x88, 0x37, 0x10, 0xd6, 0xa9,
-	0xb4, 0x4a, 0xb3, 0xbc, 0x30, 0x3a, 0xcb, 0x24, 0xf6, 0x8b, 0x8c, 0xab, 0x2c, 0xeb, 0x30, 0x3a,
-	0x93, 0xe3, 0x1d, 0x71, 0xb6, 0x07, 0x62, 0x90, 0xd0, 0x4c, 0x46, 0x5f, 0x34, 0xcc, 0xbb, 0x88,
-	0xb9, 0xdf, 0x36, 0xde, 0x61, 0x0f, 0x5f, 0xa2, 0x89, 0x90, 0x26, 0x26, 0x43, 0x64, 0xe1, 0xc9,
-	0xa5, 0xf6, 0xf4, 0x5d, 0xb3, 0x59, 0x2e, 0x14, 0x1f, 0x19, 0x53, 0x19, 0xa6, 0xe3, 0xb3, 0xb1,
-	0x4a, 0x01, 0x52, 0x64, 0x0b, 0x21, 0x3d, 0x8f, 0xc2, 0xb1, 0xe9, 0x32, 0x44, 0xf7, 0x77, 0xa7,
-	0xd4, 0x8b, 0x92, 0xbd, 0x6a, 0x5d, 0xbd, 0x70, 0xc2, 0x72, 0xf4, 0xcd, 0xec, 0x9e, 0x99, 0xda,
-	0x28, 0x58, 0xfe, 0xe3, 0x23, 0x98, 0xd5, 0xef, 0x03, 0xf3, 0xf8, 0x0a, 0x73, 0xbd, 0x32, 0xa1,
-	0xa0, 0xa2, 0x7f, 0xab, 0xc7, 0x82, 0x9b, 0xb3, 0xf5, 0xad, 0xc3, 0xed, 0x67, 0x07, 0x75, 0x2d,
-	0xe7, 0xec, 0xc0, 0x7c, 0x9b, 0xf4, 0x7a, 0x2d, 0xe2, 0x9f, 0xe0, 0xd2, 0xbc, 0x8c, 0x8e, 0xb9,
-	0xed, 0x5a, 0xa3, 0xba, 0x55, 0xdf, 0xae, 0xa7, 0xa2, 0xce, 0x7d, 0x28, 0x9a, 0x17, 0xbd, 0x5e,
-	0x9b, 0xd1, 0x5e, 0xd6, 0xf4, 0x0a, 0x06, 0xdb, 0x95, 0x90, 0xf4, 0x61, 0x67, 0xb4, 0x45, 0x62,
-	0xa6, 0x9e, 0x00, 0x66, 0xbc, 0xd3, 0xa2, 0x42, 0xf0, 0x2d, 0x9d, 0x1b, 0x43, 0x21, 0xd3, 0xb2,
-	0x03, 0xa0, 0xfb, 0x5f, 0xba, 0xe2, 0x2c, 0x82, 0xe9, 0x47, 0x29, 0xe7, 0x38, 0x50, 0xac, 0xee,
-	0xd7, 0x76, 0x0e, 0x9b, 0x5e, 0xa3, 0xb9, 0xb3, 0x75, 0x50, 0xdb, 0x2e, 0xe5, 0x9d, 0x57, 0xe0,
-	0xda, 0x51, 0xfd, 0x59, 0xf3, 0xd9, 0x93, 0xe7, 0xbb, 0xde, 0x6e, 0x6d, 0x67, 0x7f, 0xdb, 0x7b,
-	0x5e, 0x3b, 0x6c, 0xbe, 0xff, 0xb8, 0x34, 0xe5, 0xdc, 0x80, 0xd5, 0xcf, 0x76, 0x9e, 0x6c, 0x1d,
-	0xd5, 0xbc, 0xa3, 0xad, 0xfa, 0xd6, 0x81, 0x01, 0xa6, 0xdd, 0xff, 0xc8, 0xa9, 0x03, 0x7e, 0xd6,
-	0x27, 0xea, 0x57, 0x79, 0xaa, 0x2b, 0xce, 0x47, 0x30, 0x47, 0x43, 0x75, 0x73, 0xa4, 0xb2, 0x84,
-	0xd7, 0x2b, 0x97, 0x88, 0x54, 0x76, 0x64, 0x42, 0x53, 0x37, 0x62, 0x6b, 0x3f, 0xc8, 0xc1, 0x0c,
-	0x92, 0x2e, 0x78, 0xb3, 0x97, 0x7b, 0xe9, 0x37, 0x7b, 0xf9, 0xaf, 0x78, 0xb3, 0xf7, 0x36, 0xcc,
-	0xe9, 0x27, 0x72, 0xb8, 0xa2, 0x8b, 0x8f, 0x9c, 0xc9, 0x15, 0xad, 0x1b, 0x16, 0xf7, 0x6f, 0x72,
-	0xe0, 0x4e, 0x76, 0xbf, 0x8a, 0xaf, 0xa3, 0x0f, 0x78, 0xc7, 0x0c, 0xba, 0x3a, 0x3e, 0xe8, 0x37,
-	0x2b, 0x97, 0x4b, 0x8d, 0x8f, 0xfb, 0xd8, 0x0c, 0xfb, 0x2e, 0xcc, 0xa7, 0xd5, 0xb2, 0x4c, 0x41,
-	0xbf, 0xaf, 0x0b, 0x65, 0xd6, 0x18, 0xf2, 0x97, 0x8f, 0x61, 0xcf, 0x8a, 0x41, 0x17, 0xaf, 0xc0,
-	0x4b, 0x06, 0xb3, 0x8f, 0xe1, 0xfe, 0xe5, 0xa3, 0x7a, 0x49, 0x5d, 0x7f, 0x9f, 0x87, 0xd5, 0x54,
-	0x59, 0x83, 0x8a, 0x67, 0xb1, 0xba, 0x47, 0x7e, 0x0f, 0xe6, 0xa2, 0xd8, 0x3c, 0x17, 0x98, 0xda,
-	0x28, 0xea, 0xa7, 0xaf, 0x63, 0x6c, 0x15, 0xf5, 0xb7, 0x6e, 0x78, 0x9d, 0x83, 0xf4, 0x45, 0xbb,
-	0x9c, 0xb9, 0x44, 0x3a, 0x68, 0xf3, 0x6c, 0xf1, 0xd5, 0x0b, 0x15, 0xe8, 0x47, 0xdc, 0x75, 0xc9,
-	0x69, 0x9e, 0xbb, 0xcb, 0x41, 0xa0, 0xe4, 0xda, 0x47, 0xb0, 0x64, 0x33, 0xc8, 0x7c, 0xa3, 0x17,
-	0x9d, 0x95, 0x73, 0xeb, 0xf9, 0x74, 0x31, 0x24, 0x41, 0xba, 0xb3, 0x2e, 0xeb, 0x74, 0xcb, 0x79,
-	0x0b, 0x40, 0x8a, 0x1b, 0xc2, 0xac, 0x6a, 0xca, 0x29, 0xc3, 0xd5, 0xc3, 0x67, 0xcd, 0xda, 0xee,
-	0x6f, 0x79, 0xcf, 0x1b, 0x3b, 0x75, 0xaf, 0xb1, 0xd3, 0x68, 0xd4, 0x9e, 0x1d, 0x36, 0x4a, 0x57,
-	0x9c, 0x35, 0xb8, 0xae, 0x91, 0xc6, 0x4e, 0xfd, 0xd8, 0xc6, 0x72, 0x72, 0x0f, 0x6a, 0x6c, 0xab,
-	0xfa, 0xb4, 0xb6, 0x73, 0xbc, 0x73, 0xb0, 0x73, 0xd8, 0x6c, 0x94, 0xf2, 0xce, 0x35, 0x58, 0xd1,
-	0xc0, 0xf1, 0x56, 0xd5, 0xdb, 0xaa, 0x36, 0x6b, 0xcf, 0x0e, 0x4b, 0x53, 0xee, 0xbf, 0x4d, 0x9b,
-	0xf7, 0x3c, 0x4f, 0x9f, 0xc7, 0xf2, 0x94, 0xa1, 0xef, 0x49, 0x7e, 0xc3, 0x30, 0x75, 0x0b, 0x66,
-	0xa3, 0x10, 0x53, 0x29, 0x3b, 0xc2, 0x6a, 0x9a, 0x3c, 0xfb, 0x9b, 0x5b, 0x1b, 0xd3, 0xc4, 0xb4,
-	0xfd, 0x5f, 0x07, 0x0a, 0x34, 0xff, 0x75, 0x80, 0x8f, 0x33, 0x91, 0x9b, 0x04, 0x41, 0x92, 0xb9,
-	0x11, 0x02, 0x05, 0x6c, 0x05, 0x41, 0x62, 0xb1, 0xc5, 0x51, 0x22, 0x32, 0x55, 0x7c, 0xcd, 0x76,
-	0x14, 0x25, 0xf8, 0x20, 0x24, 0xe2, 0x6a, 0x8f, 0xd8, 0xb7, 0x3f, 0xb3, 0x11, 0xc7, 0x2d, 0x72,
-	0x0f, 0x16, 0xb5, 0x41, 0x60, 0x63, 0xf6, 0xa5, 0x0f, 0x28, 0x00, 0x1b, 0xab, 0xc2, 0x12, 0x3d,
-	0x17, 0x09, 0x51, 0x6e, 0x99, 0x97, 0x17, 0xac, 0x97, 0xae, 0xe3, 0x53, 0x59, 0xd9, 0x91, 0x9c,
-	0xe8, 0xa4, 0xeb, 0x8b, 0x34, 0xfd, 0xcd, 0x33, 0x2f, 0x0a, 0xc1, 0x9e, 0x62, 0xfd, 0xa2, 0x50,
-	0x06, 0x63, 0xbf, 0x9f, 0xfe, 0xaf, 0x05, 0x1f, 0x72, 0x16, 0x64, 0xee, 0x7d, 0x8a, 0x7e, 0x5f,
-	0xb7, 0xd1, 0x90, 0x98, 0x3c, 0xe7, 0x5b, 0xfc, 0xfa, 0x7c, 0xca, 0x68, 0x92, 0xa9, 0x49, 0xad,
-	0xa6, 0x42, 0xb5, 0x94, 0x01, 0xe3, 0x21, 0x8d, 0x23, 0x79, 0x44, 0xe5, 0xe5, 0x82, 0x8e, 0x87,
-	0x92, 0x50, 0x0b, 0xf8, 0xda, 0x13, 0x80, 0xd1, 0x10, 0x7e, 0xcd, 0x77, 0xb7, 0x7f, 0x9b, 0x57,
-	0x91, 0x1e, 0x9f, 0xb5, 0xea, 0x23, 0xf7, 0xb3, 0x76, 0x63, 0xc0, 0x63, 0xe6, 0xb3, 0x68, 0xa0,
-	0x1e, 0x46, 0x30, 0x31, 0xfc, 0x4d, 0xea, 0xaf, 0xce, 0xe7, 0xe0, 0xf4, 0x07, 0x3d, 0xc1, 0xe2,
-	0x1e, 0xf5, 0x58, 0xc8, 0x05, 0x09, 0x7d, 0xca, 0xb5, 0xbb, 0xfe, 0x66, 0xe5, 0x65, 0x9a, 0xaf,
-	0x1c, 0x68, 0xf9, 0x3d, 0xd2, 0xa7, 0x35, 0xa3, 0xa2, 0xbe, 0x62, 0xd4, 0xa6, 0xa4, 0xb5, 0x04,
-	0xae, 0x5d, 0xc8, 0x8b, 0x6f, 0xef, 0xe4, 0xbe, 0xd0, 0x04, 0x5d, 0xd5, 0xc9, 0xfc, 0xdb, 0x85,
-	0xdc, 0x23, 0x1a, 0x56, 0xa5, 0x9d, 0x7b, 0x50, 0x8c, 0x44, 0xd7, 0xec, 0x87, 0xd1, 0x51, 0xb6,
-	0x80, 0xd4, 0x46, 0x7a, 0xec, 0x9e, 0x57, 0x97, 0x29, 0xdb, 0x47, 0x3a, 0x57, 0x3a, 0x60, 0x7e,
-	0x12, 0x35, 0xcf, 0xd5, 0x3b, 0x1a, 0x35, 0x2d, 0xb9, 0xc9, 0x69, 0xb9, 0x0d, 0x73, 0x1d, 0x7f,
-	0x32, 0xba, 0xcd, 0x76, 0x7c, 0x8c, 0x6c, 0xdf, 0x86, 0xb9, 0x58, 0xa9, 0xd3, 0x53, 0xf5, 0x5a,
-	0xe5, 0xe2, 0x46, 0x2a, 0x9a, 0xa0, 0xc2, 0x84, 0x96, 0x71, 0x3e, 0x81, 0x25, 0x7c, 0x21, 0x40,
-	0xf4, 0x43, 0x1f, 0xf5, 0x46, 0xf3, 0x8d, 0x4b, 0x74, 0x18, 0x42, 0x3d, 0x23, 0xbc, 0xf6, 0x9f,
-	0xd3, 0xb0, 0x3c, 0xc6, 0x81, 0x8f, 0x31, 0x42, 0x26, 0x54, 0x11, 0x27, 0x73, 0xbe, 0x91, 0x64,
-	0xac, 0xdb, 0x54, 0xa0, 0xd4, 0x23, 0x5c, 0x78, 0x03, 0xdc, 0x77, 0x8a, 0xd3, 0xb6, 0x8f, 0xa2,
-	0x44, 0xd5, 0xa6, 0x44, 0xfe, 0x9b, 0x30, 0x2b, 0xce, 0x43, 0xf3, 0x92, 0x37, 0x7d, 0x76, 0x24,
-	0xce, 0x43, 0x75, 0x65, 0x62, 0xfd, 0x23, 0xcb, 0xf4, 0x05, 0x45, 0x43, 0x75, 0x33, 0x2f, 0x5d,
-	0x19, 0x3e, 0x80, 0xca, 0xb8, 0xa4, 0x79, 0x49, 0xae, 0x09, 0xda, 0x97, 0xd3, 0x2e, 0x51, 0x4f,
-	0x3f, 0x81, 0x4e, 0x9f, 0x9e, 0x49, 0xa2, 0xd2, 0x10, 0xd0, 0xb6, 0x4e, 0x41, 0x33, 0x17, 0xd1,
-	0x01, 0x6d, 0xab, 0x44, 0x74, 0x0d, 0x66, 0xe2, 0x84, 0xf9, 0x34, 0xf3, 0x0a, 0x4c, 0x91, 0x64,
-	0x68, 0x11, 0xe4, 0x3c, 0xf3, 0xee, 0x4b, 0x12, 0xa4, 0x5a, 0x64, 0xf0, 0x06, 0x3c, 0xc8, 0xbc,
-	0xf6, 0x9a, 0x47, 0xf2, 0x73, 0x8e, 0xf6, 0x20, 0xc8, 0x39, 0x32, 0xd8, 0x4f, 0xbc, 0x66, 0x05,
-	0x39, 0x97, 0xf0, 0x9b, 0x50, 0x48, 0x8f, 0x6b, 0xe8, 0x27, 0x6d, 0xef, 0xb1, 0x64, 0x20, 0xf4,
-	0x96, 0x6f, 0x41, 0x51, 0x79, 0x70, 0x39, 0x9b, 0xc8, 0x5b, 0x98, 0xb8, 0x96, 0x6e, 0x9e, 0x87,
-	0xe6, 0xaa, 0x37, 0x53, 0xa0, 0x2a, 0x66, 0xcf, 0xd8, 0x69, 0x75, 0x4a, 0xba, 0xe0, 0x84, 0x76,
-	0xa4, 0x07, 0x43, 0xbe, 0x65, 0x3b, 0x21, 0x53, 0x00, 0xb2, 0xad, 0xc3, 0xfc, 0x17, 0x03, 0x82,
-	0x05, 0x33, 0xac, 0x51, 0x9b, 0x4b, 0xa5, 0x94, 0xea, 0xbc, 0x0e, 0x4b, 0x09, 0x6d, 0x7b, 0x68,
-	0x60, 0x72, 0x15, 0x56, 0xec, 0x47, 0x77, 0x09, 0x6d, 0x37, 0x25, 0x50, 0x0b, 0xd6, 0x7e, 0x9c,
-	0x83, 0x45, 0xcb, 0xb4, 0xd5, 0x9d, 0x19, 0x7e, 0x5e, 0xf0, 0x6e, 0x5c, 0xb1, 0xa9, 0x02, 0x91,
-	0x66, 0xba, 0xa0, 0x40, 0xa4, 0x10, 0xdc, 0x5e, 0x6f, 0x42, 0xc1, 0x94, 0x84, 0xd5, 0x80, 0xec,
-	0xea, 0xc3, 0x92, 0x81, 0x70, 0x48, 0x36, 0x2b, 0x2a, 0x9d, 0xbe, 0x88, 0x15, 0xcb, 0x4e, 0x7f,
-	0x34, 0xa5, 0x4a, 0x41, 0x93, 0x3b, 0xec, 0xe5, 0x6e, 0x29, 0x8f, 0x01, 0x28, 0xbe, 0x92, 0xc2,
-	0x2e, 0xa9, 0x73, 0xce, 0x5b, 0x95, 0x17, 0x6b, 0xac, 0xec, 0xec, 0x48, 0x09, 0x55, 0x42, 0x3c,
-	0xf1, 0x0e, 0x78, 0xe7, 0x98, 0xf4, 0x58, 0x50, 0xb7, 0x34, 0xb9, 0xff, 0x95, 0x03, 0x18, 0xb1,
-	0x39, 0x45, 0xb0, 0x18, 0x4b, 0x57, 0x64, 0x16, 0x82, 0xdf, 0xb5, 0xf0, 0x54, 0x52, 0xb6, 0xe2,
-	0xb8, 0x26, 0x0f, 0x1a, 0x37, 0xe1, 0x86, 0x4d, 0xb6, 0x16, 0xa3, 0x94, 0x97, 0x29, 0x0d, 0x82,
-	0x87, 0x51, 0xd3, 0x72, 0x15, 0xa5, 0x29, 0x67, 0x15, 0x96, 0x11, 0x68, 0x7c, 0xba, 0xbf, 0x4b,
-	0x58, 0x6f, 0x90, 0xd0, 0xd2, 0xb4, 0x73, 0x17, 0x6e, 0x22, 0xd1, 0xd2, 0xb1, 0xcd, 0xb8, 0x9f,
-	0xd0, 0x98, 0x84, 0xfe, 0xb0, 0x34, 0xe3, 0xac, 0xc3, 0x2d, 0x64, 0xb0, 0x94, 0xd5, 0x42, 0x4e,
-	0x13, 0xb1, 0x8b, 0x65, 0xc3, 0xd2, 0x5c, 0xda, 0xe0, 0x56, 0x2f, 0xa1, 0x24, 0x18, 0xd6, 0x07,
-	0x61, 0xc8, 0xc2, 0x4e, 0x69, 0x3e, 0xd5, 0xad, 0xbb, 0x69, 0x69, 0xd8, 0x26, 0x82, 0x94, 0x16,
-	0xde, 0xfa, 0x49, 0x0e, 0x4a, 0xe3, 0xff, 0x9f, 0x27, 0x1b, 0x7c, 0xd1, 0xff, 0xec, 0x95, 0xae,
-	0x48, 0xbd, 0x13, 0x1c, 0xbb, 0x49, 0xd4, 0x57, 0x65, 0x96, 0x52, 0xee, 0x42, 0x15, 0xc8, 0x80,
-	0x29, 0x92, 0x3c, 0x96, 0xdd, 0x84, 0x1b, 0x17, 0x72, 0xec, 0x55, 0x4b, 0x53, 0x17, 0x8a, 0xd7,
-	0x69, 0xdc, 0x1b, 0xea, 0x06, 0xa6, 0x37, 0xab, 0xb0, 0x70, 0x42, 0x87, 0x2a, 0x9b, 0x71, 0x6e,
-	0x57, 0xd4, 0xbf, 0x93, 0x56, 0xcc, 0x01, 0xb3, 0x82, 0x91, 0x5f, 0xa7, 0xc0, 0xe5, 0x5f, 0xfc,
-	0x03, 0xe6, 0x74, 0x9b, 0x33, 0x6d, 0xd2, 0xe3, 0xb4, 0x3e, 0x7f, 0x42, 0x87, 0x88, 0x6f, 0x36,
-	0xc0, 0xe9, 0xf3, 0x4e, 0x1c, 0x45, 0x3d, 0x8f, 0x47, 0x6d, 0xa1, 0xae, 0xd7, 0x9c, 0xbb, 0x13,
-	0xda, 0x74, 0x8a, 0x9c, 0xd5, 0x37, 0xb3, 0x99, 0x7f, 0xf7, 0x51, 0xbd, 0xa4, 0x15, 0x34, 0xa2,
-	0xb6, 0xc0, 0x6b, 0xb8, 0xcd, 0xe6, 0x48, 0x69, 0x97, 0x24, 0xc1, 0xcb, 0x2a, 0xfd, 0x57, 0xad,
-	0x74, 0xea, 0xdd, 0x0f, 0x1f, 0xa7, 0x5a, 0x9f, 0x92, 0x24, 0x40, 0xad, 0x4f, 0xdc, 0x9f, 0xfd,
-	0xf2, 0x4e, 0xee, 0xe7, 0xbf, 0xbc, 0x93, 0xfb, 0xc5, 0x2f, 0xef, 0xe4, 0xfe, 0xf0, 0x57, 0x77,
-	0xae, 0xfc, 0xfc, 0x57, 0x77, 0xae, 0xfc, 0xd3, 0xaf, 0xee, 0x5c, 0x79, 0x9a, 0xfb, 0xed, 0xa9,
-	0x3e, 0xef, 0x7c, 0x99, 0xbb, 0xf2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xec, 0x98, 0x52, 0x62,
+	// 5668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x7b, 0x4b, 0x6c, 0x23, 0xc9,
+	0x79, 0xff, 0x90, 0x7a, 0x51, 0x9f, 0x44, 0x89, 0x6a, 0xcd, 0xa3, 0x57, 0x33, 0xa3, 0xd1, 0xf6,
+	0x3e, 0x46, 0xfb, 0x30, 0x67, 0x77, 0x76, 0xf6, 0x61, 0xd9, 0xc6, 0x7f, 0x35, 0xd4, 0x63, 0xb8,
+	0x2b, 0x69, 0xb4, 0xa4, 0x66, 0xf6, 0x9f, 0x20, 0x70, 0xbb, 0xd4, 0x5d, 0x24, 0xcb, 0x22, 0xbb,
+	0x7b, 0xbb, 0x8a, 0x92, 0x78, 0xdb, 0x24, 0x80, 0x61, 0x5f, 0x82, 0x00, 0x39, 0xe4, 0x12, 0xf8,
+	0x16, 0x23, 0xc8, 0x21, 0x89, 0x2f, 0x3e, 0xe4, 0x96, 0xf8, 0xe2, 0x1c, 0x02, 0xf8, 0x10, 0x20,
+	0x39, 0x25, 0x8e, 0x8d, 0x1c, 0x83, 0x00, 0xb9, 0x26, 0x40, 0x82, 0xaf, 0x1e, 0xcd, 0x6a, 0x52,
+	0x2b, 0x4d, 0xec, 0x93, 0xd8, 0xdf, 0xef, 0x57, 0x5f, 0xbd, 0xbf, 0xfa, 0xea, 0xab, 0x4f, 0xb0,
+	0xcc, 0x05, 0x25, 0xbd, 0x1e, 0xe5, 0x9c, 0xb4, 0x29, 0xaf, 0x26, 0x69, 0x2c, 0xe2, 0x95, 0xb5,
+	0x76, 0x1c, 0xb7, 0xbb, 0xf4, 0x81, 0xfc, 0x3a, 0xee, 0xb7, 0x1e, 0x84, 0x94, 0x07, 0x29, 0x4b,
+	0x44, 0x9c, 0x2a, 0x86, 0xf7, 0x57, 0x93, 0xe0, 0xd4, 0xf6, 0x79, 0xfb, 0x10, 0xbf, 0x1e, 0xf7,
+	0x5b, 0x4f, 0x28, 0x09, 0x69, 0xea, 0xbc, 0x0d, 0x8b, 0x41, 0x97, 0xd1, 0x48, 0xf8, 0x52, 0xad,
+	0xcf, 0x42, 0xb7, 0xb0, 0x56, 0x58, 0x9f, 0x7e, 0x3c, 0xf9, 0xb3, 0x7f, 0xbe, 0x77, 0xad, 0x51,
+	0x56, 0x60, 0x13, 0xb1, 0x7a, 0xe8, 0xbc, 0x03, 0x4b, 0x86, 0x4d, 0x39, 0x67, 0x71, 0x84, 0xfc,
+	0xe2, 0x5a, 0x61, 0x7d, 0x4a, 0xf3, 0xb5, 0xb2, 0xa6, 0x42, 0xeb, 0xa1, 0xb3, 0x0e, 0x65, 0x1e,
+	0xf7, 0xd3, 0x80, 0xfa, 0x24, 0x49, 0x90, 0x3d, 0xb1, 0x56, 0x58, 0x2f, 0x6b, 0xf6, 0x9c, 0x82,
+	0x36, 0x93, 0xa4, 0x1e, 0x3a, 0x1f, 0x41, 0xf9, 0xbb, 0xf1, 0xb1, 0xcf, 0x42, 0x5f, 0x49, 0x5d,
+	0xc0, 0x76, 0x6c, 0x5c, 0x7f, 0xf7, 0xa3, 0x47, 0x8f, 0x3e, 0xf8, 0xf0, 0xd1, 0xa3, 0x77, 0x3e,
+	0x7c, 0xef, 0xc3, 0x77, 0xbe, 0xfe, 0xfe, 0xfb, 0xef, 0x7e, 0xf0, 0xee, 0xfb, 0x8d, 0xb9, 0xef,
+	0xc6, 0xc7, 0xf5, 0xb0, 0x29, 0x89, 0x56, 0x49, 0x41, 0xd2, 0x36, 0x15, 0xee, 0xdc, 0x95, 0x25,
+	0x8f, 0x24, 0x11, 0x7b, 0xaf, 0x8a, 0xf8, 0xa8, 0x20, 0x22, 0x3d, 0xea, 0xce, 0xaf, 0x15, 0xd6,
+	0x67, 0x4d, 0xef, 0x15, 0xf8, 0x49, 0x7c, 0x7c, 0x40, 0x7a, 0xd4, 0xb9, 0x0d, 0x33, 0x34, 0xa5,
+	0xbc, 0xdf, 0x15, 0x6e, 0x19, 0xfb, 0xbc, 0x51, 0x78, 0xd8, 0x30, 0x12, 0xe7, 0x0d, 0x28, 0xd3,
+	0x34, 0x8d, 0x53, 0x5f, 0xcf, 0x8c, 0xbb, 0x60, 0x29, 0x9a, 0x97, 0xd0, 0xbe, 0x42, 0x9c, 0xeb,
+	0x50, 0x64, 0x89, 0xbb, 0x68, 0x0d, 0x44, 0x91, 0x25, 0xce, 0x01, 0x40, 0x3b, 0xf0, 0x7b, 0xbc,
+	0xed, 0xf3, 0x34, 0x70, 0x7f, 0x86, 0xb3, 0xb0, 0xf0, 0x70, 0xa9, 0xba, 0x5b, 0x33, 0x33, 0xb6,
+	0xcf, 0xdb, 0xcd, 0x34, 0xd8, 0xb8, 0x33, 0x2a, 0xf1, 0x9f, 0x45, 0x3c, 0xa1, 0x01, 0x6b, 0x31,
+	0x1a, 0x36, 0x4a, 0xed, 0x40, 0x49, 0x9d, 0x47, 0xb0, 0xdc, 0x0e, 0xfc, 0x90, 0xa5, 0x3e, 0x8b,
+	0x42, 0x7a, 0x6e, 0x46, 0xf5, 0xef, 0x0a, 0x56, 0xbd, 0x95, 0x76, 0xb0, 0xc5, 0xd2, 0x3a, 0xe2,
+	0x6a, 0x2c, 0x37, 0xe0, 0xcb, 0x1f, 0xdd, 0xfd, 0xb2, 0xf8, 0xfd, 0x1f, 0xdd, 0xfd, 0xb2, 0xe4,
+	0xfd, 0x75, 0x01, 0xca, 0xb8, 0x64, 0x3e, 0xa7, 0xc7, 0x9b, 0x87, 0xf5, 0x4f, 0xe9, 0xc0, 0xb9,
+	0x0d, 0xd3, 0x5c, 0x10, 0xd1, 0xe7, 0x72, 0x91, 0x94, 0x37, 0x26, 0x1e, 0xbe, 0xff, 0x7e, 0x43,
+	0x8b, 0x9c, 0x35, 0x00, 0x12, 0x04, 0x71, 0x3f, 0x12, 0x66, 0x55, 0x94, 0x37, 0x0a, 0xef, 0x34,
+	0x66, 0xb5, 0xb0, 0x1e, 0x3a, 0x0f, 0xc0, 0x49, 0xfa, 0xc7, 0x5d, 0xc6, 0x3b, 0x34, 0xf5, 0xdb,
+	0x69, 0xdc, 0x1f, 0xae, 0x08, 0x64, 0x56, 0x32, 0x70, 0x17, 0xb1, 0x7a, 0x88, 0xf5, 0x9d, 0xd0,
+	0x01, 0x92, 0x26, 0xad, 0x56, 0x4f, 0x9d, 0xd0, 0x41, 0x3d, 0x74, 0xee, 0xc0, 0x74, 0x18, 0xf7,
+	0x08, 0x8b, 0xdc, 0x29, 0x6b, 0xa8, 0xb5, 0xcc, 0xfb, 0xbd, 0x49, 0x58, 0xc4, 0xc6, 0x3f, 0x11,
+	0x22, 0x69, 0xd0, 0x2f, 0xfa, 0x94, 0x0b, 0xe7, 0x2d, 0x58, 0x48, 0xd5, 0x4f, 0xbf, 0x47, 0x45,
+	0x27, 0x56, 0x6b, 0xdd, 0xa8, 0x2d, 0x6b, 0x6c, 0x5f, 0x42, 0xce, 0x1a, 0x94, 0x3a, 0x31, 0x17,
+	0x72, 0x51, 0x14, 0xad, 0x0a, 0x32, 0xa9, 0x73, 0x13, 0x26, 0xfa, 0x69, 0xd7, 0x9d, 0xb0, 0x40,
+	0x14, 0x38, 0x1f, 0xc1, 0x4c, 0x47, 0xee, 0x2e, 0xee, 0x4e, 0xae, 0x4d, 0xac, 0xcf, 0x3d, 0x5c,
+	0xad, 0x8e, 0xb4, 0xa4, 0xaa, 0xff, 0xaa, 0x4d, 0xd8, 0x30, 0x74, 0x67, 0x03, 0x00, 0x17, 0x63,
+	0x42, 0x52, 0xd2, 0xe3, 0xee, 0x94, 0x2c, 0x7c, 0x7b, 0xac, 0xf0, 0x67, 0x7d, 0x9a, 0x0e, 0x0e,
+	0x91, 0xd3, 0x98, 0x6d, 0x53, 0x21, 0x7f, 0x71, 0xe7, 0x9b, 0x30, 0x97, 0xc4, 0x3c, 0x2b, 0x3c,
+	0x7d, 0x75, 0x61, 0x40, 0xbe, 0x2e, 0xed, 0xc2, 0xe4, 0x71, 0x1c, 0x0e, 0xdc, 0x99, 0xb5, 0xc2,
+	0xfa, 0xbc, 0xee, 0x8c, 0x94, 0x38, 0x0f, 0xa0, 0x42, 0x8e, 0x79, 0xdc, 0xed, 0x0b, 0xea, 0x0b,
+	0xd6, 0xa3, 0x71, 0x5f, 0xb8, 0x25, 0x6b, 0xd8, 0x16, 0x0d, 0x7a, 0xa4, 0xc0, 0x95, 0x6d, 0x28,
+	0xe7, 0xba, 0x87, 0xba, 0xe5, 0x28, 0x16, 0xac, 0x81, 0x92, 0x12, 0x67, 0x05, 0xa6, 0x4e, 0x49,
+	0xb7, 0x9f, 0x1f, 0x60, 0x25, 0x5a, 0x79, 0x0c, 0x30, 0x6c, 0xeb, 0x8b, 0xea, 0x98, 0xcf, 0xe9,
+	0xf0, 0x7e, 0x52, 0x84, 0xa5, 0xe1, 0x0a, 0x36, 0xcb, 0xe0, 0x6d, 0x58, 0x7c, 0x76, 0xf0, 0xac,
+	0xb9, 0xbd, 0x35, 0xdc, 0xf5, 0xb6, 0xda, 0xb2, 0x02, 0xcd, 0xae, 0x7f, 0x0b, 0x16, 0x58, 0x24,
+	0x68, 0xda, 0x22, 0x01, 0xf5, 0xc7, 0x56, 0x43, 0x39, 0xc3, 0x24, 0xf9, 0x35, 0x98, 0x53, 0x2b,
+	0x4b, 0x31, 0xed, 0xa5, 0x01, 0x0a, 0x90, 0xb4, 0x55, 0x98, 0x39, 0xa5, 0x29, 0x9a, 0xc8, 0xdc,
+	0xc2, 0x36, 0x42, 0xe7, 0x3e, 0xcc, 0x90, 0x84, 0xf9, 0x27, 0x74, 0x20, 0xd7, 0xf6, 0xdc, 0xc3,
+	0x85, 0x6a, 0x6e, 0x23, 0x36, 0xa6, 0x49, 0xc2, 0x70, 0x43, 0xbe, 0x09, 0x33, 0x7a, 0xd5, 0xba,
+	0xd3, 0x92, 0x58, 0x19, 0x9d, 0xf0, 0x86, 0x21, 0x38, 0x6f, 0xc2, 0x42, 0x1a, 0xf7, 0x05, 0x8b,
+	0xda, 0xc6, 0x16, 0xcf, 0x58, 0x75, 0xcf, 0x6b, 0x4c, 0x1a, 0x63, 0xef, 0x1f, 0x0b, 0x50, 0x19,
+	0x2a, 0xe2, 0x49, 0x1c, 0x71, 0xd9, 0x39, 0xb5, 0xd5, 0xfd, 0x20, 0x0e, 0x69, 0x6e, 0xef, 0x80,
+	0x02, 0x6a, 0x71, 0x48, 0x9d, 0x8d, 0xe1, 0xf2, 0x2f, 0xca, 0x45, 0xb8, 0x56, 0x1d, 0x55, 0x55,
+	0x35, 0x3f, 0x46, 0x37, 0x80, 0x59, 0x86, 0x13, 0xa3, 0xcb, 0x70, 0x65, 0x07, 0x16, 0xf2, 0x85,
+	0x7e, 0xbd, 0x65, 0xe5, 0xb5, 0xd4, 0x31, 0xb8, 0xb9, 0xbf, 0xc3, 0xa2, 0x70, 0x53, 0x99, 0x26,
+	0x2e, 0xbb, 0x46, 0x49, 0x1a, 0x74, 0x7c, 0x31, 0x48, 0x46, 0xbb, 0x26, 0x81, 0xa3, 0x41, 0x42,
+	0xd1, 0xc8, 0x6b, 0x1a, 0x17, 0x29, 0x8b, 0xda, 0xb9, 0x0a, 0xe6, 0x15, 0xd4, 0x94, 0x88, 0xf7,
+	0x21, 0xac, 0x8c, 0xd7, 0x93, 0x0d, 0xe5, 0x4b, 0x50, 0xb2, 0xce, 0xdb, 0x89, 0xf5, 0xe9, 0xc6,
+	0x0c, 0x57, 0x67, 0xac, 0xf7, 0xdf, 0x05, 0xd5, 0xc2, 0x83, 0x58, 0xb0, 0xd6, 0xe0, 0x73, 0x22,
+	0x82, 0x4e, 0x18, 0xb7, 0xd1, 0xda, 0x69, 0x0b, 0x6e, 0x37, 0x4e, 0xcb, 0x9c, 0x57, 0x00, 0x48,
+	0x97, 0xa6, 0x42, 0x35, 0xbf, 0x68, 0x31, 0x66, 0xa5, 0x5c, 0xb6, 0xfe, 0x5d, 0x58, 0x52, 0xa4,
+	0x90, 0x72, 0xc1, 0x22, 0x22, 0x70, 0xfd, 0xd9, 0xe7, 0x71, 0x45, 0xc2, 0x5b, 0x43, 0x14, 0x8d,
+	0x60, 0x90, 0x32, 0xc1, 0x02, 0xd2, 0x95, 0x2b, 0xb5, 0x64, 0x8c, 0xa0, 0x91, 0xe2, 0x2c, 0xa0,
+	0x55, 0x70, 0xa7, 0x2c, 0x3d, 0x52, 0x82, 0xb3, 0x40, 0x92, 0x84, 0x85, 0xee, 0xb4, 0x05, 0x29,
+	0x91, 0x2c, 0x45, 0xcf, 0x85, 0x3b, 0x63, 0x8d, 0x9f, 0x94, 0x78, 0xef, 0xa9, 0x1d, 0xbb, 0xb9,
+	0xbf, 0x4b, 0xc5, 0x1e, 0x0b, 0x68, 0xc4, 0x29, 0xc7, 0xfd, 0x22, 0x87, 0x67, 0xc4, 0x3b, 0x31,
+	0x42, 0xef, 0xf7, 0xf5, 0x98, 0x1d, 0x92, 0xe0, 0x84, 0xb4, 0xa9, 0x2e, 0x87, 0xa3, 0x92, 0x28,
+	0x89, 0xf1, 0x6b, 0xb2, 0x51, 0xd1, 0xf2, 0x7a, 0xe8, 0xdc, 0x87, 0x79, 0x6c, 0xae, 0x1f, 0xa4,
+	0x94, 0x08, 0x1a, 0xe6, 0x06, 0x6f, 0x0e, 0x91, 0x9a, 0x02, 0x9c, 0x7b, 0x50, 0x8a, 0xcf, 0x22,
+	0x9a, 0x8e, 0x7a, 0x31, 0x33, 0x52, 0x5a, 0x0f, 0xbd, 0x0e, 0xbc, 0x34, 0xd6, 0xf4, 0x6c, 0xc6,
+	0xbf, 0x06, 0x33, 0x5d, 0x25, 0x93, 0x13, 0x3e, 0xf7, 0x70, 0xb9, 0x3a, 0xde, 0xe2, 0x86, 0xe1,
+	0xe0, 0x74, 0x6b, 0x57, 0xc3, 0x6e, 0x8f, 0x96, 0x79, 0x11, 0xdc, 0xcc, 0x6a, 0x7a, 0xc6, 0x69,
+	0xba, 0x4b, 0x7a, 0xb4, 0x29, 0x88, 0xe0, 0xd8, 0xc8, 0x0b, 0x1d, 0x39, 0xb3, 0xbc, 0x9c, 0xbb,
+	0x30, 0xd3, 0x26, 0x3d, 0x6a, 0x8e, 0x68, 0x83, 0x4f, 0xa3, 0xb0, 0x1e, 0x3a, 0xd7, 0x61, 0x0a,
+	0xb7, 0x32, 0x77, 0x27, 0xd6, 0x26, 0xd6, 0xcb, 0x0d, 0xf5, 0xe1, 0xfd, 0x60, 0x12, 0x56, 0x2f,
+	0xae, 0x30, 0xeb, 0xdf, 0x6f, 0x5a, 0xb1, 0xe5, 0x5c, 0x4d, 0x8c, 0x39, 0x57, 0xdf, 0x30, 0xad,
+	0x52, 0xe7, 0xe9, 0x6b, 0xd5, 0xcb, 0x1b, 0x53, 0x55, 0x5f, 0xaa, 0x8c, 0x43, 0xc0, 0x21, 0x41,
+	0x87, 0xd1, 0x53, 0xda, 0x43, 0xcf, 0xf5, 0xb8, 0x1b, 0x07, 0x27, 0xe6, 0x70, 0x7d, 0x78, 0x95,
+	0xa6, 0x4d, 0xab, 0xe4, 0x63, 0x59, 0xb2, 0xb1, 0x64, 0x69, 0x53, 0xa2, 0x95, 0x4f, 0x61, 0x4a,
+	0x0d, 0xff, 0x5d, 0x5c, 0xa8, 0x44, 0x8c, 0x2e, 0x37, 0xe9, 0x22, 0xd5, 0x43, 0x5c, 0x90, 0x12,
+	0x1e, 0x5a, 0xa7, 0x6c, 0x41, 0xa2, 0xfc, 0xb9, 0x3c, 0xf8, 0xfe, 0xa4, 0x00, 0xce, 0x78, 0xb5,
+	0x78, 0x0e, 0xd9, 0xdd, 0x18, 0xa9, 0xa1, 0x6c, 0x61, 0xf5, 0xd0, 0x79, 0x38, 0xd2, 0x67, 0x36,
+	0xf4, 0xc9, 0xb2, 0xbd, 0x6e, 0xf5, 0x82, 0x61, 0x99, 0xd7, 0x60, 0xae, 0x1f, 0x61, 0x5d, 0xf2,
+	0x98, 0x97, 0xb3, 0x30, 0xa3, 0xc9, 0xa0, 0x00, 0x3c, 0xe1, 0xbd, 0xef, 0xc0, 0x75, 0x1c, 0xb3,
+	0xdd, 0xda, 0x2e, 0x15, 0xb5, 0xb8, 0xd7, 0x23, 0x51, 0xb8, 0xc7, 0xb8, 0x40, 0x5f, 0x4d, 0x1f,
+	0x2b, 0x85, 0x91, 0xfd, 0x5e, 0x0f, 0xb1, 0xf1, 0x81, 0xe2, 0xfa, 0x49, 0x4a, 0x5b, 0xec, 0x3c,
+	0x7f, 0x88, 0x6a, 0xec, 0x50, 0x42, 0xde, 0x26, 0xdc, 0xb9, 0xa8, 0x86, 0x6c, 0xa9, 0xbd, 0x0c,
+	0xf3, 0x46, 0x99, 0x3e, 0x00, 0x26, 0xd6, 0x67, 0x1b, 0x73, 0x5a, 0x86, 0x07, 0xac, 0xb7, 0x0e,
+	0x4e, 0x6d, 0x17, 0x95, 0xec, 0xd3, 0x5e, 0x8d, 0x04, 0x1d, 0x1a, 0xee, 0x52, 0xe1, 0x38, 0x30,
+	0x79, 0x42, 0x07, 0x5c, 0x17, 0x90, 0xbf, 0xbd, 0x1f, 0x16, 0x60, 0x65, 0x9c, 0x9a, 0xd5, 0xf5,
+	0x4d, 0x98, 0x96, 0x93, 0xc5, 0xf5, 0xae, 0x7d, 0xb5, 0xfa, 0xd5, 0xe4, 0xaa, 0x9c, 0xbf, 0x23,
+	0xd2, 0x6e, 0xe8, 0x32, 0x2b, 0x8f, 0xa1, 0x64, 0x64, 0x68, 0x0e, 0x5b, 0x71, 0x3f, 0x52, 0xc3,
+	0x63, 0xec, 0xa8, 0x12, 0x5d, 0xea, 0xc3, 0x7c, 0xaf, 0x30, 0xd6, 0x97, 0x26, 0x15, 0xce, 0x03,
+	0xab, 0x2f, 0xd2, 0xcf, 0x1b, 0xa3, 0x54, 0x3f, 0xa5, 0x83, 0x43, 0xc2, 0x52, 0xd5, 0xd1, 0x95,
+	0xff, 0x07, 0x33, 0x5a, 0xf0, 0x6b, 0x3a, 0x53, 0x6f, 0xc1, 0x8d, 0x91, 0x4a, 0xb6, 0x68, 0x97,
+	0x0a, 0x7a, 0xe1, 0xb0, 0xde, 0x84, 0xeb, 0xa3, 0x2d, 0x92, 0x96, 0xe4, 0xcf, 0x27, 0xe1, 0xce,
+	0x45, 0x40, 0x36, 0xe0, 0x0f, 0xa0, 0x12, 0xf4, 0xd3, 0xd4, 0x0f, 0xe2, 0x28, 0xa2, 0x01, 0x1e,
+	0x42, 0xea, 0xb2, 0x31, 0x99, 0xdd, 0x30, 0xfb, 0x69, 0x5a, 0x1b, 0x82, 0xb8, 0xe5, 0x82, 0x5e,
+	0xe8, 0xe3, 0xbd, 0xaf, 0x68, 0xf1, 0xa6, 0x83, 0x9e, 0x9c, 0x73, 0x0d, 0x73, 0xaa, 0xec, 0x8a,
+	0x0d, 0xe3, 0x30, 0xbe, 0x0c, 0xb3, 0x08, 0xb7, 0xba, 0x7d, 0xde, 0x71, 0x27, 0x2d, 0x42, 0x29,
+	0xe8, 0x85, 0x3b, 0x28, 0x45, 0xcb, 0x86, 0x4e, 0x79, 0x87, 0x09, 0xee, 0x4e, 0x59, 0x8c, 0x99,
+	0x36, 0x15, 0x4f, 0x98, 0xe0, 0xb8, 0xab, 0x91, 0xd0, 0x63, 0x9c, 0x53, 0xee, 0x4e, 0x5b, 0x14,
+	0x74, 0xcf, 0xf7, 0xa5, 0x18, 0x77, 0x57, 0x28, 0x87, 0x4b, 0x29, 0x9a, 0xb1, 0x58, 0xa0, 0x00,
+	0xa9, 0xeb, 0x0d, 0x28, 0x6b, 0x9a, 0x56, 0x57, 0xb2, 0x88, 0xf3, 0x0a, 0xd2, 0x1a, 0x5f, 0x01,
+	0x38, 0x1e, 0x08, 0xca, 0xfd, 0x94, 0x92, 0xd0, 0x9d, 0xb5, 0xab, 0x95, 0xf2, 0x06, 0x25, 0x21,
+	0xea, 0x53, 0xa4, 0xb3, 0x94, 0x09, 0x41, 0x23, 0x17, 0x2c, 0xde, 0xbc, 0x84, 0x3e, 0x57, 0x08,
+	0xee, 0xd1, 0x2e, 0xeb, 0x31, 0xe1, 0xf7, 0xc8, 0xb9, 0x04, 0xdc, 0x39, 0x8b, 0x5b, 0x96, 0xd8,
+	0xbe, 0x86, 0xb0, 0x72, 0x39, 0x4d, 0x4c, 0xd0, 0x1e, 0x77, 0xe7, 0x2d, 0xe2, 0x2c, 0xca, 0xeb,
+	0x28, 0x76, 0x3c, 0x98, 0xa5, 0xa7, 0x4c, 0x4f, 0x62, 0xd9, 0xe6, 0x64, 0x62, 0x5c, 0x71, 0xaa,
+	0xb2, 0x05, 0x0b, 0x57, 0x22, 0xef, 0x5b, 0xb0, 0xa0, 0xd6, 0x4a, 0xf3, 0xb3, 0x3d, 0x65, 0x5f,
+	0xdf, 0x82, 0x05, 0x1e, 0x74, 0x68, 0x8f, 0xf8, 0x01, 0x11, 0xa4, 0x1b, 0xb7, 0xf3, 0x46, 0x50,
+	0x61, 0x35, 0x05, 0x79, 0xff, 0x33, 0x01, 0x37, 0xf3, 0xe5, 0xb3, 0x55, 0xb6, 0x0a, 0x33, 0xa2,
+	0x83, 0xe3, 0xc6, 0x73, 0x0a, 0x8c, 0x10, 0x5d, 0x25, 0xfd, 0xd3, 0x2c, 0xc4, 0x11, 0xcf, 0xa0,
+	0xa2, 0xe1, 0x9a, 0x41, 0xb1, 0x69, 0xa6, 0x08, 0x09, 0x04, 0x3b, 0xa5, 0x39, 0x27, 0xa1, 0xac,
+	0xb1, 0x4d, 0x09, 0x39, 0x1f, 0xc2, 0xf5, 0x38, 0xa1, 0xa9, 0x74, 0xb2, 0xb8, 0xcf, 0xfb, 0xc7,
+	0x3d, 0x9c, 0x83, 0xfc, 0x35, 0x77, 0x79, 0xc8, 0x68, 0x1a, 0x82, 0xb3, 0x03, 0x77, 0x92, 0x94,
+	0x26, 0x24, 0xa5, 0xa1, 0x8f, 0x47, 0x86, 0xb4, 0xdf, 0xdc, 0xa7, 0xe7, 0x34, 0xe8, 0xa3, 0x02,
+	0xdb, 0x0d, 0x5b, 0x31, 0xcc, 0x66, 0x46, 0xdc, 0xd6, 0x3c, 0x67, 0x1f, 0xd6, 0xa2, 0x38, 0xf2,
+	0x2f, 0xd5, 0x65, 0xfb, 0x6d, 0x77, 0xa3, 0x38, 0x3a, 0xfc, 0x6a, 0x75, 0x0f, 0xa0, 0x12, 0x52,
+	0x12, 0xca, 0xd3, 0x23, 0xa5, 0x22, 0x65, 0x94, 0xe7, 0x6e, 0x17, 0x8b, 0x06, 0x6d, 0x28, 0xd0,
+	0xd9, 0x81, 0xbb, 0xd6, 0x00, 0xe0, 0x81, 0x13, 0xfa, 0x71, 0x5f, 0xf8, 0x2c, 0xf2, 0xbf, 0xe8,
+	0xd3, 0x3e, 0xcd, 0x5d, 0x31, 0x5f, 0x1a, 0x52, 0xf1, 0x08, 0x0a, 0x9f, 0xf6, 0x45, 0x3d, 0xfa,
+	0x0c, 0x69, 0xe8, 0x27, 0xc9, 0xd8, 0x0a, 0x77, 0x67, 0xad, 0x02, 0x5a, 0xe6, 0xfd, 0xb8, 0xa0,
+	0x0e, 0xab, 0xcd, 0xfd, 0xcd, 0x30, 0xdc, 0x49, 0x69, 0xe6, 0x19, 0x5e, 0xe1, 0x50, 0xa2, 0x59,
+	0x60, 0x89, 0x2f, 0xe3, 0x11, 0x41, 0x6e, 0xde, 0x4b, 0x2c, 0x39, 0x94, 0x52, 0x5c, 0xdc, 0xda,
+	0x89, 0x1c, 0xf1, 0x07, 0x87, 0x62, 0x3c, 0x86, 0xb9, 0x88, 0x53, 0xea, 0x4b, 0xf7, 0x3f, 0x1d,
+	0xa8, 0x8b, 0xd3, 0xa4, 0x65, 0x76, 0x2b, 0x12, 0xaf, 0x29, 0x18, 0xaf, 0x4f, 0xde, 0x1f, 0x17,
+	0xe0, 0xce, 0x45, 0x6d, 0xce, 0xd6, 0xae, 0xe5, 0x29, 0x15, 0xc6, 0x3c, 0xa5, 0x0d, 0xb8, 0x99,
+	0xf4, 0xd3, 0xa0, 0x43, 0x38, 0xf5, 0x95, 0xc8, 0x0f, 0xa9, 0x20, 0xac, 0x9b, 0x0b, 0xd3, 0x5d,
+	0x37, 0x9c, 0x86, 0xa4, 0x6c, 0x49, 0x86, 0xdc, 0x14, 0x29, 0x89, 0xb8, 0xee, 0x4f, 0x36, 0x28,
+	0x5a, 0xe8, 0xdd, 0x87, 0x65, 0xb5, 0x9d, 0x76, 0xa9, 0xa8, 0x1f, 0xee, 0xc5, 0x81, 0xba, 0x23,
+	0x54, 0x60, 0x82, 0x25, 0xca, 0xfa, 0xcf, 0x34, 0xf0, 0xa7, 0xf7, 0xd3, 0x02, 0x2c, 0xd6, 0x86,
+	0x8c, 0x7a, 0xd4, 0x8a, 0x75, 0xd0, 0xab, 0x30, 0x12, 0xf4, 0x5a, 0x83, 0x52, 0x97, 0x08, 0x26,
+	0xfa, 0xa1, 0x3a, 0x72, 0x8a, 0x66, 0x98, 0x8d, 0x14, 0x87, 0xb9, 0x1b, 0x47, 0x6d, 0x45, 0x99,
+	0xb0, 0x28, 0x43, 0x31, 0x36, 0x5c, 0x0f, 0x70, 0x6e, 0x6c, 0x8d, 0x10, 0x6d, 0x8c, 0x5c, 0xdf,
+	0xb9, 0x40, 0x91, 0x12, 0xe1, 0x59, 0x18, 0x30, 0x31, 0x70, 0xa7, 0x2d, 0x48, 0x4a, 0xbc, 0x6d,
+	0xb8, 0x7d, 0x41, 0x77, 0xb3, 0x69, 0x78, 0x1d, 0xa6, 0x58, 0xd4, 0x8a, 0xcd, 0x09, 0x5c, 0xa9,
+	0x8e, 0xf4, 0xb8, 0xa1, 0x60, 0xef, 0xdb, 0x70, 0x4b, 0x1b, 0xa1, 0x01, 0x17, 0xb4, 0x27, 0xed,
+	0x50, 0x53, 0xda, 0x29, 0x67, 0x0d, 0x66, 0xdb, 0x81, 0x7f, 0x81, 0xd7, 0x34, 0xd3, 0x0e, 0x54,
+	0x50, 0xf4, 0x65, 0x98, 0xd5, 0xf6, 0xee, 0xe4, 0x34, 0x77, 0x26, 0x97, 0x94, 0xf8, 0xd3, 0xd3,
+	0xe1, 0x49, 0xbb, 0x4b, 0x85, 0x55, 0x85, 0xf7, 0xd3, 0xec, 0xa4, 0xcd, 0x03, 0x59, 0x07, 0xae,
+	0xae, 0x5d, 0xfa, 0xf4, 0x44, 0xf0, 0xd1, 0xca, 0xa5, 0x8f, 0xcb, 0x3f, 0x3d, 0xc5, 0x43, 0x4d,
+	0xd9, 0x3a, 0x8c, 0xa4, 0xf0, 0xdc, 0x2e, 0x00, 0x05, 0x7c, 0x12, 0x1f, 0xcb, 0x43, 0x6d, 0xc0,
+	0x68, 0x37, 0xc4, 0xd0, 0x83, 0x24, 0xda, 0x66, 0x6e, 0xde, 0x40, 0x86, 0xda, 0xe7, 0x34, 0x35,
+	0xf1, 0x65, 0x9e, 0x33, 0x68, 0xf3, 0x08, 0xe9, 0xd8, 0x32, 0x77, 0x3e, 0x80, 0xeb, 0xf2, 0x42,
+	0xc1, 0x69, 0x7a, 0x6a, 0x97, 0xb0, 0xcd, 0x96, 0x83, 0x8c, 0xa6, 0x24, 0x64, 0xe5, 0xd6, 0xa0,
+	0xc4, 0xe3, 0x00, 0x5d, 0x8f, 0xbc, 0x8d, 0xca, 0xa4, 0x72, 0xdb, 0xea, 0xdf, 0xbe, 0x88, 0x7d,
+	0x74, 0x7e, 0x49, 0x98, 0xb3, 0x48, 0x15, 0x83, 0x1f, 0xc5, 0xcf, 0x24, 0x8a, 0x16, 0x30, 0x2b,
+	0x83, 0x02, 0x8c, 0x0e, 0xd8, 0x26, 0x69, 0xd1, 0xa0, 0x7b, 0x0a, 0x74, 0xbe, 0x06, 0x8b, 0x78,
+	0x26, 0xd3, 0x63, 0x12, 0x9c, 0x68, 0x9b, 0x07, 0x16, 0x7f, 0x21, 0x03, 0x95, 0xa1, 0xc3, 0xd0,
+	0x83, 0x32, 0x4e, 0xbe, 0xba, 0xc0, 0xcc, 0xd9, 0x03, 0xa3, 0xa1, 0x3d, 0x44, 0x70, 0x56, 0xba,
+	0x71, 0x3b, 0x36, 0x96, 0x74, 0xde, 0x9e, 0x15, 0x09, 0x28, 0x8d, 0xaf, 0x80, 0xfa, 0x52, 0x53,
+	0x52, 0xb6, 0x2d, 0x98, 0x94, 0xe3, 0x7c, 0x78, 0xdf, 0xd3, 0x61, 0xd4, 0xcd, 0xfd, 0x26, 0x8d,
+	0xc2, 0xed, 0x9e, 0xb6, 0x13, 0x97, 0x1a, 0xcf, 0x37, 0x61, 0x81, 0x22, 0x51, 0x06, 0xb3, 0xc7,
+	0x02, 0x12, 0xf3, 0x12, 0xdb, 0xe7, 0x6d, 0x19, 0x93, 0xb8, 0x0f, 0xea, 0xdb, 0x6f, 0xc5, 0x69,
+	0x8f, 0x88, 0xfc, 0xf3, 0x80, 0x44, 0x76, 0x24, 0xe0, 0xfc, 0x0e, 0x2c, 0x27, 0x34, 0xe5, 0x71,
+	0x44, 0xa4, 0xd3, 0xef, 0x8b, 0xf8, 0x84, 0x46, 0xe6, 0x1a, 0xf7, 0x76, 0x75, 0xa4, 0x8d, 0xd5,
+	0x43, 0xc5, 0xc5, 0xcb, 0x40, 0x83, 0x26, 0x5d, 0x12, 0xc8, 0x03, 0xeb, 0x08, 0x0b, 0x35, 0x96,
+	0x92, 0x21, 0x28, 0x25, 0x5c, 0xee, 0x33, 0xf5, 0x4c, 0xd1, 0x0e, 0x72, 0x0b, 0xa8, 0xa4, 0xc4,
+	0xbb, 0x81, 0xf3, 0x75, 0x98, 0xd6, 0x75, 0xce, 0xc8, 0x3a, 0x5f, 0x1e, 0xab, 0x73, 0xac, 0x22,
+	0x5d, 0x60, 0xe5, 0xdb, 0x50, 0x19, 0xc5, 0x70, 0xf4, 0x25, 0x3a, 0x1e, 0x7f, 0x9c, 0x95, 0x72,
+	0x13, 0x4e, 0x54, 0xa4, 0xf1, 0x70, 0x96, 0x2a, 0xad, 0x6e, 0x8c, 0xc7, 0x70, 0xfb, 0x92, 0xfe,
+	0x5e, 0x39, 0x5f, 0xf9, 0xa6, 0x14, 0x2f, 0x6c, 0x8a, 0xf7, 0x01, 0xdc, 0x1a, 0xe9, 0xef, 0x57,
+	0x1d, 0x48, 0x65, 0xfb, 0x40, 0xf2, 0xfe, 0xb2, 0x00, 0x37, 0xb3, 0xdb, 0x9c, 0x2c, 0x77, 0x44,
+	0x7b, 0x49, 0x17, 0x4d, 0xef, 0xa5, 0x37, 0xc6, 0xff, 0xcb, 0x22, 0x7a, 0x05, 0x40, 0x71, 0xbb,
+	0x24, 0x6a, 0xab, 0xf0, 0x81, 0xe9, 0x80, 0x94, 0xef, 0x91, 0xa8, 0x3d, 0xb6, 0xd2, 0x26, 0x2d,
+	0x9a, 0xbd, 0xd2, 0xbc, 0x3f, 0x28, 0xc0, 0xea, 0xc5, 0x2d, 0x7e, 0xa1, 0x1e, 0xe3, 0xc6, 0x16,
+	0xba, 0x80, 0x4f, 0xcf, 0x19, 0x17, 0xdc, 0x2d, 0x5a, 0x57, 0xbe, 0x05, 0x03, 0x6e, 0x4b, 0x0c,
+	0xcd, 0x91, 0x91, 0xe4, 0xe2, 0xc5, 0x99, 0xd4, 0xfb, 0x97, 0x82, 0x19, 0xfb, 0xdd, 0x94, 0x44,
+	0x62, 0xb7, 0x4f, 0x31, 0x6c, 0xcf, 0x39, 0xe5, 0x0f, 0xaf, 0x0e, 0xbb, 0xe4, 0x63, 0x60, 0xc5,
+	0x8b, 0x63, 0x60, 0x6f, 0xc3, 0x62, 0x22, 0x15, 0xa2, 0xb9, 0x6b, 0x63, 0x25, 0xb9, 0x51, 0x2c,
+	0x2b, 0xf0, 0x28, 0x96, 0xf5, 0xa3, 0x79, 0x0c, 0xc9, 0x40, 0x72, 0xe9, 0x79, 0xc2, 0x94, 0x67,
+	0x96, 0x1b, 0xcf, 0x0a, 0xe2, 0x47, 0xf1, 0x76, 0x86, 0xa2, 0x9f, 0x46, 0xa4, 0xc7, 0xef, 0x4e,
+	0x59, 0x3c, 0x2d, 0xf3, 0x3a, 0x70, 0xef, 0x2b, 0x3a, 0xf8, 0x62, 0x5e, 0xcf, 0x3a, 0x2c, 0xe8,
+	0xf6, 0xcb, 0xc6, 0x6b, 0x5f, 0x7d, 0x0a, 0x1f, 0x95, 0x74, 0xdb, 0x77, 0x95, 0xdc, 0xeb, 0xc0,
+	0x6a, 0x6d, 0xb7, 0xa6, 0x8e, 0xc3, 0x7d, 0xde, 0xf6, 0x77, 0xa9, 0xd0, 0xc1, 0x59, 0xe5, 0x04,
+	0x5d, 0x19, 0x6b, 0x1c, 0x86, 0x35, 0x8b, 0x63, 0x61, 0x4d, 0xf5, 0x7a, 0x56, 0xc0, 0xd7, 0xb3,
+	0x49, 0xef, 0x4f, 0xcb, 0x70, 0xff, 0xf2, 0xaa, 0xfc, 0xac, 0x73, 0xef, 0x80, 0x43, 0x33, 0x6f,
+	0x2d, 0x49, 0x69, 0x20, 0x23, 0x91, 0xd9, 0xd2, 0x5a, 0xd2, 0xe0, 0x56, 0x86, 0xe1, 0x6a, 0x36,
+	0x8f, 0x6d, 0x63, 0xbb, 0x76, 0x4e, 0x23, 0xd2, 0x84, 0xdc, 0x87, 0x79, 0xdb, 0x6e, 0xe6, 0x96,
+	0xd8, 0x9c, 0x65, 0x08, 0xf1, 0x6d, 0x97, 0x71, 0x3f, 0x49, 0xe3, 0x16, 0xeb, 0x52, 0xe3, 0xfa,
+	0xda, 0x31, 0xdf, 0x45, 0xc6, 0x0f, 0x15, 0xaa, 0x3d, 0xe0, 0x47, 0xb0, 0xcc, 0xb8, 0xcf, 0xa2,
+	0x53, 0x1a, 0x89, 0x38, 0x1d, 0x98, 0x32, 0x53, 0x56, 0x99, 0x25, 0xc6, 0xeb, 0x06, 0xd7, 0xa5,
+	0xd6, 0xa1, 0xcc, 0xb8, 0x7f, 0x4a, 0x02, 0xff, 0x98, 0x44, 0x11, 0x55, 0xaf, 0x10, 0x86, 0x3f,
+	0xc7, 0xf8, 0x73, 0x12, 0x3c, 0x96, 0x80, 0x66, 0x06, 0x83, 0x63, 0x9a, 0xfa, 0x01, 0x69, 0xa9,
+	0x3b, 0x81, 0xc5, 0xac, 0x21, 0x52, 0x23, 0x2d, 0xd3, 0x76, 0x1e, 0x74, 0xe2, 0xb8, 0xeb, 0xeb,
+	0xde, 0xbb, 0xb3, 0x16, 0x7b, 0x91, 0xa1, 0xcf, 0x15, 0xc7, 0x5d, 0x3d, 0x03, 0xb8, 0x2d, 0x18,
+	0xf7, 0xe5, 0x9d, 0x96, 0x86, 0x2e, 0x58, 0xd4, 0x59, 0xc6, 0xf7, 0x94, 0x18, 0xcf, 0x5c, 0x26,
+	0x6f, 0x67, 0xf8, 0x96, 0x7e, 0x4c, 0x43, 0x77, 0xce, 0xe2, 0xcd, 0x33, 0xde, 0xcc, 0x10, 0x5c,
+	0x35, 0x7a, 0x3b, 0xe5, 0xce, 0x5b, 0x23, 0x74, 0x3e, 0x84, 0x1b, 0x8c, 0xfb, 0xad, 0x94, 0x52,
+	0x5f, 0xa4, 0x8c, 0x0c, 0x5b, 0x59, 0xb6, 0x54, 0x3a, 0x8c, 0xa3, 0xc3, 0x7f, 0x84, 0x04, 0xd3,
+	0xd0, 0x8f, 0xe0, 0x86, 0x55, 0xca, 0xda, 0x6f, 0x0b, 0xf6, 0x55, 0xb1, 0x65, 0x8a, 0x59, 0x5b,
+	0xee, 0x6d, 0x58, 0xc4, 0x2e, 0xc6, 0x67, 0xfe, 0x29, 0x8b, 0xbb, 0x34, 0x0a, 0xa8, 0xbb, 0x68,
+	0x55, 0x56, 0x66, 0x7c, 0x2f, 0x3e, 0x7b, 0xae, 0x21, 0xe7, 0xeb, 0x70, 0x93, 0x71, 0xd3, 0x2a,
+	0xe9, 0x62, 0xd0, 0xd0, 0x0f, 0xe3, 0xb3, 0xc8, 0xad, 0x58, 0x85, 0x96, 0x19, 0xd7, 0x0d, 0xdb,
+	0x93, 0x8c, 0xad, 0xf8, 0x2c, 0xd2, 0xeb, 0x00, 0xc3, 0x6f, 0xfd, 0x88, 0x89, 0x81, 0x99, 0xd7,
+	0xa5, 0xfc, 0x3a, 0xa8, 0x19, 0x5c, 0xcf, 0xae, 0x6a, 0x9e, 0x48, 0x49, 0x48, 0x4d, 0x09, 0x27,
+	0xdf, 0xbc, 0x23, 0xc4, 0x34, 0xfb, 0x03, 0xb8, 0x9e, 0x51, 0xed, 0x51, 0x58, 0xb6, 0x9d, 0x3d,
+	0xa1, 0x0b, 0x58, 0x83, 0xe0, 0x81, 0x79, 0x7f, 0x66, 0xa1, 0x7b, 0x3d, 0xf7, 0x2e, 0x62, 0xc4,
+	0xd8, 0x7e, 0xde, 0xe7, 0x09, 0x8d, 0x64, 0x46, 0x03, 0x8d, 0x42, 0x15, 0x00, 0xbd, 0x61, 0xb1,
+	0x97, 0x86, 0x84, 0xed, 0x28, 0xc4, 0x4b, 0xa8, 0x7c, 0x1a, 0xe9, 0xa7, 0x29, 0x8d, 0x82, 0x81,
+	0x7b, 0xd3, 0xb6, 0xdb, 0x46, 0xaa, 0xde, 0xcb, 0xd0, 0x36, 0x77, 0xe9, 0x29, 0xed, 0xba, 0xb7,
+	0xf2, 0xef, 0x65, 0x94, 0xf4, 0xf6, 0x50, 0x8e, 0x3b, 0xb4, 0x95, 0x32, 0xac, 0x56, 0xad, 0x08,
+	0xd7, 0x76, 0x81, 0x14, 0x52, 0x33, 0x4b, 0xc1, 0xcc, 0x8f, 0x7c, 0xac, 0xc0, 0xd6, 0xca, 0x96,
+	0xbe, 0x64, 0x2f, 0x05, 0x4d, 0xa9, 0x69, 0x86, 0x6c, 0xeb, 0x47, 0x72, 0xf5, 0xc9, 0x3a, 0xdb,
+	0x7d, 0x92, 0x86, 0x3e, 0x8d, 0xc8, 0x71, 0x97, 0x86, 0xee, 0xed, 0xfc, 0xdc, 0x36, 0x33, 0xc6,
+	0xb6, 0x22, 0x18, 0xab, 0xd0, 0x89, 0x23, 0xea, 0x9f, 0xd2, 0x54, 0x26, 0x19, 0xb8, 0x77, 0x46,
+	0xac, 0x02, 0xa2, 0xcf, 0x35, 0xe8, 0x7c, 0x0c, 0x2b, 0x38, 0xaf, 0x67, 0xb1, 0xdf, 0x22, 0x81,
+	0x88, 0x53, 0x9f, 0xf4, 0x45, 0x27, 0xab, 0xf0, 0xae, 0x55, 0xf4, 0x26, 0xe3, 0x47, 0x67, 0xf1,
+	0x8e, 0x64, 0x6d, 0xf6, 0x45, 0xc7, 0xd4, 0xf9, 0x0d, 0xb8, 0x65, 0x15, 0xd7, 0x25, 0x55, 0x4f,
+	0x57, 0xad, 0x9e, 0x5e, 0x17, 0xa6, 0xb0, 0x2e, 0x28, 0xbb, 0xfa, 0x4d, 0xb8, 0x65, 0xb7, 0x36,
+	0xb0, 0x86, 0xe9, 0x9e, 0x55, 0xf8, 0x46, 0x32, 0x6c, 0x74, 0x30, 0x1c, 0xa8, 0x7b, 0x50, 0x52,
+	0xa5, 0x59, 0xe8, 0xbe, 0x6c, 0xc7, 0xfa, 0xa4, 0xb4, 0x2e, 0xd7, 0x61, 0x36, 0x1e, 0x2c, 0xa4,
+	0x11, 0xbe, 0xd1, 0xa1, 0xab, 0xef, 0xe5, 0xb7, 0xf1, 0xa1, 0xa2, 0x67, 0x38, 0x9e, 0x99, 0xa9,
+	0xd0, 0x25, 0xc4, 0xc0, 0xef, 0xb2, 0xe8, 0x84, 0x86, 0xee, 0x2b, 0xf6, 0x95, 0x22, 0x15, 0x75,
+	0x0d, 0xef, 0x49, 0x14, 0xed, 0x5f, 0x8a, 0xb1, 0xfb, 0x54, 0x74, 0xfc, 0x10, 0xdd, 0x83, 0x57,
+	0xed, 0x95, 0x91, 0x8a, 0xc7, 0x88, 0x6c, 0xa1, 0x27, 0x55, 0x85, 0x8a, 0x38, 0x8f, 0xf2, 0x51,
+	0x86, 0xd7, 0xac, 0x35, 0xb9, 0x20, 0xce, 0x23, 0x2b, 0xc6, 0x90, 0x3b, 0xa7, 0xde, 0x83, 0x1b,
+	0x99, 0xb7, 0x63, 0x79, 0x91, 0x18, 0x99, 0x2b, 0xe9, 0x33, 0x8f, 0xeb, 0x37, 0xca, 0xec, 0xdb,
+	0xfb, 0x7e, 0x11, 0x56, 0x2f, 0x2c, 0x35, 0x3c, 0xd3, 0xfe, 0x3f, 0x2c, 0xf1, 0x7e, 0x10, 0x50,
+	0x1a, 0x52, 0xbc, 0xb2, 0xc4, 0x27, 0xfd, 0xc4, 0xdc, 0x95, 0xdf, 0xaa, 0x5e, 0x5e, 0x36, 0xe7,
+	0xbd, 0x57, 0x32, 0x2d, 0x7b, 0x4a, 0x89, 0xf3, 0x08, 0x6e, 0xb6, 0x08, 0xeb, 0x66, 0x6a, 0xfd,
+	0xac, 0x99, 0x45, 0xd9, 0xcc, 0xeb, 0x0a, 0x55, 0xf4, 0xa6, 0xc6, 0x56, 0x9e, 0xc3, 0x9c, 0xa5,
+	0xf6, 0xca, 0x63, 0x7e, 0xf4, 0xdc, 0x2c, 0x7e, 0xc5, 0xb9, 0xe9, 0x9d, 0x98, 0xf1, 0xab, 0x75,
+	0x68, 0x70, 0xb2, 0x23, 0xf7, 0x2b, 0xef, 0xb0, 0x04, 0x35, 0x64, 0x37, 0x36, 0xda, 0x12, 0xb9,
+	0x6a, 0xe6, 0xcc, 0x85, 0x8d, 0xb6, 0x84, 0x7d, 0xb5, 0x4b, 0x59, 0xbb, 0x23, 0x72, 0xef, 0x63,
+	0x46, 0x47, 0x03, 0x11, 0xef, 0x0b, 0x58, 0xbd, 0xb0, 0x32, 0xdf, 0x8e, 0x6c, 0xca, 0x01, 0xe3,
+	0x3c, 0xf7, 0xd0, 0x60, 0x84, 0x78, 0x4f, 0x95, 0x6f, 0x0e, 0x7e, 0x2b, 0x2b, 0x9c, 0x73, 0x4f,
+	0x17, 0x25, 0x3a, 0xd4, 0xec, 0xfd, 0x45, 0x11, 0x6e, 0xab, 0x3a, 0x31, 0x68, 0x4f, 0xb8, 0xc0,
+	0xab, 0xb4, 0xd8, 0x62, 0x29, 0xc5, 0xad, 0x37, 0xc0, 0xb5, 0xd7, 0x93, 0xd2, 0x61, 0xae, 0x51,
+	0xce, 0x9f, 0x5f, 0x50, 0xa8, 0xc9, 0x33, 0x72, 0x1e, 0xc1, 0x44, 0xc8, 0x52, 0x9d, 0x1a, 0xe0,
+	0x55, 0x2f, 0x51, 0x5d, 0x6d, 0xf6, 0x8f, 0x77, 0x6b, 0x0d, 0xa4, 0xaf, 0xfc, 0x59, 0x01, 0xa6,
+	0xe4, 0x27, 0x5e, 0xd5, 0x2e, 0xae, 0xa8, 0x14, 0x9a, 0x2a, 0xcc, 0xfb, 0x46, 0x71, 0xec, 0x7d,
+	0xe3, 0x26, 0x4c, 0x1c, 0xc7, 0xe7, 0xf9, 0x94, 0x9d, 0xe3, 0xf8, 0x1c, 0xe7, 0xca, 0x3c, 0x29,
+	0x75, 0x59, 0x94, 0x0f, 0xd1, 0x99, 0x87, 0xa5, 0x3d, 0x16, 0xe1, 0xdb, 0x13, 0x06, 0x4d, 0x8e,
+	0x59, 0x44, 0xd2, 0x41, 0x2e, 0x9c, 0x54, 0x6a, 0x07, 0x8f, 0xa5, 0xd4, 0xab, 0xc1, 0xab, 0x97,
+	0x74, 0xca, 0x7f, 0x21, 0x8f, 0xd6, 0xdb, 0x87, 0xf5, 0x4c, 0x89, 0x4a, 0xfb, 0xf8, 0x24, 0x3e,
+	0xd6, 0x29, 0x1d, 0x3b, 0x71, 0x7a, 0x46, 0xd2, 0xd0, 0x7a, 0x0f, 0xbb, 0x6a, 0x44, 0xbc, 0x5d,
+	0x78, 0x6d, 0xd4, 0x17, 0x3d, 0xd4, 0x21, 0xc0, 0xa3, 0xb4, 0xcf, 0x85, 0xaf, 0x55, 0x5f, 0xf9,
+	0xd2, 0xfe, 0xc3, 0x22, 0xbc, 0x7e, 0x95, 0x26, 0xdd, 0xac, 0x4d, 0x58, 0xe9, 0x10, 0xf4, 0x28,
+	0x59, 0x9c, 0xfa, 0x59, 0x50, 0xb2, 0xc3, 0x38, 0x0e, 0x43, 0x6e, 0x71, 0xde, 0xea, 0x10, 0x7e,
+	0x88, 0x34, 0xa3, 0xe9, 0x89, 0x22, 0x61, 0x94, 0x18, 0x55, 0x44, 0xb1, 0x9f, 0xd2, 0x00, 0x1f,
+	0x32, 0xd1, 0x99, 0x3f, 0x8b, 0xd3, 0x10, 0x63, 0x9b, 0x74, 0xe4, 0x62, 0xf5, 0x52, 0x87, 0xf0,
+	0x83, 0xb8, 0x21, 0x89, 0x87, 0x9a, 0xd7, 0x90, 0x34, 0xed, 0xdc, 0x9c, 0x91, 0x6e, 0x97, 0x0a,
+	0x3f, 0x20, 0xbc, 0xe3, 0x0b, 0x6c, 0x2b, 0x55, 0x81, 0x4e, 0xeb, 0x00, 0xfc, 0x5c, 0x52, 0x6a,
+	0x84, 0x77, 0x8e, 0x14, 0x41, 0x9a, 0x56, 0x4c, 0x0f, 0x20, 0xdd, 0x6e, 0x56, 0xc8, 0x0e, 0x5f,
+	0x2d, 0x20, 0xba, 0xd9, 0xed, 0x6a, 0xbe, 0xf7, 0xef, 0x05, 0xb8, 0xab, 0x66, 0xee, 0x89, 0xf6,
+	0x94, 0x9e, 0x93, 0xa0, 0xa9, 0xb2, 0x63, 0x3a, 0x24, 0x6a, 0xbf, 0xc0, 0x4b, 0xf9, 0xf0, 0x5e,
+	0x5c, 0x1c, 0xbf, 0x17, 0x3f, 0x82, 0xe5, 0x54, 0x36, 0xe8, 0x94, 0x04, 0xe8, 0x0b, 0x71, 0x41,
+	0x52, 0x91, 0x0f, 0xbd, 0x2d, 0x49, 0xc2, 0x73, 0x89, 0x37, 0x25, 0xac, 0x5d, 0x69, 0xe5, 0x66,
+	0xf9, 0x51, 0x7c, 0x96, 0x73, 0xec, 0xe7, 0x18, 0x57, 0x5e, 0xd6, 0x41, 0x7c, 0x86, 0xfd, 0x1d,
+	0x32, 0x5b, 0x7d, 0xd1, 0x4f, 0x69, 0xce, 0xa3, 0x5f, 0x30, 0xe4, 0x1d, 0x89, 0x79, 0xdf, 0x32,
+	0xd6, 0x61, 0x57, 0x66, 0xa2, 0x89, 0x88, 0xa6, 0xc6, 0x43, 0x64, 0xd1, 0xc9, 0x95, 0xeb, 0xe9,
+	0x3b, 0xf0, 0xea, 0x25, 0xc5, 0x87, 0x8b, 0xc9, 0x85, 0xc9, 0xe4, 0x6c, 0x24, 0x52, 0x20, 0x25,
+	0x58, 0x43, 0x44, 0xcf, 0xe3, 0x68, 0x64, 0xb8, 0x8c, 0xd0, 0xfb, 0xdd, 0x09, 0x95, 0x51, 0xb2,
+	0x5b, 0x6b, 0xa8, 0x0c, 0x27, 0x19, 0x8e, 0xbe, 0x9d, 0xdf, 0x33, 0x98, 0xeb, 0x30, 0xb4, 0x1f,
+	0x1f, 0xc3, 0xb4, 0xce, 0x0f, 0x2c, 0xca, 0x2c, 0xcc, 0xb5, 0xea, 0x98, 0x82, 0xaa, 0xfe, 0xad,
+	0x92, 0x05, 0x37, 0xa6, 0x1b, 0x9b, 0x07, 0x5b, 0x4f, 0xf7, 0x1b, 0xba, 0x9c, 0xb3, 0x0d, 0xa5,
+	0x16, 0xe9, 0x76, 0x31, 0x7c, 0xe7, 0x4e, 0xbc, 0xa0, 0x8e, 0x99, 0xad, 0x7a, 0xb3, 0xb6, 0xd9,
+	0xd8, 0x6a, 0x64, 0x45, 0xf1, 0x4d, 0xc9, 0x64, 0xf4, 0xfa, 0x2d, 0x0c, 0x93, 0xe6, 0x96, 0x5e,
+	0xd9, 0x60, 0x3b, 0x08, 0xa1, 0x0d, 0x3b, 0xa3, 0xc7, 0x98, 0x37, 0x26, 0x53, 0x00, 0x73, 0xd6,
+	0x69, 0x4e, 0x21, 0x32, 0x97, 0xce, 0x4b, 0xa0, 0x9c, 0xab, 0xd9, 0x01, 0xd0, 0xed, 0xaf, 0x5c,
+	0x73, 0xe6, 0xc0, 0xb4, 0xa3, 0x52, 0x70, 0x1c, 0x58, 0xa8, 0xed, 0xd5, 0xb7, 0x0f, 0x8e, 0xfc,
+	0xe6, 0xd1, 0xf6, 0xe6, 0x7e, 0x7d, 0xab, 0x52, 0x74, 0x5e, 0x82, 0x1b, 0x87, 0x8d, 0xa7, 0x47,
+	0x4f, 0x1f, 0x3f, 0xdb, 0xf1, 0x77, 0xea, 0xdb, 0x7b, 0x5b, 0xfe, 0xb3, 0xfa, 0xc1, 0xd1, 0x07,
+	0x8f, 0x2a, 0x13, 0xce, 0x2d, 0x58, 0xfe, 0x7c, 0xfb, 0xf1, 0xe6, 0x61, 0xdd, 0x3f, 0xdc, 0x6c,
+	0x6c, 0xee, 0x1b, 0x60, 0xd2, 0xfb, 0x8f, 0x02, 0xdc, 0xcb, 0xcc, 0x59, 0x66, 0x13, 0x75, 0x56,
+	0x9e, 0x6a, 0x8a, 0xf3, 0x31, 0xcc, 0xd0, 0x48, 0xbd, 0x1c, 0x29, 0x2f, 0xe1, 0xf5, 0xea, 0x15,
+	0x45, 0xaa, 0xdb, 0xe8, 0xd0, 0x34, 0x4c, 0xb1, 0x95, 0x1f, 0x14, 0x60, 0x4a, 0x8a, 0x2e, 0xc8,
+	0xd9, 0x2b, 0xbc, 0x70, 0xce, 0x5e, 0xf1, 0x2b, 0x72, 0xf6, 0xde, 0x86, 0x19, 0x9d, 0x22, 0x27,
+	0x67, 0x74, 0xee, 0xa1, 0x33, 0x3e, 0xa3, 0x0d, 0x43, 0xf1, 0xfe, 0xa6, 0x00, 0xde, 0x78, 0xf3,
+	0x6b, 0x32, 0x3b, 0x7a, 0x9f, 0xb7, 0x4d, 0xa7, 0x6b, 0xa3, 0x9d, 0x7e, 0xa3, 0x7a, 0x75, 0xa9,
+	0xd1, 0x7e, 0x3f, 0x37, 0xdd, 0xbe, 0x07, 0xa5, 0x2c, 0x5a, 0x96, 0x0b, 0xe8, 0xf7, 0x74, 0xa0,
+	0xcc, 0xea, 0x43, 0xf1, 0xea, 0x3e, 0xec, 0xc2, 0xfa, 0x78, 0x63, 0x72, 0x33, 0xf0, 0x82, 0x87,
+	0xd9, 0x27, 0xf0, 0xd6, 0xd5, 0xbd, 0x7a, 0x41, 0x5d, 0x7f, 0x5f, 0x84, 0xe5, 0x4c, 0x59, 0x93,
+	0x8a, 0xa7, 0x89, 0x7a, 0x47, 0x7e, 0x1f, 0x66, 0xe2, 0xc4, 0xa4, 0x0b, 0x4c, 0xac, 0x2f, 0xe8,
+	0xd4, 0xd7, 0x11, 0x5a, 0x55, 0xfd, 0x6d, 0x18, 0xae, 0xb3, 0x9f, 0x65, 0xb4, 0xe3, 0xc8, 0xa5,
+	0x68, 0xa0, 0x4d, 0xda, 0xe2, 0xcb, 0x17, 0x2a, 0xd0, 0x49, 0xdc, 0x0d, 0x64, 0x9a, 0x74, 0x77,
+	0xec, 0x84, 0x2c, 0xb9, 0xf2, 0x31, 0xcc, 0xdb, 0x04, 0xf4, 0x37, 0xba, 0xf1, 0x99, 0x5b, 0x58,
+	0x2b, 0x66, 0x93, 0x81, 0x02, 0x34, 0x67, 0x1d, 0xd6, 0xee, 0xb8, 0x45, 0x0b, 0x90, 0x12, 0x2f,
+	0x82, 0x69, 0x55, 0x95, 0xe3, 0xc2, 0xf5, 0x83, 0xa7, 0x47, 0xf5, 0x9d, 0xdf, 0xf2, 0x9f, 0x35,
+	0xb7, 0x1b, 0x7e, 0x73, 0xbb, 0xd9, 0xac, 0x3f, 0x3d, 0x68, 0x56, 0xae, 0x39, 0x2b, 0x70, 0x53,
+	0x23, 0xcd, 0xed, 0xc6, 0x73, 0x1b, 0x2b, 0xe0, 0x1e, 0xd4, 0xd8, 0x66, 0xed, 0x49, 0x7d, 0xfb,
+	0xf9, 0xf6, 0xfe, 0xf6, 0xc1, 0x51, 0xb3, 0x52, 0x74, 0x6e, 0xc0, 0x92, 0x06, 0x9e, 0x6f, 0xd6,
+	0xfc, 0xcd, 0xda, 0x51, 0xfd, 0xe9, 0x41, 0x65, 0xc2, 0xfb, 0xb7, 0x49, 0x93, 0xcf, 0xf3, 0xe4,
+	0x59, 0x82, 0xb7, 0x0c, 0xfd, 0x4e, 0xf2, 0x1b, 0x1e, 0x53, 0x77, 0x60, 0x3a, 0x8e, 0xa4, 0x2b,
+	0x65, 0x9f, 0xb0, 0x5a, 0x86, 0x77, 0x7f, 0xf3, 0x6a, 0x63, 0xaa, 0x98, 0xb4, 0xaa, 0x28, 0x2b,
+	0xd0, 0xfc, 0xd7, 0x81, 0x4c, 0xce, 0x94, 0x6c, 0x12, 0x86, 0x69, 0xee, 0x45, 0x08, 0x14, 0xb0,
+	0x19, 0x86, 0xa9, 0x45, 0x4b, 0xe2, 0x54, 0xb8, 0xd3, 0xe3, 0xb4, 0xc3, 0x38, 0x95, 0x09, 0x21,
+	0x31, 0x57, 0x7b, 0xc4, 0x7e, 0xfd, 0x99, 0x8e, 0xb9, 0xdc, 0x22, 0xaf, 0xc1, 0x9c, 0x5e, 0x10,
+	0xb2, 0x32, 0xfb, 0xd1, 0x07, 0x14, 0x20, 0x2b, 0xab, 0xc1, 0x3c, 0x3d, 0x17, 0x29, 0x51, 0x66,
+	0x19, 0x5f, 0x9f, 0x87, 0x99, 0xae, 0xa3, 0x43, 0x59, 0xdd, 0x46, 0xa6, 0x34, 0xd2, 0x8d, 0x39,
+	0x9a, 0xfd, 0xe6, 0xb9, 0x8c, 0x42, 0xb0, 0x87, 0x58, 0x67, 0x14, 0xe2, 0x61, 0x1c, 0xf4, 0xb2,
+	0xff, 0xb5, 0xe0, 0x03, 0xce, 0x54, 0x0c, 0x2a, 0x73, 0x3e, 0x82, 0x9e, 0xae, 0xa3, 0x89, 0x18,
+	0xde, 0xf3, 0x2d, 0xbe, 0xbe, 0x9f, 0x32, 0x9a, 0xe6, 0x62, 0x52, 0xcb, 0x59, 0xa1, 0x7a, 0x46,
+	0x90, 0xe7, 0x21, 0x4d, 0x62, 0xbc, 0xa2, 0xe2, 0x5b, 0x90, 0x3a, 0x0f, 0x51, 0x50, 0x0f, 0x31,
+	0x8d, 0x09, 0x86, 0x5d, 0xf8, 0x35, 0xf3, 0x6e, 0xff, 0xb6, 0x08, 0xaf, 0x66, 0x69, 0xad, 0xfa,
+	0xca, 0xfd, 0xb4, 0xd5, 0xec, 0xf3, 0x84, 0x05, 0x2c, 0xee, 0xab, 0xc4, 0x08, 0x26, 0x06, 0xbf,
+	0x49, 0xfc, 0xd5, 0xf9, 0x2e, 0x38, 0xbd, 0x7e, 0x57, 0xb0, 0xa4, 0x4b, 0x7d, 0x16, 0x71, 0x41,
+	0xa2, 0x80, 0x72, 0x6d, 0xae, 0xbf, 0x51, 0x7d, 0x91, 0xea, 0xab, 0xfb, 0xba, 0x3c, 0xa6, 0x02,
+	0xd6, 0x8d, 0x8a, 0xc6, 0x92, 0x51, 0x9b, 0x89, 0x56, 0x52, 0xb8, 0x71, 0x21, 0x57, 0xe6, 0xde,
+	0xe1, 0xbe, 0xd0, 0x02, 0x1d, 0xd5, 0xc9, 0xfd, 0xdb, 0x05, 0xee, 0x11, 0x0d, 0xab, 0xd0, 0xce,
+	0x6b, 0xb0, 0x10, 0x8b, 0x8e, 0xd9, 0x0f, 0xc3, 0xab, 0x6c, 0x59, 0x4a, 0x9b, 0xd9, 0xb5, 0xbb,
+	0xa4, 0x1e, 0x53, 0xb6, 0x0e, 0xb5, 0xaf, 0xb4, 0xcf, 0x82, 0x34, 0x3e, 0x3a, 0x57, 0x79, 0x34,
+	0x6a, 0x58, 0x0a, 0xe3, 0xc3, 0x82, 0xa9, 0x97, 0xc1, 0xf8, 0xe9, 0x36, 0xdd, 0x0e, 0xe4, 0xc9,
+	0xf6, 0x2d, 0x8c, 0x5d, 0x4a, 0x75, 0x7a, 0xa8, 0x5e, 0xa9, 0x5e, 0x5c, 0x49, 0x55, 0x0b, 0xd4,
+	0x31, 0xa1, 0xcb, 0x38, 0x9f, 0xc2, 0xbc, 0xcc, 0x10, 0x20, 0x3a, 0xd1, 0x47, 0xe5, 0x68, 0xde,
+	0xbf, 0x42, 0x87, 0x11, 0x34, 0x72, 0x85, 0x57, 0xfe, 0x73, 0x12, 0x16, 0x47, 0x18, 0x32, 0x19,
+	0x23, 0x62, 0x42, 0x05, 0x71, 0x72, 0xf7, 0x1b, 0x14, 0xcb, 0xb8, 0x4d, 0x15, 0x2a, 0x5d, 0xc2,
+	0x85, 0xdf, 0x97, 0xfb, 0x4e, 0x31, 0xed, 0xf5, 0xb1, 0x80, 0xa8, 0xda, 0x94, 0x92, 0x7f, 0x1b,
+	0xa6, 0x31, 0x60, 0xa2, 0x33, 0x1d, 0xb2, 0xb4, 0x23, 0x71, 0x1e, 0xa9, 0x27, 0x13, 0xeb, 0x1f,
+	0x59, 0x26, 0x2f, 0x08, 0x1a, 0xaa, 0x97, 0x79, 0x34, 0x65, 0x32, 0x01, 0x2a, 0x67, 0x92, 0x4a,
+	0x28, 0xc6, 0xfc, 0x27, 0x1c, 0x76, 0x44, 0x7d, 0x9d, 0x02, 0x9d, 0xa5, 0x9e, 0xa1, 0x50, 0x69,
+	0x08, 0x69, 0x4b, 0xbb, 0xa0, 0xb9, 0x87, 0xe8, 0x90, 0xb6, 0x94, 0x23, 0xba, 0x02, 0x53, 0x49,
+	0xca, 0x02, 0x9a, 0xcb, 0x02, 0x53, 0x22, 0x3c, 0x5a, 0x04, 0x39, 0xcf, 0xe5, 0x7d, 0xa1, 0x00,
+	0xd5, 0x4a, 0x82, 0xdf, 0xe7, 0x61, 0x2e, 0xdb, 0xab, 0x24, 0xc5, 0xcf, 0xb8, 0x5c, 0x0f, 0x82,
+	0x9c, 0x4b, 0x82, 0x9d, 0xe2, 0x35, 0x2d, 0xc8, 0x39, 0xc2, 0x6f, 0x40, 0x39, 0xbb, 0xae, 0x49,
+	0x3b, 0x69, 0x5b, 0x8f, 0x79, 0x03, 0x49, 0x6b, 0xf9, 0x26, 0x2c, 0x28, 0x0b, 0x8e, 0xa3, 0x29,
+	0xb9, 0xe5, 0xb1, 0x67, 0xe9, 0xa3, 0xf3, 0xc8, 0x3c, 0xf5, 0xe6, 0x02, 0x54, 0x0b, 0xf9, 0x3b,
+	0x76, 0x16, 0x9d, 0x42, 0x13, 0x9c, 0xd2, 0x36, 0x5a, 0x30, 0xc9, 0x5b, 0xb4, 0x78, 0xa0, 0x00,
+	0x49, 0x5b, 0x83, 0xd2, 0x17, 0x7d, 0x22, 0x03, 0x66, 0x6e, 0xc5, 0x7a, 0x54, 0xca, 0xa4, 0xce,
+	0xeb, 0x30, 0x9f, 0xd2, 0x96, 0x2f, 0x17, 0x98, 0xcf, 0x54, 0x44, 0x7a, 0x72, 0xa8, 0xa9, 0x75,
+	0x84, 0x40, 0x3d, 0x5c, 0xf9, 0x71, 0x01, 0xe6, 0xac, 0xa5, 0xad, 0xde, 0xcc, 0xe4, 0xe7, 0x05,
+	0x79, 0xe3, 0x8a, 0xa6, 0x02, 0x44, 0x9a, 0x74, 0x41, 0x80, 0x48, 0x21, 0x72, 0x7b, 0xbd, 0x01,
+	0x65, 0x13, 0x12, 0x56, 0x1d, 0xb2, 0xa3, 0x0f, 0xf3, 0x06, 0x92, 0x5d, 0xb2, 0xa9, 0x52, 0xe9,
+	0xe4, 0x45, 0x54, 0x19, 0x76, 0xfa, 0xa3, 0x09, 0x58, 0xbd, 0x78, 0x87, 0xbd, 0xd8, 0x2b, 0xe5,
+	0x73, 0x00, 0x2a, 0xb3, 0xa4, 0x64, 0x93, 0xd4, 0x3d, 0xe7, 0xcd, 0xea, 0xe5, 0x1a, 0xab, 0xdb,
+	0xdb, 0x58, 0x42, 0x85, 0x10, 0x4f, 0xfc, 0x7d, 0xde, 0x7e, 0x4e, 0xba, 0x2c, 0x6c, 0x58, 0x9a,
+	0xbc, 0xff, 0x2a, 0x00, 0x0c, 0x69, 0xce, 0x02, 0x58, 0xc4, 0xca, 0x35, 0xf4, 0x42, 0xe4, 0x77,
+	0x3d, 0x3a, 0x45, 0x09, 0xe6, 0x99, 0xe0, 0x45, 0xe3, 0x36, 0xdc, 0xb2, 0xc5, 0xd6, 0x64, 0x54,
+	0x8a, 0xe8, 0xd2, 0x48, 0xf0, 0x20, 0x3e, 0xb2, 0x4c, 0x45, 0x65, 0xc2, 0x59, 0x86, 0x45, 0x09,
+	0x34, 0x3f, 0xdb, 0xdb, 0x21, 0xac, 0xdb, 0x4f, 0x69, 0x65, 0xd2, 0xb9, 0x07, 0xb7, 0xa5, 0xd0,
+	0xd2, 0xb1, 0xc5, 0x78, 0x80, 0xb9, 0x6b, 0x51, 0x30, 0xa8, 0x4c, 0x39, 0x6b, 0x70, 0x47, 0x12,
+	0x2c, 0x65, 0xf5, 0x88, 0xd3, 0x54, 0xec, 0xc8, 0xb0, 0x61, 0x65, 0x26, 0xab, 0x70, 0xb3, 0x9b,
+	0x52, 0x12, 0x0e, 0x1a, 0xfd, 0x28, 0x62, 0x51, 0xbb, 0x52, 0xca, 0x74, 0xeb, 0x66, 0x5a, 0x1a,
+	0xb6, 0x88, 0x20, 0x95, 0xd9, 0x37, 0x7f, 0x52, 0x80, 0xca, 0xe8, 0xff, 0xe7, 0x61, 0x85, 0x97,
+	0xfd, 0xcf, 0x5e, 0xe5, 0x1a, 0xea, 0x1d, 0x63, 0xec, 0xa4, 0x71, 0x4f, 0x85, 0x59, 0x2a, 0x85,
+	0x0b, 0x55, 0x48, 0x82, 0x74, 0x91, 0xf0, 0x5a, 0x76, 0x1b, 0x6e, 0x5d, 0xc8, 0xd8, 0xad, 0x55,
+	0x26, 0x2e, 0x2c, 0x8e, 0x69, 0x02, 0x03, 0x5d, 0xc1, 0xe4, 0x46, 0x0d, 0x66, 0xf1, 0x3f, 0xed,
+	0xa4, 0x37, 0xe3, 0xdc, 0xad, 0xaa, 0x7f, 0x27, 0xad, 0x9a, 0x0b, 0x66, 0x55, 0x9e, 0xfc, 0xda,
+	0x05, 0x76, 0x7f, 0xf1, 0x0f, 0xd2, 0xa7, 0xdb, 0x98, 0x6a, 0x91, 0x2e, 0xa7, 0x8d, 0xd2, 0x09,
+	0x1d, 0x48, 0x7c, 0xa3, 0x09, 0x4e, 0x8f, 0xb7, 0x13, 0x7c, 0x84, 0xe3, 0x71, 0x4b, 0xa8, 0xe7,
+	0x35, 0xe7, 0xde, 0x98, 0x36, 0xed, 0x22, 0xe7, 0xf5, 0x4d, 0x6d, 0x14, 0xdf, 0x7b, 0xd8, 0xa8,
+	0x68, 0x05, 0xcd, 0xb8, 0x25, 0xe4, 0x33, 0xdc, 0xc6, 0xd1, 0x50, 0x69, 0x07, 0x9f, 0x2e, 0x5e,
+	0x50, 0xe9, 0xbf, 0x6a, 0xa5, 0x13, 0xef, 0x7d, 0xf4, 0x28, 0xd3, 0xfa, 0x84, 0xa4, 0xa1, 0xd4,
+	0xfa, 0xd8, 0xfb, 0xd9, 0x2f, 0x57, 0x0b, 0x3f, 0xff, 0xe5, 0x6a, 0xe1, 0x17, 0xbf, 0x5c, 0x2d,
+	0xfc, 0xe1, 0xaf, 0x56, 0xaf, 0xfd, 0xfc, 0x57, 0xab, 0xd7, 0xfe, 0xe9, 0x57, 0xab, 0xd7, 0x9e,
+	0x14, 0x7e, 0x7b, 0xa2, 0xc7, 0xdb, 0x5f, 0x16, 0xae, 0xfd, 0xef, 0x00, 0xec, 0x98, 0x52, 0x62,
 	0x6a, 0x3b, 0x00, 0x00,
 }
 
 func (m *CMsgProtoBufHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5958,67 +5956,74 @@ func (m *CMsgProtoBufHeader) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgProtoBufHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgProtoBufHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ClientSteamId))
-	i += 8
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ClientSessionId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.SourceAppId))
-	if m.JobIdSource != nil {
-		dAtA[i] = 0x51
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.JobIdSource))
-		i += 8
-	}
-	if m.JobIdTarget != nil {
-		dAtA[i] = 0x59
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.JobIdTarget))
-		i += 8
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.GcDirIndexSource))
+	i--
+	dAtA[i] = 0xc
+	i--
+	dAtA[i] = 0xc8
+	if m.GcMsgSrc != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.GcMsgSrc))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xc0
 	}
-	dAtA[i] = 0x62
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.TargetJobName)))
-	i += copy(dAtA[i:], m.TargetJobName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Ip))
+	i--
+	dAtA[i] = 0x78
+	i -= len(m.ErrorMessage)
+	copy(dAtA[i:], m.ErrorMessage)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.ErrorMessage)))
+	i--
+	dAtA[i] = 0x72
 	if m.Eresult != nil {
-		dAtA[i] = 0x68
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x68
 	}
-	dAtA[i] = 0x72
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.ErrorMessage)))
-	i += copy(dAtA[i:], m.ErrorMessage)
-	dAtA[i] = 0x78
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Ip))
-	if m.GcMsgSrc != nil {
-		dAtA[i] = 0xc0
-		i++
-		dAtA[i] = 0xc
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.GcMsgSrc))
+	i -= len(m.TargetJobName)
+	copy(dAtA[i:], m.TargetJobName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.TargetJobName)))
+	i--
+	dAtA[i] = 0x62
+	if m.JobIdTarget != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.JobIdTarget))
+		i--
+		dAtA[i] = 0x59
 	}
-	dAtA[i] = 0xc8
-	i++
-	dAtA[i] = 0xc
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.GcDirIndexSource))
-	return i, nil
+	if m.JobIdSource != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.JobIdSource))
+		i--
+		dAtA[i] = 0x51
+	}
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.SourceAppId))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ClientSessionId))
+	i--
+	dAtA[i] = 0x10
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ClientSteamId))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgWebAPIKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6026,39 +6031,45 @@ func (m *CMsgWebAPIKey) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgWebAPIKey) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgWebAPIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Status))
+	i -= len(m.Domain)
+	copy(dAtA[i:], m.Domain)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Domain)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.KeyId))
+	i--
+	dAtA[i] = 0x20
+	if m.PublisherGroupId != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.PublisherGroupId))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.AccountId != nil {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.AccountId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PublisherGroupId != nil {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.PublisherGroupId))
+	if m.Status != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.KeyId))
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Domain)))
-	i += copy(dAtA[i:], m.Domain)
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgHttpRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6066,73 +6077,87 @@ func (m *CMsgHttpRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgHttpRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgHttpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.RequestMethod))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Hostname)))
-	i += copy(dAtA[i:], m.Hostname)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Url)))
-	i += copy(dAtA[i:], m.Url)
-	if len(m.Headers) > 0 {
-		for _, msg := range m.Headers {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AbsoluteTimeout))
+	i--
+	dAtA[i] = 0x40
+	if m.Body != nil {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PostParams) > 0 {
+		for iNdEx := len(m.PostParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x32
 		}
 	}
 	if len(m.GetParams) > 0 {
-		for _, msg := range m.GetParams {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.GetParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GetParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.PostParams) > 0 {
-		for _, msg := range m.PostParams {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.Body != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Body)))
-		i += copy(dAtA[i:], m.Body)
-	}
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AbsoluteTimeout))
-	return i, nil
+	i -= len(m.Url)
+	copy(dAtA[i:], m.Url)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Url)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Hostname)
+	copy(dAtA[i:], m.Hostname)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Hostname)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.RequestMethod))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgHttpRequest_RequestHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6140,25 +6165,32 @@ func (m *CMsgHttpRequest_RequestHeader) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgHttpRequest_RequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgHttpRequest_RequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgHttpRequest_QueryParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6166,27 +6198,34 @@ func (m *CMsgHttpRequest_QueryParam) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgHttpRequest_QueryParam) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgHttpRequest_QueryParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
 	if m.Value != nil {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
 		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgWebAPIRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6194,55 +6233,67 @@ func (m *CMsgWebAPIRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgWebAPIRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgWebAPIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.UNUSEDJobName)))
-	i += copy(dAtA[i:], m.UNUSEDJobName)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.InterfaceName)))
-	i += copy(dAtA[i:], m.InterfaceName)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.MethodName)))
-	i += copy(dAtA[i:], m.MethodName)
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Version))
-	if m.ApiKey != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(m.ApiKey.Size()))
-		n1, err1 := m.ApiKey.MarshalTo(dAtA[i:])
-		if err1 != nil {
-			return 0, err1
-		}
-		i += n1
-	}
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.RoutingAppId))
+	i--
+	dAtA[i] = 0x38
 	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSteammessages(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x32
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(m.Request.Size()))
-		n2, err2 := m.Request.MarshalTo(dAtA[i:])
-		if err2 != nil {
-			return 0, err2
+	}
+	if m.ApiKey != nil {
+		{
+			size, err := m.ApiKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSteammessages(dAtA, i, uint64(size))
 		}
-		i += n2
+		i--
+		dAtA[i] = 0x2a
 	}
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.RoutingAppId))
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.MethodName)
+	copy(dAtA[i:], m.MethodName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.MethodName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.InterfaceName)
+	copy(dAtA[i:], m.InterfaceName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.InterfaceName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.UNUSEDJobName)
+	copy(dAtA[i:], m.UNUSEDJobName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.UNUSEDJobName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgHttpResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6250,38 +6301,46 @@ func (m *CMsgHttpResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgHttpResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgHttpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.StatusCode))
+	if m.Body != nil {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Headers) > 0 {
-		for _, msg := range m.Headers {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Body != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Body)))
-		i += copy(dAtA[i:], m.Body)
-	}
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.StatusCode))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgHttpResponse_ResponseHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6289,25 +6348,32 @@ func (m *CMsgHttpResponse_ResponseHeader) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgHttpResponse_ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgHttpResponse_ResponseHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMFindAccounts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6315,24 +6381,30 @@ func (m *CMsgAMFindAccounts) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMFindAccounts) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMFindAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.SearchType))
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.SearchString)
+	copy(dAtA[i:], m.SearchString)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.SearchString)))
-	i += copy(dAtA[i:], m.SearchString)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.SearchType))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMFindAccountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6340,25 +6412,30 @@ func (m *CMsgAMFindAccountsResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMFindAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMFindAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.SteamId) > 0 {
-		for _, num := range m.SteamId {
+		for iNdEx := len(m.SteamId) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId[iNdEx]))
+			i--
 			dAtA[i] = 0x9
-			i++
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
-			i += 8
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgNotifyWatchdog) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6366,44 +6443,50 @@ func (m *CMsgNotifyWatchdog) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgNotifyWatchdog) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgNotifyWatchdog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Source))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AlertType))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AlertDestination))
-	dAtA[i] = 0x20
-	i++
+	i -= len(m.Text)
+	copy(dAtA[i:], m.Text)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Text)))
+	i--
+	dAtA[i] = 0x3a
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Appid))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Time))
+	i--
+	dAtA[i] = 0x28
+	i--
 	if m.Critical {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Time))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Appid))
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Text)))
-	i += copy(dAtA[i:], m.Text)
-	return i, nil
-}
-
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AlertDestination))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AlertType))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Source))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *CMsgAMGetLicenses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6411,21 +6494,26 @@ func (m *CMsgAMGetLicenses) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMGetLicenses) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMGetLicenses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
+	i -= 8
 	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	return i, nil
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgPackageLicense) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6433,26 +6521,31 @@ func (m *CMsgPackageLicense) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgPackageLicense) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgPackageLicense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PackageId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.TimeCreated))
-	dAtA[i] = 0x18
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.OwnerId))
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.TimeCreated))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PackageId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMGetLicensesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6460,32 +6553,39 @@ func (m *CMsgAMGetLicensesResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMGetLicensesResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMGetLicensesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Result))
+	i--
+	dAtA[i] = 0x10
 	if len(m.License) > 0 {
-		for _, msg := range m.License {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.License) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.License[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Result))
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMGetUserGameStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6493,32 +6593,37 @@ func (m *CMsgAMGetUserGameStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMGetUserGameStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMGetUserGameStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
-	i += 8
-	dAtA[i] = 0x11
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GameId))
-	i += 8
 	if len(m.Stats) > 0 {
-		for _, num := range m.Stats {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintSteammessages(dAtA, i, uint64(m.Stats[iNdEx]))
+			i--
 			dAtA[i] = 0x18
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(num))
 		}
 	}
-	return i, nil
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GameId))
+	i--
+	dAtA[i] = 0x11
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMGetUserGameStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6526,54 +6631,63 @@ func (m *CMsgAMGetUserGameStatsResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMGetUserGameStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMGetUserGameStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
-	i += 8
-	dAtA[i] = 0x11
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GameId))
-	i += 8
-	if m.Eresult != nil {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
-	}
-	if len(m.Stats) > 0 {
-		for _, msg := range m.Stats {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.AchievementBlocks) > 0 {
+		for iNdEx := len(m.AchievementBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AchievementBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.AchievementBlocks) > 0 {
-		for _, msg := range m.AchievementBlocks {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return i, nil
+	if m.Eresult != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GameId))
+	i--
+	dAtA[i] = 0x11
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMGetUserGameStatsResponse_Stats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6581,23 +6695,28 @@ func (m *CMsgAMGetUserGameStatsResponse_Stats) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgAMGetUserGameStatsResponse_Stats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMGetUserGameStatsResponse_Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.StatId))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.StatValue))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.StatId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMGetUserGameStatsResponse_Achievement_Blocks) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6605,27 +6724,32 @@ func (m *CMsgAMGetUserGameStatsResponse_Achievement_Blocks) Marshal() (dAtA []by
 }
 
 func (m *CMsgAMGetUserGameStatsResponse_Achievement_Blocks) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMGetUserGameStatsResponse_Achievement_Blocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AchievementId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AchievementBitId))
-	dAtA[i] = 0x1d
-	i++
+	i -= 4
 	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnlockTime))
-	i += 4
-	return i, nil
+	i--
+	dAtA[i] = 0x1d
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AchievementBitId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AchievementId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetCommandList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6633,24 +6757,30 @@ func (m *CMsgGCGetCommandList) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCGetCommandList) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetCommandList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppId))
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.CommandPrefix)
+	copy(dAtA[i:], m.CommandPrefix)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CommandPrefix)))
-	i += copy(dAtA[i:], m.CommandPrefix)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetCommandListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6658,32 +6788,31 @@ func (m *CMsgGCGetCommandListResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCGetCommandListResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetCommandListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.CommandName) > 0 {
-		for _, s := range m.CommandName {
+		for iNdEx := len(m.CommandName) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommandName[iNdEx])
+			copy(dAtA[i:], m.CommandName[iNdEx])
+			i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CommandName[iNdEx])))
+			i--
 			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgMemCachedGet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6691,32 +6820,31 @@ func (m *CGCMsgMemCachedGet) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgMemCachedGet) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgMemCachedGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
 			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgMemCachedGetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6724,29 +6852,36 @@ func (m *CGCMsgMemCachedGetResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgMemCachedGetResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgMemCachedGetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Values) > 0 {
-		for _, msg := range m.Values {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgMemCachedGetResponse_ValueTag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6754,31 +6889,37 @@ func (m *CGCMsgMemCachedGetResponse_ValueTag) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CGCMsgMemCachedGetResponse_ValueTag) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgMemCachedGetResponse_ValueTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
+	if m.Value != nil {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i--
 	if m.Found {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	if m.Value != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgMemCachedSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6786,29 +6927,36 @@ func (m *CGCMsgMemCachedSet) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgMemCachedSet) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgMemCachedSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Keys) > 0 {
-		for _, msg := range m.Keys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgMemCachedSet_KeyPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6816,27 +6964,34 @@ func (m *CGCMsgMemCachedSet_KeyPair) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgMemCachedSet_KeyPair) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgMemCachedSet_KeyPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
 	if m.Value != nil {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
 		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgMemCachedDelete) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6844,32 +6999,31 @@ func (m *CGCMsgMemCachedDelete) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgMemCachedDelete) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgMemCachedDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
 			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgMemCachedStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6877,17 +7031,22 @@ func (m *CGCMsgMemCachedStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgMemCachedStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgMemCachedStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgMemCachedStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6895,59 +7054,64 @@ func (m *CGCMsgMemCachedStatsResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgMemCachedStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgMemCachedStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.CurrConnections))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmdGet))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmdSet))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmdFlush))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.GetHits))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.GetMisses))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.DeleteHits))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.DeleteMisses))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.BytesRead))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.BytesWritten))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.LimitMaxbytes))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.CurrItems))
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Evictions))
-	dAtA[i] = 0x70
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.Bytes))
-	return i, nil
+	i--
+	dAtA[i] = 0x70
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Evictions))
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.CurrItems))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.LimitMaxbytes))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.BytesWritten))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.BytesRead))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.DeleteMisses))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.DeleteHits))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.GetMisses))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.GetHits))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmdFlush))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmdSet))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmdGet))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.CurrConnections))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgSQLStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6955,20 +7119,25 @@ func (m *CGCMsgSQLStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgSQLStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgSQLStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.SchemaCatalog))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgSQLStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -6976,44 +7145,49 @@ func (m *CGCMsgSQLStatsResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgSQLStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgSQLStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Threads))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ThreadsConnected))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ThreadsActive))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.OperationsSubmitted))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PreparedStatementsExecuted))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.NonPreparedStatementsExecuted))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.DeadlockRetries))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.OperationsTimedOutInQueue))
-	dAtA[i] = 0x48
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.Errors))
-	return i, nil
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.OperationsTimedOutInQueue))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.DeadlockRetries))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.NonPreparedStatementsExecuted))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PreparedStatementsExecuted))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.OperationsSubmitted))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ThreadsActive))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ThreadsConnected))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Threads))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMAddFreeLicense) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7021,31 +7195,37 @@ func (m *CMsgAMAddFreeLicense) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMAddFreeLicense) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMAddFreeLicense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.IpPublic))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Packageid))
-	dAtA[i] = 0x22
-	i++
+	i -= len(m.StoreCountryCode)
+	copy(dAtA[i:], m.StoreCountryCode)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.StoreCountryCode)))
-	i += copy(dAtA[i:], m.StoreCountryCode)
-	return i, nil
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Packageid))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.IpPublic))
+	i--
+	dAtA[i] = 0x10
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMAddFreeLicenseResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7053,29 +7233,34 @@ func (m *CMsgAMAddFreeLicenseResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMAddFreeLicenseResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMAddFreeLicenseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Transid))
+	i--
+	dAtA[i] = 0x19
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PurchaseResultDetail))
+	i--
+	dAtA[i] = 0x10
 	if m.Eresult != nil {
-		dAtA[i] = 0x8
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x8
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PurchaseResultDetail))
-	dAtA[i] = 0x19
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Transid))
-	i += 8
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgGetIPLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7083,25 +7268,30 @@ func (m *CGCMsgGetIPLocation) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgGetIPLocation) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgGetIPLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Ips) > 0 {
-		for _, num := range m.Ips {
+		for iNdEx := len(m.Ips) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.Ips[iNdEx]))
+			i--
 			dAtA[i] = 0xd
-			i++
-			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(num))
-			i += 4
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CIPLocationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7109,40 +7299,48 @@ func (m *CIPLocationInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CIPLocationInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CIPLocationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Ip))
-	dAtA[i] = 0x15
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Latitude))))
-	i += 4
-	dAtA[i] = 0x1d
-	i++
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Longitude))))
-	i += 4
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Country)))
-	i += copy(dAtA[i:], m.Country)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.State)))
-	i += copy(dAtA[i:], m.State)
-	dAtA[i] = 0x32
-	i++
+	i -= len(m.City)
+	copy(dAtA[i:], m.City)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.City)))
-	i += copy(dAtA[i:], m.City)
-	return i, nil
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.State)
+	copy(dAtA[i:], m.State)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.State)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Country)
+	copy(dAtA[i:], m.Country)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Country)))
+	i--
+	dAtA[i] = 0x22
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Longitude))))
+	i--
+	dAtA[i] = 0x1d
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Latitude))))
+	i--
+	dAtA[i] = 0x15
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Ip))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgGetIPLocationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7150,29 +7348,36 @@ func (m *CGCMsgGetIPLocationResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgGetIPLocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgGetIPLocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Infos) > 0 {
-		for _, msg := range m.Infos {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgSystemStatsSchema) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7180,26 +7385,32 @@ func (m *CGCMsgSystemStatsSchema) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgSystemStatsSchema) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgSystemStatsSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.GcAppId))
 	if m.SchemaKv != nil {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.SchemaKv)
+		copy(dAtA[i:], m.SchemaKv)
 		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.SchemaKv)))
-		i += copy(dAtA[i:], m.SchemaKv)
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.GcAppId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgGetSystemStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7207,17 +7418,22 @@ func (m *CGCMsgGetSystemStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgGetSystemStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgGetSystemStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCMsgGetSystemStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7225,59 +7441,65 @@ func (m *CGCMsgGetSystemStatsResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCMsgGetSystemStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCMsgGetSystemStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.GcAppId))
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.LogonJobs))
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.LogonQueue))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.SteamidLocks))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.WritebackQueue))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.SocachesLoading))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.SocachesToUnload))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Socaches))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.GameServerSessions))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.UserSessions))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.YieldingJobs))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ActiveJobs))
+	i--
+	dAtA[i] = 0x18
 	if m.StatsKv != nil {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.StatsKv)
+		copy(dAtA[i:], m.StatsKv)
 		i = encodeVarintSteammessages(dAtA, i, uint64(len(m.StatsKv)))
-		i += copy(dAtA[i:], m.StatsKv)
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ActiveJobs))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.YieldingJobs))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.UserSessions))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.GameServerSessions))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Socaches))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.SocachesToUnload))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.SocachesLoading))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.WritebackQueue))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.SteamidLocks))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.LogonQueue))
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.LogonJobs))
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.GcAppId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMSendEmail) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7285,54 +7507,63 @@ func (m *CMsgAMSendEmail) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMSendEmail) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMSendEmail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailMsgType))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailFormat))
-	if len(m.PersonaNameTokens) > 0 {
-		for _, msg := range m.PersonaNameTokens {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	dAtA[i] = 0x30
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.SourceGc))
-	if len(m.Tokens) > 0 {
-		for _, msg := range m.Tokens {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	i--
+	dAtA[i] = 0x30
+	if len(m.PersonaNameTokens) > 0 {
+		for iNdEx := len(m.PersonaNameTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersonaNameTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailFormat))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailMsgType))
+	i--
+	dAtA[i] = 0x10
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMSendEmail_ReplacementToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7340,25 +7571,32 @@ func (m *CMsgAMSendEmail_ReplacementToken) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMSendEmail_ReplacementToken) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMSendEmail_ReplacementToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.TokenName)))
-	i += copy(dAtA[i:], m.TokenName)
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.TokenValue)
+	copy(dAtA[i:], m.TokenValue)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.TokenValue)))
-	i += copy(dAtA[i:], m.TokenValue)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TokenName)
+	copy(dAtA[i:], m.TokenName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.TokenName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMSendEmail_PersonaNameReplacementToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7366,25 +7604,31 @@ func (m *CMsgAMSendEmail_PersonaNameReplacementToken) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgAMSendEmail_PersonaNameReplacementToken) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMSendEmail_PersonaNameReplacementToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.TokenName)
+	copy(dAtA[i:], m.TokenName)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.TokenName)))
-	i += copy(dAtA[i:], m.TokenName)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMSendEmailResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7392,22 +7636,27 @@ func (m *CMsgAMSendEmailResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMSendEmailResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMSendEmailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Eresult != nil {
-		dAtA[i] = 0x8
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetEmailTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7415,29 +7664,34 @@ func (m *CMsgGCGetEmailTemplate) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCGetEmailTemplate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetEmailTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppId))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailMsgType))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailLang))
-	dAtA[i] = 0x20
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailFormat))
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailLang))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.EmailMsgType))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetEmailTemplateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7445,34 +7699,40 @@ func (m *CMsgGCGetEmailTemplateResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCGetEmailTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetEmailTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Eresult != nil {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
-	}
-	dAtA[i] = 0x10
-	i++
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x1a
+	i--
 	if m.TemplateExists {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Template)))
-	i += copy(dAtA[i:], m.Template)
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	if m.Eresult != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMGrantGuestPasses2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7480,33 +7740,38 @@ func (m *CMsgAMGrantGuestPasses2) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMGrantGuestPasses2) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMGrantGuestPasses2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
-	i += 8
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PackageId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PassesToGrant))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.DaysToExpiration))
-	dAtA[i] = 0x28
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.Action))
-	return i, nil
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.DaysToExpiration))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PassesToGrant))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PackageId))
+	i--
+	dAtA[i] = 0x10
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgAMGrantGuestPasses2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7514,27 +7779,32 @@ func (m *CMsgAMGrantGuestPasses2Response) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgAMGrantGuestPasses2Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgAMGrantGuestPasses2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Eresult != nil {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
-	}
 	if m.PassesGranted != nil {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.PassesGranted))
+		i--
+		dAtA[i] = 0x10
 	}
-	return i, nil
+	if m.Eresult != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCSystemMsg_GetAccountDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7542,24 +7812,29 @@ func (m *CGCSystemMsg_GetAccountDetails) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CGCSystemMsg_GetAccountDetails) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCSystemMsg_GetAccountDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.Appid))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCSystemMsg_GetAccountDetails_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7567,245 +7842,254 @@ func (m *CGCSystemMsg_GetAccountDetails_Response) Marshal() (dAtA []byte, err er
 }
 
 func (m *CGCSystemMsg_GetAccountDetails_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCSystemMsg_GetAccountDetails_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EresultDeprecated != nil {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.EresultDeprecated))
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.AccountName)))
-	i += copy(dAtA[i:], m.AccountName)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.PersonaName)))
-	i += copy(dAtA[i:], m.PersonaName)
-	dAtA[i] = 0x20
-	i++
-	if m.IsProfilePublic {
+	i -= len(m.TxnCountryCode)
+	copy(dAtA[i:], m.TxnCountryCode)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.TxnCountryCode)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xaa
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.RtBirthDate))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xa0
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.RtIdentityLinked))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x98
+	i--
+	if m.IsPhoneIdentifying {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x28
-	i++
-	if m.IsInventoryPublic {
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x90
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PhoneId))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x88
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PhoneVerificationTime))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf8
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.TwoFactorEnabledTime))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf0
+	i--
+	if m.IsTwoFactorAuthEnabled {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x38
-	i++
-	if m.IsVacBanned {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe8
+	i--
+	if m.IsPhoneVerified {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x40
-	i++
-	if m.IsCyberCafe {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe0
+	i--
+	if m.IsSteamguardEnabled {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x48
-	i++
-	if m.IsSchoolAccount {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd8
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AccountCreationTime))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc8
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.FriendCount))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc0
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.SteamLevel))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb8
+	i -= len(m.Currency)
+	copy(dAtA[i:], m.Currency)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Currency)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.SuspensionEndTime))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa8
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Accountid))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa0
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.TradeBanExpiration))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x98
+	i--
+	if m.IsTradeBanned {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x50
-	i++
-	if m.IsLimited {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x90
+	i--
+	if m.IsCommunityBanned {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x58
-	i++
-	if m.IsSubscribed {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	i--
+	if m.IsAccountLockedDown {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Package))
-	dAtA[i] = 0x68
-	i++
-	if m.IsFreeTrialAccount {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	i--
+	if m.IsLowViolence {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.FreeTrialExpiration))
+	i--
 	dAtA[i] = 0x78
-	i++
-	if m.IsLowViolence {
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.FreeTrialExpiration))
+	i--
+	dAtA[i] = 0x70
+	i--
+	if m.IsFreeTrialAccount {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x80
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.IsAccountLockedDown {
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Package))
+	i--
+	dAtA[i] = 0x60
+	i--
+	if m.IsSubscribed {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x88
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.IsCommunityBanned {
+	i--
+	dAtA[i] = 0x58
+	i--
+	if m.IsLimited {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x90
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.IsTradeBanned {
+	i--
+	dAtA[i] = 0x50
+	i--
+	if m.IsSchoolAccount {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x98
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.TradeBanExpiration))
-	dAtA[i] = 0xa0
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Accountid))
-	dAtA[i] = 0xa8
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.SuspensionEndTime))
-	dAtA[i] = 0xb2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Currency)))
-	i += copy(dAtA[i:], m.Currency)
-	dAtA[i] = 0xb8
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.SteamLevel))
-	dAtA[i] = 0xc0
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.FriendCount))
-	dAtA[i] = 0xc8
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AccountCreationTime))
-	dAtA[i] = 0xd8
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.IsSteamguardEnabled {
+	i--
+	dAtA[i] = 0x48
+	i--
+	if m.IsCyberCafe {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0xe0
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.IsPhoneVerified {
+	i--
+	dAtA[i] = 0x40
+	i--
+	if m.IsVacBanned {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0xe8
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.IsTwoFactorAuthEnabled {
+	i--
+	dAtA[i] = 0x38
+	i--
+	if m.IsInventoryPublic {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0xf0
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.TwoFactorEnabledTime))
-	dAtA[i] = 0xf8
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PhoneVerificationTime))
-	dAtA[i] = 0x88
-	i++
-	dAtA[i] = 0x2
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PhoneId))
-	dAtA[i] = 0x90
-	i++
-	dAtA[i] = 0x2
-	i++
-	if m.IsPhoneIdentifying {
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.IsProfilePublic {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x98
-	i++
-	dAtA[i] = 0x2
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.RtIdentityLinked))
-	dAtA[i] = 0xa0
-	i++
-	dAtA[i] = 0x2
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.RtBirthDate))
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x2
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.TxnCountryCode)))
-	i += copy(dAtA[i:], m.TxnCountryCode)
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.PersonaName)
+	copy(dAtA[i:], m.PersonaName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.PersonaName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.AccountName)
+	copy(dAtA[i:], m.AccountName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.AccountName)))
+	i--
+	dAtA[i] = 0x12
+	if m.EresultDeprecated != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.EresultDeprecated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetPersonaNames) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7813,25 +8097,30 @@ func (m *CMsgGCGetPersonaNames) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCGetPersonaNames) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetPersonaNames) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Steamids) > 0 {
-		for _, num := range m.Steamids {
+		for iNdEx := len(m.Steamids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamids[iNdEx]))
+			i--
 			dAtA[i] = 0x9
-			i++
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
-			i += 8
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetPersonaNames_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7839,37 +8128,44 @@ func (m *CMsgGCGetPersonaNames_Response) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCGetPersonaNames_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetPersonaNames_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SucceededLookups) > 0 {
-		for _, msg := range m.SucceededLookups {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
 	if len(m.FailedLookupSteamids) > 0 {
-		for _, num := range m.FailedLookupSteamids {
+		for iNdEx := len(m.FailedLookupSteamids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.FailedLookupSteamids[iNdEx]))
+			i--
 			dAtA[i] = 0x11
-			i++
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
-			i += 8
 		}
 	}
-	return i, nil
+	if len(m.SucceededLookups) > 0 {
+		for iNdEx := len(m.SucceededLookups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SucceededLookups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetPersonaNames_Response_PersonaName) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7877,25 +8173,31 @@ func (m *CMsgGCGetPersonaNames_Response_PersonaName) Marshal() (dAtA []byte, err
 }
 
 func (m *CMsgGCGetPersonaNames_Response_PersonaName) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetPersonaNames_Response_PersonaName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.PersonaName)
+	copy(dAtA[i:], m.PersonaName)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.PersonaName)))
-	i += copy(dAtA[i:], m.PersonaName)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCheckFriendship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7903,25 +8205,30 @@ func (m *CMsgGCCheckFriendship) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCheckFriendship) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCheckFriendship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamidLeft))
-	i += 8
-	dAtA[i] = 0x11
-	i++
+	i -= 8
 	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamidRight))
-	i += 8
-	return i, nil
+	i--
+	dAtA[i] = 0x11
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamidLeft))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCCheckFriendship_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7929,33 +8236,38 @@ func (m *CMsgGCCheckFriendship_Response) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCCheckFriendship_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCCheckFriendship_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	if m.Success {
+	i--
+	if m.FoundFriendship {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
+	i--
 	dAtA[i] = 0x10
-	i++
-	if m.FoundFriendship {
+	i--
+	if m.Success {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetDirectory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7963,32 +8275,39 @@ func (m *CMsgGCMsgMasterSetDirectory) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCMsgMasterSetDirectory) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetDirectory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.MasterDirIndex))
 	if len(m.Dir) > 0 {
-		for _, msg := range m.Dir {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Dir) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dir[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.MasterDirIndex))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetDirectory_SubGC) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -7996,36 +8315,45 @@ func (m *CMsgGCMsgMasterSetDirectory_SubGC) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCMsgMasterSetDirectory_SubGC) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetDirectory_SubGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.DirIndex))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Box)))
-	i += copy(dAtA[i:], m.Box)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CommandLine)))
-	i += copy(dAtA[i:], m.CommandLine)
-	dAtA[i] = 0x2a
-	i++
+	i -= len(m.GcBinary)
+	copy(dAtA[i:], m.GcBinary)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.GcBinary)))
-	i += copy(dAtA[i:], m.GcBinary)
-	return i, nil
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.CommandLine)
+	copy(dAtA[i:], m.CommandLine)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CommandLine)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Box)
+	copy(dAtA[i:], m.Box)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Box)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.DirIndex))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetDirectory_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8033,22 +8361,27 @@ func (m *CMsgGCMsgMasterSetDirectory_Response) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgGCMsgMasterSetDirectory_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetDirectory_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Eresult != nil {
-		dAtA[i] = 0x8
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgWebAPIJobRequestForwardResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8056,20 +8389,25 @@ func (m *CMsgGCMsgWebAPIJobRequestForwardResponse) Marshal() (dAtA []byte, err e
 }
 
 func (m *CMsgGCMsgWebAPIJobRequestForwardResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgWebAPIJobRequestForwardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.DirIndex))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCSystemMsg_GetPurchaseTrust_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8077,21 +8415,26 @@ func (m *CGCSystemMsg_GetPurchaseTrust_Request) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CGCSystemMsg_GetPurchaseTrust_Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCSystemMsg_GetPurchaseTrust_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
+	i -= 8
 	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	return i, nil
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CGCSystemMsg_GetPurchaseTrust_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8099,44 +8442,49 @@ func (m *CGCSystemMsg_GetPurchaseTrust_Response) Marshal() (dAtA []byte, err err
 }
 
 func (m *CGCSystemMsg_GetPurchaseTrust_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CGCSystemMsg_GetPurchaseTrust_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	if m.HasPriorPurchaseHistory {
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.TimeAllTrusted))
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.IsWalletCashTrusted {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x10
-	i++
+	i--
+	dAtA[i] = 0x18
+	i--
 	if m.HasNoRecentPasswordResets {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x18
-	i++
-	if m.IsWalletCashTrusted {
+	i--
+	dAtA[i] = 0x10
+	i--
+	if m.HasPriorPurchaseHistory {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.TimeAllTrusted))
-	return i, nil
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCHAccountVacStatusChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8144,43 +8492,48 @@ func (m *CMsgGCHAccountVacStatusChange) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCHAccountVacStatusChange) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCHAccountVacStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
-	i += 8
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppId))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.RtimeVacbanStarts))
-	dAtA[i] = 0x20
-	i++
-	if m.IsBannedNow {
+	i--
+	if m.IsBannedFuture {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
+	i--
 	dAtA[i] = 0x28
-	i++
-	if m.IsBannedFuture {
+	i--
+	if m.IsBannedNow {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
-	i++
-	return i, nil
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.RtimeVacbanStarts))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppId))
+	i--
+	dAtA[i] = 0x10
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetPartnerAccountLink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8188,21 +8541,26 @@ func (m *CMsgGCGetPartnerAccountLink) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCGetPartnerAccountLink) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetPartnerAccountLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
+	i -= 8
 	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	return i, nil
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCGetPartnerAccountLink_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8210,23 +8568,28 @@ func (m *CMsgGCGetPartnerAccountLink_Response) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgGCGetPartnerAccountLink_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCGetPartnerAccountLink_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Pwid))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.Nexonid))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Pwid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCRoutingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8234,41 +8597,47 @@ func (m *CMsgGCRoutingInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCRoutingInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCRoutingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DirIndex) > 0 {
-		for _, num := range m.DirIndex {
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(num))
-		}
+	i -= len(m.WebapiParam)
+	copy(dAtA[i:], m.WebapiParam)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.WebapiParam)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ProtobufField))
+	i--
+	dAtA[i] = 0x20
+	if m.Fallback != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Fallback))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Method != nil {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Method))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Fallback != nil {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Fallback))
+	if len(m.DirIndex) > 0 {
+		for iNdEx := len(m.DirIndex) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintSteammessages(dAtA, i, uint64(m.DirIndex[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+		}
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ProtobufField))
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.WebapiParam)))
-	i += copy(dAtA[i:], m.WebapiParam)
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetWebAPIRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8276,29 +8645,36 @@ func (m *CMsgGCMsgMasterSetWebAPIRouting) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCMsgMasterSetWebAPIRouting) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetWebAPIRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetWebAPIRouting_Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8306,35 +8682,44 @@ func (m *CMsgGCMsgMasterSetWebAPIRouting_Entry) Marshal() (dAtA []byte, err erro
 }
 
 func (m *CMsgGCMsgMasterSetWebAPIRouting_Entry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetWebAPIRouting_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.InterfaceName)))
-	i += copy(dAtA[i:], m.InterfaceName)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.MethodName)))
-	i += copy(dAtA[i:], m.MethodName)
 	if m.Routing != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(m.Routing.Size()))
-		n3, err3 := m.Routing.MarshalTo(dAtA[i:])
-		if err3 != nil {
-			return 0, err3
+		{
+			size, err := m.Routing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSteammessages(dAtA, i, uint64(size))
 		}
-		i += n3
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	i -= len(m.MethodName)
+	copy(dAtA[i:], m.MethodName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.MethodName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.InterfaceName)
+	copy(dAtA[i:], m.InterfaceName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.InterfaceName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetClientMsgRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8342,29 +8727,36 @@ func (m *CMsgGCMsgMasterSetClientMsgRouting) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CMsgGCMsgMasterSetClientMsgRouting) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetClientMsgRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetClientMsgRouting_Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8372,30 +8764,37 @@ func (m *CMsgGCMsgMasterSetClientMsgRouting_Entry) Marshal() (dAtA []byte, err e
 }
 
 func (m *CMsgGCMsgMasterSetClientMsgRouting_Entry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetClientMsgRouting_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.MsgType))
 	if m.Routing != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(m.Routing.Size()))
-		n4, err4 := m.Routing.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
+		{
+			size, err := m.Routing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSteammessages(dAtA, i, uint64(size))
 		}
-		i += n4
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.MsgType))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetWebAPIRouting_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8403,22 +8802,27 @@ func (m *CMsgGCMsgMasterSetWebAPIRouting_Response) Marshal() (dAtA []byte, err e
 }
 
 func (m *CMsgGCMsgMasterSetWebAPIRouting_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetWebAPIRouting_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Eresult != nil {
-		dAtA[i] = 0x8
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgMasterSetClientMsgRouting_Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8426,22 +8830,27 @@ func (m *CMsgGCMsgMasterSetClientMsgRouting_Response) Marshal() (dAtA []byte, er
 }
 
 func (m *CMsgGCMsgMasterSetClientMsgRouting_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgMasterSetClientMsgRouting_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.Eresult != nil {
-		dAtA[i] = 0x8
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgSetOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8449,36 +8858,43 @@ func (m *CMsgGCMsgSetOptions) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCMsgSetOptions) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgSetOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Options) > 0 {
-		for _, num := range m.Options {
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(num))
-		}
-	}
 	if len(m.ClientMsgRanges) > 0 {
-		for _, msg := range m.ClientMsgRanges {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.ClientMsgRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientMsgRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintSteammessages(dAtA, i, uint64(m.Options[iNdEx]))
+			i--
+			dAtA[i] = 0x8
 		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCMsgSetOptions_MessageRange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8486,23 +8902,28 @@ func (m *CMsgGCMsgSetOptions_MessageRange) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCMsgSetOptions_MessageRange) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCMsgSetOptions_MessageRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Low))
-	dAtA[i] = 0x10
-	i++
 	i = encodeVarintSteammessages(dAtA, i, uint64(m.High))
-	return i, nil
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Low))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCHUpdateSession) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8510,77 +8931,84 @@ func (m *CMsgGCHUpdateSession) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCHUpdateSession) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCHUpdateSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
-	i += 8
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppId))
-	dAtA[i] = 0x18
-	i++
-	if m.Online {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if len(m.DepotIds) > 0 {
+		for iNdEx := len(m.DepotIds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintSteammessages(dAtA, i, uint64(m.DepotIds[iNdEx]))
+			i--
+			dAtA[i] = 0x68
+		}
 	}
-	i++
-	dAtA[i] = 0x21
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ServerSteamId))
-	i += 8
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ServerAddr))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ServerPort))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.OsType))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ClientAddr))
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmSessionIdentifier))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmSessionSysid))
+	i--
+	dAtA[i] = 0x58
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OwnerId))
+	i--
+	dAtA[i] = 0x51
 	if len(m.ExtraFields) > 0 {
-		for _, msg := range m.ExtraFields {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.ExtraFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtraFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	dAtA[i] = 0x51
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OwnerId))
-	i += 8
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmSessionSysid))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.CmSessionIdentifier))
-	if len(m.DepotIds) > 0 {
-		for _, num := range m.DepotIds {
-			dAtA[i] = 0x68
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(num))
-		}
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ClientAddr))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.OsType))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ServerPort))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ServerAddr))
+	i--
+	dAtA[i] = 0x28
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ServerSteamId))
+	i--
+	dAtA[i] = 0x21
+	i--
+	if m.Online {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return i, nil
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppId))
+	i--
+	dAtA[i] = 0x10
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SteamId))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgGCHUpdateSession_ExtraField) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8588,25 +9016,32 @@ func (m *CMsgGCHUpdateSession_ExtraField) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgGCHUpdateSession_ExtraField) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgGCHUpdateSession_ExtraField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	return i, nil
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgNotificationOfSuspiciousActivity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8614,34 +9049,41 @@ func (m *CMsgNotificationOfSuspiciousActivity) Marshal() (dAtA []byte, err error
 }
 
 func (m *CMsgNotificationOfSuspiciousActivity) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgNotificationOfSuspiciousActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x9
-	i++
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
-	i += 8
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Appid))
 	if m.MultipleInstances != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(m.MultipleInstances.Size()))
-		n5, err5 := m.MultipleInstances.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
+		{
+			size, err := m.MultipleInstances.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSteammessages(dAtA, i, uint64(size))
 		}
-		i += n5
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Appid))
+	i--
+	dAtA[i] = 0x10
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Steamid))
+	i--
+	dAtA[i] = 0x9
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgNotificationOfSuspiciousActivity_MultipleGameInstances) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8649,28 +9091,33 @@ func (m *CMsgNotificationOfSuspiciousActivity_MultipleGameInstances) Marshal() (
 }
 
 func (m *CMsgNotificationOfSuspiciousActivity_MultipleGameInstances) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgNotificationOfSuspiciousActivity_MultipleGameInstances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppInstanceCount))
 	if len(m.OtherSteamids) > 0 {
-		for _, num := range m.OtherSteamids {
+		for iNdEx := len(m.OtherSteamids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OtherSteamids[iNdEx]))
+			i--
 			dAtA[i] = 0x11
-			i++
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
-			i += 8
 		}
 	}
-	return i, nil
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AppInstanceCount))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgDPPartnerMicroTxns) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8678,46 +9125,56 @@ func (m *CMsgDPPartnerMicroTxns) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgDPPartnerMicroTxns) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgDPPartnerMicroTxns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Appid))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.GcName)))
-	i += copy(dAtA[i:], m.GcName)
-	if m.Partner != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(m.Partner.Size()))
-		n6, err6 := m.Partner.MarshalTo(dAtA[i:])
-		if err6 != nil {
-			return 0, err6
-		}
-		i += n6
-	}
 	if len(m.Transactions) > 0 {
-		for _, msg := range m.Transactions {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSteammessages(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x22
-			i++
-			i = encodeVarintSteammessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+		}
+	}
+	if m.Partner != nil {
+		{
+			size, err := m.Partner.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintSteammessages(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
+	i -= len(m.GcName)
+	copy(dAtA[i:], m.GcName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.GcName)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Appid))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgDPPartnerMicroTxns_PartnerMicroTxn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8725,74 +9182,81 @@ func (m *CMsgDPPartnerMicroTxns_PartnerMicroTxn) Marshal() (dAtA []byte, err err
 }
 
 func (m *CMsgDPPartnerMicroTxns_PartnerMicroTxn) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgDPPartnerMicroTxns_PartnerMicroTxn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.InitTime))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.LastUpdateTime))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.TxnId))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.AccountId))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.LineItem))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.ItemId))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.DefIndex))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Price))
-	dAtA[i] = 0x48
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Tax))
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PriceUsd))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.TaxUsd))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PurchaseType))
-	dAtA[i] = 0x68
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.SteamTxnType))
-	dAtA[i] = 0x72
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CountryCode)))
-	i += copy(dAtA[i:], m.CountryCode)
-	dAtA[i] = 0x7a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.RegionCode)))
-	i += copy(dAtA[i:], m.RegionCode)
-	dAtA[i] = 0x80
-	i++
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.RefTransId))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.Quantity))
+	i--
 	dAtA[i] = 0x88
-	i++
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Quantity))
+	i--
 	dAtA[i] = 0x1
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.RefTransId))
-	return i, nil
+	i--
+	dAtA[i] = 0x80
+	i -= len(m.RegionCode)
+	copy(dAtA[i:], m.RegionCode)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.RegionCode)))
+	i--
+	dAtA[i] = 0x7a
+	i -= len(m.CountryCode)
+	copy(dAtA[i:], m.CountryCode)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CountryCode)))
+	i--
+	dAtA[i] = 0x72
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.SteamTxnType))
+	i--
+	dAtA[i] = 0x68
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PurchaseType))
+	i--
+	dAtA[i] = 0x60
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.TaxUsd))
+	i--
+	dAtA[i] = 0x58
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PriceUsd))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Tax))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.Price))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.DefIndex))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.ItemId))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.LineItem))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.AccountId))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.TxnId))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.LastUpdateTime))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.InitTime))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgDPPartnerMicroTxns_PartnerInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8800,32 +9264,40 @@ func (m *CMsgDPPartnerMicroTxns_PartnerInfo) Marshal() (dAtA []byte, err error)
 }
 
 func (m *CMsgDPPartnerMicroTxns_PartnerInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgDPPartnerMicroTxns_PartnerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(m.PartnerId))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.PartnerName)))
-	i += copy(dAtA[i:], m.PartnerName)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CurrencyCode)))
-	i += copy(dAtA[i:], m.CurrencyCode)
-	dAtA[i] = 0x22
-	i++
+	i -= len(m.CurrencyName)
+	copy(dAtA[i:], m.CurrencyName)
 	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CurrencyName)))
-	i += copy(dAtA[i:], m.CurrencyName)
-	return i, nil
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.CurrencyCode)
+	copy(dAtA[i:], m.CurrencyCode)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.CurrencyCode)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.PartnerName)
+	copy(dAtA[i:], m.PartnerName)
+	i = encodeVarintSteammessages(dAtA, i, uint64(len(m.PartnerName)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintSteammessages(dAtA, i, uint64(m.PartnerId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
 func (m *CMsgDPPartnerMicroTxnsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -8833,31 +9305,38 @@ func (m *CMsgDPPartnerMicroTxnsResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CMsgDPPartnerMicroTxnsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CMsgDPPartnerMicroTxnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Eresult != nil {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
-	}
 	if m.Eerrorcode != nil {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eerrorcode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Eresult != nil {
+		i = encodeVarintSteammessages(dAtA, i, uint64(*m.Eresult))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintSteammessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSteammessages(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *CMsgProtoBufHeader) Size() (n int) {
 	if m == nil {
@@ -21166,6 +21645,7 @@ func (m *CMsgDPPartnerMicroTxnsResponse) Unmarshal(dAtA []byte) error {
 func skipSteammessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -21197,10 +21677,8 @@ func skipSteammessages(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -21221,55 +21699,30 @@ func skipSteammessages(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthSteammessages
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthSteammessages
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowSteammessages
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipSteammessages(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthSteammessages
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSteammessages
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSteammessages
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthSteammessages = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowSteammessages   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthSteammessages        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSteammessages          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSteammessages = fmt.Errorf("proto: unexpected end of group")
 )
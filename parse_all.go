@@ -0,0 +1,198 @@
+package demoinfocs
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// DemoSource opens a demo for ParseAll().
+// The returned stream is closed after the demo has been parsed.
+type DemoSource func() (io.ReadCloser, error)
+
+// FileSource returns a DemoSource for the demo file at the given path.
+// The file is only opened when the demo is about to be parsed, so there are at most as many open files as workers.
+func FileSource(path string) DemoSource {
+	return func() (io.ReadCloser, error) {
+		return os.Open(path)
+	}
+}
+
+// ReaderSource returns a DemoSource for an already opened demo stream.
+// The stream isn't closed by ParseAll().
+func ReaderSource(r io.Reader) DemoSource {
+	return func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(r), nil
+	}
+}
+
+// ParseAllConfig contains the configuration for ParseAllWithConfig().
+type ParseAllConfig struct {
+	// Workers is the maximum number of demos that are parsed concurrently.
+	// runtime.NumCPU() is used if it's < 1.
+	Workers int
+
+	// ParserConfig is used to create the parser of each demo.
+	// Since every demo has its own message queue, a fixed MsgQueueBufferSize
+	// may be used to limit the memory usage when parsing many demos at once.
+	ParserConfig ParserConfig
+
+	// OnProgress is called with the aggregate progress of all demos (from 0 to 1)
+	// whenever a demo's progress changes by at least a percent or a demo is finished.
+	// It's called from the parsing go-routines but never concurrently.
+	OnProgress func(progress float32)
+}
+
+// ParseAll parses multiple demos concurrently, with at most 'workers' demos being parsed at the same time.
+//
+// setup is called with the index of the source and the parser of each demo before parsing starts;
+// it can be used to register event handlers.
+// Event handlers of different demos run concurrently, so shared state needs to be synchronized.
+//
+// Returns a slice with an error for each source, nil entries mean the demo was parsed successfully.
+// If ctx is cancelled, parsing of the running demos is cancelled and demos that haven't been started yet are skipped;
+// the errors of those demos are set to ctx.Err().
+//
+// See also: ParseAllWithConfig()
+func ParseAll(ctx context.Context, sources []DemoSource, workers int, setup func(i int, p IParser)) []error {
+	return ParseAllWithConfig(ctx, sources, ParseAllConfig{
+		Workers:      workers,
+		ParserConfig: DefaultParserConfig,
+	}, setup)
+}
+
+// ParseAllWithConfig is like ParseAll() but with a custom configuration.
+//
+// Panics while parsing a demo (e.g. caused by corrupt demos, in setup or in event handlers) are recovered
+// and returned as PanicError of that demo, ParserConfig.RecoverPanics is always set for this.
+//
+// Parsers don't share any mutable state apart from the buffer pools which are safe for concurrent use.
+func ParseAllWithConfig(ctx context.Context, sources []DemoSource, config ParseAllConfig, setup func(i int, p IParser)) []error {
+	workers := config.Workers
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	if workers > len(sources) {
+		workers = len(sources)
+	}
+
+	errs := make([]error, len(sources))
+	progress := newProgressTracker(len(sources), config.OnProgress)
+
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indices {
+				if ctx.Err() != nil {
+					errs[i] = ctx.Err()
+				} else {
+					errs[i] = parseSource(ctx, sources[i], config.ParserConfig, func(p IParser) {
+						progress.register(i, p)
+
+						if setup != nil {
+							setup(i, p)
+						}
+					})
+				}
+
+				progress.finish(i)
+			}
+		}()
+	}
+
+	for i := range sources {
+		indices <- i
+	}
+
+	close(indices)
+	wg.Wait()
+
+	return errs
+}
+
+func parseSource(ctx context.Context, source DemoSource, config ParserConfig, setup func(p IParser)) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = newPanicError(r, DemoPosition{})
+		}
+	}()
+
+	r, err := source()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	config.RecoverPanics = true
+
+	p := NewParserWithConfig(r, config)
+	setup(p)
+
+	return p.ParseToEndContext(ctx)
+}
+
+// progressTracker aggregates the progress of multiple demos.
+type progressTracker struct {
+	onProgress func(float32)
+	progress   []uint32 // Progress of each demo in percent, accessed atomically
+	mutex      sync.Mutex
+}
+
+func newProgressTracker(n int, onProgress func(float32)) *progressTracker {
+	return &progressTracker{
+		onProgress: onProgress,
+		progress:   make([]uint32, n),
+	}
+}
+
+// register updates the progress of demo i whenever a frame has been parsed.
+func (pt *progressTracker) register(i int, p IParser) {
+	if pt.onProgress == nil {
+		return
+	}
+
+	// FrameDone is dispatched on the same go-routine that updates the current frame, so Progress() is safe to use here
+	p.RegisterEventHandler(func(events.FrameDone) {
+		percent := uint32(math.Min(float64(p.Progress()), 1) * 100)
+
+		if percent > atomic.LoadUint32(&pt.progress[i]) {
+			atomic.StoreUint32(&pt.progress[i], percent)
+			pt.report()
+		}
+	})
+}
+
+func (pt *progressTracker) finish(i int) {
+	if pt.onProgress == nil {
+		return
+	}
+
+	atomic.StoreUint32(&pt.progress[i], 100)
+	pt.report()
+}
+
+func (pt *progressTracker) report() {
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+
+	var sum uint32
+	for i := range pt.progress {
+		sum += atomic.LoadUint32(&pt.progress[i])
+	}
+
+	pt.onProgress(float32(sum) / float32(100*len(pt.progress)))
+}
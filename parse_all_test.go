@@ -0,0 +1,153 @@
+package demoinfocs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+func TestParseAll(t *testing.T) {
+	sources := make([]DemoSource, 20)
+	for i := range sources {
+		sources[i] = ReaderSource(fakeSeekDemo())
+	}
+
+	var (
+		mutex  sync.Mutex
+		frames = make(map[int]int)
+	)
+
+	errs := ParseAll(context.Background(), sources, 4, func(i int, p IParser) {
+		p.RegisterEventHandler(func(events.FrameDone) {
+			mutex.Lock()
+			frames[i]++
+			mutex.Unlock()
+		})
+	})
+
+	assert.Len(t, errs, len(sources))
+	for i, err := range errs {
+		assert.NoError(t, err)
+		assert.Equal(t, 10, frames[i])
+	}
+}
+
+func TestParseAll_Errors(t *testing.T) {
+	errOpen := errors.New("test")
+	sources := []DemoSource{
+		ReaderSource(fakeSeekDemo()),
+		ReaderSource(bytes.NewReader(make([]byte, 2000))),
+		func() (io.ReadCloser, error) { return nil, errOpen },
+		ReaderSource(fakeSeekDemo()),
+		FileSource("does-not-exist.dem"),
+	}
+
+	errs := ParseAll(context.Background(), sources, 2, func(i int, p IParser) {
+		if i == 3 {
+			panic("test")
+		}
+	})
+
+	assert.NoError(t, errs[0])
+	assert.Equal(t, ErrInvalidFileType, errs[1])
+	assert.Equal(t, errOpen, errs[2])
+	var panicErr *PanicError
+	assert.True(t, errors.As(errs[3], &panicErr))
+	assert.Equal(t, "test", panicErr.Value)
+	assert.Error(t, errs[4])
+}
+
+func TestParseAll_PanicInEventHandler(t *testing.T) {
+	errTest := errors.New("test")
+	sources := []DemoSource{
+		ReaderSource(fakeSeekDemo()),
+		ReaderSource(fakeSeekDemo()),
+		ReaderSource(fakeSeekDemo()),
+	}
+
+	var (
+		mutex  sync.Mutex
+		frames = make(map[int]int)
+	)
+
+	// FrameDone is dispatched on the message queue's go-routine
+	errs := ParseAll(context.Background(), sources, 2, func(i int, p IParser) {
+		p.RegisterEventHandler(func(events.FrameDone) {
+			mutex.Lock()
+			frames[i]++
+			mutex.Unlock()
+
+			if i == 1 {
+				panic(errTest)
+			}
+		})
+	})
+
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[2])
+	assert.Equal(t, 10, frames[0])
+	assert.Equal(t, 10, frames[2])
+
+	var panicErr *PanicError
+	assert.True(t, errors.As(errs[1], &panicErr))
+	assert.True(t, errors.Is(errs[1], errTest))
+	assert.Equal(t, 1, panicErr.Frame)
+	assert.NotEmpty(t, panicErr.Stack)
+}
+
+func TestParseAll_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sources := make([]DemoSource, 10)
+	for i := range sources {
+		sources[i] = ReaderSource(fakeSeekDemo())
+	}
+
+	errs := ParseAll(ctx, sources, 1, func(i int, p IParser) {
+		if i == 2 {
+			cancel()
+		}
+	})
+
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+
+	for _, err := range errs[2:] {
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+func TestParseAll_Progress(t *testing.T) {
+	sources := make([]DemoSource, 5)
+	for i := range sources {
+		sources[i] = ReaderSource(fakeSeekDemo())
+	}
+
+	var progress []float32
+
+	errs := ParseAllWithConfig(context.Background(), sources, ParseAllConfig{
+		Workers:      3,
+		ParserConfig: DefaultParserConfig,
+		OnProgress: func(p float32) {
+			progress = append(progress, p)
+		},
+	}, nil)
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	assert.NotEmpty(t, progress)
+	for i := 1; i < len(progress); i++ {
+		assert.True(t, progress[i] >= progress[i-1], "progress should never decrease")
+	}
+
+	assert.Equal(t, float32(1), progress[len(progress)-1])
+}
@@ -15,7 +15,7 @@ import (
 	st "github.com/markus-wa/demoinfocs-golang/sendtables"
 )
 
-//go:generate ifacemaker -f parser.go -f parsing.go -s Parser -i IParser -p demoinfocs -D -y "IParser is an auto-generated interface for Parser, intended to be used when mockability is needed." -c "DO NOT EDIT: Auto generated" -o parser_interface.go
+//go:generate ifacemaker -f parser.go -f parsing.go -f seeking.go -f snapshot.go -f recovery.go -s Parser -i IParser -p demoinfocs -D -y "IParser is an auto-generated interface for Parser, intended to be used when mockability is needed." -c "DO NOT EDIT: Auto generated" -o parser_interface.go
 
 /*
 Parser can parse a CS:GO demo.
@@ -24,6 +24,8 @@ Creating a new instance is done via NewParser().
 To start off you may use Parser.ParseHeader() to parse the demo header
 (this can be skipped and will be done automatically if necessary).
 Further, Parser.ParseNextFrame() and Parser.ParseToEnd() can be used to parse the demo.
+Parser.SeekToTick() and Parser.SeekToRound() can be used to jump to a specific point in the demo.
+Parser.Snapshot() and Parser.RestoreSnapshot() can be used to save the parsing state and continue from there later.
 
 Use Parser.RegisterEventHandler() to receive notifications about events.
 
@@ -50,12 +52,14 @@ type Parser struct {
 	msgDispatcher                dp.Dispatcher             // Net-message dispatcher
 	gameEventHandler             gameEventHandler
 	userMessageHandler           userMessageHandler
-	eventDispatcher              dp.Dispatcher
+	eventDispatcher              *dp.Dispatcher
 	currentFrame                 int                // Demo-frame, not ingame-tick
 	header                       *common.DemoHeader // Pointer so we can check for nil
 	gameState                    *GameState
 	demoInfoProvider             demoInfoProvider // Provides demo infos to other packages that the core package depends on
 	cancelChan                   chan struct{}    // Non-anime-related, used for aborting the parsing
+	msgQueueBufferSize           int              // Buffer size of msgQueue from ParserConfig, < 0 means it's based on the amount of ticks
+	seeker                       io.ReadSeeker    // Underlying demo stream if it can be seeked, needed to go back to an earlier position
 	err                          error            // Contains a error that occurred during parsing if any
 	errLock                      sync.Mutex       // Used to sync up error mutations between parsing & handling go-routines
 
@@ -73,6 +77,21 @@ type Parser struct {
 	grenadeModelIndices  map[int]common.EquipmentElement                 // Used to map model indices to grenades (used for grenade projectiles)
 	stringTables         []*msg.CSVCMsg_CreateStringTable                // Contains all created sendtables, needed when updating them
 	delayedEventHandlers []func()                                        // Contains event handlers that need to be executed at the end of a tick (e.g. flash events because FlashDuration isn't updated before that)
+	roundStartTicks      map[int]int                                     // Maps round numbers to the ingame tick at which they started (used for SeekToRound())
+	roundStarted         bool                                            // Set when a round started during the current frame, see updateRoundIndex()
+	rawDataTables        []byte                                          // Raw data-tables packet, needed for snapshots
+	framePosition        int                                             // Byte offset of the frame after the last one that was handled, needed for snapshots
+	readerOffset         int                                             // Byte offset in the demo at which bitReader started reading, changes when seeking
+	tradeWindow          time.Duration                                   // Max time between a kill and the trade kill, see ParserConfig.TradeWindow
+	recentKills          []recentKill                                    // Kills of the current round that may still be traded
+	clutch               *clutch                                         // Ongoing clutch situation of the current round, if any
+	killedThisRound      map[*common.Player]bool                         // Players that died during the current round, used for clutch detection
+	lenient              bool                                            // Skip corrupt data instead of failing, see ParserConfig.Lenient
+	recoveredProblems    []RecoveredProblem                              // Problems that were skipped in lenient mode
+	problemsLock         sync.Mutex                                      // Used to sync up access to recoveredProblems from other go-routines
+	checkpointInterval   time.Duration                                   // Ingame time between seek checkpoints, see ParserConfig.SeekCheckpointInterval
+	recoverPanics        bool                                            // Recover panics in net-message handlers, see ParserConfig.RecoverPanics
+	checkpoints          []seekCheckpoint                                // Snapshots taken while parsing, used to seek without starting from the beginning
 }
 
 // NetMessageCreator creates additional net-messages to be dispatched to net-message handlers.
@@ -170,7 +189,7 @@ Returns a identifier with which the handler can be removed via UnregisterNetMess
 See also: RegisterEventHandler()
 */
 func (p *Parser) RegisterNetMessageHandler(handler interface{}) dp.HandlerIdentifier {
-	return p.msgDispatcher.RegisterHandler(handler)
+	return p.registerMsgHandler(handler)
 }
 
 // UnregisterNetMessageHandler removes a net-message handler via identifier.
@@ -220,6 +239,29 @@ type ParserConfig struct {
 	// Check out parsing.go to see which net-messages are already being parsed by default.
 	// This is a beta feature and may be changed or replaced without notice.
 	AdditionalNetMessageCreators map[int]NetMessageCreator
+
+	// TradeWindow is the maximum time between a kill and the death of the killer
+	// for events.KillTraded to be dispatched.
+	// Zero means the default of 5 seconds is used, a negative value disables events.KillTraded.
+	TradeWindow time.Duration
+
+	// Lenient makes the Parser skip corrupt parts of the demo where possible instead of returning an error,
+	// e.g. net-messages that can't be unmarshalled or broken string tables.
+	// Each skipped problem is dispatched as events.ParserWarn and can be retrieved via Parser.RecoveredProblems().
+	// Parsing stops with ErrUnexpectedEndOfDemo if the demo can't be continued at all (unknown demo commands).
+	Lenient bool
+
+	// SeekCheckpointInterval is the ingame time between the snapshots that are taken while parsing
+	// so SeekToTick() and SeekToRound() can continue from the closest one instead of the start of the demo.
+	// Checkpoints are only taken if the demo stream implements io.ReadSeeker and are kept in memory,
+	// so this should only be set if the Parser is used for seeking (e.g. one minute).
+	// Zero or a negative value disables checkpoints (default).
+	SeekCheckpointInterval time.Duration
+
+	// RecoverPanics makes the Parser recover panics in net-message and event handlers
+	// (which usually run on the message queue's go-routine) and return them as PanicError from ParseToEnd() etc.
+	// Otherwise such panics crash the program since they can't be recovered by the caller.
+	RecoverPanics bool
 }
 
 // DefaultParserConfig is the default Parser configuration used by NewParser().
@@ -227,6 +269,8 @@ var DefaultParserConfig = ParserConfig{
 	MsgQueueBufferSize: -1,
 }
 
+const defaultTradeWindow = 5 * time.Second
+
 // NewParserWithConfig returns a new Parser with a custom configuration.
 //
 // See also: NewParser() & ParserConfig
@@ -235,42 +279,121 @@ func NewParserWithConfig(demostream io.Reader, config ParserConfig) *Parser {
 
 	// Init parser
 	p.bitReader = bit.NewLargeBitReader(demostream)
-	p.stParser = st.NewSendTableParser()
-	p.equipmentMapping = make(map[*st.ServerClass]common.EquipmentElement)
-	p.rawPlayers = make(map[int]*playerInfo)
-	p.triggers = make(map[int]*boundingBoxInformation)
+	p.eventDispatcher = new(dp.Dispatcher)
 	p.cancelChan = make(chan struct{}, 1)
 	p.gameState = newGameState()
-	p.grenadeModelIndices = make(map[int]common.EquipmentElement)
 	p.gameEventHandler = newGameEventHandler(&p)
 	p.userMessageHandler = newUserMessageHandler(&p)
 	p.demoInfoProvider = demoInfoProvider{parser: &p}
+	p.gameState.demoInfoProvider = p.demoInfoProvider
+	p.roundStartTicks = make(map[int]int)
+	p.resetState()
 
-	// Attach proto msg handlers
-	p.msgDispatcher.RegisterHandler(p.handlePacketEntities)
-	p.msgDispatcher.RegisterHandler(p.handleGameEventList)
-	p.msgDispatcher.RegisterHandler(p.handleGameEvent)
-	p.msgDispatcher.RegisterHandler(p.handleCreateStringTable)
-	p.msgDispatcher.RegisterHandler(p.handleUpdateStringTable)
-	p.msgDispatcher.RegisterHandler(p.handleUserMessage)
-	p.msgDispatcher.RegisterHandler(p.handleSetConVar)
-	p.msgDispatcher.RegisterHandler(p.handleFrameParsed)
-	p.msgDispatcher.RegisterHandler(p.gameState.handleIngameTickNumber)
+	if seeker, ok := demostream.(io.ReadSeeker); ok {
+		p.seeker = seeker
+	}
 
+	p.recoverPanics = config.RecoverPanics
+
+	// Attach proto msg handlers
+	p.registerMsgHandler(p.handlePacketEntities)
+	p.registerMsgHandler(p.handleGameEventList)
+	p.registerMsgHandler(p.handleGameEvent)
+	p.registerMsgHandler(p.handleCreateStringTable)
+	p.registerMsgHandler(p.handleUpdateStringTable)
+	p.registerMsgHandler(p.handleUserMessage)
+	p.registerMsgHandler(p.handleSetConVar)
+	p.registerMsgHandler(p.handleFrameEndPosition)
+	p.registerMsgHandler(p.handleFrameParsed)
+	p.registerMsgHandler(p.gameState.handleIngameTickNumber)
+	p.registerMsgHandler(p.handleRecoveredProblem)
+	p.registerMsgHandler(p.handleUserCommand)
+	p.registerMsgHandler(p.handleConsoleCommand)
+	p.registerMsgHandler(p.handleFrameCommandInfo)
+
+	p.msgQueueBufferSize = config.MsgQueueBufferSize
 	if config.MsgQueueBufferSize >= 0 {
 		p.initMsgQueue(config.MsgQueueBufferSize)
 	}
 
 	p.additionalNetMessageCreators = config.AdditionalNetMessageCreators
+	p.lenient = config.Lenient
+
+	p.tradeWindow = config.TradeWindow
+	if p.tradeWindow == 0 {
+		p.tradeWindow = defaultTradeWindow
+	}
+
+	p.checkpointInterval = config.SeekCheckpointInterval
 
 	return &p
 }
 
+// resetState (re-)initializes everything that is built up while parsing the demo.
+// The game-state is reset in place so references to it stay valid.
+func (p *Parser) resetState() {
+	p.stParser = st.NewSendTableParser()
+	p.equipmentMapping = make(map[*st.ServerClass]common.EquipmentElement)
+	p.rawPlayers = make(map[int]*playerInfo)
+	p.triggers = make(map[int]*boundingBoxInformation)
+	p.grenadeModelIndices = make(map[int]common.EquipmentElement)
+	p.bombsiteA = bombsite{}
+	p.bombsiteB = bombsite{}
+	p.additionalPlayerInfo = [maxPlayers]common.AdditionalPlayerInformation{}
+	p.weapons = [maxEntities]common.Equipment{}
+	p.modelPreCache = nil
+	p.gameEventDescs = nil
+	p.stringTables = nil
+	p.delayedEventHandlers = nil
+	p.roundStarted = false
+	p.rawDataTables = nil
+	p.framePosition = 0
+	p.recentKills = nil
+	p.resetClutch()
+	p.currentFrame = 0
+	p.header = nil
+	p.gameState.reset()
+
+	p.errLock.Lock()
+	p.err = nil
+	p.errLock.Unlock()
+
+	p.problemsLock.Lock()
+	p.recoveredProblems = nil
+	p.problemsLock.Unlock()
+}
+
 func (p *Parser) initMsgQueue(buf int) {
 	p.msgQueue = make(chan interface{}, buf)
 	p.msgDispatcher.AddQueues(p.msgQueue)
 }
 
+// ensureMsgQueue creates a new msgQueue if there is none.
+// This is the case if the buffer size is based on the amount of ticks
+// or if the queue was closed at the end of ParseToEnd() or ParseNextFrame().
+func (p *Parser) ensureMsgQueue() {
+	if p.msgQueue != nil {
+		return
+	}
+
+	// The amount of ticks seems to be a good indicator of how many events we'll get
+	bufSize := p.msgQueueBufferSize
+	if bufSize < 0 {
+		bufSize = p.header.PlaybackTicks
+	}
+
+	p.initMsgQueue(bufSize)
+}
+
+func (p *Parser) closeMsgQueue() {
+	if p.msgQueue != nil {
+		// Remove the queue synchronously so a new one can be added without the dispatcher still knowing the old one
+		p.msgDispatcher.RemoveQueues(p.msgQueue)
+		close(p.msgQueue)
+		p.msgQueue = nil
+	}
+}
+
 type demoInfoProvider struct {
 	parser *Parser
 }
@@ -280,6 +403,10 @@ func (p demoInfoProvider) IngameTick() int {
 }
 
 func (p demoInfoProvider) TickRate() float64 {
+	if p.parser.header == nil {
+		return 0
+	}
+
 	// TODO: read tickRate from CVARs as fallback
 	return p.parser.header.TickRate()
 }
@@ -287,3 +414,19 @@ func (p demoInfoProvider) TickRate() float64 {
 func (p demoInfoProvider) FindPlayerByHandle(handle int) *common.Player {
 	return p.parser.gameState.Participants().FindByHandle(handle)
 }
+
+func (p demoInfoProvider) BombTimeToExplode() time.Duration {
+	if p.parser == nil {
+		return 0
+	}
+
+	return p.parser.gameState.serverTimeRemaining(p.parser.gameState.bombExplosionTime)
+}
+
+func (p demoInfoProvider) BombDefuseTimeRemaining() time.Duration {
+	if p.parser == nil {
+		return 0
+	}
+
+	return p.parser.gameState.serverTimeRemaining(p.parser.gameState.bombDefuseTime)
+}
@@ -3,6 +3,7 @@
 package demoinfocs
 
 import (
+	"context"
 	"time"
 
 	"github.com/markus-wa/demoinfocs-golang/common"
@@ -17,6 +18,8 @@ import (
 // To start off you may use Parser.ParseHeader() to parse the demo header
 // (this can be skipped and will be done automatically if necessary).
 // Further, Parser.ParseNextFrame() and Parser.ParseToEnd() can be used to parse the demo.
+// Parser.SeekToTick() and Parser.SeekToRound() can be used to jump to a specific point in the demo.
+// Parser.Snapshot() and Parser.RestoreSnapshot() can be used to save the parsing state and continue from there later.
 //
 // Use Parser.RegisterEventHandler() to receive notifications about events.
 //
@@ -95,8 +98,17 @@ type IParser interface {
 	// ParseToEnd attempts to parse the demo until the end.
 	// Aborts and returns ErrCancelled if Cancel() is called before the end.
 	//
-	// See also: ParseNextFrame() for other possible errors.
+	// See also: ParseToEndContext() for cancellation via context.Context and ParseNextFrame() for other possible errors.
 	ParseToEnd() (err error)
+	// ParseToEndContext attempts to parse the demo until the end.
+	// Aborts and returns ctx.Err() if the context is done before the end
+	// or ErrCancelled if Cancel() is called before the end.
+	//
+	// The context is checked between frames, reads from the underlying stream aren't interrupted.
+	// All queued up net-messages are handled before returning and the go-routine handling them is stopped.
+	//
+	// See also: ParseNextFrame() for other possible errors.
+	ParseToEndContext(ctx context.Context) (err error)
 	// Cancel aborts ParseToEnd().
 	// All information that was already read up to this point may still be used (and new events may still be sent out).
 	Cancel()
@@ -106,9 +118,75 @@ type IParser interface {
 	   Returns true unless the demo command 'stop' or an error was encountered.
 
 	   May return ErrUnexpectedEndOfDemo for incomplete / corrupt demos.
-	   May panic if the demo is corrupt in some way.
+	   May return one of CorruptPacketError, UnknownDemoCommandError, CustomDataError or StringTableError
+	   (all matching ErrCorruptDemo via errors.Is()) for corrupt demos, see also ParserConfig.Lenient.
+	   May panic if the demo is corrupt in some other way.
 
 	   See also: ParseToEnd() for parsing the complete demo in one go (faster).
 	*/
 	ParseNextFrame() (moreFrames bool, err error)
+	/*
+	   ParseNextFrameContext is like ParseNextFrame() but returns false and ctx.Err()
+	   without parsing the frame if the context is done.
+
+	   In that case the go-routine handling net-messages is stopped after all queued up messages were handled,
+	   parsing may be resumed by calling ParseNextFrame() or ParseToEnd() again.
+	*/
+	ParseNextFrameContext(ctx context.Context) (moreFrames bool, err error)
+	/*
+	   SeekToTick parses the demo until the game-state reflects the given ingame tick.
+	   Afterwards parsing may be continued from there with ParseNextFrame() or ParseToEnd().
+
+	   Seeking forward is possible with any demo stream. Seeking backwards requires the demo stream
+	   passed to NewParser() to implement io.ReadSeeker (e.g. os.File or bytes.Reader),
+	   otherwise ErrNotSeekable is returned.
+	   For seekable demo streams the game-state, entities and string-tables are rebuilt from the start of the demo,
+	   or restored from the closest checkpoint before the tick if checkpoints are enabled via ParserConfig.SeekCheckpointInterval.
+
+	   No events are dispatched while seeking, except for events.DataTablesParsed so entity handlers can be registered.
+	   Net-message handlers are only called for the frames that are parsed while seeking.
+	   References to players, equipment etc. from before seeking backwards are not updated anymore.
+
+	   Returns ErrSeekOutOfRange if the demo ends before the tick is reached.
+	*/
+	SeekToTick(tick int) error
+	/*
+	   SeekToRound parses the demo until the start of the given round (starting at 1, warmup rounds aren't counted).
+	   The round number corresponds to GameState.TotalRoundsPlayed() + 1 during the round.
+	   If the match is restarted, the rounds after the restart are used.
+
+	   See SeekToTick() for more information and possible errors.
+	*/
+	SeekToRound(round int) error
+	/*
+	   Snapshot serializes the current state of the Parser into a byte blob.
+	   The blob can be passed to RestoreSnapshot() of another Parser of the same demo to continue parsing from there.
+	   This can be used to checkpoint long running jobs or to split the work on a demo between multiple workers.
+
+	   The snapshot reflects the state at the end of the last frame that was handled.
+	   So Snapshot() should be called between calls to ParseNextFrame() or from an events.FrameDone handler.
+	   When called from other event handlers the state of a partially handled frame may end up in the snapshot.
+
+	   Snapshot() must not be called concurrently with parsing (e.g. from a different go-routine while ParseToEnd() is running).
+	*/
+	Snapshot() ([]byte, error)
+	/*
+	   RestoreSnapshot restores the state from a snapshot created by Snapshot() and moves to the frame after it was taken.
+	   Afterwards parsing may be continued with ParseNextFrame() or ParseToEnd().
+
+	   The Parser must have been created for the same demo as the one the snapshot was taken from.
+	   If the Parser has already parsed (parts of) the demo, the demo stream needs to implement io.ReadSeeker,
+	   otherwise ErrNotSeekable is returned.
+
+	   Events are not dispatched while restoring, except for events.DataTablesParsed so entity handlers can be registered.
+	   Players, entities etc. are re-created, this means some information isn't restored:
+	   for instance grenade trajectories only contain the current position and unique-IDs will be different.
+
+	   Returns ErrInvalidSnapshot if the data isn't a valid snapshot.
+	*/
+	RestoreSnapshot(data []byte) (err error)
+	// RecoveredProblems returns all problems that were skipped so far in lenient mode.
+	//
+	// See also: ParserConfig.Lenient
+	RecoveredProblems() []RecoveredProblem
 }
@@ -1,6 +1,8 @@
 package demoinfocs
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -10,6 +12,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/markus-wa/go-unassert"
 
+	bit "github.com/markus-wa/demoinfocs-golang/bitread"
 	"github.com/markus-wa/demoinfocs-golang/common"
 	"github.com/markus-wa/demoinfocs-golang/events"
 	"github.com/markus-wa/demoinfocs-golang/msg"
@@ -41,50 +44,72 @@ var (
 //
 // Returns ErrInvalidFileType if the filestamp (first 8 bytes) doesn't match HL2DEMO.
 func (p *Parser) ParseHeader() (common.DemoHeader, error) {
+	h, err := readHeader(p.bitReader)
+	if err != nil {
+		return h, err
+	}
+
+	p.header = &h
+	p.framePosition = p.demoPosition()
+
+	// Initialize queue if the buffer size wasn't specified
+	p.ensureMsgQueue()
+
+	return h, nil
+}
+
+func readHeader(br *bit.BitReader) (common.DemoHeader, error) {
 	var h common.DemoHeader
-	h.Filestamp = p.bitReader.ReadCString(8)
-	h.Protocol = p.bitReader.ReadSignedInt(32)
-	h.NetworkProtocol = p.bitReader.ReadSignedInt(32)
-	h.ServerName = p.bitReader.ReadCString(maxOsPath)
-	h.ClientName = p.bitReader.ReadCString(maxOsPath)
-	h.MapName = p.bitReader.ReadCString(maxOsPath)
-	h.GameDirectory = p.bitReader.ReadCString(maxOsPath)
-	h.PlaybackTime = time.Duration(p.bitReader.ReadFloat() * float32(time.Second))
-	h.PlaybackTicks = p.bitReader.ReadSignedInt(32)
-	h.PlaybackFrames = p.bitReader.ReadSignedInt(32)
-	h.SignonLength = p.bitReader.ReadSignedInt(32)
+	h.Filestamp = br.ReadCString(8)
+	h.Protocol = br.ReadSignedInt(32)
+	h.NetworkProtocol = br.ReadSignedInt(32)
+	h.ServerName = br.ReadCString(maxOsPath)
+	h.ClientName = br.ReadCString(maxOsPath)
+	h.MapName = br.ReadCString(maxOsPath)
+	h.GameDirectory = br.ReadCString(maxOsPath)
+	h.PlaybackTime = time.Duration(br.ReadFloat() * float32(time.Second))
+	h.PlaybackTicks = br.ReadSignedInt(32)
+	h.PlaybackFrames = br.ReadSignedInt(32)
+	h.SignonLength = br.ReadSignedInt(32)
 
 	if h.Filestamp != "HL2DEMO" {
 		return h, ErrInvalidFileType
 	}
 
-	// Initialize queue if the buffer size wasn't specified, the amount of ticks
-	// seems to be a good indicator of how many events we'll get
-	if p.msgQueue == nil {
-		p.initMsgQueue(h.PlaybackTicks)
-	}
-
-	p.header = &h
-
 	return h, nil
 }
 
 // ParseToEnd attempts to parse the demo until the end.
 // Aborts and returns ErrCancelled if Cancel() is called before the end.
 //
-// See also: ParseNextFrame() for other possible errors.
+// See also: ParseToEndContext() for cancellation via context.Context and ParseNextFrame() for other possible errors.
 func (p *Parser) ParseToEnd() (err error) {
+	return p.ParseToEndContext(context.Background())
+}
+
+// ParseToEndContext attempts to parse the demo until the end.
+// Aborts and returns ctx.Err() if the context is done before the end
+// or ErrCancelled if Cancel() is called before the end.
+//
+// The context is checked between frames, reads from the underlying stream aren't interrupted.
+// All queued up net-messages are handled before returning and the go-routine handling them is stopped.
+//
+// See also: ParseNextFrame() for other possible errors.
+func (p *Parser) ParseToEndContext(ctx context.Context) (err error) {
 	defer func() {
 		// Make sure all the messages of the demo are handled
 		p.msgDispatcher.SyncAllQueues()
 
 		// Close msgQueue
-		if p.msgQueue != nil {
-			close(p.msgQueue)
+		p.closeMsgQueue()
+
+		if err == nil {
+			err = p.recoverPanic(recover())
 		}
 
+		// Net-message handlers may have failed after the last check (e.g. StringTableError)
 		if err == nil {
-			err = recoverFromUnexpectedEOF(recover())
+			err = p.error()
 		}
 	}()
 
@@ -95,11 +120,17 @@ func (p *Parser) ParseToEnd() (err error) {
 		}
 	}
 
+	// Parsing may be resumed after a previous call was cancelled
+	p.ensureMsgQueue()
+
 	for {
 		select {
 		case <-p.cancelChan:
 			return ErrCancelled
 
+		case <-ctx.Done():
+			return ctx.Err()
+
 		default:
 			if !p.parseFrame() {
 				return p.error()
@@ -117,6 +148,12 @@ func recoverFromUnexpectedEOF(r interface{}) error {
 		if r == io.ErrUnexpectedEOF || r == io.EOF {
 			return ErrUnexpectedEndOfDemo
 		}
+
+		// Corrupt demo data that couldn't be skipped (see Parser.recoverProblem())
+		if err, ok := r.(positionedError); ok {
+			return err
+		}
+
 		panic(r)
 	}
 	return nil
@@ -134,25 +171,49 @@ ParseNextFrame attempts to parse the next frame / demo-tick (not ingame tick).
 Returns true unless the demo command 'stop' or an error was encountered.
 
 May return ErrUnexpectedEndOfDemo for incomplete / corrupt demos.
-May panic if the demo is corrupt in some way.
+May return one of CorruptPacketError, UnknownDemoCommandError, CustomDataError or StringTableError
+(all matching ErrCorruptDemo via errors.Is()) for corrupt demos, see also ParserConfig.Lenient.
+May panic if the demo is corrupt in some other way.
 
 See also: ParseToEnd() for parsing the complete demo in one go (faster).
 */
 func (p *Parser) ParseNextFrame() (moreFrames bool, err error) {
+	return p.ParseNextFrameContext(context.Background())
+}
+
+/*
+ParseNextFrameContext is like ParseNextFrame() but returns false and ctx.Err()
+without parsing the frame if the context is done.
+
+In that case the go-routine handling net-messages is stopped after all queued up messages were handled,
+parsing may be resumed by calling ParseNextFrame() or ParseToEnd() again.
+*/
+func (p *Parser) ParseNextFrameContext(ctx context.Context) (moreFrames bool, err error) {
 	defer func() {
 		// Make sure all the messages of the frame are handled
 		p.msgDispatcher.SyncAllQueues()
 
-		// Close msgQueue (only if we are done)
-		if p.msgQueue != nil && !moreFrames {
-			close(p.msgQueue)
+		if err == nil {
+			err = p.recoverPanic(recover())
 		}
 
+		// Net-message handlers may have failed while the frame was being parsed (e.g. StringTableError)
 		if err == nil {
-			err = recoverFromUnexpectedEOF(recover())
+			if err = p.error(); err != nil {
+				moreFrames = false
+			}
+		}
+
+		// Close msgQueue (only if we are done)
+		if !moreFrames {
+			p.closeMsgQueue()
 		}
 	}()
 
+	if err = ctx.Err(); err != nil {
+		return false, err
+	}
+
 	if p.header == nil {
 		_, err = p.ParseHeader()
 		if err != nil {
@@ -160,6 +221,8 @@ func (p *Parser) ParseNextFrame() (moreFrames bool, err error) {
 		}
 	}
 
+	p.ensureMsgQueue()
+
 	moreFrames = p.parseFrame()
 
 	return moreFrames, p.error()
@@ -180,7 +243,23 @@ const (
 	dcStringTables   demoCommand = 9
 )
 
+// parseConsoleCommand reads a console command frame, the command is a null-terminated string.
+func (p *Parser) parseConsoleCommand() {
+	data := p.bitReader.ReadBytes(p.bitReader.ReadSignedInt(32))
+
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+
+	p.msgQueue <- events.ConsoleCommand{Command: string(data)}
+}
+
+func (p *Parser) handleConsoleCommand(cmd events.ConsoleCommand) {
+	p.eventDispatcher.Dispatch(cmd)
+}
+
 func (p *Parser) parseFrame() bool {
+	frameStart := p.demoPosition()
 	cmd := demoCommand(p.bitReader.ReadSingleByte())
 
 	// Send ingame tick number update
@@ -199,20 +278,14 @@ func (p *Parser) parseFrame() bool {
 		return false
 
 	case dcConsoleCommand:
-		// Skip
-		p.bitReader.Skip(p.bitReader.ReadSignedInt(32) << 3)
+		p.parseConsoleCommand()
 
 	case dcDataTables:
 		p.msgDispatcher.SyncAllQueues()
 
-		p.bitReader.BeginChunk(p.bitReader.ReadSignedInt(32) << 3)
-		p.stParser.ParsePacket(p.bitReader)
-		p.bitReader.EndChunk()
-
-		debugAllServerClasses(p.ServerClasses())
-
-		p.mapEquipment()
-		p.bindEntities()
+		// Keep the raw data-tables around, they are needed for snapshots
+		p.rawDataTables = p.bitReader.ReadBytes(p.bitReader.ReadSignedInt(32))
+		p.parseDataTables(p.rawDataTables)
 
 		p.eventDispatcher.Dispatch(events.DataTablesParsed{})
 
@@ -222,9 +295,7 @@ func (p *Parser) parseFrame() bool {
 		p.parseStringTables()
 
 	case dcUserCommand:
-		// Skip
-		p.bitReader.Skip(32)
-		p.bitReader.Skip(p.bitReader.ReadSignedInt(32) << 3)
+		p.parseUserCommand()
 
 	case dcSignon:
 		fallthrough
@@ -232,19 +303,46 @@ func (p *Parser) parseFrame() bool {
 		p.parsePacket()
 
 	case dcCustomData:
-		// Might as well panic since we'll be way off if we dont skip the whole thing
-		panic("Found CustomData but not handled")
+		// Callback index followed by the size of the data
+		p.bitReader.Skip(32)
+		size := p.bitReader.ReadSignedInt(32)
+		p.recoverProblem(&CustomDataError{
+			DemoPosition: DemoPosition{Offset: frameStart},
+			Size:         size,
+		})
+		p.bitReader.Skip(size << 3)
 
 	default:
-		panic(fmt.Sprintf("I haven't programmed that pathway yet (command %v unknown)", cmd))
+		// We can't know how much to skip, so we have to stop here
+		p.recoverProblem(&UnknownDemoCommandError{
+			DemoPosition: DemoPosition{Offset: frameStart},
+			Cmd:          int(cmd),
+		})
+		p.setError(ErrUnexpectedEndOfDemo)
+
+		return false
 	}
 
+	// Remember where the next frame starts, needed for snapshots
+	p.msgQueue <- frameEndPosition(p.demoPosition())
+
 	// Queue up some post processing
 	p.msgQueue <- frameParsedToken
 
 	return true
 }
 
+func (p *Parser) parseDataTables(data []byte) {
+	r := bit.NewSmallBitReader(bytes.NewReader(data))
+	p.stParser.ParsePacket(r)
+	r.Pool()
+
+	debugAllServerClasses(p.ServerClasses())
+
+	p.mapEquipment()
+	p.bindEntities()
+}
+
 var byteSlicePool = sync.Pool{
 	New: func() interface{} {
 		s := make([]byte, 0, 256)
@@ -266,10 +364,10 @@ var defaultNetMessageCreators = map[int]NetMessageCreator{
 }
 
 func (p *Parser) parsePacket() {
-	// Booooring
-	// 152 bytes CommandInfo, 4 bytes SeqNrIn, 4 bytes SeqNrOut
-	// See at the bottom of the file what the CommandInfo would contain if you are interested.
-	p.bitReader.Skip((152 + 4 + 4) << 3)
+	p.parseCommandInfo()
+
+	// Skip 4 bytes SeqNrIn & 4 bytes SeqNrOut
+	p.bitReader.Skip((4 + 4) << 3)
 
 	// Here we go
 	p.bitReader.BeginChunk(p.bitReader.ReadSignedInt(32) << 3)
@@ -308,15 +406,23 @@ func (p *Parser) parsePacket() {
 			}
 		}
 
+		msgStart := p.demoPosition()
+
 		b := byteSlicePool.Get().(*[]byte)
 		p.bitReader.ReadBytesInto(b, size)
 
 		m := msgCreator()
-		if proto.Unmarshal(*b, m) != nil {
-			// TODO: Don't crash here, happens with demos that work in gotv
-			panic(fmt.Sprintf("Failed to unmarshal cmd %d", cmd))
+		err := proto.Unmarshal(*b, m)
+		if err != nil {
+			// Happens with demos that work in GOTV, the message is skipped in lenient mode
+			p.recoverProblem(&CorruptPacketError{
+				DemoPosition: DemoPosition{Offset: msgStart},
+				Cmd:          cmd,
+				Err:          err,
+			})
+		} else {
+			p.msgQueue <- m
 		}
-		p.msgQueue <- m
 
 		// Reset length to 0 and pool
 		*b = (*b)[:0]
@@ -328,6 +434,13 @@ func (p *Parser) parsePacket() {
 	p.bitReader.EndChunk()
 }
 
+// frameEndPosition is the byte offset in the demo at which a frame ended.
+type frameEndPosition int
+
+func (p *Parser) handleFrameEndPosition(pos frameEndPosition) {
+	p.framePosition = int(pos)
+}
+
 type frameParsedTokenType struct{}
 
 var frameParsedToken = new(frameParsedTokenType)
@@ -340,56 +453,11 @@ func (p *Parser) handleFrameParsed(*frameParsedTokenType) {
 	}
 	p.delayedEventHandlers = p.delayedEventHandlers[:0]
 
+	p.updateRoundIndex()
+
 	p.currentFrame++
+	p.takeCheckpoint()
+
 	p.eventDispatcher.Dispatch(events.TickDone{})
 	p.eventDispatcher.Dispatch(events.FrameDone{})
 }
-
-/*
-Format of 'CommandInfos' - I honestly have no clue what they are good for.
-This data is skipped in Parser.parsePacket().
-If you find a use for this please let me know!
-
-Here is all i know:
-
-CommandInfo [152 bytes]
-- [2]Split
-
-Split [76 bytes]
-- flags [4 bytes]
-- viewOrigin [12 bytes]
-- viewAngles [12 bytes]
-- localViewAngles [12 bytes]
-- viewOrigin2 [12 bytes]
-- viewAngles2 [12 bytes]
-- localViewAngles2 [12 bytes]
-
-Origin [12 bytes]
-- X [4 bytes]
-- Y [4 bytes]
-- Z [4 bytes]
-
-Angle [12 bytes]
-- X [4 bytes]
-- Y [4 bytes]
-- Z [4 bytes]
-
-They are parsed in the following order:
-split1.flags
-split1.viewOrigin.x
-split1.viewOrigin.y
-split1.viewOrigin.z
-split1.viewAngles.x
-split1.viewAngles.y
-split1.viewAngles.z
-split1.localViewAngles.x
-split1.localViewAngles.y
-split1.localViewAngles.z
-split1.viewOrigin2...
-split1.viewAngles2...
-split1.localViewAngles2...
-split2.flags
-...
-
-Or just check this file's history for an example on how to parse them
-*/
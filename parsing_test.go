@@ -0,0 +1,235 @@
+package demoinfocs
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// fakeDemo can be used to build small in-memory demos for unit tests.
+type fakeDemo struct {
+	buf bytes.Buffer
+}
+
+func newFakeDemo(h common.DemoHeader) *fakeDemo {
+	d := new(fakeDemo)
+
+	d.cString(h.Filestamp, 8)
+	d.int32(h.Protocol)
+	d.int32(h.NetworkProtocol)
+	d.cString(h.ServerName, maxOsPath)
+	d.cString(h.ClientName, maxOsPath)
+	d.cString(h.MapName, maxOsPath)
+	d.cString(h.GameDirectory, maxOsPath)
+	d.float32(float32(h.PlaybackTime.Seconds()))
+	d.int32(h.PlaybackTicks)
+	d.int32(h.PlaybackFrames)
+	d.int32(h.SignonLength)
+
+	return d
+}
+
+func fakeDemoHeader() common.DemoHeader {
+	return common.DemoHeader{
+		Filestamp:      "HL2DEMO",
+		Protocol:       4,
+		MapName:        "de_cache",
+		PlaybackTime:   time.Second,
+		PlaybackTicks:  128,
+		PlaybackFrames: 64,
+	}
+}
+
+// frame adds a frame with the given command, tick and raw data (without the player slot).
+func (d *fakeDemo) frame(cmd demoCommand, tick int, data ...[]byte) *fakeDemo {
+	d.buf.WriteByte(byte(cmd))
+	d.int32(tick)
+	d.buf.WriteByte(0) // player slot
+
+	for _, b := range data {
+		d.buf.Write(b)
+	}
+
+	return d
+}
+
+// syncTicks adds synctick frames for all ticks from 'from' up to and including 'to'.
+func (d *fakeDemo) syncTicks(from, to int) *fakeDemo {
+	for tick := from; tick <= to; tick++ {
+		d.frame(dcSynctick, tick)
+	}
+
+	return d
+}
+
+func (d *fakeDemo) stop(tick int) *fakeDemo {
+	return d.frame(dcStop, tick)
+}
+
+func (d *fakeDemo) reader() *bytes.Reader {
+	// Some padding so the bit-reader doesn't run out of sled
+	return bytes.NewReader(append(d.buf.Bytes(), make([]byte, 16)...))
+}
+
+func int32Bytes(i int) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(int32(i)))
+	return b
+}
+
+// netMessage returns a net-message as contained in a packet.
+func netMessage(cmd int, data []byte) []byte {
+	b := make([]byte, 2*binary.MaxVarintLen32)
+	n := binary.PutUvarint(b, uint64(cmd))
+	n += binary.PutUvarint(b[n:], uint64(len(data)))
+
+	return append(b[:n], data...)
+}
+
+// packet returns the data of a packet (or sign-on) frame containing the given net-messages.
+func packet(msgs ...[]byte) []byte {
+	var msgBuf bytes.Buffer
+	for _, m := range msgs {
+		msgBuf.Write(m)
+	}
+
+	b := make([]byte, 152+4+4) // CommandInfo, SeqNrIn & SeqNrOut
+	b = append(b, int32Bytes(msgBuf.Len())...)
+
+	return append(b, msgBuf.Bytes()...)
+}
+
+func (d *fakeDemo) cString(s string, n int) {
+	b := make([]byte, n)
+	copy(b, s)
+	d.buf.Write(b)
+}
+
+func (d *fakeDemo) int32(i int) {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(int32(i)))
+	d.buf.Write(b)
+}
+
+func (d *fakeDemo) float32(f float32) {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, math.Float32bits(f))
+	d.buf.Write(b)
+}
+
+func TestParseHeader_FakeDemo(t *testing.T) {
+	expected := fakeDemoHeader()
+	p := NewParser(newFakeDemo(expected).stop(0).reader())
+
+	actual, err := p.ParseHeader()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestParseToEnd_SyncTicks(t *testing.T) {
+	p := NewParser(newFakeDemo(fakeDemoHeader()).syncTicks(0, 9).stop(9).reader())
+
+	frames := 0
+	p.RegisterEventHandler(func(events.FrameDone) {
+		frames++
+	})
+
+	err := p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, frames)
+	assert.Equal(t, 10, p.CurrentFrame())
+	assert.Equal(t, 9, p.GameState().IngameTick())
+}
+
+func TestParseToEndContext_Cancelled(t *testing.T) {
+	// Unbuffered so the parser can't get ahead of the handlers
+	p := NewParserWithConfig(newFakeDemo(fakeDemoHeader()).syncTicks(0, 9).stop(9).reader(), ParserConfig{MsgQueueBufferSize: 0})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	frames := 0
+	p.RegisterEventHandler(func(events.FrameDone) {
+		frames++
+		if frames == 3 {
+			cancel()
+		}
+	})
+
+	err := p.ParseToEndContext(ctx)
+
+	assert.Equal(t, context.Canceled, err)
+	assert.True(t, frames >= 3, "all queued up frames should be handled")
+	assert.True(t, frames < 10, "parsing should have been aborted")
+	assert.Nil(t, p.msgQueue, "msgQueue should be closed")
+
+	// Parsing can be resumed
+	err = p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, frames)
+}
+
+func TestParseToEndContext_DeadlineExceeded(t *testing.T) {
+	p := NewParser(newFakeDemo(fakeDemoHeader()).syncTicks(0, 9).stop(9).reader())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	err := p.ParseToEndContext(ctx)
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Zero(t, p.CurrentFrame())
+	assert.Nil(t, p.msgQueue)
+}
+
+func TestParseNextFrameContext(t *testing.T) {
+	p := NewParser(newFakeDemo(fakeDemoHeader()).syncTicks(0, 9).stop(9).reader())
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	more, err := p.ParseNextFrameContext(ctx)
+
+	assert.True(t, more)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, p.CurrentFrame())
+
+	cancel()
+	more, err = p.ParseNextFrameContext(ctx)
+
+	assert.False(t, more)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, p.CurrentFrame())
+	assert.Nil(t, p.msgQueue, "msgQueue should be closed")
+}
+
+func TestParseConsoleCommand(t *testing.T) {
+	cmd := []byte("say glhf\x00")
+
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 1)
+	d.frame(dcConsoleCommand, 1, int32Bytes(len(cmd)), cmd)
+	d.frame(dcConsoleCommand, 1, int32Bytes(len("+attack")), []byte("+attack"))
+	d.syncTicks(2, 2).stop(2)
+
+	p := NewParserWithConfig(d.reader(), ParserConfig{MsgQueueBufferSize: -1})
+
+	var actual []events.ConsoleCommand
+	p.RegisterEventHandler(func(e events.ConsoleCommand) {
+		actual = append(actual, e)
+	})
+
+	err := p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, p.GameState().IngameTick())
+	assert.Equal(t, []events.ConsoleCommand{{Command: "say glhf"}, {Command: "+attack"}}, actual)
+}
@@ -0,0 +1,119 @@
+package demoinfocs
+
+import (
+	"io"
+	"reflect"
+
+	dp "github.com/markus-wa/godispatch"
+
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// RecoveredProblem contains information about corrupt demo data that was skipped in lenient mode.
+//
+// See also: ParserConfig.Lenient & Parser.RecoveredProblems()
+type RecoveredProblem struct {
+	Frame  int   // Demo-frame (not ingame-tick) in which the problem occurred
+	Offset int   // Byte offset in the demo of the skipped data (or of the frame containing it)
+	Err    error // One of CorruptPacketError, UnknownDemoCommandError, CustomDataError or StringTableError
+}
+
+// RecoveredProblems returns all problems that were skipped so far in lenient mode.
+//
+// See also: ParserConfig.Lenient
+func (p *Parser) RecoveredProblems() []RecoveredProblem {
+	p.problemsLock.Lock()
+	defer p.problemsLock.Unlock()
+
+	return append([]RecoveredProblem(nil), p.recoveredProblems...)
+}
+
+// recoverProblem records a problem with the demo data if the parser is lenient, otherwise it panics with the error.
+// The panic is turned into the return value of ParseToEnd() etc. by recoverFromUnexpectedEOF().
+// Must be called on the parsing go-routine, the problem is recorded once all previous net-messages are handled.
+func (p *Parser) recoverProblem(err positionedError) {
+	if !p.lenient {
+		// Make sure the current frame is up to date
+		p.msgDispatcher.SyncAllQueues()
+		err.position().Frame = p.currentFrame
+
+		panic(err)
+	}
+
+	p.msgQueue <- &RecoveredProblem{Err: err}
+}
+
+// handleRecoveredProblem records a problem and dispatches a warning.
+// Must be called on the message handling go-routine.
+func (p *Parser) handleRecoveredProblem(problem *RecoveredProblem) {
+	pos := problem.Err.(positionedError).position()
+	pos.Frame = p.currentFrame
+
+	problem.Frame = pos.Frame
+	problem.Offset = pos.Offset
+
+	p.problemsLock.Lock()
+	p.recoveredProblems = append(p.recoveredProblems, *problem)
+	p.problemsLock.Unlock()
+
+	p.eventDispatcher.Dispatch(events.ParserWarn{Message: problem.Err.Error()})
+}
+
+// recoverHandlerProblem records a problem that occurred while handling a net-message if the parser is lenient,
+// otherwise the error is set as the parser's error and returned by ParseToEnd() etc.
+// Must be called on the message handling go-routine, the caller must stop handling the message afterwards.
+func (p *Parser) recoverHandlerProblem(err positionedError) {
+	pos := err.position()
+	pos.Frame = p.currentFrame
+	pos.Offset = p.framePosition
+
+	if !p.lenient {
+		p.setError(err)
+		return
+	}
+
+	p.handleRecoveredProblem(&RecoveredProblem{Err: err})
+}
+
+// registerMsgHandler registers a net-message handler,
+// wrapped so panics are recovered if ParserConfig.RecoverPanics is set.
+func (p *Parser) registerMsgHandler(handler interface{}) dp.HandlerIdentifier {
+	h := reflect.ValueOf(handler)
+	if !p.recoverPanics || h.Kind() != reflect.Func {
+		return p.msgDispatcher.RegisterHandler(handler)
+	}
+
+	return p.msgDispatcher.RegisterHandler(reflect.MakeFunc(h.Type(), func(args []reflect.Value) []reflect.Value {
+		defer p.recoverHandlerPanic()
+
+		return h.Call(args)
+	}).Interface())
+}
+
+// recoverHandlerPanic sets a panic that occurred while handling a net-message as the parser's error.
+// Must be deferred on the message handling go-routine, only the first error is kept.
+func (p *Parser) recoverHandlerPanic() {
+	if r := recover(); r != nil {
+		err := p.recoverPanic(r)
+
+		if p.error() == nil {
+			p.setError(err)
+		}
+	}
+}
+
+// recoverPanic is like recoverFromUnexpectedEOF() but returns any other panic as PanicError
+// instead of re-panicking if ParserConfig.RecoverPanics is set.
+func (p *Parser) recoverPanic(r interface{}) error {
+	if r == nil {
+		return nil
+	}
+
+	if p.recoverPanics && r != io.ErrUnexpectedEOF && r != io.EOF {
+		if _, ok := r.(positionedError); !ok {
+			return newPanicError(r, DemoPosition{Frame: p.currentFrame, Offset: p.framePosition})
+		}
+	}
+
+	return recoverFromUnexpectedEOF(r)
+}
@@ -0,0 +1,203 @@
+package demoinfocs
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/events"
+	"github.com/markus-wa/demoinfocs-golang/msg"
+)
+
+func lenientParser(d *fakeDemo) *Parser {
+	return NewParserWithConfig(d.reader(), ParserConfig{MsgQueueBufferSize: -1, Lenient: true})
+}
+
+// customData returns the data of a CustomData frame with the given payload.
+func customData(payload []byte) []byte {
+	return append(append(int32Bytes(0), int32Bytes(len(payload))...), payload...)
+}
+
+func TestLenient_CustomData(t *testing.T) {
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 1)
+	d.frame(dcCustomData, 1, customData([]byte{1, 2, 3}))
+	d.syncTicks(2, 3).stop(3)
+
+	p := lenientParser(d)
+
+	var warnings []events.ParserWarn
+	p.RegisterEventHandler(func(e events.ParserWarn) {
+		warnings = append(warnings, e)
+	})
+
+	err := p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, p.GameState().IngameTick(), "parsing should continue after the custom data")
+
+	problems := p.RecoveredProblems()
+	assert.Len(t, problems, 1)
+	assert.Equal(t, 2, problems[0].Frame)
+	assert.Equal(t, &CustomDataError{
+		DemoPosition: DemoPosition{Frame: 2, Offset: problems[0].Offset},
+		Size:         3,
+	}, problems[0].Err)
+	assert.Equal(t, []events.ParserWarn{{Message: problems[0].Err.Error()}}, warnings)
+}
+
+func TestLenient_CorruptNetMessage(t *testing.T) {
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 0)
+	// Truncated string field
+	d.frame(dcPacket, 1, packet(netMessage(int(msg.SVC_Messages_svc_GameEvent), []byte{0x0a, 0x05, 'a'})))
+	d.syncTicks(2, 2).stop(2)
+
+	p := lenientParser(d)
+
+	err := p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, p.GameState().IngameTick())
+
+	problems := p.RecoveredProblems()
+	assert.Len(t, problems, 1)
+	assert.Equal(t, 1, problems[0].Frame)
+	assert.IsType(t, new(CorruptPacketError), problems[0].Err)
+	assert.Equal(t, int(msg.SVC_Messages_svc_GameEvent), problems[0].Err.(*CorruptPacketError).Cmd)
+}
+
+func TestLenient_UnknownCommand(t *testing.T) {
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 1)
+	d.frame(demoCommand(42), 2)
+	d.syncTicks(3, 3).stop(3)
+
+	p := lenientParser(d)
+
+	err := p.ParseToEnd()
+
+	assert.Equal(t, ErrUnexpectedEndOfDemo, err)
+	assert.Equal(t, 2, p.GameState().IngameTick())
+
+	problems := p.RecoveredProblems()
+	assert.Len(t, problems, 1)
+	assert.Equal(t, 42, problems[0].Err.(*UnknownDemoCommandError).Cmd)
+}
+
+func TestLenient_StringTableNameTooLong(t *testing.T) {
+	var tables bytes.Buffer
+	tables.WriteByte(2) // Number of tables
+	tables.WriteString(stNameUserInfo)
+	tables.WriteByte(0)
+	tables.Write([]byte{1, 0}) // Number of strings
+	tables.Write(bytes.Repeat([]byte{'a'}, 120))
+	tables.WriteByte(0)
+	tables.Write(make([]byte, 8)) // Garbage that must be skipped
+
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 0)
+	d.frame(dcStringTables, 1, int32Bytes(tables.Len()), tables.Bytes())
+	d.syncTicks(2, 2).stop(2)
+
+	p := lenientParser(d)
+
+	err := p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, p.GameState().IngameTick())
+
+	problems := p.RecoveredProblems()
+	assert.Len(t, problems, 1)
+	assert.Equal(t, stNameUserInfo, problems[0].Err.(*StringTableError).Table)
+	assert.EqualError(t, errors.Unwrap(problems[0].Err), "entry name is too long (120 bytes)")
+}
+
+func TestStrict_CustomData(t *testing.T) {
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 1)
+	d.frame(dcCustomData, 1, customData([]byte{1, 2, 3}))
+	d.stop(1)
+
+	p := NewParser(d.reader())
+
+	err := p.ParseToEnd()
+
+	var cdErr *CustomDataError
+	assert.True(t, errors.As(err, &cdErr))
+	assert.Equal(t, 2, cdErr.Frame)
+	assert.Equal(t, 3, cdErr.Size)
+	assert.True(t, errors.Is(err, ErrCorruptDemo))
+	assert.Empty(t, p.RecoveredProblems())
+}
+
+func TestStrict_UnknownCommand(t *testing.T) {
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 1)
+	frameStart := d.buf.Len()
+	d.frame(demoCommand(42), 2)
+
+	p := NewParser(d.reader())
+
+	err := p.ParseToEnd()
+
+	assert.Equal(t, &UnknownDemoCommandError{
+		DemoPosition: DemoPosition{Frame: 2, Offset: frameStart},
+		Cmd:          42,
+	}, err)
+}
+
+func TestStrict_StringTable(t *testing.T) {
+	tab, err := proto.Marshal(&msg.CSVCMsg_CreateStringTable{
+		Name:       stNameUserInfo,
+		MaxEntries: 1,
+		NumEntries: 1,
+		StringData: []byte{1}, // Dictionary encoded
+	})
+	assert.NoError(t, err)
+
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 0)
+	d.frame(dcPacket, 1, packet(netMessage(int(msg.SVC_Messages_svc_CreateStringTable), tab)))
+	d.syncTicks(2, 2).stop(2)
+
+	p := NewParser(d.reader())
+
+	err = p.ParseToEnd()
+
+	var stErr *StringTableError
+	assert.True(t, errors.As(err, &stErr))
+	assert.Equal(t, stNameUserInfo, stErr.Table)
+	assert.EqualError(t, stErr.Err, "can't decode dictionary encoded data")
+	assert.True(t, errors.Is(err, ErrCorruptDemo))
+	assert.Empty(t, p.RecoveredProblems())
+}
+
+func TestRecoveredProblems_Copy(t *testing.T) {
+	p := NewParser(newFakeDemo(fakeDemoHeader()).stop(0).reader())
+	p.recoveredProblems = []RecoveredProblem{{Frame: 1}}
+
+	problems := p.RecoveredProblems()
+	problems[0].Frame = 2
+
+	assert.Equal(t, 1, p.RecoveredProblems()[0].Frame)
+}
+
+func TestRecoverPanics_NetMessageHandler(t *testing.T) {
+	conVar, err := proto.Marshal(&msg.CNETMsg_SetConVar{Convars: &msg.CMsg_CVars{}})
+	assert.NoError(t, err)
+
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 1)
+	d.frame(dcPacket, 2, packet(netMessage(int(msg.NET_Messages_net_SetConVar), conVar)))
+	d.syncTicks(3, 4).stop(4)
+
+	p := NewParserWithConfig(d.reader(), ParserConfig{MsgQueueBufferSize: -1, RecoverPanics: true})
+
+	p.RegisterNetMessageHandler(func(*msg.CNETMsg_SetConVar) {
+		panic("test")
+	})
+
+	err = p.ParseToEnd()
+
+	var panicErr *PanicError
+	assert.True(t, errors.As(err, &panicErr))
+	assert.Equal(t, "test", panicErr.Value)
+	assert.Equal(t, 2, panicErr.Frame)
+	assert.False(t, errors.Is(err, ErrCorruptDemo))
+}
@@ -0,0 +1,216 @@
+package demoinfocs
+
+import (
+	"errors"
+	"io"
+	"sort"
+
+	dp "github.com/markus-wa/godispatch"
+
+	bit "github.com/markus-wa/demoinfocs-golang/bitread"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// Seeking errors
+var (
+	// ErrNotSeekable signals that the Parser would need to go back to an earlier position
+	// but the demo stream passed to NewParser() doesn't implement io.ReadSeeker.
+	ErrNotSeekable = errors.New("demo stream does not implement io.ReadSeeker, can't seek backwards (ErrNotSeekable)")
+
+	// ErrSeekOutOfRange signals that the end of the demo was reached before the seek target was found.
+	// The Parser is positioned at the end of the demo after this.
+	ErrSeekOutOfRange = errors.New("reached the end of the demo before the seek target (ErrSeekOutOfRange)")
+)
+
+/*
+SeekToTick parses the demo until the game-state reflects the given ingame tick.
+Afterwards parsing may be continued from there with ParseNextFrame() or ParseToEnd().
+
+Seeking forward is possible with any demo stream. Seeking backwards requires the demo stream
+passed to NewParser() to implement io.ReadSeeker (e.g. os.File or bytes.Reader),
+otherwise ErrNotSeekable is returned.
+For seekable demo streams the game-state, entities and string-tables are rebuilt from the start of the demo,
+or restored from the closest checkpoint before the tick if checkpoints are enabled via ParserConfig.SeekCheckpointInterval.
+
+No events are dispatched while seeking, except for events.DataTablesParsed so entity handlers can be registered.
+Net-message handlers are only called for the frames that are parsed while seeking.
+References to players, equipment etc. from before seeking backwards are not updated anymore.
+
+Returns ErrSeekOutOfRange if the demo ends before the tick is reached.
+*/
+func (p *Parser) SeekToTick(tick int) error {
+	backwards := p.header != nil && tick < p.gameState.ingameTick
+
+	if cp := p.checkpointBefore(tick); cp != nil && (backwards || cp.tick > p.gameState.ingameTick) {
+		err := p.RestoreSnapshot(cp.data)
+		if err != nil {
+			return err
+		}
+	} else if backwards {
+		err := p.rewind()
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.fastForward(func() bool {
+		return p.gameState.ingameTick >= tick
+	})
+}
+
+/*
+SeekToRound parses the demo until the start of the given round (starting at 1, warmup rounds aren't counted).
+The round number corresponds to GameState.TotalRoundsPlayed() + 1 during the round.
+If the match is restarted, the rounds after the restart are used.
+
+See SeekToTick() for more information and possible errors.
+*/
+func (p *Parser) SeekToRound(round int) error {
+	if tick, ok := p.roundStartTicks[round]; ok {
+		return p.SeekToTick(tick)
+	}
+
+	return p.fastForward(func() bool {
+		_, ok := p.roundStartTicks[round]
+		return ok
+	})
+}
+
+// fastForward parses frames without dispatching events until done() returns true.
+func (p *Parser) fastForward(done func() bool) (err error) {
+	// Events are dispatched to a dispatcher without handlers during seeking
+	eventDispatcher := p.eventDispatcher
+	p.eventDispatcher = new(dp.Dispatcher)
+
+	// Entity handlers are usually registered on DataTablesParsed, they need to be registered again after rewind()
+	p.eventDispatcher.RegisterHandler(func(e events.DataTablesParsed) {
+		eventDispatcher.Dispatch(e)
+	})
+
+	defer func() {
+		// Make sure all the messages are handled before restoring the event dispatcher
+		p.msgDispatcher.SyncAllQueues()
+
+		p.eventDispatcher = eventDispatcher
+
+		if err == nil {
+			err = recoverFromUnexpectedEOF(recover())
+		}
+	}()
+
+	if p.header == nil {
+		_, err = p.ParseHeader()
+		if err != nil {
+			return
+		}
+	}
+
+	p.ensureMsgQueue()
+
+	for !done() {
+		if !p.parseFrame() {
+			p.msgDispatcher.SyncAllQueues()
+			if err = p.error(); err != nil {
+				return
+			}
+			return ErrSeekOutOfRange
+		}
+
+		// done() depends on state that is updated by the message handlers
+		p.msgDispatcher.SyncAllQueues()
+
+		if err = p.error(); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// seekCheckpoint is a snapshot that was taken while parsing.
+type seekCheckpoint struct {
+	tick int
+	data []byte
+}
+
+// takeCheckpoint takes a snapshot at the end of a frame if the last checkpoint is at least ParserConfig.SeekCheckpointInterval old.
+// Must be called on the message handling go-routine.
+func (p *Parser) takeCheckpoint() {
+	if p.seeker == nil || p.checkpointInterval <= 0 || p.header == nil {
+		return
+	}
+
+	interval := int(p.checkpointInterval.Seconds() * p.header.TickRate())
+	if interval <= 0 {
+		return
+	}
+
+	// Frames before the last checkpoint may be parsed again after seeking backwards
+	tick := p.gameState.ingameTick
+	if n := len(p.checkpoints); n > 0 && tick < p.checkpoints[n-1].tick+interval {
+		return
+	}
+
+	data, err := p.Snapshot()
+	if err != nil {
+		return
+	}
+
+	p.checkpoints = append(p.checkpoints, seekCheckpoint{tick: tick, data: data})
+}
+
+// checkpointBefore returns the last checkpoint at or before the given tick, nil if there is none.
+func (p *Parser) checkpointBefore(tick int) *seekCheckpoint {
+	i := sort.Search(len(p.checkpoints), func(i int) bool {
+		return p.checkpoints[i].tick > tick
+	})
+
+	if i == 0 {
+		return nil
+	}
+
+	return &p.checkpoints[i-1]
+}
+
+// rewind moves back to the start of the demo and resets everything that was parsed so far.
+// Registered event and net-message handlers are kept.
+func (p *Parser) rewind() error {
+	return p.rewindTo(0)
+}
+
+// rewindTo moves to the given byte offset in the demo and resets everything that was parsed so far.
+func (p *Parser) rewindTo(offset int) error {
+	if p.seeker == nil {
+		return ErrNotSeekable
+	}
+
+	_, err := p.seeker.Seek(int64(offset), io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	p.bitReader = bit.NewLargeBitReader(p.seeker)
+	p.readerOffset = offset
+	p.resetState()
+
+	return nil
+}
+
+// demoPosition returns the current byte offset in the demo.
+func (p *Parser) demoPosition() int {
+	return p.readerOffset + p.bitReader.ActualPosition()>>3
+}
+
+// updateRoundIndex remembers at which tick a round started, if one started during the current frame.
+// This needs to be done at the end of the frame because the number of rounds played may be updated after the RoundStart event.
+func (p *Parser) updateRoundIndex() {
+	if !p.roundStarted {
+		return
+	}
+
+	p.roundStarted = false
+
+	if p.gameState.isMatchStarted && !p.gameState.isWarmupPeriod {
+		p.roundStartTicks[p.gameState.totalRoundsPlayed+1] = p.gameState.ingameTick
+	}
+}
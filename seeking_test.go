@@ -0,0 +1,256 @@
+package demoinfocs
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/events"
+	"github.com/markus-wa/demoinfocs-golang/msg"
+)
+
+// nonSeeker hides the Seek() method of the wrapped reader.
+type nonSeeker struct {
+	io.Reader
+}
+
+func fakeSeekDemo() *bytes.Reader {
+	return newFakeDemo(fakeDemoHeader()).syncTicks(0, 9).stop(9).reader()
+}
+
+func TestSeekToTick_Forward(t *testing.T) {
+	p := NewParser(nonSeeker{fakeSeekDemo()})
+
+	frames := 0
+	p.RegisterEventHandler(func(events.FrameDone) {
+		frames++
+	})
+
+	err := p.SeekToTick(5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, p.GameState().IngameTick())
+	assert.Equal(t, 6, p.CurrentFrame())
+	assert.Zero(t, frames, "no events should be dispatched while seeking")
+
+	err = p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, frames)
+	assert.Equal(t, 9, p.GameState().IngameTick())
+}
+
+func TestSeekToTick_Backward(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	err := p.ParseToEnd()
+	assert.NoError(t, err)
+
+	err = p.SeekToTick(3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, p.GameState().IngameTick())
+	assert.Equal(t, 4, p.CurrentFrame())
+
+	frames := 0
+	p.RegisterEventHandler(func(events.FrameDone) {
+		frames++
+	})
+
+	err = p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 6, frames)
+	assert.Equal(t, 10, p.CurrentFrame())
+}
+
+func TestSeekToTick_NotSeekable(t *testing.T) {
+	p := NewParser(nonSeeker{fakeSeekDemo()})
+
+	err := p.SeekToTick(5)
+	assert.NoError(t, err)
+
+	err = p.SeekToTick(2)
+
+	assert.Equal(t, ErrNotSeekable, err)
+}
+
+func TestSeekToTick_OutOfRange(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	err := p.SeekToTick(100)
+
+	assert.Equal(t, ErrSeekOutOfRange, err)
+}
+
+// roundStartDemo returns a demo with RoundStart events at ticks 3 & 6.
+func roundStartDemo(t *testing.T) *bytes.Reader {
+	gel, err := proto.Marshal(&msg.CSVCMsg_GameEventList{
+		Descriptors: []*msg.CSVCMsg_GameEventListDescriptorT{{Eventid: 1, Name: "round_start"}},
+	})
+	assert.NoError(t, err)
+
+	roundStart, err := proto.Marshal(&msg.CSVCMsg_GameEvent{Eventid: 1})
+	assert.NoError(t, err)
+
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 2)
+	d.frame(dcPacket, 3, packet(
+		netMessage(int(msg.SVC_Messages_svc_GameEventList), gel),
+		netMessage(int(msg.SVC_Messages_svc_GameEvent), roundStart),
+	))
+	d.syncTicks(4, 5)
+	d.frame(dcPacket, 6, packet(netMessage(int(msg.SVC_Messages_svc_GameEvent), roundStart)))
+	d.syncTicks(7, 9).stop(9)
+
+	return d.reader()
+}
+
+func TestSeekToRound(t *testing.T) {
+	p := NewParser(roundStartDemo(t))
+
+	// The fake demo has no game-rules entity, emulate it
+	p.gameState.isMatchStarted = true
+	p.RegisterNetMessageHandler(func(*msg.CSVCMsg_GameEvent) {
+		p.gameState.totalRoundsPlayed = len(p.roundStartTicks)
+	})
+
+	err := p.SeekToRound(2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 6, p.GameState().IngameTick())
+	assert.Equal(t, map[int]int{1: 3, 2: 6}, p.roundStartTicks)
+
+	err = p.SeekToRound(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, p.GameState().IngameTick())
+
+	err = p.SeekToRound(3)
+
+	assert.Equal(t, ErrSeekOutOfRange, err)
+}
+
+// seekRecorder records the offsets that are passed to Seek().
+type seekRecorder struct {
+	*bytes.Reader
+	offsets []int64
+}
+
+func (r *seekRecorder) Seek(offset int64, whence int) (int64, error) {
+	r.offsets = append(r.offsets, offset)
+	return r.Reader.Seek(offset, whence)
+}
+
+func TestSeekToTick_Checkpoints(t *testing.T) {
+	r := &seekRecorder{Reader: fakeSeekDemo()}
+	// 3 ticks at 128 ticks per second
+	p := NewParserWithConfig(r, ParserConfig{MsgQueueBufferSize: -1, SeekCheckpointInterval: 3 * time.Second / 128})
+
+	err := p.ParseToEnd()
+	assert.NoError(t, err)
+
+	ticks := make([]int, len(p.checkpoints))
+	for i, cp := range p.checkpoints {
+		ticks[i] = cp.tick
+	}
+	assert.Equal(t, []int{0, 3, 6, 9}, ticks)
+
+	err = p.SeekToTick(7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, p.GameState().IngameTick())
+	assert.Equal(t, 8, p.CurrentFrame())
+	assert.Len(t, r.offsets, 1)
+	assert.NotZero(t, r.offsets[0], "seeking backwards should start at the checkpoint of tick 6")
+
+	err = p.SeekToTick(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, p.GameState().IngameTick())
+
+	err = p.SeekToTick(8)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 8, p.GameState().IngameTick())
+	assert.Len(t, r.offsets, 3)
+	assert.Equal(t, r.offsets[0], r.offsets[2], "seeking forward should skip to the checkpoint of tick 6")
+	assert.Len(t, p.checkpoints, 4, "checkpoints shouldn't be taken again")
+
+	frames := 0
+	p.RegisterEventHandler(func(events.FrameDone) {
+		frames++
+	})
+
+	err = p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, frames)
+	assert.Equal(t, 10, p.CurrentFrame())
+}
+
+func TestSeekToTick_CheckpointsDisabled(t *testing.T) {
+	r := &seekRecorder{Reader: fakeSeekDemo()}
+	p := NewParser(r)
+
+	err := p.ParseToEnd()
+	assert.NoError(t, err)
+	assert.Empty(t, p.checkpoints)
+
+	err = p.SeekToTick(7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, p.GameState().IngameTick())
+	assert.Equal(t, []int64{0}, r.offsets)
+}
+
+func TestFastForward_DataTablesParsed(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	var actual []interface{}
+	p.RegisterEventHandler(func(e events.DataTablesParsed) {
+		actual = append(actual, e)
+	})
+	p.RegisterEventHandler(func(e events.FrameDone) {
+		actual = append(actual, e)
+	})
+
+	err := p.fastForward(func() bool {
+		p.eventDispatcher.Dispatch(events.DataTablesParsed{})
+		p.eventDispatcher.Dispatch(events.FrameDone{})
+		return true
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{events.DataTablesParsed{}}, actual, "only DataTablesParsed should be dispatched while seeking")
+}
+
+func TestUpdateRoundIndex(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+	p.gameState.isMatchStarted = true
+	p.gameState.totalRoundsPlayed = 3
+	p.gameState.ingameTick = 500
+
+	p.updateRoundIndex()
+	assert.Empty(t, p.roundStartTicks, "no round started")
+
+	p.roundStarted = true
+	p.updateRoundIndex()
+
+	assert.Equal(t, map[int]int{4: 500}, p.roundStartTicks)
+	assert.False(t, p.roundStarted)
+}
+
+func TestUpdateRoundIndex_Warmup(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+	p.gameState.isMatchStarted = true
+	p.gameState.isWarmupPeriod = true
+	p.roundStarted = true
+
+	p.updateRoundIndex()
+
+	assert.Empty(t, p.roundStartTicks)
+}
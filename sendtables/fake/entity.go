@@ -41,8 +41,10 @@ func (e *Entity) FindProperty(name string) *st.Property {
 }
 
 // FindPropertyI is a mock-implementation of IEntity.FindPropertyI().
+// Returns nil (like a missing property) if the mock is set up to return nil.
 func (e *Entity) FindPropertyI(name string) st.IProperty {
-	return e.Called(name).Get(0).(st.IProperty)
+	prop, _ := e.Called(name).Get(0).(st.IProperty)
+	return prop
 }
 
 // BindProperty is a mock-implementation of IEntity.BindProperty().
@@ -93,15 +93,7 @@ func (sc *ServerClass) PropertyEntries() []string {
 }
 
 func (sc *ServerClass) newEntity(entityDataReader *bit.BitReader, entityID int) *Entity {
-	propCount := len(sc.flattenedProps)
-	props := make([]Property, propCount)
-	for i := range sc.flattenedProps {
-		props[i] = Property{entry: &sc.flattenedProps[i]}
-	}
-
-	entity := &Entity{serverClass: sc, id: entityID, props: props}
-
-	entity.initialize()
+	entity := sc.allocEntity(entityID)
 
 	if sc.preprocessedBaseline != nil {
 		entity.applyBaseline(sc.preprocessedBaseline)
@@ -115,6 +107,43 @@ func (sc *ServerClass) newEntity(entityDataReader *bit.BitReader, entityID int)
 
 	entity.ApplyUpdate(entityDataReader)
 
+	sc.fireEntityCreated(entity)
+
+	return entity
+}
+
+// newEntityWithValues creates an entity with the given property values instead of reading them from an update.
+func (sc *ServerClass) newEntityWithValues(entityID int, values []PropertyValue) *Entity {
+	if len(values) != len(sc.flattenedProps) {
+		panic(fmt.Sprintf("Expected %d property values for server-class %q, got %d", len(sc.flattenedProps), sc.name, len(values)))
+	}
+
+	entity := sc.allocEntity(entityID)
+
+	for i := range values {
+		entity.props[i].value = values[i]
+	}
+
+	sc.fireEntityCreated(entity)
+
+	return entity
+}
+
+func (sc *ServerClass) allocEntity(entityID int) *Entity {
+	propCount := len(sc.flattenedProps)
+	props := make([]Property, propCount)
+	for i := range sc.flattenedProps {
+		props[i] = Property{entry: &sc.flattenedProps[i]}
+	}
+
+	entity := &Entity{serverClass: sc, id: entityID, props: props}
+
+	entity.initialize()
+
+	return entity
+}
+
+func (sc *ServerClass) fireEntityCreated(entity *Entity) {
 	// Fire created-handlers so update-handlers can be registered
 	for _, h := range sc.createdHandlers {
 		h(entity)
@@ -124,8 +153,6 @@ func (sc *ServerClass) newEntity(entityDataReader *bit.BitReader, entityID int)
 	for _, f := range entity.onCreateFinished {
 		f()
 	}
-
-	return entity
 }
 
 // OnEntityCreated registers a function to be called when a new entity is created from this ServerClass.
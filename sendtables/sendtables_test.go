@@ -62,3 +62,37 @@ func TestServerClassString(t *testing.T) {
 
 	assert.Equal(t, expectedString, sc.String())
 }
+
+func TestServerClassNewEntityWithValues(t *testing.T) {
+	sc := ServerClass{
+		name:           "TestClass",
+		flattenedProps: []flattenedPropEntry{{name: "prop1"}, {name: "prop2"}},
+	}
+
+	var created *Entity
+	var prop2 PropertyValue
+	sc.OnEntityCreated(func(e *Entity) {
+		created = e
+		e.FindPropertyI("prop2").OnUpdate(func(val PropertyValue) {
+			prop2 = val
+		})
+	})
+
+	values := []PropertyValue{{IntVal: 1}, {StringVal: "test"}}
+	entity := sc.newEntityWithValues(5, values)
+
+	assert.Equal(t, 5, entity.ID())
+	assert.Equal(t, created, entity, "created-handlers should be fired")
+	assert.Equal(t, values[0], entity.FindPropertyI("prop1").Value())
+	assert.Equal(t, values[1], prop2, "update-handlers should be called with the restored value")
+}
+
+func TestServerClassNewEntityWithValues_WrongCount(t *testing.T) {
+	sc := ServerClass{
+		flattenedProps: []flattenedPropEntry{{name: "prop1"}, {name: "prop2"}},
+	}
+
+	assert.Panics(t, func() {
+		sc.newEntityWithValues(1, []PropertyValue{{IntVal: 1}})
+	})
+}
@@ -290,6 +290,33 @@ func (p *SendTableParser) ReadEnterPVS(r *bit.BitReader, entityID int) *Entity {
 	return p.serverClasses[scID].newEntity(r, entityID)
 }
 
+// InstanceBaselines returns the raw instance-baselines that were set so far, mapped by server-class ID.
+//
+// Intended for internal use only.
+func (p *SendTableParser) InstanceBaselines() map[int][]byte {
+	res := make(map[int][]byte, len(p.instanceBaselines))
+	for scID, data := range p.instanceBaselines {
+		res[scID] = data
+	}
+
+	for _, sc := range p.serverClasses {
+		if sc.instanceBaseline != nil {
+			res[sc.id] = sc.instanceBaseline
+		}
+	}
+
+	return res
+}
+
+// RestoreEntity re-creates an entity from previously recorded property values (see Property.Value())
+// instead of reading it from the demo. The values must be in the same order as returned by Entity.PropertiesI().
+// Entity-created handlers are fired the same way as for entities entering the PVS.
+//
+// Intended for internal use only.
+func (p *SendTableParser) RestoreEntity(scID, entityID int, values []PropertyValue) *Entity {
+	return p.serverClasses[scID].newEntityWithValues(entityID, values)
+}
+
 // classBits seems to calculate how many bits must be read for the server-class ID.
 // Not 100% sure how tho tbh.
 func (p *SendTableParser) classBits() int {
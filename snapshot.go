@@ -0,0 +1,546 @@
+package demoinfocs
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/geo/r3"
+	dp "github.com/markus-wa/godispatch"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+	"github.com/markus-wa/demoinfocs-golang/msg"
+	st "github.com/markus-wa/demoinfocs-golang/sendtables"
+)
+
+// Snapshot errors
+var (
+	// ErrInvalidSnapshot signals that the data passed to Parser.RestoreSnapshot() isn't a snapshot
+	// or was created by an incompatible version of this library.
+	ErrInvalidSnapshot = errors.New("invalid or incompatible snapshot (ErrInvalidSnapshot)")
+)
+
+// snapshotVersion needs to be increased whenever the snapshot format changes in an incompatible way.
+const snapshotVersion = 2
+
+// snapshot contains everything needed to continue parsing a demo from a specific frame.
+// Most of the game-state isn't stored directly but re-created from the entities when restoring.
+type snapshot struct {
+	Version           int
+	Header            *common.DemoHeader
+	Position          int // Byte offset of the next frame in the demo
+	CurrentFrame      int
+	IngameTick        int
+	DataTables        []byte
+	InstanceBaselines map[int][]byte
+	StringTables      [][]byte // Marshalled CSVCMsg_CreateStringTable messages
+	GameEventList     []byte   // Marshalled CSVCMsg_GameEventList
+	ModelPreCache     []string
+	RawPlayers        map[int]playerInfoSnapshot
+	Players           []playerSnapshot
+	Entities          []entitySnapshot
+	ConVars           map[string]string
+	RoundStartTicks   map[int]int
+	BombPosition      r3.Vector
+	DefuserUserID     *int
+	PlanterUserID     *int
+	Vote              *voteSnapshot
+	RecentKills       []killSnapshot // Kills that may still be traded
+	ClutchUserID      *int
+	ClutchTeam        common.Team
+	KilledThisRound   []int // UserIDs
+}
+
+type playerInfoSnapshot struct {
+	Version         int64
+	XUID            int64
+	Name            string
+	UserID          int
+	GUID            string
+	FriendsID       int
+	FriendsName     string
+	CustomFiles     [4]int
+	FilesDownloaded byte
+	IsFakePlayer    bool
+	IsHltv          bool
+}
+
+// playerSnapshot contains the player information that can't be re-created from the player's entity.
+type playerSnapshot struct {
+	UserID            int
+	Name              string
+	SteamID           int64
+	LastAlivePosition r3.Vector
+	FlashTick         int
+	IsBot             bool
+	IsConnected       bool
+	IsPlanting        bool
+	IsReloading       bool
+}
+
+// voteSnapshot contains the active vote, players are referenced by UserID.
+type voteSnapshot struct {
+	IssuerUserID *int
+	Issue        common.VoteType
+	TargetUserID *int
+	Team         common.Team
+	Details      string
+	StartTick    int
+	Votes        map[int]common.VoteOption
+}
+
+// killSnapshot contains a recent kill, players are referenced by UserID.
+type killSnapshot struct {
+	VictimUserID      *int
+	KillerUserID      *int
+	AssisterUserID    *int
+	Weapon            *common.EquipmentElement
+	WeaponEntityID    int
+	PenetratedObjects int
+	IsHeadshot        bool
+	Tick              int
+	Time              time.Duration
+}
+
+type entitySnapshot struct {
+	ID            int
+	ServerClassID int
+	Values        []st.PropertyValue
+}
+
+/*
+Snapshot serializes the current state of the Parser into a byte blob.
+The blob can be passed to RestoreSnapshot() of another Parser of the same demo to continue parsing from there.
+This can be used to checkpoint long running jobs or to split the work on a demo between multiple workers.
+
+The snapshot reflects the state at the end of the last frame that was handled.
+So Snapshot() should be called between calls to ParseNextFrame() or from an events.FrameDone handler.
+When called from other event handlers the state of a partially handled frame may end up in the snapshot.
+
+Snapshot() must not be called concurrently with parsing (e.g. from a different go-routine while ParseToEnd() is running).
+*/
+func (p *Parser) Snapshot() ([]byte, error) {
+	s := snapshot{
+		Version:           snapshotVersion,
+		Header:            p.header,
+		Position:          p.framePosition,
+		CurrentFrame:      p.currentFrame,
+		IngameTick:        p.gameState.ingameTick,
+		DataTables:        p.rawDataTables,
+		InstanceBaselines: p.stParser.InstanceBaselines(),
+		ModelPreCache:     p.modelPreCache,
+		RawPlayers:        make(map[int]playerInfoSnapshot, len(p.rawPlayers)),
+		ConVars:           p.gameState.conVars,
+		RoundStartTicks:   p.roundStartTicks,
+		BombPosition:      p.gameState.bomb.LastOnGroundPosition,
+	}
+
+	for _, tab := range p.stringTables {
+		b, err := proto.Marshal(tab)
+		if err != nil {
+			return nil, err
+		}
+		s.StringTables = append(s.StringTables, b)
+	}
+
+	if p.gameEventDescs != nil {
+		gel := new(msg.CSVCMsg_GameEventList)
+		for _, desc := range p.gameEventDescs {
+			gel.Descriptors = append(gel.Descriptors, desc)
+		}
+
+		b, err := proto.Marshal(gel)
+		if err != nil {
+			return nil, err
+		}
+		s.GameEventList = b
+	}
+
+	for i, rp := range p.rawPlayers {
+		s.RawPlayers[i] = rp.snapshot()
+	}
+
+	for _, pl := range p.gameState.playersByUserID {
+		s.Players = append(s.Players, playerSnapshot{
+			UserID:            pl.UserID,
+			Name:              pl.Name,
+			SteamID:           pl.SteamID,
+			LastAlivePosition: pl.LastAlivePosition,
+			FlashTick:         pl.FlashTick,
+			IsBot:             pl.IsBot,
+			IsConnected:       pl.IsConnected,
+			IsPlanting:        pl.IsPlanting,
+			IsReloading:       pl.IsReloading,
+		})
+	}
+
+	for _, entity := range p.gameState.entities {
+		props := entity.PropertiesI()
+		values := make([]st.PropertyValue, len(props))
+		for i, prop := range props {
+			values[i] = prop.Value()
+		}
+
+		s.Entities = append(s.Entities, entitySnapshot{
+			ID:            entity.ID(),
+			ServerClassID: entity.ServerClass().ID(),
+			Values:        values,
+		})
+	}
+
+	// Entities are restored in order so players exist before the entities that reference them
+	sort.Slice(s.Entities, func(i, j int) bool {
+		return s.Entities[i].ID < s.Entities[j].ID
+	})
+
+	s.DefuserUserID = userIDOf(p.gameState.currentDefuser)
+	s.PlanterUserID = userIDOf(p.gameState.currentPlanter)
+
+	if vote := p.gameState.activeVote; vote != nil {
+		s.Vote = snapshotVote(vote)
+	}
+
+	for _, rk := range p.recentKills {
+		s.RecentKills = append(s.RecentKills, snapshotKill(rk))
+	}
+
+	if p.clutch != nil {
+		s.ClutchUserID = userIDOf(p.clutch.player)
+		s.ClutchTeam = p.clutch.team
+	}
+
+	for pl := range p.killedThisRound {
+		s.KilledThisRound = append(s.KilledThisRound, pl.UserID)
+	}
+
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+/*
+RestoreSnapshot restores the state from a snapshot created by Snapshot() and moves to the frame after it was taken.
+Afterwards parsing may be continued with ParseNextFrame() or ParseToEnd().
+
+The Parser must have been created for the same demo as the one the snapshot was taken from.
+If the Parser has already parsed (parts of) the demo, the demo stream needs to implement io.ReadSeeker,
+otherwise ErrNotSeekable is returned.
+
+Events are not dispatched while restoring, except for events.DataTablesParsed so entity handlers can be registered.
+Players, entities etc. are re-created, this means some information isn't restored:
+for instance grenade trajectories only contain the current position and unique-IDs will be different.
+
+Returns ErrInvalidSnapshot if the data isn't a valid snapshot.
+*/
+func (p *Parser) RestoreSnapshot(data []byte) (err error) {
+	var s snapshot
+	err = gob.NewDecoder(bytes.NewReader(data)).Decode(&s)
+	if err != nil || s.Version != snapshotVersion {
+		return ErrInvalidSnapshot
+	}
+
+	if !s.isValid() {
+		return ErrInvalidSnapshot
+	}
+
+	if s.Header == nil {
+		// Nothing parsed yet when the snapshot was taken
+		if p.header != nil {
+			return p.rewind()
+		}
+		return nil
+	}
+
+	eventDispatcher := p.eventDispatcher
+
+	defer func() {
+		p.eventDispatcher = eventDispatcher
+
+		if err == nil {
+			err = recoverFromUnexpectedEOF(recover())
+		}
+	}()
+
+	// Move to the frame after the snapshot
+	if p.seeker != nil {
+		err = p.rewindTo(s.Position)
+		if err != nil {
+			return
+		}
+	} else if p.header != nil {
+		return ErrNotSeekable
+	} else {
+		p.bitReader.Skip(s.Position << 3)
+	}
+
+	p.framePosition = s.Position
+	p.header = s.Header
+	p.ensureMsgQueue()
+
+	p.currentFrame = s.CurrentFrame
+	p.gameState.ingameTick = s.IngameTick
+
+	for scID, baseline := range s.InstanceBaselines {
+		p.stParser.SetInstanceBaseline(scID, baseline)
+	}
+
+	if s.DataTables != nil {
+		p.rawDataTables = s.DataTables
+		p.parseDataTables(s.DataTables)
+
+		eventDispatcher.Dispatch(events.DataTablesParsed{})
+	}
+
+	// Everything else happens without events
+	p.eventDispatcher = new(dp.Dispatcher)
+
+	err = p.restoreTables(s)
+	if err != nil {
+		return
+	}
+
+	for k, v := range s.ConVars {
+		p.gameState.conVars[k] = v
+	}
+
+	for k, v := range s.RoundStartTicks {
+		p.roundStartTicks[k] = v
+	}
+
+	// Players need to exist before their entities are created so they can be found via rawPlayers
+	for _, ps := range s.Players {
+		pl := common.NewPlayer(p.demoInfoProvider)
+		pl.UserID = ps.UserID
+		pl.Name = ps.Name
+		pl.SteamID = ps.SteamID
+		pl.IsBot = ps.IsBot
+
+		p.gameState.playersByUserID[ps.UserID] = pl
+	}
+
+	serverClasses := p.stParser.ServerClasses()
+	for _, es := range s.Entities {
+		if es.ServerClassID >= len(serverClasses) || len(es.Values) != len(serverClasses[es.ServerClassID].PropertyEntries()) {
+			return ErrInvalidSnapshot
+		}
+
+		p.gameState.entities[es.ID] = p.stParser.RestoreEntity(es.ServerClassID, es.ID, es.Values)
+	}
+
+	// Restore what can't be re-created from the entities
+	for _, ps := range s.Players {
+		pl := p.gameState.playersByUserID[ps.UserID]
+		pl.LastAlivePosition = ps.LastAlivePosition
+		pl.FlashTick = ps.FlashTick
+		pl.IsConnected = ps.IsConnected
+		pl.IsPlanting = ps.IsPlanting
+		pl.IsReloading = ps.IsReloading
+	}
+
+	p.gameState.bomb.LastOnGroundPosition = s.BombPosition
+
+	p.gameState.currentDefuser = p.snapshotPlayer(s.DefuserUserID)
+	p.gameState.currentPlanter = p.snapshotPlayer(s.PlanterUserID)
+
+	if s.Vote != nil {
+		p.gameState.activeVote = p.restoreVote(s.Vote)
+	}
+
+	for _, ks := range s.RecentKills {
+		p.recentKills = append(p.recentKills, p.restoreKill(ks))
+	}
+
+	if s.ClutchUserID != nil {
+		p.clutch = &clutch{
+			player: p.snapshotPlayer(s.ClutchUserID),
+			team:   s.ClutchTeam,
+		}
+	}
+
+	for _, userID := range s.KilledThisRound {
+		if pl := p.gameState.playersByUserID[userID]; pl != nil {
+			p.killedThisRound[pl] = true
+		}
+	}
+
+	return nil
+}
+
+// userIDOf returns the UserID of a player for snapshots, nil if there is no player.
+func userIDOf(pl *common.Player) *int {
+	if pl == nil {
+		return nil
+	}
+
+	return &pl.UserID
+}
+
+// snapshotPlayer returns the restored player with the UserID, nil if there is no player.
+func (p *Parser) snapshotPlayer(userID *int) *common.Player {
+	if userID == nil {
+		return nil
+	}
+
+	return p.gameState.playersByUserID[*userID]
+}
+
+func snapshotVote(vote *common.Vote) *voteSnapshot {
+	vs := &voteSnapshot{
+		IssuerUserID: userIDOf(vote.Issuer),
+		Issue:        vote.Issue,
+		TargetUserID: userIDOf(vote.Target),
+		Team:         vote.Team,
+		Details:      vote.Details,
+		StartTick:    vote.StartTick,
+		Votes:        make(map[int]common.VoteOption, len(vote.Votes)),
+	}
+
+	for pl, option := range vote.Votes {
+		vs.Votes[pl.UserID] = option
+	}
+
+	return vs
+}
+
+func (p *Parser) restoreVote(vs *voteSnapshot) *common.Vote {
+	vote := &common.Vote{
+		Issuer:    p.snapshotPlayer(vs.IssuerUserID),
+		Issue:     vs.Issue,
+		Target:    p.snapshotPlayer(vs.TargetUserID),
+		Team:      vs.Team,
+		Details:   vs.Details,
+		StartTick: vs.StartTick,
+		Votes:     make(map[*common.Player]common.VoteOption, len(vs.Votes)),
+	}
+
+	for userID, option := range vs.Votes {
+		if pl := p.gameState.playersByUserID[userID]; pl != nil {
+			vote.Votes[pl] = option
+		}
+	}
+
+	return vote
+}
+
+func snapshotKill(rk recentKill) killSnapshot {
+	ks := killSnapshot{
+		VictimUserID:      userIDOf(rk.kill.Victim),
+		KillerUserID:      userIDOf(rk.kill.Killer),
+		AssisterUserID:    userIDOf(rk.kill.Assister),
+		PenetratedObjects: rk.kill.PenetratedObjects,
+		IsHeadshot:        rk.kill.IsHeadshot,
+		Tick:              rk.tick,
+		Time:              rk.time,
+	}
+
+	if wep := rk.kill.Weapon; wep != nil {
+		ks.Weapon = &wep.Weapon
+		ks.WeaponEntityID = wep.EntityID
+	}
+
+	return ks
+}
+
+// restoreKill re-creates a recent kill, the weapon is a new instance that only contains the type and entity-ID.
+func (p *Parser) restoreKill(ks killSnapshot) recentKill {
+	kill := events.Kill{
+		Victim:            p.snapshotPlayer(ks.VictimUserID),
+		Killer:            p.snapshotPlayer(ks.KillerUserID),
+		Assister:          p.snapshotPlayer(ks.AssisterUserID),
+		PenetratedObjects: ks.PenetratedObjects,
+		IsHeadshot:        ks.IsHeadshot,
+	}
+
+	if ks.Weapon != nil {
+		wep := common.NewEquipment(*ks.Weapon)
+		wep.EntityID = ks.WeaponEntityID
+		kill.Weapon = &wep
+	}
+
+	return recentKill{
+		kill: kill,
+		tick: ks.Tick,
+		time: ks.Time,
+	}
+}
+
+func (s snapshot) isValid() bool {
+	if s.Header == nil {
+		return true
+	}
+
+	return s.Position > 0 && s.Header.Filestamp == "HL2DEMO"
+}
+
+// restoreTables restores string-tables and other tables that are usually sent via net-messages.
+func (p *Parser) restoreTables(s snapshot) error {
+	for _, b := range s.StringTables {
+		tab := new(msg.CSVCMsg_CreateStringTable)
+		if proto.Unmarshal(b, tab) != nil {
+			return ErrInvalidSnapshot
+		}
+
+		p.stringTables = append(p.stringTables, tab)
+	}
+
+	if s.GameEventList != nil {
+		gel := new(msg.CSVCMsg_GameEventList)
+		if proto.Unmarshal(s.GameEventList, gel) != nil {
+			return ErrInvalidSnapshot
+		}
+
+		p.handleGameEventList(gel)
+	}
+
+	for i, rp := range s.RawPlayers {
+		p.rawPlayers[i] = rp.playerInfo()
+	}
+
+	p.modelPreCache = s.ModelPreCache
+	p.processModelPreCacheUpdate()
+
+	return nil
+}
+
+func (pi *playerInfo) snapshot() playerInfoSnapshot {
+	return playerInfoSnapshot{
+		Version:         pi.version,
+		XUID:            pi.xuid,
+		Name:            pi.name,
+		UserID:          pi.userID,
+		GUID:            pi.guid,
+		FriendsID:       pi.friendsID,
+		FriendsName:     pi.friendsName,
+		CustomFiles:     [4]int{pi.customFiles0, pi.customFiles1, pi.customFiles2, pi.customFiles3},
+		FilesDownloaded: pi.filesDownloaded,
+		IsFakePlayer:    pi.isFakePlayer,
+		IsHltv:          pi.isHltv,
+	}
+}
+
+func (pis playerInfoSnapshot) playerInfo() *playerInfo {
+	return &playerInfo{
+		version:         pis.Version,
+		xuid:            pis.XUID,
+		name:            pis.Name,
+		userID:          pis.UserID,
+		guid:            pis.GUID,
+		friendsID:       pis.FriendsID,
+		friendsName:     pis.FriendsName,
+		customFiles0:    pis.CustomFiles[0],
+		customFiles1:    pis.CustomFiles[1],
+		customFiles2:    pis.CustomFiles[2],
+		customFiles3:    pis.CustomFiles[3],
+		filesDownloaded: pis.FilesDownloaded,
+		isFakePlayer:    pis.IsFakePlayer,
+		isHltv:          pis.IsHltv,
+	}
+}
@@ -0,0 +1,255 @@
+package demoinfocs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+func TestSnapshot_Restore(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	for i := 0; i < 5; i++ {
+		_, err := p.ParseNextFrame()
+		assert.NoError(t, err)
+	}
+
+	p.gameState.conVars["mp_maxrounds"] = "30"
+	p.roundStartTicks[1] = 3
+	p.rawPlayers[0] = &playerInfo{userID: 2, name: "Player", xuid: 76561198000000000}
+	pl := common.NewPlayer(p.demoInfoProvider)
+	pl.UserID = 2
+	pl.Name = "Player"
+	pl.SteamID = 76561198000000000
+	pl.LastAlivePosition.X = 100
+	p.gameState.playersByUserID[2] = pl
+	p.gameState.currentPlanter = pl
+
+	data, err := p.Snapshot()
+	assert.NoError(t, err)
+
+	restored := NewParser(nonSeeker{fakeSeekDemo()})
+	err = restored.RestoreSnapshot(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, p.Header(), restored.Header())
+	assert.Equal(t, 5, restored.CurrentFrame())
+	assert.Equal(t, 4, restored.GameState().IngameTick())
+	assert.Equal(t, map[string]string{"mp_maxrounds": "30"}, restored.GameState().ConVars())
+	assert.Equal(t, map[int]int{1: 3}, restored.roundStartTicks)
+	assert.Equal(t, p.rawPlayers, restored.rawPlayers)
+
+	restoredPl := restored.gameState.playersByUserID[2]
+	assert.Equal(t, "Player", restoredPl.Name)
+	assert.Equal(t, int64(76561198000000000), restoredPl.SteamID)
+	assert.Equal(t, float64(100), restoredPl.LastAlivePosition.X)
+	assert.False(t, restoredPl.IsConnected)
+	assert.Equal(t, restoredPl, restored.gameState.currentPlanter)
+
+	frames := 0
+	restored.RegisterEventHandler(func(events.FrameDone) {
+		frames++
+	})
+
+	err = restored.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, frames)
+	assert.Equal(t, 9, restored.GameState().IngameTick())
+}
+
+func TestSnapshot_RestoreAfterParsing(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	err := p.SeekToTick(2)
+	assert.NoError(t, err)
+
+	data, err := p.Snapshot()
+	assert.NoError(t, err)
+
+	err = p.ParseToEnd()
+	assert.NoError(t, err)
+
+	err = p.RestoreSnapshot(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, p.CurrentFrame())
+	assert.Equal(t, 2, p.GameState().IngameTick())
+
+	err = p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, p.CurrentFrame())
+}
+
+func TestSnapshot_RestoreAfterParsing_NotSeekable(t *testing.T) {
+	p := NewParser(nonSeeker{fakeSeekDemo()})
+
+	data, err := p.Snapshot()
+	assert.NoError(t, err)
+
+	err = p.ParseToEnd()
+	assert.NoError(t, err)
+
+	err = p.RestoreSnapshot(data)
+
+	assert.Equal(t, ErrNotSeekable, err)
+}
+
+func TestSnapshot_BeforeHeader(t *testing.T) {
+	data, err := NewParser(fakeSeekDemo()).Snapshot()
+	assert.NoError(t, err)
+
+	p := NewParser(fakeSeekDemo())
+	err = p.RestoreSnapshot(data)
+	assert.NoError(t, err)
+
+	err = p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, p.CurrentFrame())
+}
+
+func TestSnapshot_AfterHeader(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	_, err := p.ParseHeader()
+	assert.NoError(t, err)
+
+	data, err := p.Snapshot()
+	assert.NoError(t, err)
+
+	restored := NewParser(fakeSeekDemo())
+	err = restored.RestoreSnapshot(data)
+	assert.NoError(t, err)
+
+	err = restored.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, restored.CurrentFrame())
+}
+
+func TestRestoreSnapshot_Invalid(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	err := p.RestoreSnapshot([]byte("not a snapshot"))
+
+	assert.Equal(t, ErrInvalidSnapshot, err)
+}
+
+func TestSnapshot_RestoreSeekable_Position(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	for i := 0; i < 2; i++ {
+		_, err := p.ParseNextFrame()
+		assert.NoError(t, err)
+	}
+
+	data, err := p.Snapshot()
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = p.ParseNextFrame()
+		assert.NoError(t, err)
+	}
+
+	restored := NewParser(fakeSeekDemo())
+	err = restored.RestoreSnapshot(data)
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = restored.ParseNextFrame()
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, p.framePosition, restored.framePosition, "positions should be absolute after restoring")
+
+	actual, err := restored.Snapshot()
+	assert.NoError(t, err)
+
+	// The snapshot taken after restoring should continue at the same frame
+	again := NewParser(fakeSeekDemo())
+	err = again.RestoreSnapshot(actual)
+	assert.NoError(t, err)
+	assert.Equal(t, p.framePosition, again.framePosition)
+	assert.Equal(t, 4, again.CurrentFrame())
+
+	err = again.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, again.CurrentFrame())
+	assert.Equal(t, 9, again.GameState().IngameTick())
+}
+
+func TestSnapshot_RestoreSeekable_TradesClutchAndVote(t *testing.T) {
+	p := NewParser(fakeSeekDemo())
+
+	for i := 0; i < 5; i++ {
+		_, err := p.ParseNextFrame()
+		assert.NoError(t, err)
+	}
+
+	addPlayer := func(parser *Parser, userID int, team common.Team) *common.Player {
+		pl := common.NewPlayer(parser.demoInfoProvider)
+		pl.UserID = userID
+		pl.Team = team
+		parser.gameState.playersByUserID[userID] = pl
+
+		return pl
+	}
+
+	t1 := addPlayer(p, 2, common.TeamTerrorists)
+	ct := addPlayer(p, 3, common.TeamCounterTerrorists)
+	t2 := addPlayer(p, 4, common.TeamTerrorists)
+
+	p.handleTrades(events.Kill{Killer: ct, Victim: t1, IsHeadshot: true})
+	p.clutch = &clutch{player: t2, team: common.TeamTerrorists}
+	p.killedThisRound[t1] = true
+	p.startVote(events.VoteStart{Caller: t2, Target: ct, Team: common.TeamTerrorists})
+	p.castVote(t2, common.VoteOptionYes)
+
+	data, err := p.Snapshot()
+	assert.NoError(t, err)
+
+	restored := NewParser(fakeSeekDemo())
+	err = restored.RestoreSnapshot(data)
+	assert.NoError(t, err)
+
+	rt1 := restored.gameState.playersByUserID[2]
+	rct := restored.gameState.playersByUserID[3]
+	rt2 := restored.gameState.playersByUserID[4]
+
+	// Teams are usually restored from the player entities
+	rt1.Team = common.TeamTerrorists
+	rct.Team = common.TeamCounterTerrorists
+	rt2.Team = common.TeamTerrorists
+
+	vote := restored.GameState().ActiveVote()
+	assert.NotNil(t, vote)
+	assert.Equal(t, rt2, vote.Issuer)
+	assert.Equal(t, rct, vote.Target)
+	assert.Equal(t, common.TeamTerrorists, vote.Team)
+	assert.Equal(t, map[*common.Player]common.VoteOption{rt2: common.VoteOptionYes}, vote.Votes)
+	assert.Equal(t, map[*common.Player]bool{rt1: true}, restored.killedThisRound)
+
+	var (
+		traded []events.KillTraded
+		ended  []events.ClutchEnded
+	)
+	restored.RegisterEventHandler(func(e events.KillTraded) {
+		traded = append(traded, e)
+	})
+	restored.RegisterEventHandler(func(e events.ClutchEnded) {
+		ended = append(ended, e)
+	})
+
+	restored.handleTrades(events.Kill{Killer: rt2, Victim: rct})
+	restored.endClutch(common.TeamTerrorists)
+
+	assert.Len(t, traded, 1)
+	assert.Equal(t, events.Kill{Killer: rct, Victim: rt1, IsHeadshot: true}, traded[0].Kill)
+	assert.Equal(t, []events.ClutchEnded{{Player: rt2, Won: true}}, ended)
+}
@@ -0,0 +1,379 @@
+// Package stats calculates player statistics such as ADR, KAST and an HLTV 2.0-like rating from match summaries.
+//
+// The statistics are calculated from the rounds of a match.Match, so the quirks of demos (warmup, restarts etc.)
+// are already taken care of when they get here.
+// Trades and clutches are the ones reported by the parser (see events.KillTraded & events.ClutchStarted),
+// the trade window can be configured via demoinfocs.ParserConfig.TradeWindow.
+package stats
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/match"
+)
+
+const maxHealth = 100
+
+// PlayerKey identifies a player across rounds and matches.
+// Name is only set for players without a SteamID (bots).
+type PlayerKey struct {
+	SteamID int64
+	Name    string
+}
+
+// KeyOf returns the PlayerKey of a player.
+func KeyOf(pl *common.Player) PlayerKey {
+	if pl.SteamID != 0 {
+		return PlayerKey{SteamID: pl.SteamID}
+	}
+
+	return PlayerKey{Name: pl.Name}
+}
+
+// PlayerStats contains the statistics of a player over a number of rounds.
+type PlayerStats struct {
+	Name           string // Name during the last round that was counted
+	SteamID        int64
+	RoundsPlayed   int
+	Kills          int // Kills of enemies, team-kills aren't counted
+	Deaths         int
+	Assists        int // Assists to kills of enemies
+	HeadshotKills  int
+	Damage         int    // Health damage dealt to enemies, capped by the remaining health of the victims
+	KASTRounds     int    // Rounds with a kill, assist, survival or traded death
+	OpeningKills   int    // First kill of a round
+	OpeningDeaths  int    // First death of a round
+	TradeKills     int    // Kills of enemies that traded the death of a teammate, see events.KillTraded
+	TradedDeaths   int    // Deaths that were traded by a teammate, see events.KillTraded
+	MultiKills     [6]int // MultiKills[n] is the number of rounds with n kills, rounds with more than five kills are counted at index 5
+	ClutchesPlayed int    // Rounds where the player was the last one alive of their team against one or more enemies, see events.ClutchStarted
+	ClutchesWon    int
+}
+
+func (ps *PlayerStats) perRound(n int) float64 {
+	if ps.RoundsPlayed == 0 {
+		return 0
+	}
+
+	return float64(n) / float64(ps.RoundsPlayed)
+}
+
+// ADR returns the average damage per round.
+func (ps *PlayerStats) ADR() float64 {
+	return ps.perRound(ps.Damage)
+}
+
+// KAST returns the fraction (0 to 1) of rounds with a kill, assist, survival or traded death.
+func (ps *PlayerStats) KAST() float64 {
+	return ps.perRound(ps.KASTRounds)
+}
+
+// KPR returns the average kills per round.
+func (ps *PlayerStats) KPR() float64 {
+	return ps.perRound(ps.Kills)
+}
+
+// DPR returns the average deaths per round.
+func (ps *PlayerStats) DPR() float64 {
+	return ps.perRound(ps.Deaths)
+}
+
+// APR returns the average assists per round.
+func (ps *PlayerStats) APR() float64 {
+	return ps.perRound(ps.Assists)
+}
+
+// Impact returns the impact rating which is based on kills and assists per round.
+func (ps *PlayerStats) Impact() float64 {
+	if ps.RoundsPlayed == 0 {
+		return 0
+	}
+
+	return 2.13*ps.KPR() + 0.42*ps.APR() - 0.41
+}
+
+// Rating returns a rating similar to HLTV's rating 2.0, where 1.0 is about average.
+// HLTV doesn't publish their formula, this uses a common approximation based on KAST, KPR, DPR, Impact and ADR.
+func (ps *PlayerStats) Rating() float64 {
+	if ps.RoundsPlayed == 0 {
+		return 0
+	}
+
+	return 0.0073*ps.KAST()*100 + 0.3591*ps.KPR() - 0.5329*ps.DPR() + 0.2372*ps.Impact() + 0.0032*ps.ADR() + 0.1587
+}
+
+func (ps *PlayerStats) add(other *PlayerStats) {
+	ps.Name = other.Name
+	ps.SteamID = other.SteamID
+	ps.RoundsPlayed += other.RoundsPlayed
+	ps.Kills += other.Kills
+	ps.Deaths += other.Deaths
+	ps.Assists += other.Assists
+	ps.HeadshotKills += other.HeadshotKills
+	ps.Damage += other.Damage
+	ps.KASTRounds += other.KASTRounds
+	ps.OpeningKills += other.OpeningKills
+	ps.OpeningDeaths += other.OpeningDeaths
+	ps.TradeKills += other.TradeKills
+	ps.TradedDeaths += other.TradedDeaths
+	ps.ClutchesPlayed += other.ClutchesPlayed
+	ps.ClutchesWon += other.ClutchesWon
+
+	for i, n := range other.MultiKills {
+		ps.MultiKills[i] += n
+	}
+}
+
+// Stats contains the statistics of all players that took part in a number of rounds.
+type Stats map[PlayerKey]*PlayerStats
+
+func (s Stats) add(other Stats) {
+	for k, ps := range other {
+		if s[k] == nil {
+			s[k] = new(PlayerStats)
+		}
+		s[k].add(ps)
+	}
+}
+
+// MapStats contains the statistics of a single match.
+type MapStats struct {
+	Map    string
+	Total  Stats
+	Halves []Stats // Halves[0] is the first half, overtime halves follow after the second half
+}
+
+// Result contains the statistics of all matches passed to Compute(), in total and per map.
+type Result struct {
+	Total Stats
+	Maps  []MapStats // In the order the matches were passed
+}
+
+// Compute calculates the statistics of all players in the given matches.
+func Compute(matches ...match.Match) Result {
+	res := Result{
+		Total: make(Stats),
+		Maps:  make([]MapStats, 0, len(matches)),
+	}
+
+	for _, m := range matches {
+		ms := MapStats{
+			Map:   m.Map,
+			Total: make(Stats),
+		}
+
+		for _, r := range m.Rounds {
+			rs := RoundStats(r)
+
+			half := r.Half
+			if half < 1 {
+				half = 1
+			}
+			for len(ms.Halves) < half {
+				ms.Halves = append(ms.Halves, make(Stats))
+			}
+
+			ms.Halves[half-1].add(rs)
+			ms.Total.add(rs)
+		}
+
+		res.Total.add(ms.Total)
+		res.Maps = append(res.Maps, ms)
+	}
+
+	return res
+}
+
+// RoundStats calculates the statistics of all players of a single round.
+// Teams are determined by Round.PlayersT and Round.PlayersCT.
+func RoundStats(r match.Round) Stats {
+	rc := newRoundCalculator(r)
+	rc.damages()
+	rc.kills()
+	rc.trades()
+	rc.clutch()
+
+	return rc.result()
+}
+
+type roundCalculator struct {
+	round   match.Round
+	teams   map[*common.Player]common.Team
+	players []*common.Player
+	stats   map[*common.Player]*PlayerStats
+
+	health      map[*common.Player]int
+	dead        map[*common.Player]bool
+	traded      map[*common.Player]bool
+	assisted    map[*common.Player]bool
+	killCount   map[*common.Player]int
+	openingDone bool
+}
+
+func newRoundCalculator(r match.Round) *roundCalculator {
+	rc := &roundCalculator{
+		round:     r,
+		teams:     make(map[*common.Player]common.Team),
+		stats:     make(map[*common.Player]*PlayerStats),
+		health:    make(map[*common.Player]int),
+		dead:      make(map[*common.Player]bool),
+		traded:    make(map[*common.Player]bool),
+		assisted:  make(map[*common.Player]bool),
+		killCount: make(map[*common.Player]int),
+	}
+
+	rc.addTeam(r.PlayersT, common.TeamTerrorists)
+	rc.addTeam(r.PlayersCT, common.TeamCounterTerrorists)
+
+	return rc
+}
+
+func (rc *roundCalculator) addTeam(players []*common.Player, team common.Team) {
+	for _, pl := range players {
+		if pl == nil || rc.stats[pl] != nil {
+			continue
+		}
+
+		rc.teams[pl] = team
+		rc.players = append(rc.players, pl)
+		rc.stats[pl] = &PlayerStats{
+			Name:         pl.Name,
+			SteamID:      pl.SteamID,
+			RoundsPlayed: 1,
+		}
+		rc.health[pl] = maxHealth
+	}
+}
+
+// isEnemy returns true if both players took part in the round and are on opposing teams.
+func (rc *roundCalculator) isEnemy(a, b *common.Player) bool {
+	teamA, okA := rc.teams[a]
+	teamB, okB := rc.teams[b]
+
+	return okA && okB && teamA != teamB
+}
+
+func (rc *roundCalculator) isTeammate(a, b *common.Player) bool {
+	teamA, okA := rc.teams[a]
+	teamB, okB := rc.teams[b]
+
+	return okA && okB && a != b && teamA == teamB
+}
+
+func (rc *roundCalculator) damages() {
+	for _, dmg := range rc.round.Damages {
+		if !rc.isEnemy(dmg.Attacker, dmg.Player) {
+			continue
+		}
+
+		dealt := dmg.HealthDamage
+		if remaining := rc.health[dmg.Player]; dealt > remaining {
+			dealt = remaining
+		}
+
+		rc.health[dmg.Player] -= dealt
+		rc.stats[dmg.Attacker].Damage += dealt
+	}
+}
+
+func (rc *roundCalculator) kills() {
+	for _, kill := range rc.round.Kills {
+		victim := kill.Victim
+		if rc.stats[victim] == nil || rc.dead[victim] {
+			continue
+		}
+
+		rc.dead[victim] = true
+		rc.stats[victim].Deaths++
+
+		if rc.isEnemy(kill.Killer, victim) {
+			killer := rc.stats[kill.Killer]
+			killer.Kills++
+			rc.killCount[kill.Killer]++
+
+			if kill.IsHeadshot {
+				killer.HeadshotKills++
+			}
+
+			if !rc.openingDone {
+				killer.OpeningKills++
+				rc.stats[victim].OpeningDeaths++
+			}
+
+			if rc.isTeammate(kill.Assister, kill.Killer) {
+				rc.stats[kill.Assister].Assists++
+				rc.assisted[kill.Assister] = true
+			}
+		}
+
+		// Deaths by the world or team-kills don't count as opening duels
+		rc.openingDone = rc.openingDone || rc.isEnemy(kill.Killer, victim)
+	}
+}
+
+// trades counts the trades that were reported by the parser.
+// A trade kill that traded multiple deaths is only counted once.
+func (rc *roundCalculator) trades() {
+	tradeKills := make(map[*common.Player]map[*common.Player]bool)
+
+	for _, trade := range rc.round.Trades {
+		victim, killer := trade.Kill.Victim, trade.TradeKill.Killer
+		if !rc.isTeammate(victim, killer) || !rc.isEnemy(killer, trade.TradeKill.Victim) {
+			continue
+		}
+
+		if !rc.traded[victim] {
+			rc.traded[victim] = true
+			rc.stats[victim].TradedDeaths++
+		}
+
+		if tradeKills[killer] == nil {
+			tradeKills[killer] = make(map[*common.Player]bool)
+		}
+
+		if !tradeKills[killer][trade.TradeKill.Victim] {
+			tradeKills[killer][trade.TradeKill.Victim] = true
+			rc.stats[killer].TradeKills++
+		}
+	}
+}
+
+// clutch counts the clutch situation that was reported by the parser.
+func (rc *roundCalculator) clutch() {
+	c := rc.round.Clutch
+	if c == nil || rc.stats[c.Player] == nil {
+		return
+	}
+
+	rc.stats[c.Player].ClutchesPlayed++
+
+	if c.Won {
+		rc.stats[c.Player].ClutchesWon++
+	}
+}
+
+func (rc *roundCalculator) result() Stats {
+	res := make(Stats, len(rc.players))
+
+	for _, pl := range rc.players {
+		ps := rc.stats[pl]
+
+		if n := rc.killCount[pl]; n > 0 {
+			if n >= len(ps.MultiKills) {
+				n = len(ps.MultiKills) - 1
+			}
+			ps.MultiKills[n]++
+		}
+
+		if rc.killCount[pl] > 0 || rc.assisted[pl] || !rc.dead[pl] || rc.traded[pl] {
+			ps.KASTRounds++
+		}
+
+		key := KeyOf(pl)
+		if res[key] == nil {
+			res[key] = new(PlayerStats)
+		}
+		res[key].add(ps)
+	}
+
+	return res
+}
@@ -0,0 +1,177 @@
+package stats_test
+
+import (
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+
+	common "github.com/markus-wa/demoinfocs-golang/common"
+	events "github.com/markus-wa/demoinfocs-golang/events"
+	match "github.com/markus-wa/demoinfocs-golang/match"
+	stats "github.com/markus-wa/demoinfocs-golang/stats"
+)
+
+func player(name string, steamID int64) *common.Player {
+	return &common.Player{Name: name, SteamID: steamID}
+}
+
+func kill(killer, victim *common.Player, t time.Duration) match.Kill {
+	return match.Kill{Kill: events.Kill{Killer: killer, Victim: victim}, Time: t}
+}
+
+func damage(attacker, victim *common.Player, dmg int) match.Damage {
+	return match.Damage{PlayerHurt: events.PlayerHurt{Attacker: attacker, Player: victim, HealthDamage: dmg}}
+}
+
+func TestRoundStats(t *testing.T) {
+	t1, t2 := player("t1", 1), player("t2", 2)
+	ct1, ct2 := player("ct1", 3), player("bot", 0)
+
+	firstKill := kill(ct1, t1, time.Second)
+	firstKill.Assister = ct2
+	firstKill.IsHeadshot = true
+
+	tradeKill := kill(t2, ct1, 3*time.Second)
+
+	r := match.Round{
+		Half:      1,
+		Winner:    common.TeamTerrorists,
+		PlayersT:  []*common.Player{t1, t2},
+		PlayersCT: []*common.Player{ct1, ct2},
+		Damages: []match.Damage{
+			damage(ct2, t1, 30),
+			damage(ct1, t1, 120),
+			damage(t2, ct1, 100),
+			damage(t2, ct2, 100),
+			damage(t2, t1, 50), // Team damage
+		},
+		Kills: []match.Kill{
+			firstKill,
+			tradeKill,
+			kill(t2, ct2, 20*time.Second),
+		},
+		Trades: []match.Trade{
+			{KillTraded: events.KillTraded{Kill: firstKill.Kill, TradeKill: tradeKill.Kill, TimeDelta: 2 * time.Second}},
+		},
+		Clutch: &match.Clutch{Player: t2, Opponents: []*common.Player{ct2}, Won: true},
+	}
+
+	s := stats.RoundStats(r)
+
+	assert.Len(t, s, 4)
+
+	st1 := s[stats.PlayerKey{SteamID: 1}]
+	assert.Equal(t, 1, st1.Deaths)
+	assert.Equal(t, 1, st1.OpeningDeaths)
+	assert.Equal(t, 1, st1.TradedDeaths)
+	assert.Equal(t, 1, st1.KASTRounds)
+
+	st2 := s[stats.PlayerKey{SteamID: 2}]
+	assert.Equal(t, "t2", st2.Name)
+	assert.Equal(t, 2, st2.Kills)
+	assert.Equal(t, 200, st2.Damage)
+	assert.Equal(t, 1, st2.TradeKills)
+	assert.Equal(t, [6]int{0, 0, 1, 0, 0, 0}, st2.MultiKills)
+	assert.Equal(t, 1, st2.ClutchesPlayed)
+	assert.Equal(t, 1, st2.ClutchesWon)
+	assert.Equal(t, 1, st2.KASTRounds)
+
+	sct1 := s[stats.PlayerKey{SteamID: 3}]
+	assert.Equal(t, 1, sct1.Kills)
+	assert.Equal(t, 1, sct1.HeadshotKills)
+	assert.Equal(t, 1, sct1.OpeningKills)
+	assert.Equal(t, 70, sct1.Damage)
+	assert.Equal(t, 1, sct1.KASTRounds)
+
+	bot := s[stats.PlayerKey{Name: "bot"}]
+	assert.Equal(t, 1, bot.Assists)
+	assert.Equal(t, 30, bot.Damage)
+	assert.Equal(t, 0, bot.ClutchesPlayed, "only the clutch reported by the parser should be counted")
+	assert.Equal(t, 1, bot.KASTRounds)
+}
+
+func TestRoundStats_TradeOfMultipleDeaths(t *testing.T) {
+	t1, t2, t3 := player("t1", 1), player("t2", 2), player("t3", 3)
+	ct1 := player("ct1", 4)
+
+	death1, death2 := kill(ct1, t1, 0), kill(ct1, t2, time.Second)
+	tradeKill := kill(t3, ct1, 2*time.Second)
+
+	r := match.Round{
+		PlayersT:  []*common.Player{t1, t2, t3},
+		PlayersCT: []*common.Player{ct1},
+		Kills:     []match.Kill{death1, death2, tradeKill},
+		Trades: []match.Trade{
+			{KillTraded: events.KillTraded{Kill: death1.Kill, TradeKill: tradeKill.Kill}},
+			{KillTraded: events.KillTraded{Kill: death2.Kill, TradeKill: tradeKill.Kill}},
+		},
+	}
+
+	s := stats.RoundStats(r)
+
+	assert.Equal(t, 1, s[stats.PlayerKey{SteamID: 1}].TradedDeaths)
+	assert.Equal(t, 1, s[stats.PlayerKey{SteamID: 2}].TradedDeaths)
+	assert.Equal(t, 1, s[stats.PlayerKey{SteamID: 3}].TradeKills)
+}
+
+func TestRoundStats_NoKAST(t *testing.T) {
+	t1, ct1 := player("t1", 1), player("ct1", 2)
+
+	r := match.Round{
+		PlayersT:  []*common.Player{t1},
+		PlayersCT: []*common.Player{ct1},
+		Kills:     []match.Kill{kill(ct1, t1, 0)},
+	}
+
+	s := stats.RoundStats(r)
+
+	assert.Equal(t, 0, s[stats.PlayerKey{SteamID: 1}].KASTRounds)
+	assert.Equal(t, 1, s[stats.PlayerKey{SteamID: 2}].KASTRounds)
+	assert.Equal(t, 0, s[stats.PlayerKey{SteamID: 2}].ClutchesPlayed)
+}
+
+func TestCompute(t *testing.T) {
+	a, b := player("a", 1), player("b", 2)
+
+	round := func(half int, killer, victim *common.Player) match.Round {
+		return match.Round{
+			Half:      half,
+			PlayersT:  []*common.Player{a},
+			PlayersCT: []*common.Player{b},
+			Damages:   []match.Damage{damage(killer, victim, 100)},
+			Kills:     []match.Kill{kill(killer, victim, 0)},
+		}
+	}
+
+	m1 := match.Match{Map: "de_dust2", Rounds: []match.Round{round(1, a, b), round(2, a, b), round(2, b, a)}}
+	m2 := match.Match{Map: "de_inferno", Rounds: []match.Round{round(1, a, b)}}
+
+	res := stats.Compute(m1, m2)
+
+	assert.Len(t, res.Maps, 2)
+	assert.Equal(t, "de_dust2", res.Maps[0].Map)
+	assert.Len(t, res.Maps[0].Halves, 2)
+	assert.Equal(t, 1, res.Maps[0].Halves[0][stats.PlayerKey{SteamID: 1}].Kills)
+	assert.Equal(t, 2, res.Maps[0].Halves[1][stats.PlayerKey{SteamID: 1}].RoundsPlayed)
+	assert.Equal(t, 2, res.Maps[0].Total[stats.PlayerKey{SteamID: 1}].Kills)
+	assert.Equal(t, "de_inferno", res.Maps[1].Map)
+
+	total := res.Total[stats.PlayerKey{SteamID: 1}]
+	assert.Equal(t, 4, total.RoundsPlayed)
+	assert.Equal(t, 3, total.Kills)
+	assert.Equal(t, 1, total.Deaths)
+	assert.Equal(t, float64(75), total.ADR())
+	assert.Equal(t, 0.75, total.KPR())
+	assert.Equal(t, 0.25, total.DPR())
+	assert.Equal(t, 0.75, total.KAST())
+	assert.InDelta(t, 1.36, total.Rating(), 0.01)
+}
+
+func TestPlayerStats_NoRounds(t *testing.T) {
+	var ps stats.PlayerStats
+
+	assert.Zero(t, ps.ADR())
+	assert.Zero(t, ps.Impact())
+	assert.Zero(t, ps.Rating())
+}
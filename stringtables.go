@@ -3,6 +3,8 @@ package demoinfocs
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 	"strconv"
 	"strings"
@@ -41,22 +43,33 @@ type playerInfo struct {
 }
 
 func (p *Parser) parseStringTables() {
+	start := p.demoPosition()
 	p.bitReader.BeginChunk(p.bitReader.ReadSignedInt(32) << 3)
 	tables := int(p.bitReader.ReadSingleByte())
 	for i := 0; i < tables; i++ {
 		tableName := p.bitReader.ReadString()
-		p.parseSingleStringTable(tableName)
+		err := p.parseSingleStringTable(tableName)
+		if err != nil {
+			// The remaining tables can't be read anymore, they are skipped by EndChunk()
+			p.recoverProblem(&StringTableError{
+				DemoPosition: DemoPosition{Offset: start},
+				Table:        tableName,
+				Err:          err,
+			})
+
+			break
+		}
 	}
 	p.processModelPreCacheUpdate()
 	p.bitReader.EndChunk()
 }
 
-func (p *Parser) parseSingleStringTable(name string) {
+func (p *Parser) parseSingleStringTable(name string) error {
 	nStrings := p.bitReader.ReadSignedInt(16)
 	for i := 0; i < nStrings; i++ {
 		stringName := p.bitReader.ReadString()
 		if len(stringName) >= 100 {
-			panic("Someone said that Roy said I should panic")
+			return fmt.Errorf("entry name is too long (%d bytes)", len(stringName))
 		}
 		if p.bitReader.ReadBit() {
 			userDataSize := p.bitReader.ReadSignedInt(16)
@@ -66,14 +79,14 @@ func (p *Parser) parseSingleStringTable(name string) {
 				player := parsePlayerInfo(bytes.NewReader(data))
 				playerIndex, err := strconv.ParseInt(stringName, 10, 64)
 				if err != nil {
-					panic("Couldn't parse playerIndex from string")
+					return fmt.Errorf("couldn't parse player index from %q", stringName)
 				}
 				p.rawPlayers[int(playerIndex)] = player
 
 			case stNameInstanceBaseline:
 				classID, err := strconv.ParseInt(stringName, 10, 64)
 				if err != nil {
-					panic("Couldn't parse id from string")
+					return fmt.Errorf("couldn't parse class ID from %q", stringName)
 				}
 				p.stParser.SetInstanceBaseline(int(classID), data)
 
@@ -96,6 +109,8 @@ func (p *Parser) parseSingleStringTable(name string) {
 			}
 		}
 	}
+
+	return nil
 }
 
 func (p *Parser) handleUpdateStringTable(tab *msg.CSVCMsg_UpdateStringTable) {
@@ -140,7 +155,8 @@ func (p *Parser) processStringTable(tab *msg.CSVCMsg_CreateStringTable) {
 	br := bit.NewSmallBitReader(bytes.NewReader(tab.StringData))
 
 	if br.ReadBit() {
-		panic("Can't decode")
+		p.recoverHandlerProblem(&StringTableError{Table: tab.Name, Err: errors.New("can't decode dictionary encoded data")})
+		return
 	}
 
 	nTmp := tab.MaxEntries
@@ -166,7 +182,8 @@ func (p *Parser) processStringTable(tab *msg.CSVCMsg_CreateStringTable) {
 
 		var entry string
 		if entryIndex < 0 || entryIndex >= int(tab.MaxEntries) {
-			panic("Something went to shit")
+			p.recoverHandlerProblem(&StringTableError{Table: tab.Name, Err: fmt.Errorf("entry index %d out of range", entryIndex)})
+			return
 		}
 		if br.ReadBit() {
 			if br.ReadBit() {
@@ -206,7 +223,8 @@ func (p *Parser) processStringTable(tab *msg.CSVCMsg_CreateStringTable) {
 		case stNameInstanceBaseline:
 			classID, err := strconv.ParseInt(entry, 10, 64)
 			if err != nil {
-				panic("WTF VOLVO PLS")
+				p.recoverHandlerProblem(&StringTableError{Table: tab.Name, Err: fmt.Errorf("couldn't parse class ID from %q", entry)})
+				return
 			}
 			p.stParser.SetInstanceBaseline(int(classID), userdata)
 
@@ -0,0 +1,374 @@
+package ticks
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// ParquetWriter errors
+var (
+	// ErrWriterClosed signals that rows were written to a ParquetWriter after Close() was called.
+	ErrWriterClosed = errors.New("rows written after Close() (ErrWriterClosed)")
+)
+
+// DefaultRowGroupSize is the default number of rows per row-group of a ParquetWriter.
+const DefaultRowGroupSize = 64 * 1024
+
+const parquetMagic = "PAR1"
+
+// Parquet physical types & other enum values, see https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift
+const (
+	parquetBoolean   = 0
+	parquetInt32     = 1
+	parquetInt64     = 2
+	parquetFloat     = 4
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	parquetRequired      = 0
+	parquetConvertedUTF8 = 0
+	parquetConvertedNone = -1
+	parquetEncodingPlain = 0
+	parquetEncodingRLE   = 3
+	parquetDataPage      = 0
+	parquetUncompressed  = 0
+)
+
+// parquetColumn describes a column of the Parquet schema.
+type parquetColumn struct {
+	name          string
+	physicalType  int32
+	convertedType int32 // parquetConvertedNone if there is none
+	encode        func(buf *bytes.Buffer, rows []Row)
+}
+
+func int32Column(name string, value func(*Row) int32) parquetColumn {
+	return parquetColumn{name, parquetInt32, parquetConvertedNone, func(buf *bytes.Buffer, rows []Row) {
+		for i := range rows {
+			binary.Write(buf, binary.LittleEndian, value(&rows[i]))
+		}
+	}}
+}
+
+func int64Column(name string, value func(*Row) int64) parquetColumn {
+	return parquetColumn{name, parquetInt64, parquetConvertedNone, func(buf *bytes.Buffer, rows []Row) {
+		for i := range rows {
+			binary.Write(buf, binary.LittleEndian, value(&rows[i]))
+		}
+	}}
+}
+
+func floatColumn(name string, value func(*Row) float32) parquetColumn {
+	return parquetColumn{name, parquetFloat, parquetConvertedNone, func(buf *bytes.Buffer, rows []Row) {
+		for i := range rows {
+			binary.Write(buf, binary.LittleEndian, math.Float32bits(value(&rows[i])))
+		}
+	}}
+}
+
+func doubleColumn(name string, value func(*Row) float64) parquetColumn {
+	return parquetColumn{name, parquetDouble, parquetConvertedNone, func(buf *bytes.Buffer, rows []Row) {
+		for i := range rows {
+			binary.Write(buf, binary.LittleEndian, math.Float64bits(value(&rows[i])))
+		}
+	}}
+}
+
+func stringColumn(name string, value func(*Row) string) parquetColumn {
+	return parquetColumn{name, parquetByteArray, parquetConvertedUTF8, func(buf *bytes.Buffer, rows []Row) {
+		for i := range rows {
+			s := value(&rows[i])
+			binary.Write(buf, binary.LittleEndian, uint32(len(s)))
+			buf.WriteString(s)
+		}
+	}}
+}
+
+// boolColumn values are bit-packed, LSB first.
+func boolColumn(name string, value func(*Row) bool) parquetColumn {
+	return parquetColumn{name, parquetBoolean, parquetConvertedNone, func(buf *bytes.Buffer, rows []Row) {
+		var b byte
+		for i := range rows {
+			if value(&rows[i]) {
+				b |= 1 << uint(i%8)
+			}
+			if i%8 == 7 {
+				buf.WriteByte(b)
+				b = 0
+			}
+		}
+		if len(rows)%8 != 0 {
+			buf.WriteByte(b)
+		}
+	}}
+}
+
+// parquetColumns is the schema of the files written by ParquetWriter, all columns are required.
+var parquetColumns = []parquetColumn{
+	int32Column("tick", func(r *Row) int32 { return int32(r.Tick) }),
+	int64Column("steam_id", func(r *Row) int64 { return r.SteamID }),
+	int32Column("user_id", func(r *Row) int32 { return int32(r.UserID) }),
+	stringColumn("name", func(r *Row) string { return r.Name }),
+	int32Column("team", func(r *Row) int32 { return int32(r.Team) }),
+	doubleColumn("position_x", func(r *Row) float64 { return r.Position[0] }),
+	doubleColumn("position_y", func(r *Row) float64 { return r.Position[1] }),
+	doubleColumn("position_z", func(r *Row) float64 { return r.Position[2] }),
+	doubleColumn("velocity_x", func(r *Row) float64 { return r.Velocity[0] }),
+	doubleColumn("velocity_y", func(r *Row) float64 { return r.Velocity[1] }),
+	doubleColumn("velocity_z", func(r *Row) float64 { return r.Velocity[2] }),
+	floatColumn("view_direction_x", func(r *Row) float32 { return r.ViewDirection[0] }),
+	floatColumn("view_direction_y", func(r *Row) float32 { return r.ViewDirection[1] }),
+	int32Column("hp", func(r *Row) int32 { return int32(r.Hp) }),
+	int32Column("armor", func(r *Row) int32 { return int32(r.Armor) }),
+	int32Column("money", func(r *Row) int32 { return int32(r.Money) }),
+	stringColumn("active_weapon", func(r *Row) string { return r.ActiveWeapon.String() }),
+	boolColumn("is_alive", func(r *Row) bool { return r.IsAlive }),
+	boolColumn("is_ducking", func(r *Row) bool { return r.IsDucking }),
+	boolColumn("is_scoped", func(r *Row) bool { return r.IsScoped }),
+	boolColumn("is_airborne", func(r *Row) bool { return r.IsAirborne }),
+	int64Column("spotted_by_mask", func(r *Row) int64 { return int64(r.SpottedByMask) }),
+}
+
+type parquetColumnChunk struct {
+	offset int64
+	size   int64 // Including the page header
+}
+
+type parquetRowGroup struct {
+	numRows int64
+	columns []parquetColumnChunk
+}
+
+/*
+ParquetWriter is a RowWriter that writes the rows to a Parquet file.
+
+Rows are buffered until a row-group is full, so the memory usage depends on the row-group size and not on the demo.
+The file is only valid after Close() has been called.
+
+The file uses plain encoding without compression, which can easily be converted with other tools if needed.
+*/
+type ParquetWriter struct {
+	w            io.Writer
+	rowGroupSize int
+	rows         []Row
+	rowGroups    []parquetRowGroup
+	offset       int64
+	closed       bool
+}
+
+// NewParquetWriter creates a ParquetWriter that writes to w.
+// rowGroupSize is the maximum number of rows per row-group, DefaultRowGroupSize is used if it's < 1.
+func NewParquetWriter(w io.Writer, rowGroupSize int) *ParquetWriter {
+	if rowGroupSize < 1 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+
+	return &ParquetWriter{
+		w:            w,
+		rowGroupSize: rowGroupSize,
+	}
+}
+
+// WriteRows implements RowWriter.
+func (pw *ParquetWriter) WriteRows(rows []Row) error {
+	if pw.closed {
+		return ErrWriterClosed
+	}
+
+	for len(rows) > 0 {
+		n := pw.rowGroupSize - len(pw.rows)
+		if n > len(rows) {
+			n = len(rows)
+		}
+
+		pw.rows = append(pw.rows, rows[:n]...)
+		rows = rows[n:]
+
+		if len(pw.rows) == pw.rowGroupSize {
+			err := pw.flushRowGroup()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Close writes the remaining rows and the file metadata.
+// It doesn't close the underlying io.Writer.
+func (pw *ParquetWriter) Close() error {
+	if pw.closed {
+		return nil
+	}
+
+	err := pw.flushRowGroup()
+	if err != nil {
+		return err
+	}
+
+	pw.closed = true
+
+	err = pw.writeMagic()
+	if err != nil {
+		return err
+	}
+
+	meta := pw.fileMetaData()
+
+	err = pw.write(meta)
+	if err != nil {
+		return err
+	}
+
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(meta)))
+
+	err = pw.write(length[:])
+	if err != nil {
+		return err
+	}
+
+	return pw.write([]byte(parquetMagic))
+}
+
+func (pw *ParquetWriter) write(b []byte) error {
+	n, err := pw.w.Write(b)
+	pw.offset += int64(n)
+
+	return err
+}
+
+// writeMagic writes the leading magic bytes if nothing has been written yet.
+func (pw *ParquetWriter) writeMagic() error {
+	if pw.offset > 0 {
+		return nil
+	}
+
+	return pw.write([]byte(parquetMagic))
+}
+
+func (pw *ParquetWriter) flushRowGroup() error {
+	if len(pw.rows) == 0 {
+		return nil
+	}
+
+	err := pw.writeMagic()
+	if err != nil {
+		return err
+	}
+
+	rg := parquetRowGroup{
+		numRows: int64(len(pw.rows)),
+		columns: make([]parquetColumnChunk, len(parquetColumns)),
+	}
+
+	var page bytes.Buffer
+	for i, col := range parquetColumns {
+		page.Reset()
+		col.encode(&page, pw.rows)
+
+		header := dataPageHeader(page.Len(), len(pw.rows))
+
+		rg.columns[i] = parquetColumnChunk{
+			offset: pw.offset,
+			size:   int64(len(header) + page.Len()),
+		}
+
+		err = pw.write(header)
+		if err != nil {
+			return err
+		}
+
+		err = pw.write(page.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	pw.rowGroups = append(pw.rowGroups, rg)
+	pw.rows = pw.rows[:0]
+
+	return nil
+}
+
+func dataPageHeader(size, numValues int) []byte {
+	tw := newThriftWriter()
+
+	tw.i32Field(1, parquetDataPage)
+	tw.i32Field(2, int32(size)) // Uncompressed size
+	tw.i32Field(3, int32(size)) // Compressed size
+	tw.structField(5, func() {
+		tw.i32Field(1, int32(numValues))
+		tw.i32Field(2, parquetEncodingPlain)
+		tw.i32Field(3, parquetEncodingRLE) // Definition levels (unused, all columns are required)
+		tw.i32Field(4, parquetEncodingRLE) // Repetition levels (unused)
+	})
+
+	return tw.bytes()
+}
+
+func (pw *ParquetWriter) fileMetaData() []byte {
+	tw := newThriftWriter()
+
+	var numRows int64
+	for _, rg := range pw.rowGroups {
+		numRows += rg.numRows
+	}
+
+	tw.i32Field(1, 1) // Version
+
+	// Schema, the root element is followed by the columns
+	tw.structListField(2, len(parquetColumns)+1, func(i int) {
+		if i == 0 {
+			tw.stringField(4, "schema")
+			tw.i32Field(5, int32(len(parquetColumns)))
+			return
+		}
+
+		col := parquetColumns[i-1]
+		tw.i32Field(1, col.physicalType)
+		tw.i32Field(3, parquetRequired)
+		tw.stringField(4, col.name)
+		if col.convertedType != parquetConvertedNone {
+			tw.i32Field(6, col.convertedType)
+		}
+	})
+
+	tw.i64Field(3, numRows)
+
+	tw.structListField(4, len(pw.rowGroups), func(i int) {
+		rg := pw.rowGroups[i]
+
+		var totalSize int64
+		for _, c := range rg.columns {
+			totalSize += c.size
+		}
+
+		tw.structListField(1, len(rg.columns), func(j int) {
+			col := parquetColumns[j]
+			chunk := rg.columns[j]
+
+			tw.i64Field(2, chunk.offset)
+			tw.structField(3, func() {
+				tw.i32Field(1, col.physicalType)
+				tw.i32ListField(2, parquetEncodingPlain, parquetEncodingRLE)
+				tw.stringListField(3, col.name)
+				tw.i32Field(4, parquetUncompressed)
+				tw.i64Field(5, rg.numRows)
+				tw.i64Field(6, chunk.size)
+				tw.i64Field(7, chunk.size)
+				tw.i64Field(9, chunk.offset)
+			})
+		})
+		tw.i64Field(2, totalSize)
+		tw.i64Field(3, rg.numRows)
+	})
+
+	tw.stringField(6, "demoinfocs-golang")
+
+	return tw.bytes()
+}
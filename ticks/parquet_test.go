@@ -0,0 +1,92 @@
+package ticks
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	pw := NewParquetWriter(&buf, 2)
+
+	assert.NoError(t, pw.WriteRows([]Row{{Tick: 1}, {Tick: 1}, {Tick: 1}}))
+	assert.Len(t, pw.rowGroups, 1)
+
+	assert.NoError(t, pw.WriteRows([]Row{{Tick: 2, Name: "Player", IsAlive: true}}))
+	assert.NoError(t, pw.Close())
+
+	b := buf.Bytes()
+	assert.Equal(t, parquetMagic, string(b[:4]))
+	assert.Equal(t, parquetMagic, string(b[len(b)-4:]))
+
+	metaLen := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	metaStart := len(b) - 8 - metaLen
+	assert.Equal(t, int64(metaStart), pw.offset-8-int64(metaLen))
+
+	assert.Len(t, pw.rowGroups, 2)
+	assert.Equal(t, int64(2), pw.rowGroups[0].numRows)
+	assert.Equal(t, int64(2), pw.rowGroups[1].numRows)
+	assert.Equal(t, int64(4), pw.rowGroups[0].columns[0].offset, "first column chunk should start after the magic bytes")
+
+	// Column chunks must be contiguous
+	end := pw.rowGroups[1].columns[len(parquetColumns)-1]
+	assert.Equal(t, int64(metaStart), end.offset+end.size)
+
+	assert.Equal(t, ErrWriterClosed, pw.WriteRows([]Row{{}}))
+}
+
+func TestParquetWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	pw := NewParquetWriter(&buf, 0)
+
+	assert.NoError(t, pw.Close())
+	assert.Equal(t, DefaultRowGroupSize, pw.rowGroupSize)
+	assert.Equal(t, parquetMagic, buf.String()[:4])
+}
+
+func TestBoolColumn(t *testing.T) {
+	col := boolColumn("test", func(r *Row) bool { return r.IsAlive })
+
+	rows := make([]Row, 10)
+	rows[0].IsAlive = true
+	rows[7].IsAlive = true
+	rows[9].IsAlive = true
+
+	var buf bytes.Buffer
+	col.encode(&buf, rows)
+
+	assert.Equal(t, []byte{0x81, 0x02}, buf.Bytes())
+}
+
+func TestStringColumn(t *testing.T) {
+	col := stringColumn("test", func(r *Row) string { return r.Name })
+
+	var buf bytes.Buffer
+	col.encode(&buf, []Row{{Name: "ab"}})
+
+	assert.Equal(t, []byte{2, 0, 0, 0, 'a', 'b'}, buf.Bytes())
+}
+
+func TestThriftWriter(t *testing.T) {
+	tw := newThriftWriter()
+
+	tw.i32Field(1, 5)
+	tw.i64Field(20, -1)
+	tw.structField(21, func() {
+		tw.stringField(1, "a")
+	})
+	tw.i32ListField(22, 1, 2)
+
+	assert.Equal(t, []byte{
+		0x15, 0x0a, // Field 1, i32 5
+		0x06, 0x28, 0x01, // Field 20 (long form), i64 -1
+		0x1c,            // Field 21 (delta 1), struct
+		0x18, 0x01, 'a', // Field 1, binary "a"
+		0x00,                   // Struct stop
+		0x19, 0x25, 0x02, 0x04, // Field 22, list of 2 i32
+		0x00, // Stop
+	}, tw.bytes())
+}
@@ -0,0 +1,32 @@
+module github.com/markus-wa/demoinfocs-golang/ticks/roundtrip
+
+go 1.24.9
+
+replace github.com/markus-wa/demoinfocs-golang => ../..
+
+require (
+	github.com/markus-wa/demoinfocs-golang v0.0.0-00010101000000-000000000000
+	github.com/parquet-go/parquet-go v0.32.0
+	github.com/stretchr/testify v1.4.0
+)
+
+require (
+	github.com/andybalholm/brotli v1.1.1 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/gogo/protobuf v1.3.1 // indirect
+	github.com/golang/geo v0.0.0-20190916061304-5b978397cfec // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/klauspost/compress v1.17.9 // indirect
+	github.com/markus-wa/go-unassert v0.1.1 // indirect
+	github.com/markus-wa/gobitread v0.2.2 // indirect
+	github.com/markus-wa/godispatch v1.1.0 // indirect
+	github.com/markus-wa/quickhull-go/v2 v2.1.0 // indirect
+	github.com/parquet-go/bitpack v1.0.0 // indirect
+	github.com/parquet-go/jsonlite v1.0.0 // indirect
+	github.com/pierrec/lz4/v4 v4.1.21 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/twpayne/go-geom v1.6.1 // indirect
+	golang.org/x/sys v0.38.0 // indirect
+	google.golang.org/protobuf v1.34.2 // indirect
+	gopkg.in/yaml.v2 v2.2.2 // indirect
+)
@@ -0,0 +1,122 @@
+// Package roundtrip checks that files written by ticks.ParquetWriter can be read by a real Parquet implementation.
+//
+// It's a separate module so the main module doesn't depend on a Parquet library (and its minimum Go version).
+// Run it with 'go test ./...' from this directory.
+package roundtrip
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/ticks"
+)
+
+// row is a row as it's read back from the Parquet file.
+type row struct {
+	Tick           int32   `parquet:"tick"`
+	SteamID        int64   `parquet:"steam_id"`
+	UserID         int32   `parquet:"user_id"`
+	Name           string  `parquet:"name"`
+	Team           int32   `parquet:"team"`
+	PositionX      float64 `parquet:"position_x"`
+	PositionY      float64 `parquet:"position_y"`
+	PositionZ      float64 `parquet:"position_z"`
+	VelocityX      float64 `parquet:"velocity_x"`
+	VelocityY      float64 `parquet:"velocity_y"`
+	VelocityZ      float64 `parquet:"velocity_z"`
+	ViewDirectionX float32 `parquet:"view_direction_x"`
+	ViewDirectionY float32 `parquet:"view_direction_y"`
+	Hp             int32   `parquet:"hp"`
+	Armor          int32   `parquet:"armor"`
+	Money          int32   `parquet:"money"`
+	ActiveWeapon   string  `parquet:"active_weapon"`
+	IsAlive        bool    `parquet:"is_alive"`
+	IsDucking      bool    `parquet:"is_ducking"`
+	IsScoped       bool    `parquet:"is_scoped"`
+	IsAirborne     bool    `parquet:"is_airborne"`
+	SpottedByMask  int64   `parquet:"spotted_by_mask"`
+}
+
+func TestParquetWriter_RoundTrip(t *testing.T) {
+	rows := make([]ticks.Row, 21)
+	for i := range rows {
+		rows[i] = ticks.Row{
+			Tick:          i / 10,
+			SteamID:       76561198000000000 + int64(i),
+			UserID:        i + 2,
+			Name:          "Player " + string(rune('A'+i)),
+			Team:          common.TeamTerrorists,
+			Position:      [3]float64{float64(i), -float64(i), 0.5},
+			Velocity:      [3]float64{250, 0, -float64(i)},
+			ViewDirection: [2]float32{float32(i) * 10, -5},
+			Hp:            100 - i,
+			Armor:         i,
+			Money:         800 * i,
+			ActiveWeapon:  common.EqAK47,
+			IsAlive:       i%2 == 0,
+			IsDucking:     i%3 == 0,
+			IsScoped:      i == 20,
+			IsAirborne:    i%7 == 0,
+			SpottedByMask: 1 << uint(i),
+		}
+	}
+
+	var buf bytes.Buffer
+	// Multiple row-groups, the last one is incomplete
+	pw := ticks.NewParquetWriter(&buf, 8)
+	assert.NoError(t, pw.WriteRows(rows[:15]))
+	assert.NoError(t, pw.WriteRows(rows[15:]))
+	assert.NoError(t, pw.Close())
+
+	f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(rows)), f.NumRows())
+	assert.Len(t, f.RowGroups(), 3)
+
+	actual, err := parquet.Read[row](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	assert.Len(t, actual, len(rows))
+
+	for i, r := range rows {
+		assert.Equal(t, row{
+			Tick:           int32(r.Tick),
+			SteamID:        r.SteamID,
+			UserID:         int32(r.UserID),
+			Name:           r.Name,
+			Team:           int32(r.Team),
+			PositionX:      r.Position[0],
+			PositionY:      r.Position[1],
+			PositionZ:      r.Position[2],
+			VelocityX:      r.Velocity[0],
+			VelocityY:      r.Velocity[1],
+			VelocityZ:      r.Velocity[2],
+			ViewDirectionX: r.ViewDirection[0],
+			ViewDirectionY: r.ViewDirection[1],
+			Hp:             int32(r.Hp),
+			Armor:          int32(r.Armor),
+			Money:          int32(r.Money),
+			ActiveWeapon:   r.ActiveWeapon.String(),
+			IsAlive:        r.IsAlive,
+			IsDucking:      r.IsDucking,
+			IsScoped:       r.IsScoped,
+			IsAirborne:     r.IsAirborne,
+			SpottedByMask:  int64(r.SpottedByMask),
+		}, actual[i], "row %d", i)
+	}
+}
+
+func TestParquetWriter_RoundTrip_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	pw := ticks.NewParquetWriter(&buf, 0)
+	assert.NoError(t, pw.Close())
+
+	f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+	assert.NoError(t, err)
+	assert.Zero(t, f.NumRows())
+	assert.Len(t, f.Schema().Fields(), 22)
+}
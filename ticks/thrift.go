@@ -0,0 +1,120 @@
+package ticks
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Thrift compact protocol types, see https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes the subset of the Thrift compact protocol needed for Parquet metadata.
+type thriftWriter struct {
+	buf       bytes.Buffer
+	lastField []int16 // Stack of the last written field IDs, one entry per nested struct
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{lastField: []int16{0}}
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	w.buf.Write(b[:n])
+}
+
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) fieldHeader(id int16, typ byte) {
+	last := &w.lastField[len(w.lastField)-1]
+
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.zigzag(int64(id))
+	}
+
+	*last = id
+}
+
+func (w *thriftWriter) i32Field(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64Field(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) stringField(id int16, s string) {
+	w.fieldHeader(id, thriftBinary)
+	w.stringValue(s)
+}
+
+func (w *thriftWriter) stringValue(s string) {
+	w.varint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+// structField writes a nested struct, the fields are written by f.
+func (w *thriftWriter) structField(id int16, f func()) {
+	w.fieldHeader(id, thriftStruct)
+	w.structValue(f)
+}
+
+func (w *thriftWriter) structValue(f func()) {
+	w.lastField = append(w.lastField, 0)
+	f()
+	w.buf.WriteByte(0) // Stop
+	w.lastField = w.lastField[:len(w.lastField)-1]
+}
+
+func (w *thriftWriter) listHeader(id int16, elemType byte, n int) {
+	w.fieldHeader(id, thriftList)
+
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | elemType)
+	} else {
+		w.buf.WriteByte(0xf0 | elemType)
+		w.varint(uint64(n))
+	}
+}
+
+func (w *thriftWriter) i32ListField(id int16, values ...int32) {
+	w.listHeader(id, thriftI32, len(values))
+	for _, v := range values {
+		w.zigzag(int64(v))
+	}
+}
+
+func (w *thriftWriter) stringListField(id int16, values ...string) {
+	w.listHeader(id, thriftBinary, len(values))
+	for _, v := range values {
+		w.stringValue(v)
+	}
+}
+
+// structListField writes a list of n structs, the fields of the i-th struct are written by f(i).
+func (w *thriftWriter) structListField(id int16, n int, f func(i int)) {
+	w.listHeader(id, thriftStruct, n)
+	for i := 0; i < n; i++ {
+		w.structValue(func() { f(i) })
+	}
+}
+
+// bytes returns the encoded top-level struct (including the stop byte).
+func (w *thriftWriter) bytes() []byte {
+	w.buf.WriteByte(0)
+	return w.buf.Bytes()
+}
@@ -0,0 +1,145 @@
+// Package ticks samples the state of all players every N ticks and streams it to a RowWriter.
+//
+// This can be used to create dense tick tables (e.g. for machine learning) without keeping the whole demo in memory.
+// ParquetWriter writes the rows to a Parquet file.
+package ticks
+
+import (
+	dem "github.com/markus-wa/demoinfocs-golang"
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+	st "github.com/markus-wa/demoinfocs-golang/sendtables"
+)
+
+// Row contains the state of a single player at a specific tick.
+type Row struct {
+	Tick          int
+	SteamID       int64
+	UserID        int
+	Name          string
+	Team          common.Team
+	Position      [3]float64
+	Velocity      [3]float64
+	ViewDirection [2]float32 // X (yaw) and Y (pitch), see Player.ViewDirectionX/Y
+	Hp            int
+	Armor         int
+	Money         int
+	ActiveWeapon  common.EquipmentElement // EqUnknown if the player has no active weapon
+	IsAlive       bool
+	IsDucking     bool
+	IsScoped      bool
+	IsAirborne    bool
+	SpottedByMask uint64 // Bit n is set if the player is spotted by the player with entity-ID n+1
+}
+
+// RowWriter is implemented by the sinks of a Sampler.
+type RowWriter interface {
+	// WriteRows writes the rows of a tick.
+	// The slice is re-used after the call returns so it must not be retained.
+	WriteRows(rows []Row) error
+}
+
+// Sampler writes the state of all playing players to a RowWriter every N ticks.
+// Use NewSampler() to create one.
+type Sampler struct {
+	parser   dem.IParser
+	w        RowWriter
+	interval int
+	lastTick int
+	rows     []Row
+	err      error
+}
+
+// NewSampler creates a Sampler that writes a row per playing player to w every interval ticks.
+// The sampling happens at the end of the frames, so if the demo was recorded at a lower rate than the tick-rate
+// the time between the sampled ticks may be slightly longer.
+//
+// Sampling stops at the first error returned by w, see Err().
+func NewSampler(parser dem.IParser, w RowWriter, interval int) *Sampler {
+	if interval < 1 {
+		interval = 1
+	}
+
+	s := &Sampler{
+		parser:   parser,
+		w:        w,
+		interval: interval,
+		lastTick: -1,
+	}
+
+	parser.RegisterEventHandler(s.onFrameDone)
+
+	return s
+}
+
+// Err returns the first error returned by the RowWriter, if any.
+func (s *Sampler) Err() error {
+	return s.err
+}
+
+func (s *Sampler) onFrameDone(events.FrameDone) {
+	if s.err != nil {
+		return
+	}
+
+	tick := s.parser.GameState().IngameTick()
+
+	// Ticks going backwards means the parser was moved to an earlier position
+	if s.lastTick >= 0 && tick >= s.lastTick && tick < s.lastTick+s.interval {
+		return
+	}
+
+	s.lastTick = tick
+	s.rows = s.rows[:0]
+
+	for _, pl := range s.parser.GameState().Participants().Playing() {
+		s.rows = append(s.rows, newRow(tick, pl))
+	}
+
+	if len(s.rows) == 0 {
+		return
+	}
+
+	s.err = s.w.WriteRows(s.rows)
+}
+
+func newRow(tick int, pl *common.Player) Row {
+	row := Row{
+		Tick:          tick,
+		SteamID:       pl.SteamID,
+		UserID:        pl.UserID,
+		Name:          pl.Name,
+		Team:          pl.Team,
+		Position:      [3]float64{pl.Position.X, pl.Position.Y, pl.Position.Z},
+		Velocity:      [3]float64{pl.Velocity.X, pl.Velocity.Y, pl.Velocity.Z},
+		ViewDirection: [2]float32{pl.ViewDirectionX, pl.ViewDirectionY},
+		Hp:            pl.Hp,
+		Armor:         pl.Armor,
+		Money:         pl.Money,
+		IsAlive:       pl.IsAlive(),
+		IsDucking:     pl.IsDucking,
+		IsAirborne:    pl.IsAirborne(),
+	}
+
+	if wep := pl.ActiveWeapon(); wep != nil {
+		row.ActiveWeapon = wep.Weapon
+	}
+
+	if pl.Entity != nil {
+		row.IsScoped = propertyInt(pl.Entity, "m_bIsScoped") == 1
+		row.SpottedByMask = uint64(uint32(propertyInt(pl.Entity, "m_bSpottedByMask.000"))) |
+			uint64(uint32(propertyInt(pl.Entity, "m_bSpottedByMask.001")))<<32
+	}
+
+	return row
+}
+
+// propertyInt returns the integer value of an entity's property, 0 if the entity doesn't have the property.
+func propertyInt(entity st.IEntity, name string) int {
+	prop := entity.FindPropertyI(name)
+	if prop == nil {
+		return 0
+	}
+
+	return prop.Value().IntVal
+}
@@ -0,0 +1,121 @@
+package ticks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+	"github.com/markus-wa/demoinfocs-golang/fake"
+	st "github.com/markus-wa/demoinfocs-golang/sendtables"
+	stfake "github.com/markus-wa/demoinfocs-golang/sendtables/fake"
+)
+
+type rowRecorder struct {
+	rows []Row
+	err  error
+}
+
+func (rr *rowRecorder) WriteRows(rows []Row) error {
+	rr.rows = append(rr.rows, rows...)
+	return rr.err
+}
+
+func newFakeParser(ticks []int, players []*common.Player) *fake.Parser {
+	p := fake.NewParser()
+	gs := new(fake.GameState)
+	ptcp := new(fake.Participants)
+
+	p.On("GameState").Return(gs)
+	p.On("ParseToEnd").Return(nil)
+	gs.On("Participants").Return(ptcp)
+	ptcp.On("Playing").Return(players)
+
+	for _, tick := range ticks {
+		gs.On("IngameTick").Return(tick).Once()
+		p.MockEvents(events.FrameDone{})
+	}
+
+	return p
+}
+
+func TestSampler(t *testing.T) {
+	pl := &common.Player{
+		SteamID:  76561198000000000,
+		UserID:   2,
+		Name:     "Player",
+		Team:     common.TeamCounterTerrorists,
+		Position: r3.Vector{X: 1, Y: 2, Z: 3},
+		Hp:       100,
+		Money:    800,
+	}
+
+	p := newFakeParser([]int{1, 2, 3, 5, 6, 9, 2}, []*common.Player{pl})
+	rec := new(rowRecorder)
+	s := NewSampler(p, rec, 4)
+
+	assert.NoError(t, p.ParseToEnd())
+	assert.NoError(t, s.Err())
+
+	var ticks []int
+	for _, r := range rec.rows {
+		ticks = append(ticks, r.Tick)
+	}
+
+	// 2 is sampled again after going backwards
+	assert.Equal(t, []int{1, 5, 9, 2}, ticks)
+	assert.Equal(t, Row{
+		Tick:     1,
+		SteamID:  76561198000000000,
+		UserID:   2,
+		Name:     "Player",
+		Team:     common.TeamCounterTerrorists,
+		Position: [3]float64{1, 2, 3},
+		Hp:       100,
+		Money:    800,
+		IsAlive:  true,
+	}, rec.rows[0])
+}
+
+func TestSampler_Error(t *testing.T) {
+	p := newFakeParser([]int{1, 2}, []*common.Player{new(common.Player)})
+	rec := &rowRecorder{err: errors.New("test")}
+	s := NewSampler(p, rec, 1)
+
+	assert.NoError(t, p.ParseToEnd())
+	assert.Equal(t, rec.err, s.Err())
+	assert.Len(t, rec.rows, 1)
+}
+
+func TestSampler_MissingProperties(t *testing.T) {
+	entity := new(stfake.Entity)
+	entity.On("FindPropertyI", "m_hGroundEntity").Return(fakeProperty(0))
+	entity.On("FindPropertyI", "m_bIsScoped").Return(fakeProperty(1))
+	entity.On("FindPropertyI", "m_bSpottedByMask.000").Return(fakeProperty(3))
+	entity.On("FindPropertyI", "m_bSpottedByMask.001").Return(nil)
+
+	pl := &common.Player{
+		UserID: 2,
+		Entity: entity,
+	}
+
+	p := newFakeParser([]int{1}, []*common.Player{pl})
+	rec := new(rowRecorder)
+	s := NewSampler(p, rec, 1)
+
+	assert.NoError(t, p.ParseToEnd())
+	assert.NoError(t, s.Err())
+	assert.Len(t, rec.rows, 1)
+	assert.True(t, rec.rows[0].IsScoped)
+	assert.Equal(t, uint64(3), rec.rows[0].SpottedByMask)
+}
+
+func fakeProperty(intVal int) *stfake.Property {
+	prop := new(stfake.Property)
+	prop.On("Value").Return(st.PropertyValue{IntVal: intVal})
+
+	return prop
+}
@@ -0,0 +1,73 @@
+package demoinfocs
+
+import (
+	"time"
+
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// recentKill is a kill that may still be traded.
+type recentKill struct {
+	kill events.Kill
+	tick int
+	time time.Duration
+}
+
+// handleTrades dispatches events.KillTraded for all recent kills that are traded by the given kill
+// and remembers the kill so it can be traded itself.
+func (p *Parser) handleTrades(kill events.Kill) {
+	if p.tradeWindow < 0 {
+		return
+	}
+
+	tick := p.gameState.ingameTick
+	now := p.CurrentTime()
+
+	kept := p.recentKills[:0]
+
+	for _, rk := range p.recentKills {
+		delta := p.timeBetween(rk, tick, now)
+		if delta > p.tradeWindow {
+			continue
+		}
+
+		kept = append(kept, rk)
+
+		if isTrade(rk.kill, kill) {
+			p.eventDispatcher.Dispatch(events.KillTraded{
+				Kill:      rk.kill,
+				TradeKill: kill,
+				TimeDelta: delta,
+			})
+		}
+	}
+
+	p.recentKills = append(kept, recentKill{
+		kill: kill,
+		tick: tick,
+		time: now,
+	})
+}
+
+// timeBetween returns the time since the recent kill.
+// It's based on the ingame ticks if the tick-rate is known and on the demo's frames otherwise.
+func (p *Parser) timeBetween(rk recentKill, tick int, now time.Duration) time.Duration {
+	if p.header.PlaybackTicks > 0 && p.header.PlaybackTime > 0 && tick >= rk.tick {
+		return time.Duration(tick-rk.tick) * p.header.TickTime()
+	}
+
+	return now - rk.time
+}
+
+// isTrade returns true if the trade kill killed the killer of the earlier kill
+// and was made by a teammate of the earlier kill's victim.
+func isTrade(earlier, trade events.Kill) bool {
+	if earlier.Killer == nil || earlier.Victim == nil || trade.Killer == nil {
+		return false
+	}
+
+	return trade.Victim == earlier.Killer &&
+		trade.Killer != earlier.Victim &&
+		trade.Killer.Team == earlier.Victim.Team &&
+		earlier.Killer.Team != earlier.Victim.Team
+}
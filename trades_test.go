@@ -0,0 +1,85 @@
+package demoinfocs
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+func newTradeTestParser(config ParserConfig) *Parser {
+	p := NewParserWithConfig(rand.Reader, config)
+	p.header = &common.DemoHeader{
+		PlaybackTime:   10 * time.Second,
+		PlaybackTicks:  1280,
+		PlaybackFrames: 640,
+	}
+
+	return p
+}
+
+func TestHandleTrades(t *testing.T) {
+	p := newTradeTestParser(DefaultParserConfig)
+
+	t1 := &common.Player{Name: "t1", Team: common.TeamTerrorists}
+	t2 := &common.Player{Name: "t2", Team: common.TeamTerrorists}
+	ct := &common.Player{Name: "ct", Team: common.TeamCounterTerrorists}
+
+	var traded []events.KillTraded
+	p.RegisterEventHandler(func(e events.KillTraded) {
+		traded = append(traded, e)
+	})
+
+	kill := events.Kill{Killer: ct, Victim: t1}
+	trade := events.Kill{Killer: t2, Victim: ct}
+
+	p.gameState.ingameTick = 100
+	p.handleTrades(kill)
+	p.gameState.ingameTick = 228
+	p.handleTrades(trade)
+
+	assert.Equal(t, []events.KillTraded{{Kill: kill, TradeKill: trade, TimeDelta: time.Second}}, traded)
+}
+
+func TestHandleTrades_OutsideWindow(t *testing.T) {
+	p := newTradeTestParser(ParserConfig{TradeWindow: time.Second})
+
+	t1 := &common.Player{Team: common.TeamTerrorists}
+	t2 := &common.Player{Team: common.TeamTerrorists}
+	ct := &common.Player{Team: common.TeamCounterTerrorists}
+
+	p.RegisterEventHandler(func(events.KillTraded) {
+		t.Error("KillTraded should not be dispatched")
+	})
+
+	p.gameState.ingameTick = 100
+	p.handleTrades(events.Kill{Killer: ct, Victim: t1})
+	p.gameState.ingameTick = 300
+	p.handleTrades(events.Kill{Killer: t2, Victim: ct})
+
+	assert.Len(t, p.recentKills, 1)
+}
+
+func TestHandleTrades_Disabled(t *testing.T) {
+	p := newTradeTestParser(ParserConfig{TradeWindow: -1})
+
+	p.handleTrades(events.Kill{})
+
+	assert.Empty(t, p.recentKills)
+}
+
+func TestIsTrade(t *testing.T) {
+	t1 := &common.Player{Team: common.TeamTerrorists}
+	t2 := &common.Player{Team: common.TeamTerrorists}
+	ct := &common.Player{Team: common.TeamCounterTerrorists}
+
+	assert.True(t, isTrade(events.Kill{Killer: ct, Victim: t1}, events.Kill{Killer: t2, Victim: ct}))
+	assert.False(t, isTrade(events.Kill{Killer: ct, Victim: t1}, events.Kill{Killer: t2, Victim: t1}), "different victim")
+	assert.False(t, isTrade(events.Kill{Killer: t2, Victim: t1}, events.Kill{Killer: ct, Victim: t2}), "team kill")
+	assert.False(t, isTrade(events.Kill{Killer: ct, Victim: t1}, events.Kill{Killer: nil, Victim: ct}), "world damage")
+	assert.False(t, isTrade(events.Kill{Killer: nil, Victim: t1}, events.Kill{Killer: t2, Victim: ct}), "world damage")
+}
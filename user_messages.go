@@ -3,8 +3,11 @@ package demoinfocs
 import (
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/geo/r3"
 	"github.com/markus-wa/go-unassert"
 
+	"github.com/markus-wa/demoinfocs-golang/common"
 	"github.com/markus-wa/demoinfocs-golang/events"
 	"github.com/markus-wa/demoinfocs-golang/msg"
 )
@@ -39,11 +42,22 @@ func newUserMessageHandler(parser *Parser) userMessageHandler {
 	umh := userMessageHandler{parser: parser}
 
 	umh.msgTypeToHandler = map[msg.ECstrike15UserMessages]userMessageHandlerFunc{
-		msg.ECstrike15UserMessages_CS_UM_SayText:          umh.sayText,
-		msg.ECstrike15UserMessages_CS_UM_SayText2:         umh.sayText2,
-		msg.ECstrike15UserMessages_CS_UM_ServerRankUpdate: umh.rankUpdate,
+		msg.ECstrike15UserMessages_CS_UM_SayText:                      umh.sayText,
+		msg.ECstrike15UserMessages_CS_UM_SayText2:                     umh.sayText2,
+		msg.ECstrike15UserMessages_CS_UM_ServerRankUpdate:             umh.rankUpdate,
+		msg.ECstrike15UserMessages_CS_UM_RadioText:                    umh.radioText,
+		msg.ECstrike15UserMessages_CS_UM_VoteStart:                    umh.voteStart,
+		msg.ECstrike15UserMessages_CS_UM_VotePass:                     umh.votePass,
+		msg.ECstrike15UserMessages_CS_UM_VoteFailed:                   umh.voteFailed,
+		msg.ECstrike15UserMessages_CS_UM_RoundBackupFilenames:         umh.roundBackupFilenames,
+		msg.ECstrike15UserMessages_CS_UM_XpUpdate:                     umh.xpUpdate,
+		msg.ECstrike15UserMessages_CS_UM_MatchEndConditions:           umh.matchEndConditions,
+		msg.ECstrike15UserMessages_CS_UM_PlayerStatsUpdate:            umh.playerStatsUpdate,
+		msg.ECstrike15UserMessages_CS_UM_ReportHit:                    umh.reportHit,
+		msg.ECstrike15UserMessages_CS_UM_WarmupHasEnded:               umh.warmupHasEnded,
+		msg.ECstrike15UserMessages_CS_UM_SendLastKillerDamageToClient: umh.damagePrintout,
+		msg.ECstrike15UserMessages_CS_UM_EndOfMatchAllPlayersData:     umh.endOfMatchAllPlayersData,
 		// TODO: handle more user messages (if they are interesting)
-		// Maybe msg.ECstrike15UserMessages_CS_UM_RadioText
 	}
 
 	return umh
@@ -126,3 +140,233 @@ func (umh userMessageHandler) rankUpdate(um *msg.CSVCMsg_UserMessage) {
 		})
 	}
 }
+
+// unmarshal decodes the data of a user message.
+// Dispatches a ParserWarn and returns false if the data is corrupt.
+func (umh userMessageHandler) unmarshal(um *msg.CSVCMsg_UserMessage, m proto.Message) bool {
+	err := proto.Unmarshal(um.MsgData, m)
+	if err != nil {
+		name := msg.ECstrike15UserMessages(um.MsgType).String()
+		umh.dispatch(events.ParserWarn{Message: fmt.Sprintf("failed to decode %s message: %s", name, err.Error())})
+		unassert.Error("failed to decode %s message: %s", name, err.Error())
+
+		return false
+	}
+
+	return true
+}
+
+// voteTeam translates the team of a vote message, -1 is used for votes of all players.
+func voteTeam(team int32) common.Team {
+	if team < 0 {
+		return common.TeamUnassigned
+	}
+
+	return common.Team(team)
+}
+
+func (umh userMessageHandler) radioText(um *msg.CSVCMsg_UserMessage) {
+	rt := new(msg.CCSUsrMsg_RadioText)
+	if !umh.unmarshal(um, rt) {
+		return
+	}
+
+	umh.dispatch(events.RadioText{
+		Player:  umh.gameState().playersByEntityID[int(rt.Client)],
+		MsgName: rt.MsgName,
+		Params:  rt.Params,
+	})
+}
+
+func (umh userMessageHandler) voteStart(um *msg.CSVCMsg_UserMessage) {
+	vs := new(msg.CCSUsrMsg_VoteStart)
+	if !umh.unmarshal(um, vs) {
+		return
+	}
+
+	event := events.VoteStart{
+		Team:          voteTeam(vs.Team),
+		Caller:        umh.gameState().playersByEntityID[int(vs.EntIdx)],
+		Target:        umh.gameState().playersByEntityID[int(vs.EntidxTarget)],
+		Type:          common.VoteType(vs.VoteType),
+		DisplayString: vs.DispStr,
+		Details:       vs.DetailsStr,
+		OtherTeamStr:  vs.OtherTeamStr,
+		IsYesNoVote:   vs.IsYesNoVote,
+	}
+
+	umh.dispatch(event)
+	umh.parser.startVote(event)
+}
+
+func (umh userMessageHandler) votePass(um *msg.CSVCMsg_UserMessage) {
+	vp := new(msg.CCSUsrMsg_VotePass)
+	if !umh.unmarshal(um, vp) {
+		return
+	}
+
+	umh.dispatch(events.VotePass{
+		Team:          voteTeam(vp.Team),
+		Type:          common.VoteType(vp.VoteType),
+		DisplayString: vp.DispStr,
+		Details:       vp.DetailsStr,
+	})
+	umh.parser.endVote(true)
+}
+
+func (umh userMessageHandler) voteFailed(um *msg.CSVCMsg_UserMessage) {
+	vf := new(msg.CCSUsrMsg_VoteFailed)
+	if !umh.unmarshal(um, vf) {
+		return
+	}
+
+	umh.dispatch(events.VoteFailed{
+		Team:   voteTeam(vf.Team),
+		Reason: int(vf.Reason),
+	})
+	umh.parser.endVote(false)
+}
+
+func (umh userMessageHandler) roundBackupFilenames(um *msg.CSVCMsg_UserMessage) {
+	rbf := new(msg.CCSUsrMsg_RoundBackupFilenames)
+	if !umh.unmarshal(um, rbf) {
+		return
+	}
+
+	umh.dispatch(events.RoundBackupFilenames{
+		Count:    int(rbf.Count),
+		Index:    int(rbf.Index),
+		Filename: rbf.Filename,
+		NiceName: rbf.Nicename,
+	})
+}
+
+func (umh userMessageHandler) xpUpdate(um *msg.CSVCMsg_UserMessage) {
+	xu := new(msg.CCSUsrMsg_XpUpdate)
+	if !umh.unmarshal(um, xu) || xu.Data == nil {
+		return
+	}
+
+	rewards := make([]events.XPReward, 0, len(xu.Data.XpProgressData))
+	for _, xp := range xu.Data.XpProgressData {
+		rewards = append(rewards, events.XPReward{
+			Points:   int(xp.XpPoints),
+			Category: int(xp.XpCategory),
+		})
+	}
+
+	umh.dispatch(events.XPUpdate{
+		SteamID32:              xu.Data.AccountId,
+		CurrentXP:              int(xu.Data.CurrentXp),
+		CurrentLevel:           int(xu.Data.CurrentLevel),
+		OperationPointsAwarded: int(xu.Data.OperationPointsAwarded),
+		Rewards:                rewards,
+	})
+}
+
+func (umh userMessageHandler) matchEndConditions(um *msg.CSVCMsg_UserMessage) {
+	mec := new(msg.CCSUsrMsg_MatchEndConditions)
+	if !umh.unmarshal(um, mec) {
+		return
+	}
+
+	umh.dispatch(events.MatchEndConditions{
+		FragLimit: int(mec.Fraglimit),
+		MaxRounds: int(mec.MpMaxrounds),
+		WinLimit:  int(mec.MpWinlimit),
+		TimeLimit: int(mec.MpTimelimit),
+	})
+}
+
+func (umh userMessageHandler) playerStatsUpdate(um *msg.CSVCMsg_UserMessage) {
+	psu := new(msg.CCSUsrMsg_PlayerStatsUpdate)
+	if !umh.unmarshal(um, psu) {
+		return
+	}
+
+	stats := make([]events.PlayerStat, 0, len(psu.Stats))
+	for _, stat := range psu.Stats {
+		stats = append(stats, events.PlayerStat{
+			Index: int(stat.Idx),
+			Delta: int(stat.Delta),
+		})
+	}
+
+	umh.dispatch(events.PlayerStatsUpdate{
+		Player: umh.gameState().playersByUserID[int(psu.UserId)],
+		Stats:  stats,
+	})
+}
+
+func (umh userMessageHandler) reportHit(um *msg.CSVCMsg_UserMessage) {
+	rh := new(msg.CCSUsrMsg_ReportHit)
+	if !umh.unmarshal(um, rh) {
+		return
+	}
+
+	umh.dispatch(events.ReportHit{
+		Position:  r3.Vector{X: float64(rh.PosX), Y: float64(rh.PosY), Z: float64(rh.PosZ)},
+		Timestamp: rh.Timestamp,
+	})
+}
+
+func (umh userMessageHandler) warmupHasEnded(um *msg.CSVCMsg_UserMessage) {
+	if !umh.unmarshal(um, new(msg.CCSUsrMsg_WarmupHasEnded)) {
+		return
+	}
+
+	umh.dispatch(events.WarmupHasEnded{})
+}
+
+func (umh userMessageHandler) damagePrintout(um *msg.CSVCMsg_UserMessage) {
+	dmg := new(msg.CCSUsrMsg_SendLastKillerDamageToClient)
+	if !umh.unmarshal(um, dmg) {
+		return
+	}
+
+	umh.dispatch(events.DamagePrintout{
+		HitsGiven:   int(dmg.NumHitsGiven),
+		DamageGiven: int(dmg.DamageGiven),
+		HitsTaken:   int(dmg.NumHitsTaken),
+		DamageTaken: int(dmg.DamageTaken),
+	})
+}
+
+func (umh userMessageHandler) endOfMatchAllPlayersData(um *msg.CSVCMsg_UserMessage) {
+	eom := new(msg.CCSUsrMsg_EndOfMatchAllPlayersData)
+	if !umh.unmarshal(um, eom) {
+		return
+	}
+
+	players := make([]events.EndOfMatchPlayerData, 0, len(eom.Allplayerdata))
+	for _, pd := range eom.Allplayerdata {
+		data := events.EndOfMatchPlayerData{
+			Player:   umh.gameState().playersByEntityID[int(pd.Entindex)],
+			EntityID: int(pd.Entindex),
+			SteamID:  int64(pd.Xuid),
+			Name:     pd.Name,
+			Team:     common.Team(pd.Teamnumber),
+			Color:    int(pd.Playercolor),
+			IsBot:    pd.Isbot,
+		}
+
+		if pd.Nomination != nil {
+			data.Accolade = &events.EndOfMatchAccolade{
+				Type:     int(pd.Nomination.Eaccolade),
+				Value:    pd.Nomination.Value,
+				Position: int(pd.Nomination.Position),
+			}
+		}
+
+		for _, item := range pd.Items {
+			data.ItemDefIDs = append(data.ItemDefIDs, int(item.Defindex))
+		}
+
+		players = append(players, data)
+	}
+
+	umh.dispatch(events.EndOfMatchAllPlayersData{
+		Players: players,
+		Scene:   int(eom.Scene),
+	})
+}
@@ -1,9 +1,11 @@
 package demoinfocs
 
 import (
+	"reflect"
 	"testing"
 
 	proto "github.com/gogo/protobuf/proto"
+	"github.com/golang/geo/r3"
 	assert "github.com/stretchr/testify/assert"
 
 	common "github.com/markus-wa/demoinfocs-golang/common"
@@ -165,3 +167,222 @@ func Test_UserMessages_SayText2_ChatMessage(t *testing.T) {
 	}
 	assert.Equal(t, expected, actual)
 }
+
+func userMessage(t *testing.T, msgType msg.ECstrike15UserMessages, m proto.Message) *msg.CSVCMsg_UserMessage {
+	data, err := proto.Marshal(m)
+	assert.Nil(t, err)
+
+	return &msg.CSVCMsg_UserMessage{
+		MsgType: int32(msgType),
+		MsgData: data,
+	}
+}
+
+// dispatchedEvent handles a user message and returns the last event of the same type as expected.
+func dispatchedEvent(t *testing.T, p *Parser, um *msg.CSVCMsg_UserMessage, expected interface{}) interface{} {
+	var actual interface{}
+	p.RegisterEventHandler(func(e interface{}) {
+		if reflect.TypeOf(e) == reflect.TypeOf(expected) {
+			actual = e
+		}
+	})
+
+	p.handleUserMessage(um)
+
+	return actual
+}
+
+func Test_UserMessages_RadioText(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+	sender := &common.Player{Name: "The Suspect"}
+	p.gameState.playersByEntityID[2] = sender
+
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_RadioText, &msg.CCSUsrMsg_RadioText{
+		Client:  2,
+		MsgName: "#Game_radio_location",
+		Params:  []string{"#ENTNAME[2]The Suspect", "BombsiteA", "#Cstrike_TitlesTXT_Enemy_Spotted"},
+	})
+
+	expected := events.RadioText{
+		Player:  sender,
+		MsgName: "#Game_radio_location",
+		Params:  []string{"#ENTNAME[2]The Suspect", "BombsiteA", "#Cstrike_TitlesTXT_Enemy_Spotted"},
+	}
+	assert.Equal(t, expected, dispatchedEvent(t, p, um, expected))
+}
+
+func Test_UserMessages_Votes(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+	caller := &common.Player{Name: "Caller"}
+	p.gameState.playersByEntityID[3] = caller
+
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_VoteStart, &msg.CCSUsrMsg_VoteStart{
+		Team:         3,
+		EntIdx:       3,
+		VoteType:     13,
+		DispStr:      "#SFUI_vote_start_timeout",
+		IsYesNoVote:  true,
+		EntidxTarget: 0,
+	})
+
+	expectedStart := events.VoteStart{
+		Team:          common.TeamCounterTerrorists,
+		Caller:        caller,
+		Type:          common.VoteTypeStartTimeout,
+		DisplayString: "#SFUI_vote_start_timeout",
+		IsYesNoVote:   true,
+	}
+	assert.Equal(t, expectedStart, dispatchedEvent(t, p, um, expectedStart))
+
+	um = userMessage(t, msg.ECstrike15UserMessages_CS_UM_VotePass, &msg.CCSUsrMsg_VotePass{
+		Team:     -1,
+		VoteType: 6,
+		DispStr:  "#SFUI_vote_passed_surrender",
+	})
+
+	expectedPass := events.VotePass{
+		Team:          common.TeamUnassigned,
+		Type:          common.VoteTypeSurrender,
+		DisplayString: "#SFUI_vote_passed_surrender",
+	}
+	assert.Equal(t, expectedPass, dispatchedEvent(t, p, um, expectedPass))
+
+	um = userMessage(t, msg.ECstrike15UserMessages_CS_UM_VoteFailed, &msg.CCSUsrMsg_VoteFailed{Team: 2, Reason: 3})
+
+	expectedFailed := events.VoteFailed{Team: common.TeamTerrorists, Reason: 3}
+	assert.Equal(t, expectedFailed, dispatchedEvent(t, p, um, expectedFailed))
+}
+
+func Test_UserMessages_RoundBackupFilenames(t *testing.T) {
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_RoundBackupFilenames, &msg.CCSUsrMsg_RoundBackupFilenames{
+		Count:    2,
+		Index:    1,
+		Filename: "backup_round05.txt",
+		Nicename: "Round 5 (3:1)",
+	})
+
+	expected := events.RoundBackupFilenames{Count: 2, Index: 1, Filename: "backup_round05.txt", NiceName: "Round 5 (3:1)"}
+	assert.Equal(t, expected, dispatchedEvent(t, NewParser(new(DevNullReader)), um, expected))
+}
+
+func Test_UserMessages_XpUpdate(t *testing.T) {
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_XpUpdate, &msg.CCSUsrMsg_XpUpdate{
+		Data: &msg.CMsgGCCstrike15V2_GC2ServerNotifyXPRewarded{
+			XpProgressData: []*msg.XpProgressData{{XpPoints: 250, XpCategory: 1}},
+			AccountId:      123,
+			CurrentXp:      1000,
+			CurrentLevel:   21,
+		},
+	})
+
+	expected := events.XPUpdate{
+		SteamID32:    123,
+		CurrentXP:    1000,
+		CurrentLevel: 21,
+		Rewards:      []events.XPReward{{Points: 250, Category: 1}},
+	}
+	assert.Equal(t, expected, dispatchedEvent(t, NewParser(new(DevNullReader)), um, expected))
+}
+
+func Test_UserMessages_MatchEndConditions(t *testing.T) {
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_MatchEndConditions, &msg.CCSUsrMsg_MatchEndConditions{
+		MpMaxrounds: 30,
+		MpWinlimit:  16,
+	})
+
+	expected := events.MatchEndConditions{MaxRounds: 30, WinLimit: 16}
+	assert.Equal(t, expected, dispatchedEvent(t, NewParser(new(DevNullReader)), um, expected))
+}
+
+func Test_UserMessages_PlayerStatsUpdate(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+	pl := &common.Player{Name: "The Suspect"}
+	p.gameState.playersByUserID[5] = pl
+
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_PlayerStatsUpdate, &msg.CCSUsrMsg_PlayerStatsUpdate{
+		UserId: 5,
+		Stats:  []*msg.CCSUsrMsg_PlayerStatsUpdate_Stat{{Idx: 1, Delta: 2}},
+	})
+
+	expected := events.PlayerStatsUpdate{Player: pl, Stats: []events.PlayerStat{{Index: 1, Delta: 2}}}
+	assert.Equal(t, expected, dispatchedEvent(t, p, um, expected))
+}
+
+func Test_UserMessages_ReportHit(t *testing.T) {
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_ReportHit, &msg.CCSUsrMsg_ReportHit{
+		PosX:      1,
+		PosY:      2,
+		PosZ:      3,
+		Timestamp: 100.5,
+	})
+
+	expected := events.ReportHit{Position: r3.Vector{X: 1, Y: 2, Z: 3}, Timestamp: 100.5}
+	assert.Equal(t, expected, dispatchedEvent(t, NewParser(new(DevNullReader)), um, expected))
+}
+
+func Test_UserMessages_WarmupHasEnded(t *testing.T) {
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_WarmupHasEnded, &msg.CCSUsrMsg_WarmupHasEnded{})
+
+	expected := events.WarmupHasEnded{}
+	assert.Equal(t, expected, dispatchedEvent(t, NewParser(new(DevNullReader)), um, expected))
+}
+
+func Test_UserMessages_DamagePrintout(t *testing.T) {
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_SendLastKillerDamageToClient, &msg.CCSUsrMsg_SendLastKillerDamageToClient{
+		NumHitsGiven: 2,
+		DamageGiven:  54,
+		NumHitsTaken: 3,
+		DamageTaken:  100,
+	})
+
+	expected := events.DamagePrintout{HitsGiven: 2, DamageGiven: 54, HitsTaken: 3, DamageTaken: 100}
+	assert.Equal(t, expected, dispatchedEvent(t, NewParser(new(DevNullReader)), um, expected))
+}
+
+func Test_UserMessages_EndOfMatchAllPlayersData(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+	pl := &common.Player{Name: "The Suspect"}
+	p.gameState.playersByEntityID[3] = pl
+
+	um := userMessage(t, msg.ECstrike15UserMessages_CS_UM_EndOfMatchAllPlayersData, &msg.CCSUsrMsg_EndOfMatchAllPlayersData{
+		Allplayerdata: []*msg.CCSUsrMsg_EndOfMatchAllPlayersData_PlayerData{
+			{
+				Entindex:    3,
+				Xuid:        76561198000000000,
+				Name:        "The Suspect",
+				Teamnumber:  2,
+				Nomination:  &msg.CCSUsrMsg_EndOfMatchAllPlayersData_Accolade{Eaccolade: 1, Value: 25, Position: 1},
+				Items:       []*msg.CEconItemPreviewDataBlock{{Defindex: 7}},
+				Playercolor: 4,
+			},
+			{
+				Entindex: 4,
+				Name:     "Bot",
+				Isbot:    true,
+			},
+		},
+		Scene: 1,
+	})
+
+	expected := events.EndOfMatchAllPlayersData{
+		Players: []events.EndOfMatchPlayerData{
+			{
+				Player:     pl,
+				EntityID:   3,
+				SteamID:    76561198000000000,
+				Name:       "The Suspect",
+				Team:       common.TeamTerrorists,
+				Accolade:   &events.EndOfMatchAccolade{Type: 1, Value: 25, Position: 1},
+				ItemDefIDs: []int{7},
+				Color:      4,
+			},
+			{
+				EntityID: 4,
+				Name:     "Bot",
+				IsBot:    true,
+			},
+		},
+		Scene: 1,
+	}
+	assert.Equal(t, expected, dispatchedEvent(t, p, um, expected))
+}
@@ -0,0 +1,89 @@
+package demoinfocs
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/bitread"
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+const weaponSubTypeBits = 6
+
+// parseUserCommand reads a user command frame, these are only available in POV demos.
+func (p *Parser) parseUserCommand() {
+	sequence := p.bitReader.ReadSignedInt(32)
+	size := p.bitReader.ReadSignedInt(32)
+
+	p.bitReader.BeginChunk(size << 3)
+	cmd := readUserCommand(p.bitReader)
+	p.bitReader.EndChunk()
+
+	cmd.Sequence = sequence
+
+	p.msgQueue <- cmd
+}
+
+func (p *Parser) handleUserCommand(cmd events.UserCommand) {
+	p.eventDispatcher.Dispatch(cmd)
+}
+
+// readUserCommand reads a delta encoded CUserCmd (see ReadUsercmd() in usercmd.cpp of the Source SDK).
+// Commands in demos are always encoded relative to an empty command.
+func readUserCommand(r *bitread.BitReader) events.UserCommand {
+	var cmd events.UserCommand
+
+	cmd.CommandNumber = 1
+	if r.ReadBit() {
+		cmd.CommandNumber = int(r.ReadInt(32))
+	}
+
+	cmd.TickCount = 1
+	if r.ReadBit() {
+		cmd.TickCount = int(r.ReadInt(32))
+	}
+
+	cmd.ViewAngles.X = readOptionalFloat(r)
+	cmd.ViewAngles.Y = readOptionalFloat(r)
+	cmd.ViewAngles.Z = readOptionalFloat(r)
+
+	cmd.AimDirection.X = readOptionalFloat(r)
+	cmd.AimDirection.Y = readOptionalFloat(r)
+	cmd.AimDirection.Z = readOptionalFloat(r)
+
+	cmd.ForwardMove = float32(readOptionalFloat(r))
+	cmd.SideMove = float32(readOptionalFloat(r))
+	cmd.UpMove = float32(readOptionalFloat(r))
+
+	if r.ReadBit() {
+		cmd.Buttons = common.Buttons(r.ReadInt(32))
+	}
+
+	if r.ReadBit() {
+		cmd.Impulse = int(r.ReadInt(8))
+	}
+
+	if r.ReadBit() {
+		cmd.WeaponSelect = int(r.ReadInt(maxEdictBits))
+
+		if r.ReadBit() {
+			cmd.WeaponSubType = int(r.ReadInt(weaponSubTypeBits))
+		}
+	}
+
+	if r.ReadBit() {
+		cmd.MouseDX = r.ReadSignedInt(16)
+	}
+
+	if r.ReadBit() {
+		cmd.MouseDY = r.ReadSignedInt(16)
+	}
+
+	return cmd
+}
+
+func readOptionalFloat(r *bitread.BitReader) float64 {
+	if !r.ReadBit() {
+		return 0
+	}
+
+	return float64(r.ReadFloat())
+}
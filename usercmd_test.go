@@ -0,0 +1,85 @@
+package demoinfocs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// userCommandData returns a delta encoded user command with view angles, buttons, a weapon switch & mouse movement.
+func userCommandData() []byte {
+	w := new(bitWriter)
+
+	w.bit(true)
+	w.bits(1337, 32) // Command number
+	w.bit(false)     // Tick count
+
+	w.bit(true)
+	w.bits(uint64(math.Float32bits(-10.5)), 32) // Pitch
+	w.bit(true)
+	w.bits(uint64(math.Float32bits(90)), 32) // Yaw
+	w.bit(false)                             // Roll
+
+	w.bits(0, 3) // Aim direction
+
+	w.bit(true)
+	w.bits(uint64(math.Float32bits(450)), 32) // Forward move
+	w.bit(false)                              // Side move
+	w.bit(false)                              // Up move
+
+	w.bit(true)
+	w.bits(uint64(common.ButtonAttack|common.ButtonDuck), 32)
+
+	w.bit(false) // Impulse
+
+	w.bit(true)
+	w.bits(42, 11) // Weapon select
+	w.bit(true)
+	w.bits(3, 6) // Weapon sub-type
+
+	w.bit(true)
+	w.bits(0xfffb, 16) // Mouse dx (-5)
+	w.bit(true)
+	w.bits(7, 16) // Mouse dy
+
+	return w.buf
+}
+
+func TestParseUserCommand(t *testing.T) {
+	cmd := userCommandData()
+
+	d := newFakeDemo(fakeDemoHeader()).syncTicks(0, 1)
+	d.frame(dcUserCommand, 1, int32Bytes(99), int32Bytes(len(cmd)), cmd)
+	d.syncTicks(2, 2).stop(2)
+
+	p := NewParserWithConfig(d.reader(), ParserConfig{MsgQueueBufferSize: -1})
+
+	var actual []events.UserCommand
+	p.RegisterEventHandler(func(e events.UserCommand) {
+		actual = append(actual, e)
+	})
+
+	err := p.ParseToEnd()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, p.GameState().IngameTick(), "parsing should continue after the user command")
+	assert.Equal(t, []events.UserCommand{{
+		Sequence:      99,
+		CommandNumber: 1337,
+		TickCount:     1,
+		ViewAngles:    r3.Vector{X: -10.5, Y: 90},
+		ForwardMove:   450,
+		Buttons:       common.ButtonAttack | common.ButtonDuck,
+		WeaponSelect:  42,
+		WeaponSubType: 3,
+		MouseDX:       -5,
+		MouseDY:       7,
+	}}, actual)
+	assert.True(t, actual[0].Buttons.Has(common.ButtonAttack))
+	assert.False(t, actual[0].Buttons.Has(common.ButtonAttack|common.ButtonJump))
+}
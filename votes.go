@@ -0,0 +1,65 @@
+package demoinfocs
+
+import (
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+)
+
+// startVote replaces the active vote with a new one and dispatches events.VoteStarted.
+func (p *Parser) startVote(vs events.VoteStart) {
+	vote := &common.Vote{
+		Issuer:    vs.Caller,
+		Issue:     vs.Type,
+		Target:    vs.Target,
+		Team:      vs.Team,
+		Details:   vs.Details,
+		StartTick: p.gameState.ingameTick,
+		Votes:     make(map[*common.Player]common.VoteOption),
+	}
+
+	p.gameState.activeVote = vote
+
+	p.eventDispatcher.Dispatch(events.VoteStarted{
+		Issuer: vote.Issuer,
+		Issue:  vote.Issue,
+		Target: vote.Target,
+		Vote:   vote,
+	})
+}
+
+// castVote records a player's vote for the active vote and dispatches events.VoteCast.
+// Votes without an active vote (e.g. if the recording started during a vote) are ignored.
+func (p *Parser) castVote(pl *common.Player, option common.VoteOption) {
+	vote := p.gameState.activeVote
+	if vote == nil {
+		return
+	}
+
+	// Unknown players can't be counted
+	if pl != nil {
+		vote.Votes[pl] = option
+	}
+
+	p.eventDispatcher.Dispatch(events.VoteCast{
+		Player: pl,
+		Option: option,
+		Vote:   vote,
+	})
+}
+
+// endVote dispatches events.VoteEnded for the active vote and clears it.
+func (p *Parser) endVote(passed bool) {
+	vote := p.gameState.activeVote
+	if vote == nil {
+		return
+	}
+
+	p.gameState.activeVote = nil
+
+	p.eventDispatcher.Dispatch(events.VoteEnded{
+		Passed: passed,
+		Yes:    vote.Yes(),
+		No:     vote.No(),
+		Vote:   vote,
+	})
+}
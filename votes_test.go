@@ -0,0 +1,109 @@
+package demoinfocs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/markus-wa/demoinfocs-golang/common"
+	"github.com/markus-wa/demoinfocs-golang/events"
+	"github.com/markus-wa/demoinfocs-golang/msg"
+)
+
+func voteCastData(entityID int, option common.VoteOption) map[string]*msg.CSVCMsg_GameEventKeyT {
+	return map[string]*msg.CSVCMsg_GameEventKeyT{
+		"entityid":    {ValLong: int32(entityID)},
+		"vote_option": {ValByte: int32(option)},
+		"team":        {ValShort: 3},
+	}
+}
+
+func TestVotes(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+	p.gameState.ingameTick = 100
+
+	issuer := &common.Player{Name: "Issuer", EntityID: 1}
+	other := &common.Player{Name: "Other", EntityID: 2}
+	p.gameState.playersByEntityID[1] = issuer
+	p.gameState.playersByEntityID[2] = other
+
+	var (
+		started []events.VoteStarted
+		cast    []events.VoteCast
+		ended   []events.VoteEnded
+	)
+	p.RegisterEventHandler(func(e events.VoteStarted) {
+		started = append(started, e)
+	})
+	p.RegisterEventHandler(func(e events.VoteCast) {
+		cast = append(cast, e)
+	})
+	p.RegisterEventHandler(func(e events.VoteEnded) {
+		ended = append(ended, e)
+	})
+
+	p.handleUserMessage(userMessage(t, msg.ECstrike15UserMessages_CS_UM_VoteStart, &msg.CCSUsrMsg_VoteStart{
+		Team:        3,
+		EntIdx:      1,
+		VoteType:    int32(common.VoteTypeStartTimeout),
+		IsYesNoVote: true,
+	}))
+
+	vote := p.GameState().ActiveVote()
+	assert.Equal(t, &common.Vote{
+		Issuer:    issuer,
+		Issue:     common.VoteTypeStartTimeout,
+		Team:      common.TeamCounterTerrorists,
+		StartTick: 100,
+		Votes:     map[*common.Player]common.VoteOption{},
+	}, vote)
+	assert.Equal(t, []events.VoteStarted{{Issuer: issuer, Issue: common.VoteTypeStartTimeout, Vote: vote}}, started)
+
+	p.gameEventHandler.voteCast(voteCastData(1, common.VoteOptionYes))
+	p.gameEventHandler.voteCast(voteCastData(2, common.VoteOptionNo))
+	p.gameEventHandler.voteCast(voteCastData(3, common.VoteOptionYes)) // Unknown player
+
+	assert.Equal(t, []events.VoteCast{
+		{Player: issuer, Option: common.VoteOptionYes, Vote: vote},
+		{Player: other, Option: common.VoteOptionNo, Vote: vote},
+		{Option: common.VoteOptionYes, Vote: vote},
+	}, cast)
+	assert.Equal(t, 1, vote.Yes())
+	assert.Equal(t, 1, vote.No())
+
+	p.handleUserMessage(userMessage(t, msg.ECstrike15UserMessages_CS_UM_VoteFailed, &msg.CCSUsrMsg_VoteFailed{Team: 3, Reason: 3}))
+
+	assert.Equal(t, []events.VoteEnded{{Passed: false, Yes: 1, No: 1, Vote: vote}}, ended)
+	assert.Nil(t, p.GameState().ActiveVote())
+}
+
+func TestVotes_Passed(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+
+	var ended []events.VoteEnded
+	p.RegisterEventHandler(func(e events.VoteEnded) {
+		ended = append(ended, e)
+	})
+
+	p.handleUserMessage(userMessage(t, msg.ECstrike15UserMessages_CS_UM_VoteStart, &msg.CCSUsrMsg_VoteStart{Team: -1, VoteType: int32(common.VoteTypeSurrender)}))
+	vote := p.GameState().ActiveVote()
+	p.handleUserMessage(userMessage(t, msg.ECstrike15UserMessages_CS_UM_VotePass, &msg.CCSUsrMsg_VotePass{Team: -1, VoteType: int32(common.VoteTypeSurrender)}))
+
+	assert.Equal(t, []events.VoteEnded{{Passed: true, Vote: vote}}, ended)
+	assert.Nil(t, p.GameState().ActiveVote())
+}
+
+func TestVotes_NoActiveVote(t *testing.T) {
+	p := NewParser(new(DevNullReader))
+	p.gameState.playersByEntityID[1] = new(common.Player)
+
+	p.RegisterEventHandler(func(events.VoteCast) {
+		t.Error("expected no VoteCast event without an active vote")
+	})
+	p.RegisterEventHandler(func(events.VoteEnded) {
+		t.Error("expected no VoteEnded event without an active vote")
+	})
+
+	p.gameEventHandler.voteCast(voteCastData(1, common.VoteOptionYes))
+	p.handleUserMessage(userMessage(t, msg.ECstrike15UserMessages_CS_UM_VotePass, &msg.CCSUsrMsg_VotePass{}))
+}